@@ -0,0 +1,51 @@
+package addrs
+
+import (
+	"fmt"
+)
+
+// Check is the address of a "check" block, in the context of the module that
+// is defining it.
+//
+// Checks cannot be referenced from expressions, so Check is not Referenceable.
+type Check struct {
+	Name string
+}
+
+func (c Check) String() string {
+	return "check." + c.Name
+}
+
+// Absolute converts the receiver into an absolute address within the given
+// module instance.
+func (c Check) Absolute(m ModuleInstance) AbsCheck {
+	return AbsCheck{
+		Module: m,
+		Check:  c,
+	}
+}
+
+// AbsCheck is the absolute address of a "check" block within a module
+// instance.
+type AbsCheck struct {
+	Module ModuleInstance
+	Check  Check
+}
+
+// Check returns the absolute address of the "check" block of the given
+// name within the receiving module instance.
+func (m ModuleInstance) Check(name string) AbsCheck {
+	return AbsCheck{
+		Module: m,
+		Check: Check{
+			Name: name,
+		},
+	}
+}
+
+func (c AbsCheck) String() string {
+	if c.Module.IsRoot() {
+		return c.Check.String()
+	}
+	return fmt.Sprintf("%s.%s", c.Module.String(), c.Check.String())
+}
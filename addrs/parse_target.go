@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/hcl/v2/hclsyntax"
 
 	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/terraform/helper/didyoumean"
 	"github.com/hashicorp/terraform/tfdiags"
 )
 
@@ -39,6 +40,27 @@ func ParseTarget(traversal hcl.Traversal) (*Target, tfdiags.Diagnostics) {
 		}, diags
 	}
 
+	if remain.RootName() == "provider" {
+		// Provider configuration addresses are also targetable, for the
+		// benefit of commands that operate on provider configurations rather
+		// than resources. We accept both the modern form with the
+		// fully-qualified provider name in brackets and the legacy form with
+		// a bare type name, since user input tends to use the latter.
+		pc, pcDiags := ParseAbsProviderConfig(traversal)
+		if pcDiags.HasErrors() {
+			var legacyDiags tfdiags.Diagnostics
+			pc, legacyDiags = ParseLegacyAbsProviderConfig(traversal)
+			if legacyDiags.HasErrors() {
+				diags = diags.Append(pcDiags)
+				return nil, diags
+			}
+		}
+		return &Target{
+			Subject:     pc,
+			SourceRange: rng,
+		}, diags
+	}
+
 	mode := ManagedResourceMode
 	if remain.RootName() == "data" {
 		mode = DataResourceMode
@@ -169,6 +191,38 @@ func ParseTargetStr(str string) (*Target, tfdiags.Diagnostics) {
 	return target, diags
 }
 
+// ParseTargetStrWithSuggestion is a variant of ParseTargetStr for callers
+// that have a set of known targetable addresses available, such as those in
+// the current configuration or state. If parsing fails and one of the known
+// addresses is similar to the given string then the returned diagnostics
+// additionally include a did-you-mean hint naming it.
+func ParseTargetStrWithSuggestion(str string, known []Targetable) (*Target, tfdiags.Diagnostics) {
+	target, diags := ParseTargetStr(str)
+	if diags.HasErrors() {
+		if suggestion := SuggestTarget(str, known); suggestion != "" {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Invalid target address",
+				fmt.Sprintf("Terraform could not parse %q as a target address. Did you mean %q?", str, suggestion),
+			))
+		}
+	}
+	return target, diags
+}
+
+// SuggestTarget returns the string representation of a known targetable
+// address that is similar to the given (possibly mistyped) address string,
+// or an empty string if none of the known addresses is similar enough.
+// Callers that have the current configuration or state available can use
+// this to enrich "not found" diagnostics with a did-you-mean hint.
+func SuggestTarget(given string, known []Targetable) string {
+	names := make([]string, len(known))
+	for i, addr := range known {
+		names[i] = addr.String()
+	}
+	return didyoumean.NameSuggestion(given, names)
+}
+
 // ParseAbsResource attempts to interpret the given traversal as an absolute
 // resource address, using the same syntax as expected by ParseTarget.
 //
@@ -1,6 +1,7 @@
 package addrs
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/go-test/deep"
@@ -304,6 +305,54 @@ func TestParseTarget(t *testing.T) {
 			nil,
 			`Unexpected extra operators after address.`,
 		},
+		{
+			`provider["registry.terraform.io/hashicorp/aws"]`,
+			&Target{
+				Subject: AbsProviderConfig{
+					Module:   RootModuleInstance,
+					Provider: NewDefaultProvider("aws"),
+				},
+				SourceRange: tfdiags.SourceRange{
+					Start: tfdiags.SourcePos{Line: 1, Column: 1, Byte: 0},
+					End:   tfdiags.SourcePos{Line: 1, Column: 48, Byte: 47},
+				},
+			},
+			``,
+		},
+		{
+			`provider.aws.foo`,
+			&Target{
+				Subject: AbsProviderConfig{
+					Module:   RootModuleInstance,
+					Provider: NewLegacyProvider("aws"),
+					Alias:    "foo",
+				},
+				SourceRange: tfdiags.SourceRange{
+					Start: tfdiags.SourcePos{Line: 1, Column: 1, Byte: 0},
+					End:   tfdiags.SourcePos{Line: 1, Column: 17, Byte: 16},
+				},
+			},
+			``,
+		},
+		{
+			`module.bar.provider["registry.terraform.io/hashicorp/aws"].foo`,
+			&Target{
+				Subject: AbsProviderConfig{
+					Module: ModuleInstance{
+						{
+							Name: "bar",
+						},
+					},
+					Provider: NewDefaultProvider("aws"),
+					Alias:    "foo",
+				},
+				SourceRange: tfdiags.SourceRange{
+					Start: tfdiags.SourcePos{Line: 1, Column: 1, Byte: 0},
+					End:   tfdiags.SourcePos{Line: 1, Column: 63, Byte: 62},
+				},
+			},
+			``,
+		},
 	}
 
 	for _, test := range tests {
@@ -341,3 +390,40 @@ func TestParseTarget(t *testing.T) {
 		})
 	}
 }
+
+func TestParseTargetStrWithSuggestion(t *testing.T) {
+	known := []Targetable{
+		RootModuleInstance.Resource(ManagedResourceMode, "aws_instance", "foo"),
+		RootModuleInstance.Child("child", NoKey),
+	}
+
+	// A valid address parses as normal, with no extra diagnostics.
+	target, diags := ParseTargetStrWithSuggestion(`aws_instance.foo`, known)
+	if diags.HasErrors() {
+		t.Fatalf("unexpected diagnostics: %s", diags.Err())
+	}
+	if got, want := target.Subject.String(), "aws_instance.foo"; got != want {
+		t.Errorf("wrong subject %s; want %s", got, want)
+	}
+
+	// An invalid address that is close to a known one gets a hint.
+	_, diags = ParseTargetStrWithSuggestion(`aws_instance,foo`, known)
+	if !diags.HasErrors() {
+		t.Fatal("succeeded; want error")
+	}
+	if got, want := diags.Err().Error(), `Did you mean "aws_instance.foo"?`; !strings.Contains(got, want) {
+		t.Errorf("missing did-you-mean hint\ngot: %s\nwant substring: %s", got, want)
+	}
+}
+
+func TestSuggestTarget(t *testing.T) {
+	known := []Targetable{
+		RootModuleInstance.Resource(ManagedResourceMode, "aws_instance", "foo"),
+	}
+	if got, want := SuggestTarget("aws_instnace.foo", known), "aws_instance.foo"; got != want {
+		t.Errorf("wrong suggestion %q; want %q", got, want)
+	}
+	if got := SuggestTarget("something_else.bar", known); got != "" {
+		t.Errorf("unexpected suggestion %q; want none", got)
+	}
+}
@@ -92,6 +92,26 @@ type AbsProviderConfig struct {
 }
 
 var _ ProviderConfig = AbsProviderConfig{}
+var _ Targetable = AbsProviderConfig{}
+
+func (pc AbsProviderConfig) targetableSigil() {
+	// AbsProviderConfig is targetable. We don't embed the targetable
+	// helper type here because AbsProviderConfig values appear in various
+	// comparable data structures where an unexported field would be
+	// problematic.
+}
+
+// TargetContains implements Targetable by returning true only if the given
+// other address is an identical provider configuration address, since a
+// provider configuration does not contain any other targetable addresses.
+func (pc AbsProviderConfig) TargetContains(other Targetable) bool {
+	switch to := other.(type) {
+	case AbsProviderConfig:
+		return pc.Module.Equal(to.Module) && pc.Provider == to.Provider && pc.Alias == to.Alias
+	default:
+		return false
+	}
+}
 
 // ParseAbsProviderConfig parses the given traversal as an absolute provider
 // address. The following are examples of traversals that can be successfully
@@ -50,6 +50,15 @@ func (r Resource) Absolute(module ModuleInstance) AbsResource {
 	}
 }
 
+// InModule returns a ConfigResource from the receiver and the given module
+// address.
+func (r Resource) InModule(module Module) ConfigResource {
+	return ConfigResource{
+		Module:   module,
+		Resource: r,
+	}
+}
+
 // DefaultProvider returns the address of the provider whose default
 // configuration shouldbe used for the resource identified by the reciever if
 // it does not have a provider configuration address explicitly set in
@@ -165,6 +174,64 @@ func (r AbsResource) Equal(o AbsResource) bool {
 	return r.String() == o.String()
 }
 
+// Config returns the unexpanded ConfigResource for this AbsResource.
+func (r AbsResource) Config() ConfigResource {
+	return ConfigResource{
+		Module:   r.Module.Module(),
+		Resource: r.Resource,
+	}
+}
+
+// ConfigResource is an address for a resource within a configuration.
+//
+// This is the static counterpart of AbsResource: it identifies a "resource"
+// block in a particular module of the configuration without regard to which
+// instances of that module might be created by "count" or "for_each"
+// arguments on the module call. It is therefore suitable for contexts, such
+// as the dependency addresses stored in the state, where addressing any
+// instance of a module must imply addressing all of its instances.
+type ConfigResource struct {
+	targetable
+	Module   Module
+	Resource Resource
+}
+
+// Absolute produces the address for the receiver within a specific module
+// instance.
+func (r ConfigResource) Absolute(module ModuleInstance) AbsResource {
+	return AbsResource{
+		Module:   module,
+		Resource: r.Resource,
+	}
+}
+
+// TargetContains implements Targetable by returning true if the given other
+// address is either equal to the receiver or is a module-instance-qualified
+// or instance-keyed form of it.
+func (r ConfigResource) TargetContains(other Targetable) bool {
+	switch to := other.(type) {
+	case ConfigResource:
+		return r.Equal(to)
+	case AbsResource:
+		return r.TargetContains(to.Config())
+	case AbsResourceInstance:
+		return r.TargetContains(to.ContainingResource())
+	default:
+		return false
+	}
+}
+
+func (r ConfigResource) String() string {
+	if len(r.Module) == 0 {
+		return r.Resource.String()
+	}
+	return fmt.Sprintf("%s.%s", r.Module.String(), r.Resource.String())
+}
+
+func (r ConfigResource) Equal(o ConfigResource) bool {
+	return r.String() == o.String()
+}
+
 // AbsResourceInstance is an absolute address for a resource instance under a
 // given module path.
 type AbsResourceInstance struct {
@@ -0,0 +1,14 @@
+package addrs
+
+// StateReference is the address of a federated state mounted read-only into
+// the expression scope, addressed as "state.<name>". The object it refers
+// to exposes the mounted state's root module output values under an
+// "outputs" attribute, e.g. "state.networking.outputs.vpc_id".
+type StateReference struct {
+	referenceable
+	Name string
+}
+
+func (r StateReference) String() string {
+	return "state." + r.Name
+}
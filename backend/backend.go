@@ -6,15 +6,18 @@ package backend
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"time"
 
 	"github.com/hashicorp/terraform/addrs"
 	"github.com/hashicorp/terraform/command/clistate"
+	viewsjson "github.com/hashicorp/terraform/command/views/json"
 	"github.com/hashicorp/terraform/configs"
 	"github.com/hashicorp/terraform/configs/configload"
 	"github.com/hashicorp/terraform/configs/configschema"
 	"github.com/hashicorp/terraform/plans"
+	"github.com/hashicorp/terraform/plans/ownership"
 	"github.com/hashicorp/terraform/plans/planfile"
 	"github.com/hashicorp/terraform/states"
 	"github.com/hashicorp/terraform/states/statemgr"
@@ -146,6 +149,22 @@ type Local interface {
 	Context(*Operation) (*terraform.Context, statemgr.Full, tfdiags.Diagnostics)
 }
 
+// WorkspaceMetaStore is an optional interface for backends that can store
+// a small metadata document describing each workspace alongside its state.
+//
+// Callers must check whether a backend supports this interface using a
+// type assertion, and should treat a backend that doesn't support it as if
+// every workspace simply has no metadata.
+type WorkspaceMetaStore interface {
+	// WorkspaceMeta returns the metadata document for the given workspace,
+	// or nil if no metadata has been recorded for it.
+	WorkspaceMeta(name string) (*statemgr.WorkspaceMeta, error)
+
+	// SetWorkspaceMeta replaces the metadata document for the given
+	// workspace with the one given.
+	SetWorkspaceMeta(name string, meta *statemgr.WorkspaceMeta) error
+}
+
 // An operation represents an operation for Terraform to execute.
 //
 // Note that not all fields are supported by all backends and can result
@@ -175,6 +194,19 @@ type Operation struct {
 	PlanOutPath    string // PlanOutPath is the path to save the plan
 	PlanOutBackend *plans.Backend
 
+	// PlanRefreshOnly, for a plan operation, requests a drift detection
+	// report instead of a normal plan: all resource instances are
+	// refreshed and compared against the prior state, without computing
+	// any config-driven changes.
+	PlanRefreshOnly bool
+
+	// PlanCache, for a plan operation, permits the backend to retain the
+	// resulting plan and to reuse the results of a previously retained
+	// plan for resource instances whose planning inputs (configuration,
+	// prior state, and provider) are unchanged since that plan was
+	// created. Backends with no way to retain a previous plan ignore this.
+	PlanCache bool
+
 	// ConfigDir is the path to the directory containing the configuration's
 	// root module.
 	ConfigDir string
@@ -187,6 +219,18 @@ type Operation struct {
 	// plan and apply arguments but may not work for all backends.
 	PlanFile *planfile.Reader
 
+	// Resume requests that an apply operation continue a previous apply
+	// that failed partway through, using the backend's saved record of the
+	// changes that were not yet applied. Not all backends support this.
+	Resume bool
+
+	// AllowEphemeralVariables permits variable values given for an apply of
+	// a saved plan to differ from the values that were recorded in the plan
+	// file, overriding the recorded values. Without this, any differing
+	// value is rejected so that the apply is guaranteed to match what was
+	// shown when the plan was created.
+	AllowEphemeralVariables bool
+
 	// The options below are more self-explanatory and affect the runtime
 	// behavior of the operation.
 	AutoApprove  bool
@@ -194,6 +238,7 @@ type Operation struct {
 	DestroyForce bool
 	Parallelism  int
 	Targets      []addrs.Targetable
+	Excludes     []addrs.Targetable
 	Variables    map[string]UnparsedVariableValue
 
 	// Some operations use root module variables only opportunistically or
@@ -210,6 +255,17 @@ type Operation struct {
 	UIIn  terraform.UIInput
 	UIOut terraform.UIOutput
 
+	// JSONUIStream, if non-nil, receives machine-readable events describing
+	// the operation's progress, for the benefit of wrapping programs that
+	// render their own UI from the event stream.
+	JSONUIStream *viewsjson.Stream
+
+	// OwnershipPolicy, if non-nil, is consulted after planning to check
+	// that all planned changes are within the scope owned by OwnershipTeam,
+	// producing diagnostics for any changes outside that scope.
+	OwnershipPolicy *ownership.Policy
+	OwnershipTeam   string
+
 	// If LockState is true, the Operation must Lock any
 	// state.Lockers for its duration, and Unlock when complete.
 	LockState bool
@@ -269,12 +325,65 @@ type RunningOperation struct {
 	// to note whether a plan is empty or has changes.
 	PlanEmpty bool
 
+	// ApplyResult is a structured summary of an Apply operation, populated
+	// once the operation completes without error. It is nil for other
+	// operation types and for applies that failed.
+	ApplyResult *ApplyResult
+
 	// State is the final state after the operation completed. Persisting
 	// this state is managed by the backend. This should only be read
 	// after the operation completes to avoid read/write races.
 	State *states.State
 }
 
+// ApplyResult is a machine-readable summary of a completed apply, covering
+// the overall resource counts, the wall-clock duration of the operation,
+// each resource instance that was acted on, and the final root module
+// output values. The struct is JSON-serializable so that callers can emit
+// it directly, as "terraform apply -json" does.
+type ApplyResult struct {
+	// Added, Changed and Destroyed are the number of resource instances
+	// that were created, updated and deleted respectively.
+	Added     int `json:"added"`
+	Changed   int `json:"changed"`
+	Destroyed int `json:"destroyed"`
+
+	// DurationSeconds is the wall-clock duration of the apply itself, not
+	// including any refresh or planning that preceded it.
+	DurationSeconds float64 `json:"duration_seconds"`
+
+	// Resources describes each resource instance the apply acted on, in
+	// address order.
+	Resources []ApplyResourceResult `json:"resources"`
+
+	// Outputs are the root module output values as they stand after the
+	// apply, keyed by output name.
+	Outputs map[string]ApplyOutputResult `json:"outputs"`
+}
+
+// ApplyResourceResult describes the application of a single resource
+// instance change within an ApplyResult.
+type ApplyResourceResult struct {
+	// Address is the absolute resource instance address.
+	Address string `json:"address"`
+
+	// Action is the action that was applied, using the same vocabulary as
+	// plans.Action.String: "Create", "Update", "Delete", etc.
+	Action string `json:"action"`
+
+	// DurationSeconds is how long the provider took to apply the change.
+	DurationSeconds float64 `json:"duration_seconds"`
+}
+
+// ApplyOutputResult describes one root module output value within an
+// ApplyResult. The value and its type are serialized in the same way as
+// for "terraform output -json".
+type ApplyOutputResult struct {
+	Value     json.RawMessage `json:"value"`
+	Type      json.RawMessage `json:"type"`
+	Sensitive bool            `json:"sensitive,omitempty"`
+}
+
 // OperationResult describes the result status of an operation.
 type OperationResult int
 
@@ -63,6 +63,11 @@ type CLIOpts struct {
 	StateOutPath    string
 	StateBackupPath string
 
+	// DataDir is the working directory's data directory, under which
+	// backends that keep ancillary working-directory-local files (such as
+	// the local backend's state snapshot history) should root them.
+	DataDir string
+
 	// ContextOpts are the base context options to set when initializing a
 	// Terraform context. Many of these will be overridden or merged by
 	// Operation. See Operation for more details.
@@ -26,6 +26,7 @@ import (
 	backendOSS "github.com/hashicorp/terraform/backend/remote-state/oss"
 	backendPg "github.com/hashicorp/terraform/backend/remote-state/pg"
 	backendS3 "github.com/hashicorp/terraform/backend/remote-state/s3"
+	backendS3Compat "github.com/hashicorp/terraform/backend/remote-state/s3compat"
 	backendSwift "github.com/hashicorp/terraform/backend/remote-state/swift"
 )
 
@@ -68,6 +69,7 @@ func Init(services *disco.Disco) {
 		"oss":         func() backend.Backend { return backendOSS.New() },
 		"pg":          func() backend.Backend { return backendPg.New() },
 		"s3":          func() backend.Backend { return backendS3.New() },
+		"s3compat":    func() backend.Backend { return backendS3Compat.New() },
 		"swift":       func() backend.Backend { return backendSwift.New() },
 
 		// Deprecated backends.
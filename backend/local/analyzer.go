@@ -0,0 +1,120 @@
+package local
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/plans"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/terraform/tfdiags"
+)
+
+// PlanAnnotation is a structured note attached to a plan by a PlanAnalyzer,
+// for rendering alongside the plan output and inclusion in the JSON UI
+// event stream.
+type PlanAnnotation struct {
+	// Analyzer is the name of the analyzer that produced the annotation.
+	// It is filled in automatically if the analyzer leaves it empty.
+	Analyzer string `json:"analyzer"`
+
+	// Addr is the resource instance address the annotation concerns, or
+	// empty when the annotation applies to the plan as a whole.
+	Addr string `json:"addr,omitempty"`
+
+	// Kind classifies the annotation, such as "cost" or "policy".
+	Kind string `json:"kind,omitempty"`
+
+	Summary string `json:"summary"`
+	Detail  string `json:"detail,omitempty"`
+}
+
+// PlanAnalyzer is an extension point allowing embedders of the local backend
+// to inspect a freshly-created plan and attach structured annotations to it,
+// such as estimated costs or policy warnings.
+//
+// Analyzers run after a plan has been created successfully and before it is
+// rendered. They must not modify the plan.
+type PlanAnalyzer interface {
+	// Name returns a short identifier for the analyzer, used to attribute
+	// its annotations.
+	Name() string
+
+	// AnalyzePlan receives the created plan along with the schemas that
+	// were used to produce it, returning any annotations the analyzer wants
+	// to attach. Error diagnostics abort the operation.
+	AnalyzePlan(plan *plans.Plan, schemas *terraform.Schemas) ([]PlanAnnotation, tfdiags.Diagnostics)
+}
+
+// NoopPlanAnalyzer is a PlanAnalyzer that attaches no annotations. It is a
+// convenient embedding target for analyzers that only sometimes have
+// something to say.
+type NoopPlanAnalyzer struct{}
+
+var _ PlanAnalyzer = NoopPlanAnalyzer{}
+
+func (NoopPlanAnalyzer) Name() string {
+	return "noop"
+}
+
+func (NoopPlanAnalyzer) AnalyzePlan(plan *plans.Plan, schemas *terraform.Schemas) ([]PlanAnnotation, tfdiags.Diagnostics) {
+	return nil, nil
+}
+
+// ReplacementImpactAnalyzer is an example PlanAnalyzer that flags planned
+// resource replacements, which typically cause downtime while the new object
+// is created.
+type ReplacementImpactAnalyzer struct{}
+
+var _ PlanAnalyzer = ReplacementImpactAnalyzer{}
+
+func (ReplacementImpactAnalyzer) Name() string {
+	return "replacement-impact"
+}
+
+func (ReplacementImpactAnalyzer) AnalyzePlan(plan *plans.Plan, schemas *terraform.Schemas) ([]PlanAnnotation, tfdiags.Diagnostics) {
+	var ret []PlanAnnotation
+
+	replaceCount := 0
+	for _, rc := range plan.Changes.Resources {
+		if !rc.Action.IsReplace() {
+			continue
+		}
+		replaceCount++
+		ret = append(ret, PlanAnnotation{
+			Addr:    rc.Addr.String(),
+			Kind:    "impact",
+			Summary: "Instance will be replaced",
+			Detail:  "The existing object will be destroyed and a new one created, which typically causes downtime until the replacement is ready.",
+		})
+	}
+	if replaceCount > 0 {
+		ret = append(ret, PlanAnnotation{
+			Kind:    "impact",
+			Summary: fmt.Sprintf("%d instance(s) are planned for replacement", replaceCount),
+		})
+	}
+
+	return ret, nil
+}
+
+// analyzePlan runs the backend's registered analyzers against the given
+// plan, returning their combined annotations.
+func (b *Local) analyzePlan(plan *plans.Plan, schemas *terraform.Schemas) ([]PlanAnnotation, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+	var ret []PlanAnnotation
+
+	for _, analyzer := range b.PlanAnalyzers {
+		annotations, moreDiags := analyzer.AnalyzePlan(plan, schemas)
+		diags = diags.Append(moreDiags)
+		if moreDiags.HasErrors() {
+			return ret, diags
+		}
+		for i := range annotations {
+			if annotations[i].Analyzer == "" {
+				annotations[i].Analyzer = analyzer.Name()
+			}
+		}
+		ret = append(ret, annotations...)
+	}
+
+	return ret, diags
+}
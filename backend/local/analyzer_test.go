@@ -0,0 +1,96 @@
+package local
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/backend"
+	"github.com/hashicorp/terraform/plans"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/terraform/tfdiags"
+	"github.com/mitchellh/cli"
+)
+
+// testPlanAnalyzer records that it ran and attaches one plan-wide
+// annotation.
+type testPlanAnalyzer struct {
+	called bool
+}
+
+func (a *testPlanAnalyzer) Name() string {
+	return "test"
+}
+
+func (a *testPlanAnalyzer) AnalyzePlan(plan *plans.Plan, schemas *terraform.Schemas) ([]PlanAnnotation, tfdiags.Diagnostics) {
+	a.called = true
+	return []PlanAnnotation{
+		{
+			Kind:    "test",
+			Summary: "plan inspected",
+		},
+	}, nil
+}
+
+func TestLocal_planAnalyzer(t *testing.T) {
+	b, cleanup := TestLocal(t)
+	defer cleanup()
+	TestLocalProvider(t, b, "test", planFixtureSchema())
+
+	analyzer := &testPlanAnalyzer{}
+	b.PlanAnalyzers = []PlanAnalyzer{analyzer}
+	b.CLI = cli.NewMockUi()
+
+	op, configCleanup := testOperationPlan(t, "./testdata/plan")
+	defer configCleanup()
+	op.PlanRefresh = true
+
+	run, err := b.Operation(context.Background(), op)
+	if err != nil {
+		t.Fatalf("bad: %s", err)
+	}
+	<-run.Done()
+	if run.Result != backend.OperationSuccess {
+		t.Fatalf("plan operation failed")
+	}
+
+	if !analyzer.called {
+		t.Fatal("analyzer was not called")
+	}
+
+	output := b.CLI.(*cli.MockUi).OutputWriter.String()
+	if !strings.Contains(output, "Note (test): plan inspected") {
+		t.Fatalf("annotation not rendered in plan output:\n%s", output)
+	}
+}
+
+func TestReplacementImpactAnalyzer(t *testing.T) {
+	changes := plans.NewChanges()
+	addr := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_instance",
+		Name: "foo",
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+	changes.Resources = append(changes.Resources, &plans.ResourceInstanceChangeSrc{
+		Addr: addr,
+		ChangeSrc: plans.ChangeSrc{
+			Action: plans.DeleteThenCreate,
+		},
+	})
+	plan := &plans.Plan{Changes: changes}
+
+	annotations, diags := ReplacementImpactAnalyzer{}.AnalyzePlan(plan, nil)
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+	if len(annotations) != 2 {
+		t.Fatalf("wrong number of annotations %d; want 2", len(annotations))
+	}
+	if got, want := annotations[0].Addr, "test_instance.foo"; got != want {
+		t.Errorf("wrong annotated address %q; want %q", got, want)
+	}
+	if !strings.Contains(annotations[1].Summary, "1 instance(s)") {
+		t.Errorf("wrong summary annotation: %q", annotations[1].Summary)
+	}
+}
@@ -1,6 +1,7 @@
 package local
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -27,6 +28,26 @@ const (
 	DefaultWorkspaceFile   = "environment"
 	DefaultStateFilename   = "terraform.tfstate"
 	DefaultBackupExtension = ".backup"
+
+	// DefaultDataDir is where working-directory-local support files are
+	// kept when the working directory's data directory has not been
+	// configured explicitly.
+	DefaultDataDir = ".terraform"
+
+	// historyDirName is the directory under the data dir where previous
+	// state snapshots are retained so that they can be restored with
+	// "terraform state rollback".
+	historyDirName = "history"
+
+	// DefaultHistoryRetention is how many previous snapshots per lineage
+	// are retained in the history directory.
+	DefaultHistoryRetention = 10
+
+	// planCacheDirName is the directory under the data dir where the most
+	// recent plan for each workspace is retained so that a subsequent plan
+	// can reuse its results for resource instances whose planning inputs
+	// are unchanged.
+	planCacheDirName = "plan-cache"
 )
 
 // Local is an implementation of EnhancedBackend that performs all operations
@@ -60,6 +81,12 @@ type Local struct {
 	StateBackupPath   string
 	StateWorkspaceDir string
 
+	// DataDir is the working directory's data directory, which roots
+	// ancillary storage such as the state snapshot history. If this is
+	// empty then the TF_DATA_DIR environment variable is honored, and
+	// failing that DefaultDataDir is used.
+	DataDir string
+
 	// The OverrideState* paths are set based on per-operation CLI arguments
 	// and will override what'd be built from the State* fields if non-empty.
 	// While the interpretation of the State* fields depends on the active
@@ -92,6 +119,11 @@ type Local struct {
 	// If this is nil, local performs normal state loading and storage.
 	Backend backend.Backend
 
+	// PlanAnalyzers are run against each plan created by this backend,
+	// attaching annotations that are rendered with the plan output and
+	// included in the JSON UI event stream.
+	PlanAnalyzers []PlanAnalyzer
+
 	// RunningInAutomation indicates that commands are being run by an
 	// automated system rather than directly at a command prompt.
 	//
@@ -271,6 +303,12 @@ func (b *Local) StateMgr(name string) (statemgr.Full, error) {
 	if backupPath != "" {
 		s.SetBackupPath(backupPath)
 	}
+	if b.OverrideStatePath == "" && b.OverrideStateOutPath == "" {
+		// Snapshot history applies only to the working directory's own
+		// state. When explicit state paths were given on the command line
+		// we must not write anywhere other than the paths we were given.
+		s.EnableSnapshotHistory(b.historyDir(), DefaultHistoryRetention)
+	}
 
 	if b.states == nil {
 		b.states = map[string]statemgr.Full{}
@@ -392,6 +430,18 @@ func (b *Local) opWait(
 			canceled = true
 		case <-doneCh:
 			log.Println("[TRACE] backend/local: graceful stop has completed")
+
+			// Tell the user which instances had operations in progress when
+			// the stop was requested, since those may have been left
+			// partially modified in the remote system.
+			if interrupted := tfCtx.InterruptedInstances(); len(interrupted) > 0 && b.CLI != nil {
+				var buf bytes.Buffer
+				buf.WriteString("The following resource instances had operations in progress when the stop\nwas requested, and may not match what is recorded in the state:\n")
+				for _, addr := range interrupted {
+					fmt.Fprintf(&buf, "  - %s\n", addr)
+				}
+				b.CLI.Warn(buf.String())
+			}
 		}
 	case <-cancelCtx.Done():
 		// this should not be called without first attempting to stop the
@@ -554,3 +604,21 @@ func (b *Local) stateWorkspaceDir() string {
 
 	return DefaultWorkspaceDir
 }
+
+// dataDir returns the directory where working-directory-local support files
+// (such as the state snapshot history) are stored.
+func (b *Local) dataDir() string {
+	if b.DataDir != "" {
+		return b.DataDir
+	}
+	if dir := os.Getenv("TF_DATA_DIR"); dir != "" {
+		return dir
+	}
+	return DefaultDataDir
+}
+
+// historyDir returns the directory where previous state snapshots are
+// retained for "terraform state rollback".
+func (b *Local) historyDir() string {
+	return filepath.Join(b.dataDir(), historyDirName)
+}
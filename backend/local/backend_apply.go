@@ -6,9 +6,14 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"time"
+
+	ctyjson "github.com/zclconf/go-cty/cty/json"
 
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/backend"
+	viewsjson "github.com/hashicorp/terraform/command/views/json"
+	"github.com/hashicorp/terraform/plans"
 	"github.com/hashicorp/terraform/states"
 	"github.com/hashicorp/terraform/states/statefile"
 	"github.com/hashicorp/terraform/states/statemgr"
@@ -25,6 +30,30 @@ func (b *Local) opApply(
 
 	var diags tfdiags.Diagnostics
 
+	if op.JSONUIStream != nil {
+		// Emit whatever diagnostics we've accumulated as events once the
+		// operation completes, however it ends.
+		defer func() { viewsjson.Diagnostics(op.JSONUIStream, diags) }()
+	}
+
+	// If the user asked to resume a previously-failed apply then the saved
+	// bundle of remaining changes stands in for a plan file argument.
+	if op.Resume {
+		pf, resumeMeta, resumeDiags := b.openResumeBundle(op.Workspace)
+		diags = diags.Append(resumeDiags)
+		if resumeDiags.HasErrors() {
+			b.ReportResult(runningOp, diags)
+			return
+		}
+		op.PlanFile = pf
+		if b.CLI != nil {
+			b.CLI.Output(fmt.Sprintf(
+				"Resuming the previous apply: %d of %d change(s) were already applied.",
+				len(resumeMeta.Completed), resumeMeta.Total,
+			))
+		}
+	}
+
 	// If we have a nil module at this point, then set it to an empty tree
 	// to avoid any potential crashes.
 	if op.PlanFile == nil && !op.Destroy && !op.HasConfig() {
@@ -39,18 +68,56 @@ func (b *Local) opApply(
 		return
 	}
 
-	// Setup our count hook that keeps track of resource changes
+	// Setup our count hook that keeps track of resource changes, and the
+	// completion hook that tracks which changes have been applied so that
+	// a partial failure can be resumed later.
 	countHook := new(CountHook)
 	stateHook := new(StateHook)
+	completionHook := new(CompletionHook)
+	resultHook := new(ResultHook)
 	if b.ContextOpts == nil {
 		b.ContextOpts = new(terraform.ContextOpts)
 	}
 	old := b.ContextOpts.Hooks
 	defer func() { b.ContextOpts.Hooks = old }()
-	b.ContextOpts.Hooks = append(b.ContextOpts.Hooks, countHook, stateHook)
+	b.ContextOpts.Hooks = append(b.ContextOpts.Hooks, countHook, stateHook, completionHook, resultHook)
+	if op.JSONUIStream != nil {
+		b.ContextOpts.Hooks = append(b.ContextOpts.Hooks, viewsjson.NewHook(op.JSONUIStream))
+	}
+
+	// Record per-resource timings and schedule this run using the timings
+	// from previous ones, unless the user has opted out.
+	defer b.setupTimingMetrics()()
+
+	// If an external inventory system is configured then we'll record
+	// lifecycle events during the apply, and drain the queue before we
+	// return so that events are not lost when the process exits.
+	inventoryQueue, inventoryHook, invDiags := inventoryEventQueue()
+	diags = diags.Append(invDiags)
+	if invDiags.HasErrors() {
+		b.ReportResult(runningOp, diags)
+		return
+	}
+	if inventoryHook != nil {
+		b.ContextOpts.Hooks = append(b.ContextOpts.Hooks, inventoryHook)
+		defer func() {
+			for _, err := range inventoryHook.EnqueueErrs {
+				log.Printf("[WARN] backend/local: failed to record inventory event: %s", err)
+			}
+			if remaining := inventoryQueue.Flush(); remaining > 0 {
+				log.Printf("[WARN] backend/local: %d inventory event(s) could not be delivered and remain queued for a later run", remaining)
+				if b.CLI != nil {
+					b.CLI.Warn(fmt.Sprintf(
+						"Warning: %d inventory event(s) could not be delivered to the configured\nreceiver. They remain queued on disk and will be delivered during a\nfuture operation.",
+						remaining,
+					))
+				}
+			}
+		}()
+	}
 
 	// Get our context
-	tfCtx, _, opState, contextDiags := b.context(op)
+	tfCtx, configSnap, opState, contextDiags := b.context(op)
 	diags = diags.Append(contextDiags)
 	if contextDiags.HasErrors() {
 		b.ReportResult(runningOp, diags)
@@ -60,6 +127,11 @@ func (b *Local) opApply(
 	// Setup the state
 	runningOp.State = tfCtx.State()
 
+	// We retain the plan for the whole operation below so that, if the
+	// apply fails partway through, the remaining changes can be saved for
+	// a later -resume.
+	var plan *plans.Plan
+
 	// If we weren't given a plan, then we refresh/plan
 	if op.PlanFile == nil {
 		// If we're refreshing before apply, perform that
@@ -76,7 +148,8 @@ func (b *Local) opApply(
 
 		// Perform the plan
 		log.Printf("[INFO] backend/local: apply calling Plan")
-		plan, planDiags := tfCtx.Plan()
+		var planDiags tfdiags.Diagnostics
+		plan, planDiags = tfCtx.Plan()
 		diags = diags.Append(planDiags)
 		if planDiags.HasErrors() {
 			b.ReportResult(runningOp, diags)
@@ -139,6 +212,15 @@ func (b *Local) opApply(
 				return
 			}
 		}
+	} else {
+		var err error
+		plan, err = op.PlanFile.ReadPlan()
+		if err != nil {
+			// Unexpected, since the context above was built from this same
+			// plan file; the worst consequence is that a partial failure
+			// cannot be resumed.
+			log.Printf("[WARN] backend/local: failed to re-read plan for resume tracking: %s", err)
+		}
 	}
 
 	// Setup our hook for continuous state updates
@@ -148,6 +230,7 @@ func (b *Local) opApply(
 	var applyState *states.State
 	var applyDiags tfdiags.Diagnostics
 	doneCh := make(chan struct{})
+	applyStart := time.Now()
 	go func() {
 		defer close(doneCh)
 		log.Printf("[INFO] backend/local: apply calling Apply")
@@ -179,10 +262,47 @@ func (b *Local) opApply(
 
 	diags = diags.Append(applyDiags)
 	if applyDiags.HasErrors() {
+		// If some of the planned changes were applied before the failure
+		// then we'll save the remaining ones, so the user can continue
+		// with "terraform apply -resume" once the cause is resolved.
+		if completed := completionHook.Completed(); plan != nil && len(completed) > 0 {
+			stateFile := statemgr.Export(opState)
+			if resumeErr := b.writeResumeBundle(op.Workspace, configSnap, stateFile, plan, completed); resumeErr != nil {
+				log.Printf("[WARN] backend/local: failed to save resume file: %s", resumeErr)
+			} else if b.CLI != nil {
+				b.CLI.Output(b.Colorize().Color(
+					"[reset][yellow]\nThe changes that were not yet applied have been saved. After resolving\nthe problem above, run 'terraform apply -resume' to apply them.[reset]",
+				))
+			}
+		}
 		b.ReportResult(runningOp, diags)
 		return
 	}
 
+	// The apply completed fully, so any previously-saved resume record is
+	// now stale.
+	if err := b.clearResumeBundle(); err != nil {
+		log.Printf("[WARN] backend/local: failed to remove resume file: %s", err)
+	}
+
+	// Build the structured summary of what the apply did, for callers that
+	// want more than the text output below.
+	result := &backend.ApplyResult{
+		Added:           countHook.Added,
+		Changed:         countHook.Changed,
+		Destroyed:       countHook.Removed,
+		DurationSeconds: time.Since(applyStart).Seconds(),
+		Resources:       resultHook.Results(),
+		Outputs:         applyOutputResults(applyState),
+	}
+	runningOp.ApplyResult = result
+	if op.JSONUIStream != nil {
+		op.JSONUIStream.Emit("apply_result", fmt.Sprintf(
+			"Apply complete! Resources: %d added, %d changed, %d destroyed.",
+			result.Added, result.Changed, result.Destroyed,
+		), result)
+	}
+
 	// If we've accumulated any warnings along the way then we'll show them
 	// here just before we show the summary and next steps. If we encountered
 	// errors then we would've returned early at some other point above.
@@ -218,6 +338,35 @@ func (b *Local) opApply(
 	}
 }
 
+// applyOutputResults converts the root module output values from the given
+// state into the serialized form used in backend.ApplyResult, in the same
+// way as "terraform output -json". Output values that fail to serialize are
+// skipped with a log message rather than failing the whole summary.
+func applyOutputResults(state *states.State) map[string]backend.ApplyOutputResult {
+	ret := make(map[string]backend.ApplyOutputResult)
+	if state == nil {
+		return ret
+	}
+	for name, os := range state.RootModule().OutputValues {
+		value, err := ctyjson.Marshal(os.Value, os.Value.Type())
+		if err != nil {
+			log.Printf("[WARN] backend/local: failed to serialize output %q for apply result: %s", name, err)
+			continue
+		}
+		valueType, err := ctyjson.MarshalType(os.Value.Type())
+		if err != nil {
+			log.Printf("[WARN] backend/local: failed to serialize type of output %q for apply result: %s", name, err)
+			continue
+		}
+		ret[name] = backend.ApplyOutputResult{
+			Value:     value,
+			Type:      valueType,
+			Sensitive: os.Sensitive,
+		}
+	}
+	return ret
+}
+
 // backupStateForError is called in a scenario where we're unable to persist the
 // state for some reason, and will attempt to save a backup copy of the state
 // to local disk to help the user recover. This is a "last ditch effort" sort
@@ -62,6 +62,23 @@ test_instance.foo:
   provider = provider["registry.terraform.io/-/test"]
   ami = bar
 `)
+
+	result := run.ApplyResult
+	if result == nil {
+		t.Fatal("ApplyResult not populated")
+	}
+	if result.Added != 1 || result.Changed != 0 || result.Destroyed != 0 {
+		t.Fatalf("wrong counts in ApplyResult: %d added, %d changed, %d destroyed", result.Added, result.Changed, result.Destroyed)
+	}
+	if len(result.Resources) != 1 {
+		t.Fatalf("wrong number of resources in ApplyResult %d; want 1", len(result.Resources))
+	}
+	if got, want := result.Resources[0].Address, "test_instance.foo"; got != want {
+		t.Errorf("wrong resource address %q; want %q", got, want)
+	}
+	if got, want := result.Resources[0].Action, "Create"; got != want {
+		t.Errorf("wrong resource action %q; want %q", got, want)
+	}
 }
 
 func TestLocal_applyEmptyDir(t *testing.T) {
@@ -11,7 +11,6 @@ import (
 	"github.com/hashicorp/terraform/command/clistate"
 	"github.com/hashicorp/terraform/configs"
 	"github.com/hashicorp/terraform/configs/configload"
-	"github.com/hashicorp/terraform/plans/planfile"
 	"github.com/hashicorp/terraform/states/statemgr"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/hashicorp/terraform/tfdiags"
@@ -64,6 +63,7 @@ func (b *Local) context(op *backend.Operation) (*terraform.Context, *configload.
 	// Copy set options from the operation
 	opts.Destroy = op.Destroy
 	opts.Targets = op.Targets
+	opts.Excludes = op.Excludes
 	opts.UIInput = op.UIIn
 
 	// Load the latest state. If we enter contextFromPlanFile below then the
@@ -72,6 +72,13 @@ func (b *Local) context(op *backend.Operation) (*terraform.Context, *configload.
 	log.Printf("[TRACE] backend/local: retrieving local state snapshot for workspace %q", op.Workspace)
 	opts.State = s.State()
 
+	// A normal plan operation may be able to reuse results from a plan we
+	// retained on a previous run, for any resource instances whose planning
+	// inputs haven't changed in the meantime.
+	if op.Type == backend.OperationTypePlan && op.PlanCache && !op.Destroy && !op.PlanRefreshOnly {
+		opts.PreviousPlan = b.readPlanCache(op.Workspace)
+	}
+
 	var tfCtx *terraform.Context
 	var ctxDiags tfdiags.Diagnostics
 	var configSnap *configload.Snapshot
@@ -85,7 +92,7 @@ func (b *Local) context(op *backend.Operation) (*terraform.Context, *configload.
 			stateMeta = &m
 		}
 		log.Printf("[TRACE] backend/local: building context from plan file")
-		tfCtx, configSnap, ctxDiags = b.contextFromPlanFile(op.PlanFile, opts, stateMeta)
+		tfCtx, configSnap, ctxDiags = b.contextFromPlanFile(op, opts, stateMeta)
 		// Write sources into the cache of the main loader so that they are
 		// available if we need to generate diagnostic message snippets.
 		op.ConfigLoader.ImportSourcesFromSnapshot(configSnap)
@@ -136,19 +143,28 @@ func (b *Local) contextDirect(op *backend.Operation, opts terraform.ContextOpts)
 	}
 	opts.Config = config
 
+	// If the active workspace has a default var-file recorded in its
+	// metadata then we load it first, so that any values given explicitly
+	// for this operation can override it.
+	opVariables, varFileDiags := b.addWorkspaceVarFile(op, op.Variables)
+	diags = diags.Append(varFileDiags)
+	if varFileDiags.HasErrors() {
+		return nil, nil, diags
+	}
+
 	var rawVariables map[string]backend.UnparsedVariableValue
 	if op.AllowUnsetVariables {
 		// Rather than prompting for input, we'll just stub out the required
 		// but unset variables with unknown values to represent that they are
 		// placeholders for values the user would need to provide for other
 		// operations.
-		rawVariables = b.stubUnsetRequiredVariables(op.Variables, config.Module.Variables)
+		rawVariables = b.stubUnsetRequiredVariables(opVariables, config.Module.Variables)
 	} else {
 		// If interactive input is enabled, we might gather some more variable
 		// values through interactive prompts.
 		// TODO: Need to route the operation context through into here, so that
 		// the interactive prompts can be sensitive to its timeouts/etc.
-		rawVariables = b.interactiveCollectVariables(context.TODO(), op.Variables, config.Module.Variables, opts.UIInput)
+		rawVariables = b.interactiveCollectVariables(context.TODO(), opVariables, config.Module.Variables, opts.UIInput)
 	}
 
 	variables, varDiags := backend.ParseVariableValues(rawVariables, config.Module.Variables)
@@ -163,8 +179,9 @@ func (b *Local) contextDirect(op *backend.Operation, opts terraform.ContextOpts)
 	return tfCtx, configSnap, diags
 }
 
-func (b *Local) contextFromPlanFile(pf *planfile.Reader, opts terraform.ContextOpts, currentStateMeta *statemgr.SnapshotMeta) (*terraform.Context, *configload.Snapshot, tfdiags.Diagnostics) {
+func (b *Local) contextFromPlanFile(op *backend.Operation, opts terraform.ContextOpts, currentStateMeta *statemgr.SnapshotMeta) (*terraform.Context, *configload.Snapshot, tfdiags.Diagnostics) {
 	var diags tfdiags.Diagnostics
+	pf := op.PlanFile
 
 	const errSummary = "Invalid plan file"
 
@@ -248,10 +265,46 @@ func (b *Local) contextFromPlanFile(pf *planfile.Reader, opts terraform.ContextO
 			SourceType: terraform.ValueFromPlan,
 		}
 	}
+	// The plan file remembers the variable values that were in effect when
+	// the plan was created, and an apply of the plan must use those same
+	// values or else the result could differ from what the plan reported.
+	// If any values are set for this operation then we check them against
+	// the recorded ones, rather than silently ignoring them.
+	if len(op.Variables) > 0 {
+		applyVars, applyVarDiags := backend.ParseVariableValuesExplicit(op.Variables, config.Module.Variables)
+		diags = diags.Append(applyVarDiags)
+		if applyVarDiags.HasErrors() {
+			return nil, snap, diags
+		}
+		if conflicts := variables.ConflictingValues(applyVars); len(conflicts) > 0 && !op.AllowEphemeralVariables {
+			for _, name := range conflicts {
+				// We intentionally don't include the two values here in case
+				// the variable contains something sensitive.
+				diags = diags.Append(tfdiags.Sourceless(
+					tfdiags.Error,
+					"Variable value changed since plan was created",
+					fmt.Sprintf("The value given for var.%s does not match the value recorded in the saved plan. Either apply with the original value, create a new plan, or set -allow-ephemeral-variables if this variable is expected to change between plan and apply.", name),
+				))
+			}
+			return nil, snap, diags
+		}
+		if op.AllowEphemeralVariables {
+			variables = variables.Override(applyVars)
+		}
+	}
+
 	opts.Variables = variables
 	opts.Changes = plan.Changes
 	opts.Targets = plan.TargetAddrs
 	opts.ProviderSHA256s = plan.ProviderSHA256s
+	if plan.Destroy {
+		// A plan created in destroy mode must also be applied in destroy
+		// mode, or else the apply graph would be built with the wrong
+		// ordering. We update the operation too so that the UI messaging
+		// and confirmation prompt reflect that this is a destroy.
+		opts.Destroy = true
+		op.Destroy = true
+	}
 
 	tfCtx, ctxDiags := terraform.NewContext(&opts)
 	diags = diags.Append(ctxDiags)
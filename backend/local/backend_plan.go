@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform/addrs"
 	"github.com/hashicorp/terraform/backend"
 	"github.com/hashicorp/terraform/command/format"
+	viewsjson "github.com/hashicorp/terraform/command/views/json"
 	"github.com/hashicorp/terraform/plans"
 	"github.com/hashicorp/terraform/plans/planfile"
 	"github.com/hashicorp/terraform/states"
@@ -32,6 +33,12 @@ func (b *Local) opPlan(
 
 	var diags tfdiags.Diagnostics
 
+	if op.JSONUIStream != nil {
+		// Emit whatever diagnostics we've accumulated as events once the
+		// operation completes, however it ends.
+		defer func() { viewsjson.Diagnostics(op.JSONUIStream, diags) }()
+	}
+
 	if op.PlanFile != nil {
 		diags = diags.Append(tfdiags.Sourceless(
 			tfdiags.Error,
@@ -43,6 +50,16 @@ func (b *Local) opPlan(
 		return
 	}
 
+	if op.PlanRefreshOnly && op.PlanOutPath != "" {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Can't save a refresh-only plan",
+			"A refresh-only plan is a drift report rather than a set of planned changes, so it cannot be saved to a plan file for later apply.",
+		))
+		b.ReportResult(runningOp, diags)
+		return
+	}
+
 	// Local planning requires a config, unless we're planning to destroy.
 	if !op.Destroy && !op.HasConfig() {
 		diags = diags.Append(tfdiags.Sourceless(
@@ -65,6 +82,9 @@ func (b *Local) opPlan(
 	old := b.ContextOpts.Hooks
 	defer func() { b.ContextOpts.Hooks = old }()
 	b.ContextOpts.Hooks = append(b.ContextOpts.Hooks, countHook)
+	if op.JSONUIStream != nil {
+		b.ContextOpts.Hooks = append(b.ContextOpts.Hooks, viewsjson.NewHook(op.JSONUIStream))
+	}
 
 	// Get our context
 	tfCtx, configSnap, opState, ctxDiags := b.context(op)
@@ -77,6 +97,41 @@ func (b *Local) opPlan(
 	// Setup the state
 	runningOp.State = tfCtx.State()
 
+	// In refresh-only mode we don't compute a normal plan at all; we just
+	// refresh everything and report how the remote objects have drifted
+	// from the prior state.
+	if op.PlanRefreshOnly {
+		var report *terraform.DriftReport
+		var driftDiags tfdiags.Diagnostics
+		doneCh := make(chan struct{})
+		go func() {
+			defer close(doneCh)
+			log.Printf("[INFO] backend/local: plan calling DetectDrift")
+			report, driftDiags = tfCtx.DetectDrift()
+		}()
+
+		if b.opWait(doneCh, stopCtx, cancelCtx, tfCtx, opState) {
+			log.Printf("[INFO] backend/local: refresh-only plan operation was force-cancelled by interrupt")
+			runningOp.Result = backend.OperationFailure
+			return
+		}
+
+		diags = diags.Append(driftDiags)
+		if driftDiags.HasErrors() {
+			b.ReportResult(runningOp, diags)
+			return
+		}
+
+		runningOp.PlanEmpty = report.Empty()
+		if op.JSONUIStream != nil {
+			viewsjson.Drift(op.JSONUIStream, report)
+		}
+		if b.CLI != nil {
+			b.renderDriftReport(report)
+		}
+		return
+	}
+
 	// If we're refreshing before plan, perform that
 	baseState := runningOp.State
 	if op.PlanRefresh {
@@ -125,6 +180,39 @@ func (b *Local) opPlan(
 	// Record state
 	runningOp.PlanEmpty = plan.Changes.Empty()
 
+	// If the caller declared an ownership scope then check the planned
+	// changes against the policy before going any further.
+	if op.OwnershipPolicy != nil {
+		policyDiags := op.OwnershipPolicy.CheckChanges(plan.Changes, op.OwnershipTeam)
+		diags = diags.Append(policyDiags)
+		if policyDiags.HasErrors() {
+			b.ReportResult(runningOp, diags)
+			return
+		}
+	}
+
+	if op.JSONUIStream != nil {
+		viewsjson.PlannedChanges(op.JSONUIStream, plan.Changes)
+	}
+
+	// Run any registered plan analyzers so that their annotations can be
+	// rendered along with the plan below.
+	var annotations []PlanAnnotation
+	if len(b.PlanAnalyzers) > 0 {
+		var analyzeDiags tfdiags.Diagnostics
+		annotations, analyzeDiags = b.analyzePlan(plan, tfCtx.Schemas())
+		diags = diags.Append(analyzeDiags)
+		if analyzeDiags.HasErrors() {
+			b.ReportResult(runningOp, diags)
+			return
+		}
+		if op.JSONUIStream != nil {
+			for _, a := range annotations {
+				op.JSONUIStream.Emit("plan_annotation", a.Summary, a)
+			}
+		}
+	}
+
 	// Save the plan to disk
 	if path := op.PlanOutPath; path != "" {
 		if op.PlanOutBackend == nil {
@@ -156,18 +244,43 @@ func (b *Local) opPlan(
 		}
 	}
 
+	// Retain this plan so that the next plan operation can reuse its results
+	// for resource instances whose planning inputs are unchanged. The plan
+	// file format requires a backend configuration to be recorded, so we can
+	// only do this when the caller provided one. Destroy plans are excluded
+	// because their changes are computed without consulting the provider,
+	// so there is nothing worth reusing.
+	if op.PlanCache && !op.Destroy && op.PlanOutBackend != nil {
+		plan.Backend = *op.PlanOutBackend
+		plannedStateFile := statemgr.PlannedStateUpdate(opState, baseState)
+		b.writePlanCache(op.Workspace, configSnap, plannedStateFile, plan)
+	}
+
 	// Perform some output tasks if we have a CLI to output to.
 	if b.CLI != nil {
 		schemas := tfCtx.Schemas()
 
 		if plan.Changes.Empty() {
 			b.CLI.Output("\n" + b.Colorize().Color(strings.TrimSpace(planNoChanges)))
+			b.renderCheckProblems(baseState)
 			// Even if there are no changes, there still could be some warnings
 			b.ShowDiagnostics(diags)
 			return
 		}
 
 		b.renderPlan(plan, baseState, schemas)
+		b.renderCheckProblems(baseState)
+
+		if len(annotations) > 0 {
+			b.CLI.Output("")
+			for _, a := range annotations {
+				if a.Addr != "" {
+					b.CLI.Output(fmt.Sprintf("Note (%s): %s: %s", a.Analyzer, a.Addr, a.Summary))
+				} else {
+					b.CLI.Output(fmt.Sprintf("Note (%s): %s", a.Analyzer, a.Summary))
+				}
+			}
+		}
 
 		// If we've accumulated any warnings along the way then we'll show them
 		// here just before we show the summary and next steps. If we encountered
@@ -198,6 +311,89 @@ func (b *Local) renderPlan(plan *plans.Plan, state *states.State, schemas *terra
 	RenderPlan(plan, state, schemas, b.CLI, b.Colorize())
 }
 
+// renderDriftReport renders the result of a refresh-only plan to the UI.
+func (b *Local) renderDriftReport(report *terraform.DriftReport) {
+	if report.Empty() {
+		b.CLI.Output(b.Colorize().Color(
+			"\n[reset][bold][green]No drift detected. The remote objects still match the state.[reset][green]",
+		))
+		return
+	}
+
+	b.CLI.Output(b.Colorize().Color(
+		"\n[reset][bold]Terraform detected the following changes made outside of Terraform:[reset]\n",
+	))
+	changed := 0
+	deleted := 0
+	for _, inst := range report.Instances {
+		switch {
+		case inst.Deleted:
+			deleted++
+			b.CLI.Output(b.Colorize().Color(fmt.Sprintf(
+				"[red]  - %s has been deleted outside of Terraform[reset]", inst.Addr,
+			)))
+		case len(inst.ChangedAttrs) > 0:
+			changed++
+			b.CLI.Output(b.Colorize().Color(fmt.Sprintf(
+				"[yellow]  ~ %s has changed (%s)[reset]", inst.Addr, strings.Join(inst.ChangedAttrs, ", "),
+			)))
+		default:
+			changed++
+			b.CLI.Output(b.Colorize().Color(fmt.Sprintf(
+				"[yellow]  ~ %s has changed[reset]", inst.Addr,
+			)))
+		}
+	}
+	b.CLI.Output(b.Colorize().Color(fmt.Sprintf(
+		"\n[reset][bold]Drift:[reset] %d changed, %d deleted.", changed, deleted,
+	)))
+}
+
+// renderCheckProblems reports any configuration-defined checks whose most
+// recently recorded outcome was a failure or an error, so that problems
+// detected at the end of the previous apply remain visible on subsequent
+// runs until they are resolved.
+func (b *Local) renderCheckProblems(state *states.State) {
+	if state == nil || len(state.CheckResults) == 0 {
+		return
+	}
+
+	problemAddrs := make([]string, 0, len(state.CheckResults))
+	for addr, result := range state.CheckResults {
+		if result.Status == states.CheckStatusFail || result.Status == states.CheckStatusError {
+			problemAddrs = append(problemAddrs, addr)
+		}
+	}
+	if len(problemAddrs) == 0 {
+		return
+	}
+	sort.Strings(problemAddrs)
+
+	b.CLI.Output(b.Colorize().Color(
+		"\n[reset][bold][yellow]The following checks did not pass after the most recent apply:[reset]\n",
+	))
+	for _, addr := range problemAddrs {
+		result := state.CheckResults[addr]
+		if result.Status == states.CheckStatusError {
+			b.CLI.Output(b.Colorize().Color(fmt.Sprintf(
+				"[red]  ! %s could not be evaluated[reset]", addr,
+			)))
+			continue
+		}
+		if len(result.FailureMessages) == 0 {
+			b.CLI.Output(b.Colorize().Color(fmt.Sprintf(
+				"[yellow]  x %s failed[reset]", addr,
+			)))
+			continue
+		}
+		for _, msg := range result.FailureMessages {
+			b.CLI.Output(b.Colorize().Color(fmt.Sprintf(
+				"[yellow]  x %s: %s[reset]", addr, msg,
+			)))
+		}
+	}
+}
+
 // RenderPlan renders the given plan to the given UI.
 //
 // This is exported only so that the "terraform show" command can re-use it.
@@ -296,24 +492,22 @@ func RenderPlan(plan *plans.Plan, state *states.State, schemas *terraform.Schema
 		))
 	}
 
-	// stats is similar to counts above, but:
-	// - it considers only resource changes
-	// - it simplifies "replace" into both a create and a delete
-	stats := map[plans.Action]int{}
-	for _, change := range rChanges {
-		switch change.Action {
-		case plans.CreateThenDelete, plans.DeleteThenCreate:
-			stats[plans.Create]++
-			stats[plans.Delete]++
-		default:
-			stats[change.Action]++
-		}
-	}
+	// The summary simplifies "replace" into both a create and a delete for
+	// the purposes of the counts shown in the footer, since that matches
+	// what will really happen to the remote objects.
+	summary := plan.Changes.Summary()
 	ui.Output(colorize.Color(fmt.Sprintf(
 		"[reset][bold]Plan:[reset] "+
 			"%d to add, %d to change, %d to destroy.",
-		stats[plans.Create], stats[plans.Update], stats[plans.Delete],
+		summary.Total.Create+summary.Total.Replace,
+		summary.Total.Update,
+		summary.Total.Delete+summary.Total.Replace,
 	)))
+	if summary.OnlyOutputs {
+		ui.Output(colorize.Color(
+			"[reset]\nThis plan changes only output values, and not any real infrastructure objects.",
+		))
+	}
 }
 
 const planHeaderIntro = `
@@ -0,0 +1,62 @@
+package local
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform/configs/configload"
+	"github.com/hashicorp/terraform/plans"
+	"github.com/hashicorp/terraform/plans/planfile"
+	"github.com/hashicorp/terraform/states/statefile"
+)
+
+// planCachePath returns the path of the file where the most recent plan for
+// the given workspace is retained for possible reuse by a subsequent plan.
+func (b *Local) planCachePath(workspace string) string {
+	return filepath.Join(b.dataDir(), planCacheDirName, fmt.Sprintf("%s.tfplan", workspace))
+}
+
+// readPlanCache returns the retained plan for the given workspace, or nil if
+// there is none or it cannot be read. The cache is strictly an optimization:
+// a missing or stale entry just means the next plan starts from scratch, so
+// problems here are logged rather than returned.
+func (b *Local) readPlanCache(workspace string) *plans.Plan {
+	path := b.planCachePath(workspace)
+	r, err := planfile.Open(path)
+	if err != nil {
+		log.Printf("[TRACE] backend/local: no reusable previous plan for workspace %q (%s)", workspace, err)
+		return nil
+	}
+	defer r.Close()
+
+	plan, err := r.ReadPlan()
+	if err != nil {
+		log.Printf("[WARN] backend/local: ignoring retained plan in %s because it could not be read: %s", path, err)
+		return nil
+	}
+
+	log.Printf("[TRACE] backend/local: loaded previous plan from %s for possible reuse", path)
+	return plan
+}
+
+// writePlanCache retains the given plan for the workspace so that the next
+// plan operation can reuse its results. As with readPlanCache, failures are
+// logged rather than returned, since the operation that produced the plan
+// has already succeeded.
+func (b *Local) writePlanCache(workspace string, configSnap *configload.Snapshot, stateFile *statefile.File, plan *plans.Plan) {
+	path := b.planCachePath(workspace)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		log.Printf("[WARN] backend/local: failed to create plan cache directory: %s", err)
+		return
+	}
+	if err := planfile.Create(path, configSnap, stateFile, plan); err != nil {
+		log.Printf("[WARN] backend/local: failed to retain plan in %s: %s", path, err)
+		// Make sure we don't leave behind a partially-written file that a
+		// later plan might then fail to read.
+		os.Remove(path)
+		return
+	}
+	log.Printf("[TRACE] backend/local: retained plan in %s for reuse by a later plan", path)
+}
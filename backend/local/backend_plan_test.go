@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform/internal/initwd"
 	"github.com/hashicorp/terraform/plans"
 	"github.com/hashicorp/terraform/plans/planfile"
+	"github.com/hashicorp/terraform/providers"
 	"github.com/hashicorp/terraform/states"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/mitchellh/cli"
@@ -400,6 +401,91 @@ func TestLocal_planRefreshFalse(t *testing.T) {
 	}
 }
 
+func TestLocal_planRefreshOnly(t *testing.T) {
+	b, cleanup := TestLocal(t)
+	defer cleanup()
+
+	p := TestLocalProvider(t, b, "test", planFixtureSchema())
+	testStateFile(t, b.StatePath, testPlanState())
+
+	// The remote object has drifted from what the state records.
+	p.ReadResourceFn = func(req providers.ReadResourceRequest) providers.ReadResourceResponse {
+		new, _ := cty.Transform(req.PriorState, func(path cty.Path, v cty.Value) (cty.Value, error) {
+			if len(path) == 1 && path[0].(cty.GetAttrStep).Name == "ami" {
+				return cty.StringVal("drifted"), nil
+			}
+			return v, nil
+		})
+		return providers.ReadResourceResponse{NewState: new}
+	}
+
+	outputBuf := cli.NewMockUi()
+	b.CLI = outputBuf
+
+	op, configCleanup := testOperationPlan(t, "./testdata/plan")
+	defer configCleanup()
+	op.PlanRefresh = true
+	op.PlanRefreshOnly = true
+
+	run, err := b.Operation(context.Background(), op)
+	if err != nil {
+		t.Fatalf("bad: %s", err)
+	}
+	<-run.Done()
+	if run.Result != backend.OperationSuccess {
+		t.Fatalf("plan operation failed: %s", outputBuf.ErrorWriter.String())
+	}
+
+	if !p.ReadResourceCalled {
+		t.Fatal("ReadResource should be called")
+	}
+	if run.PlanEmpty {
+		t.Fatal("drift was detected, so the result should not be empty")
+	}
+
+	output := outputBuf.OutputWriter.String()
+	if !strings.Contains(output, "test_instance.foo[0] has changed (ami)") {
+		t.Fatalf("output does not report the drifted instance:\n%s", output)
+	}
+}
+
+func TestLocal_planRefreshOnly_noDrift(t *testing.T) {
+	b, cleanup := TestLocal(t)
+	defer cleanup()
+
+	p := TestLocalProvider(t, b, "test", planFixtureSchema())
+	testStateFile(t, b.StatePath, testPlanState())
+
+	outputBuf := cli.NewMockUi()
+	b.CLI = outputBuf
+
+	op, configCleanup := testOperationPlan(t, "./testdata/plan")
+	defer configCleanup()
+	op.PlanRefresh = true
+	op.PlanRefreshOnly = true
+
+	run, err := b.Operation(context.Background(), op)
+	if err != nil {
+		t.Fatalf("bad: %s", err)
+	}
+	<-run.Done()
+	if run.Result != backend.OperationSuccess {
+		t.Fatalf("plan operation failed: %s", outputBuf.ErrorWriter.String())
+	}
+
+	if !p.ReadResourceCalled {
+		t.Fatal("ReadResource should be called")
+	}
+	if !run.PlanEmpty {
+		t.Fatal("no drift was introduced, so the result should be empty")
+	}
+
+	output := outputBuf.OutputWriter.String()
+	if !strings.Contains(output, "No drift detected") {
+		t.Fatalf("output does not report the lack of drift:\n%s", output)
+	}
+}
+
 func TestLocal_planDestroy(t *testing.T) {
 	b, cleanup := TestLocal(t)
 	defer cleanup()
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform/backend"
 	"github.com/hashicorp/terraform/states"
 	"github.com/hashicorp/terraform/states/statemgr"
+	"github.com/hashicorp/terraform/terraform"
 	"github.com/hashicorp/terraform/tfdiags"
 )
 
@@ -42,6 +43,15 @@ func (b *Local) opRefresh(
 		}
 	}
 
+	// Record per-resource timings and schedule this run using the timings
+	// from previous ones, unless the user has opted out.
+	if b.ContextOpts == nil {
+		b.ContextOpts = new(terraform.ContextOpts)
+	}
+	oldHooks := b.ContextOpts.Hooks
+	defer func() { b.ContextOpts.Hooks = oldHooks }()
+	defer b.setupTimingMetrics()()
+
 	// Get our context
 	tfCtx, _, opState, contextDiags := b.context(op)
 	diags = diags.Append(contextDiags)
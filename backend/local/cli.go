@@ -16,6 +16,10 @@ func (b *Local) CLIInit(opts *backend.CLIOpts) error {
 	b.OpValidation = opts.Validation
 	b.RunningInAutomation = opts.RunningInAutomation
 
+	if opts.DataDir != "" {
+		b.DataDir = opts.DataDir
+	}
+
 	// configure any new cli options
 	if opts.StatePath != "" {
 		log.Printf("[TRACE] backend/local: CLI option -state is overriding state path to %s", opts.StatePath)
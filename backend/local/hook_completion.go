@@ -0,0 +1,46 @@
+package local
+
+import (
+	"sync"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/states"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// CompletionHook records which planned changes have been successfully
+// applied during an apply walk, so that a partial failure can save the
+// remaining changes for a later "terraform apply -resume".
+type CompletionHook struct {
+	completed []string
+
+	sync.Mutex
+	terraform.NilHook
+}
+
+var _ terraform.Hook = (*CompletionHook)(nil)
+
+func (h *CompletionHook) PostApply(addr addrs.AbsResourceInstance, gen states.Generation, newState cty.Value, err error) (terraform.HookAction, error) {
+	if err == nil {
+		dk := states.NotDeposed
+		if key, ok := gen.(states.DeposedKey); ok {
+			dk = key
+		}
+
+		h.Lock()
+		h.completed = append(h.completed, resumeChangeKey(addr, dk))
+		h.Unlock()
+	}
+
+	return terraform.HookActionContinue, nil
+}
+
+// Completed returns the keys of the changes applied so far, in completion
+// order.
+func (h *CompletionHook) Completed() []string {
+	h.Lock()
+	defer h.Unlock()
+	return h.completed
+}
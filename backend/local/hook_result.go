@@ -0,0 +1,75 @@
+package local
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/backend"
+	"github.com/hashicorp/terraform/plans"
+	"github.com/hashicorp/terraform/states"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// ResultHook is a hook that records which action was applied to each
+// resource instance and how long it took, for inclusion in the structured
+// apply result summary.
+type ResultHook struct {
+	resources map[string]*resultRecord
+
+	sync.Mutex
+	terraform.NilHook
+}
+
+type resultRecord struct {
+	action   plans.Action
+	start    time.Time
+	duration time.Duration
+}
+
+var _ terraform.Hook = (*ResultHook)(nil)
+
+func (h *ResultHook) PreApply(addr addrs.AbsResourceInstance, gen states.Generation, action plans.Action, priorState, plannedNewState cty.Value) (terraform.HookAction, error) {
+	h.Lock()
+	defer h.Unlock()
+
+	if h.resources == nil {
+		h.resources = make(map[string]*resultRecord)
+	}
+	h.resources[addr.String()] = &resultRecord{
+		action: action,
+		start:  time.Now(),
+	}
+	return terraform.HookActionContinue, nil
+}
+
+func (h *ResultHook) PostApply(addr addrs.AbsResourceInstance, gen states.Generation, newState cty.Value, err error) (terraform.HookAction, error) {
+	h.Lock()
+	defer h.Unlock()
+
+	if record, ok := h.resources[addr.String()]; ok {
+		record.duration = time.Since(record.start)
+	}
+	return terraform.HookActionContinue, nil
+}
+
+// Results returns a description of each resource instance the apply acted
+// on, sorted by address.
+func (h *ResultHook) Results() []backend.ApplyResourceResult {
+	h.Lock()
+	defer h.Unlock()
+
+	ret := make([]backend.ApplyResourceResult, 0, len(h.resources))
+	for addr, record := range h.resources {
+		ret = append(ret, backend.ApplyResourceResult{
+			Address:         addr,
+			Action:          record.action.String(),
+			DurationSeconds: record.duration.Seconds(),
+		})
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Address < ret[j].Address })
+	return ret
+}
@@ -0,0 +1,75 @@
+package local
+
+import (
+	"sync"
+	"time"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/plans"
+	"github.com/hashicorp/terraform/states"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// TimingHook is a hook that records how long the apply and refresh of each
+// resource instance takes, accumulating the samples into a ResourceTimings
+// for persistence after the operation.
+type TimingHook struct {
+	Timings *ResourceTimings
+
+	starts map[string]time.Time
+
+	sync.Mutex
+	terraform.NilHook
+}
+
+var _ terraform.Hook = (*TimingHook)(nil)
+
+func (h *TimingHook) PreApply(addr addrs.AbsResourceInstance, gen states.Generation, action plans.Action, priorState, plannedNewState cty.Value) (terraform.HookAction, error) {
+	h.start(addr)
+	return terraform.HookActionContinue, nil
+}
+
+func (h *TimingHook) PostApply(addr addrs.AbsResourceInstance, gen states.Generation, newState cty.Value, err error) (terraform.HookAction, error) {
+	// Failed operations are not recorded, since their durations say more
+	// about the failure mode than about the resource.
+	h.finish(addr, err == nil)
+	return terraform.HookActionContinue, nil
+}
+
+func (h *TimingHook) PreRefresh(addr addrs.AbsResourceInstance, gen states.Generation, priorState cty.Value) (terraform.HookAction, error) {
+	h.start(addr)
+	return terraform.HookActionContinue, nil
+}
+
+func (h *TimingHook) PostRefresh(addr addrs.AbsResourceInstance, gen states.Generation, priorState cty.Value, newState cty.Value) (terraform.HookAction, error) {
+	h.finish(addr, true)
+	return terraform.HookActionContinue, nil
+}
+
+func (h *TimingHook) start(addr addrs.AbsResourceInstance) {
+	h.Lock()
+	defer h.Unlock()
+
+	if h.starts == nil {
+		h.starts = make(map[string]time.Time)
+	}
+	h.starts[addr.String()] = time.Now()
+}
+
+func (h *TimingHook) finish(addr addrs.AbsResourceInstance, record bool) {
+	h.Lock()
+	defer h.Unlock()
+
+	key := addr.String()
+	begin, ok := h.starts[key]
+	if !ok {
+		return
+	}
+	delete(h.starts, key)
+
+	if record && h.Timings != nil {
+		h.Timings.Record(key, addr.Resource.Resource.Type, time.Since(begin))
+	}
+}
@@ -0,0 +1,59 @@
+package local
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/plans"
+	"github.com/hashicorp/terraform/states"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestTimingHook_impl(t *testing.T) {
+	var _ terraform.Hook = new(TimingHook)
+}
+
+func TestTimingHookApply(t *testing.T) {
+	timings := &ResourceTimings{}
+	h := &TimingHook{Timings: timings}
+
+	addr := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_instance",
+		Name: "foo",
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+
+	h.PreApply(addr, states.CurrentGen, plans.Create, cty.DynamicVal, cty.DynamicVal)
+	h.PostApply(addr, states.CurrentGen, cty.DynamicVal, nil)
+
+	if got := len(timings.Instances["test_instance.foo"]); got != 1 {
+		t.Errorf("wrong sample count %d for test_instance.foo; want 1", got)
+	}
+	if got := len(timings.Types["test_instance"]); got != 1 {
+		t.Errorf("wrong sample count %d for test_instance; want 1", got)
+	}
+	if len(h.starts) != 0 {
+		t.Errorf("start times were not cleaned up: %#v", h.starts)
+	}
+}
+
+func TestTimingHookApplyError(t *testing.T) {
+	timings := &ResourceTimings{}
+	h := &TimingHook{Timings: timings}
+
+	addr := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_instance",
+		Name: "foo",
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+
+	h.PreApply(addr, states.CurrentGen, plans.Create, cty.DynamicVal, cty.DynamicVal)
+	h.PostApply(addr, states.CurrentGen, cty.DynamicVal, errors.New("boom"))
+
+	if got := len(timings.Instances["test_instance.foo"]); got != 0 {
+		t.Errorf("failed apply was recorded; have %d sample(s)", got)
+	}
+}
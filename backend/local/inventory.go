@@ -0,0 +1,76 @@
+package local
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform/inventory"
+	"github.com/hashicorp/terraform/tfdiags"
+)
+
+// Environment variables used to configure delivery of resource lifecycle
+// events to an external inventory system during apply. Exactly one of the
+// webhook and command variables should be set to enable delivery.
+const (
+	// InventoryWebhookEnvVar configures an HTTP URL that events are POSTed
+	// to as JSON.
+	InventoryWebhookEnvVar = "TF_INVENTORY_WEBHOOK_URL"
+
+	// InventoryCommandEnvVar configures a command line, split on spaces,
+	// that is run with each JSON event on its stdin.
+	InventoryCommandEnvVar = "TF_INVENTORY_COMMAND"
+
+	// InventoryDirEnvVar overrides the directory used to buffer events on
+	// disk before they are acknowledged by the receiver. By default events
+	// are buffered under the .terraform data directory.
+	InventoryDirEnvVar = "TF_INVENTORY_DIR"
+)
+
+// inventoryEventQueue constructs the event queue and hook for delivering
+// resource lifecycle events, based on the environment. If no inventory
+// delivery is configured then both results are nil.
+func inventoryEventQueue() (*inventory.Queue, *inventory.Hook, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	webhookURL := os.Getenv(InventoryWebhookEnvVar)
+	commandStr := os.Getenv(InventoryCommandEnvVar)
+	if webhookURL == "" && commandStr == "" {
+		return nil, nil, diags
+	}
+	if webhookURL != "" && commandStr != "" {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid inventory event configuration",
+			fmt.Sprintf("Only one of %s and %s may be set.", InventoryWebhookEnvVar, InventoryCommandEnvVar),
+		))
+		return nil, nil, diags
+	}
+
+	var sink inventory.Sink
+	if webhookURL != "" {
+		sink = &inventory.WebhookSink{URL: webhookURL}
+	} else {
+		sink = &inventory.CommandSink{Command: strings.Fields(commandStr)}
+	}
+
+	dir := os.Getenv(InventoryDirEnvVar)
+	if dir == "" {
+		// The same default data directory as the CLI layer uses, which we
+		// can't reference directly from here.
+		dir = filepath.Join(".terraform", "inventory")
+	}
+
+	queue, err := inventory.NewQueue(dir, sink)
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to open inventory event queue",
+			fmt.Sprintf("Could not open the inventory event queue in %s: %s.", dir, err),
+		))
+		return nil, nil, diags
+	}
+
+	return queue, inventory.NewHook(queue), diags
+}
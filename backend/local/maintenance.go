@@ -0,0 +1,201 @@
+package local
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	multierror "github.com/hashicorp/go-multierror"
+
+	"github.com/hashicorp/terraform/backend"
+)
+
+// CleanupOptions configures a call to Cleanup.
+type CleanupOptions struct {
+	// RetentionPeriod is the minimum age a file must have before Cleanup
+	// will consider it stale. Files younger than this are always kept.
+	// A zero period means any matching file is considered stale regardless
+	// of age.
+	RetentionPeriod time.Duration
+
+	// PluginCacheDir optionally names a plugin cache directory to sweep for
+	// entries that are not referenced by any plugin lock file under the
+	// working directory. If empty, the plugin cache is left alone.
+	PluginCacheDir string
+
+	// DryRun causes Cleanup to report what it would remove without actually
+	// removing anything.
+	DryRun bool
+}
+
+// CleanupReport describes the files that Cleanup removed, or would remove
+// when running in dry-run mode.
+type CleanupReport struct {
+	// LockFiles are stale lock metadata files left behind by processes that
+	// exited without unlocking.
+	LockFiles []string
+
+	// StateBackups are state backup files older than the retention period.
+	StateBackups []string
+
+	// ErroredStates are errored.tfstate files written by failed applies.
+	ErroredStates []string
+
+	// PluginCacheEntries are plugin cache entries not referenced by any
+	// plugin lock file.
+	PluginCacheEntries []string
+}
+
+// Cleanup removes stale artifacts that accumulate around local state over
+// time: orphaned lock metadata files, state backups older than the retention
+// period, leftover errored.tfstate files, and optionally unreferenced plugin
+// cache entries.
+//
+// This is a maintenance operation and is never run implicitly; callers must
+// invoke it deliberately, and should usually offer the dry-run mode to the
+// user first. Cleanup does not delegate to a wrapped non-local backend, since
+// the artifacts it manages exist only on the local filesystem.
+func (b *Local) Cleanup(opts *CleanupOptions) (*CleanupReport, error) {
+	report := &CleanupReport{}
+	var errs error
+
+	cutoff := time.Now().Add(-opts.RetentionPeriod)
+
+	// The various paths for a workspace can coincide, depending on the
+	// backend configuration, so we make sure to consider each candidate
+	// only once.
+	seen := map[string]bool{}
+
+	remove := func(path string, found *[]string) {
+		if path == "" || seen[path] {
+			return
+		}
+		seen[path] = true
+
+		info, err := os.Stat(path)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				errs = multierror.Append(errs, err)
+			}
+			return
+		}
+		if info.ModTime().After(cutoff) {
+			return
+		}
+		if !opts.DryRun {
+			if err := os.RemoveAll(path); err != nil {
+				errs = multierror.Append(errs, err)
+				return
+			}
+		}
+		*found = append(*found, path)
+	}
+
+	// When a non-local backend handles state storage, the only local state
+	// artifacts are those for the default paths (e.g. backups written by
+	// "state pull"-style operations), so we don't enumerate its workspaces.
+	workspaces := []string{backend.DefaultStateName}
+	if b.Backend == nil {
+		var err error
+		workspaces, err = b.Workspaces()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, name := range workspaces {
+		statePath, stateOutPath, backupPath := b.StatePaths(name)
+
+		for _, path := range []string{statePath, stateOutPath} {
+			remove(lockMetadataPath(path), &report.LockFiles)
+			remove(path+DefaultBackupExtension, &report.StateBackups)
+		}
+		if backupPath != "" && backupPath != "-" {
+			remove(backupPath, &report.StateBackups)
+		}
+
+		remove(filepath.Join(filepath.Dir(statePath), "errored.tfstate"), &report.ErroredStates)
+	}
+
+	if opts.PluginCacheDir != "" {
+		b.cleanupPluginCache(opts, report, remove)
+	}
+
+	return report, errs
+}
+
+// cleanupPluginCache sweeps the configured plugin cache directory for entries
+// that no plugin lock file under the working directory refers to.
+func (b *Local) cleanupPluginCache(opts *CleanupOptions, report *CleanupReport, remove func(string, *[]string)) {
+	referenced := referencedProviders(".terraform/plugins")
+
+	entries, err := ioutil.ReadDir(opts.PluginCacheDir)
+	if err != nil {
+		return
+	}
+
+	for _, entry := range entries {
+		if providerName := cacheEntryProvider(entry.Name()); providerName != "" {
+			if referenced[providerName] {
+				continue
+			}
+		}
+		remove(filepath.Join(opts.PluginCacheDir, entry.Name()), &report.PluginCacheEntries)
+	}
+}
+
+// referencedProviders collects the provider names recorded in any plugin
+// lock files (lock.json) below the given directory.
+func referencedProviders(pluginDir string) map[string]bool {
+	referenced := map[string]bool{}
+
+	filepath.Walk(pluginDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || filepath.Base(path) != "lock.json" {
+			return nil
+		}
+
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil
+		}
+		digests := map[string]string{}
+		if err := json.Unmarshal(data, &digests); err != nil {
+			return nil
+		}
+		for name := range digests {
+			referenced[name] = true
+		}
+		return nil
+	})
+
+	return referenced
+}
+
+// cacheEntryProvider returns the provider name embedded in a plugin cache
+// entry name, or an empty string if the name doesn't look like a provider
+// plugin.
+func cacheEntryProvider(name string) string {
+	const prefix = "terraform-provider-"
+	if !strings.HasPrefix(name, prefix) {
+		return ""
+	}
+	rest := name[len(prefix):]
+	if idx := strings.Index(rest, "_"); idx != -1 {
+		rest = rest[:idx]
+	}
+	return rest
+}
+
+// lockMetadataPath returns the path of the lock metadata file that the
+// filesystem state manager would use for the state file at the given path.
+func lockMetadataPath(statePath string) string {
+	stateDir, stateName := filepath.Split(statePath)
+	if stateName == "" {
+		return ""
+	}
+	stateName = strings.TrimPrefix(stateName, ".")
+	return filepath.Join(stateDir, "."+stateName+".lock.info")
+}
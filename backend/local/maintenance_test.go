@@ -0,0 +1,116 @@
+package local
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLocal_cleanup(t *testing.T) {
+	defer testTmpDir(t)()
+	b := New()
+
+	old := time.Now().Add(-48 * time.Hour)
+	writeOldFile := func(path string) {
+		t.Helper()
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte("{}"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, old, old); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Stale artifacts that should be removed...
+	writeOldFile(".terraform.tfstate.lock.info")
+	writeOldFile("terraform.tfstate.backup")
+	writeOldFile("errored.tfstate")
+
+	// ...and a recent backup that must survive the retention period.
+	if err := ioutil.WriteFile("fresh.tfstate.backup", []byte("{}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	opts := &CleanupOptions{
+		RetentionPeriod: 24 * time.Hour,
+		DryRun:          true,
+	}
+
+	report, err := b.Cleanup(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.LockFiles) != 1 || len(report.StateBackups) != 1 || len(report.ErroredStates) != 1 {
+		t.Fatalf("wrong dry-run report %#v", report)
+	}
+
+	// Dry run must not remove anything.
+	for _, path := range []string{".terraform.tfstate.lock.info", "terraform.tfstate.backup", "errored.tfstate"} {
+		if _, err := os.Stat(path); err != nil {
+			t.Fatalf("dry run removed %s", path)
+		}
+	}
+
+	opts.DryRun = false
+	report, err = b.Cleanup(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.LockFiles) != 1 || len(report.StateBackups) != 1 || len(report.ErroredStates) != 1 {
+		t.Fatalf("wrong report %#v", report)
+	}
+
+	for _, path := range []string{".terraform.tfstate.lock.info", "terraform.tfstate.backup", "errored.tfstate"} {
+		if _, err := os.Stat(path); !os.IsNotExist(err) {
+			t.Errorf("%s was not removed", path)
+		}
+	}
+	if _, err := os.Stat("fresh.tfstate.backup"); err != nil {
+		t.Error("recent backup was removed despite retention period")
+	}
+}
+
+func TestLocal_cleanupPluginCache(t *testing.T) {
+	defer testTmpDir(t)()
+	b := New()
+
+	lockFile := filepath.Join(".terraform", "plugins", "linux_amd64", "lock.json")
+	if err := os.MkdirAll(filepath.Dir(lockFile), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(lockFile, []byte(`{"aws":"abc123"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cacheDir := "plugin-cache"
+	if err := os.MkdirAll(cacheDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"terraform-provider-aws_v2.0.0_x4", "terraform-provider-null_v1.0.0_x4"} {
+		if err := ioutil.WriteFile(filepath.Join(cacheDir, name), []byte("binary"), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	report, err := b.Cleanup(&CleanupOptions{
+		PluginCacheDir: cacheDir,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(report.PluginCacheEntries) != 1 {
+		t.Fatalf("wrong report %#v", report)
+	}
+	if _, err := os.Stat(filepath.Join(cacheDir, "terraform-provider-aws_v2.0.0_x4")); err != nil {
+		t.Error("referenced plugin cache entry was removed")
+	}
+	if _, err := os.Stat(filepath.Join(cacheDir, "terraform-provider-null_v1.0.0_x4")); !os.IsNotExist(err) {
+		t.Error("unreferenced plugin cache entry was not removed")
+	}
+}
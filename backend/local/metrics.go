@@ -0,0 +1,210 @@
+package local
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// Environment variables used to configure the recording of per-resource
+// timing statistics during apply and refresh operations.
+const (
+	// MetricsDisableEnvVar, when set to any non-empty value, disables both
+	// the recording and the use of timing statistics.
+	MetricsDisableEnvVar = "TF_METRICS_DISABLE"
+
+	// MetricsPathEnvVar overrides the file used to persist timing
+	// statistics. By default they are kept under the .terraform data
+	// directory.
+	MetricsPathEnvVar = "TF_METRICS_PATH"
+)
+
+// metricsFilename is the name of the file under the data dir where timing
+// statistics are persisted when MetricsPathEnvVar is not set.
+const metricsFilename = "metrics.json"
+
+// metricsMaxSamples is how many samples are retained per resource instance
+// and per resource type; older samples rotate out as new ones are recorded.
+const metricsMaxSamples = 8
+
+const metricsVersion = 1
+
+// MetricsEnabled returns whether timing statistics should be recorded and
+// used for this process.
+func MetricsEnabled() bool {
+	return os.Getenv(MetricsDisableEnvVar) == ""
+}
+
+// MetricsPath returns the file used to persist timing statistics for
+// callers that don't have a configured backend at hand, rooting it under
+// the TF_DATA_DIR directory if that is set.
+func MetricsPath() string {
+	if path := os.Getenv(MetricsPathEnvVar); path != "" {
+		return path
+	}
+	if dir := os.Getenv("TF_DATA_DIR"); dir != "" {
+		return filepath.Join(dir, metricsFilename)
+	}
+	return filepath.Join(DefaultDataDir, metricsFilename)
+}
+
+// metricsPath is like MetricsPath but uses the backend's own data
+// directory, which in tests may be somewhere other than the default.
+func (b *Local) metricsPath() string {
+	if path := os.Getenv(MetricsPathEnvVar); path != "" {
+		return path
+	}
+	return filepath.Join(b.dataDir(), metricsFilename)
+}
+
+// ResourceTimings records how long recent apply and refresh operations took
+// for each resource instance and, in aggregate, for each resource type.
+// Samples are durations in milliseconds, oldest first.
+//
+// The per-instance averages feed the graph walk scheduler on later runs, so
+// that instances known to be slow are dispatched before fast ones when the
+// parallelism limit forces a choice.
+type ResourceTimings struct {
+	Version   int                `json:"version"`
+	Instances map[string][]int64 `json:"instances,omitempty"`
+	Types     map[string][]int64 `json:"types,omitempty"`
+}
+
+// LoadResourceTimings reads previously-persisted timings from the given
+// file. A missing file is not an error; it yields an empty set of timings.
+func LoadResourceTimings(path string) (*ResourceTimings, error) {
+	ret := &ResourceTimings{Version: metricsVersion}
+	src, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return ret, nil
+	}
+	if err != nil {
+		return ret, err
+	}
+	if err := json.Unmarshal(src, ret); err != nil {
+		// A corrupt metrics file shouldn't block operations; start over.
+		return &ResourceTimings{Version: metricsVersion}, err
+	}
+	return ret, nil
+}
+
+// Record adds a sample for the given resource instance and its resource
+// type, rotating out the oldest samples once metricsMaxSamples is reached.
+func (t *ResourceTimings) Record(instanceAddr, typeName string, d time.Duration) {
+	if t.Instances == nil {
+		t.Instances = make(map[string][]int64)
+	}
+	if t.Types == nil {
+		t.Types = make(map[string][]int64)
+	}
+	t.Instances[instanceAddr] = appendTimingSample(t.Instances[instanceAddr], d)
+	t.Types[typeName] = appendTimingSample(t.Types[typeName], d)
+}
+
+func appendTimingSample(samples []int64, d time.Duration) []int64 {
+	samples = append(samples, int64(d/time.Millisecond))
+	if len(samples) > metricsMaxSamples {
+		samples = samples[len(samples)-metricsMaxSamples:]
+	}
+	return samples
+}
+
+// Write persists the timings to the given file, creating its directory if
+// necessary.
+func (t *ResourceTimings) Write(path string) error {
+	t.Version = metricsVersion
+	src, err := json.MarshalIndent(t, "", "  ")
+	if err != nil {
+		return err
+	}
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return err
+		}
+	}
+	return ioutil.WriteFile(path, src, 0644)
+}
+
+// NodeDurations returns the average recorded duration for each resource
+// instance, keyed by instance address, in the form expected by
+// terraform.ContextOpts.NodeDurations.
+func (t *ResourceTimings) NodeDurations() map[string]time.Duration {
+	if len(t.Instances) == 0 {
+		return nil
+	}
+	ret := make(map[string]time.Duration, len(t.Instances))
+	for addr, samples := range t.Instances {
+		if d := timingAverage(samples); d > 0 {
+			ret[addr] = d
+		}
+	}
+	return ret
+}
+
+// InstanceAverages returns the average recorded duration per resource
+// instance, with addresses sorted for stable iteration via the returned
+// key slice.
+func (t *ResourceTimings) InstanceAverages() ([]string, map[string]time.Duration) {
+	return timingAverages(t.Instances)
+}
+
+// TypeAverages returns the average recorded duration per resource type,
+// with type names sorted for stable iteration via the returned key slice.
+func (t *ResourceTimings) TypeAverages() ([]string, map[string]time.Duration) {
+	return timingAverages(t.Types)
+}
+
+func timingAverages(samples map[string][]int64) ([]string, map[string]time.Duration) {
+	keys := make([]string, 0, len(samples))
+	ret := make(map[string]time.Duration, len(samples))
+	for k, s := range samples {
+		keys = append(keys, k)
+		ret[k] = timingAverage(s)
+	}
+	sort.Strings(keys)
+	return keys, ret
+}
+
+// setupTimingMetrics loads any previously-recorded resource timings, feeds
+// their averages to the graph walk scheduler, and attaches a hook that
+// records new samples. The returned cleanup function persists the updated
+// timings and must be called once the operation completes. When metrics are
+// disabled it does nothing. The caller is responsible for restoring
+// b.ContextOpts.Hooks afterwards, as the operation functions already do.
+func (b *Local) setupTimingMetrics() func() {
+	if !MetricsEnabled() {
+		return func() {}
+	}
+
+	path := b.metricsPath()
+	timings, err := LoadResourceTimings(path)
+	if err != nil {
+		log.Printf("[WARN] backend/local: failed to load resource timing metrics from %s: %s", path, err)
+	}
+
+	oldDurations := b.ContextOpts.NodeDurations
+	b.ContextOpts.NodeDurations = timings.NodeDurations()
+	b.ContextOpts.Hooks = append(b.ContextOpts.Hooks, &TimingHook{Timings: timings})
+
+	return func() {
+		b.ContextOpts.NodeDurations = oldDurations
+		if err := timings.Write(path); err != nil {
+			log.Printf("[WARN] backend/local: failed to write resource timing metrics to %s: %s", path, err)
+		}
+	}
+}
+
+func timingAverage(samples []int64) time.Duration {
+	if len(samples) == 0 {
+		return 0
+	}
+	var total int64
+	for _, s := range samples {
+		total += s
+	}
+	return time.Duration(total/int64(len(samples))) * time.Millisecond
+}
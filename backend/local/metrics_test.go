@@ -0,0 +1,89 @@
+package local
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestResourceTimingsRecord(t *testing.T) {
+	timings := &ResourceTimings{}
+
+	timings.Record("aws_instance.foo", "aws_instance", 2*time.Second)
+	timings.Record("aws_instance.foo", "aws_instance", 4*time.Second)
+	timings.Record("aws_instance.bar", "aws_instance", 10*time.Second)
+
+	durations := timings.NodeDurations()
+	if got, want := durations["aws_instance.foo"], 3*time.Second; got != want {
+		t.Errorf("wrong average %s for aws_instance.foo; want %s", got, want)
+	}
+	if got, want := durations["aws_instance.bar"], 10*time.Second; got != want {
+		t.Errorf("wrong average %s for aws_instance.bar; want %s", got, want)
+	}
+
+	names, typeAvgs := timings.TypeAverages()
+	if len(names) != 1 || names[0] != "aws_instance" {
+		t.Fatalf("wrong type names %#v; want just aws_instance", names)
+	}
+	if got := typeAvgs["aws_instance"]; got <= 0 {
+		t.Errorf("wrong type average %s; want a positive duration", got)
+	}
+}
+
+func TestResourceTimingsRotation(t *testing.T) {
+	timings := &ResourceTimings{}
+	for i := 0; i < metricsMaxSamples*2; i++ {
+		timings.Record("aws_instance.foo", "aws_instance", time.Duration(i)*time.Second)
+	}
+
+	samples := timings.Instances["aws_instance.foo"]
+	if len(samples) != metricsMaxSamples {
+		t.Fatalf("wrong sample count %d; want %d", len(samples), metricsMaxSamples)
+	}
+	// The oldest samples must have rotated out, leaving the newest last.
+	if got, want := samples[len(samples)-1], int64((metricsMaxSamples*2-1)*1000); got != want {
+		t.Errorf("wrong newest sample %d; want %d", got, want)
+	}
+}
+
+func TestResourceTimingsRoundTrip(t *testing.T) {
+	path := filepath.Join(testTempDir(t), "data", "metrics.json")
+
+	timings := &ResourceTimings{}
+	timings.Record("aws_instance.foo", "aws_instance", 2*time.Second)
+	if err := timings.Write(path); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	loaded, err := LoadResourceTimings(path)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if got, want := loaded.NodeDurations()["aws_instance.foo"], 2*time.Second; got != want {
+		t.Errorf("wrong average %s after round trip; want %s", got, want)
+	}
+
+	// A missing file is not an error, and yields no durations.
+	empty, err := LoadResourceTimings(filepath.Join(testTempDir(t), "nonexist.json"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if durations := empty.NodeDurations(); durations != nil {
+		t.Errorf("unexpected durations %#v from missing file", durations)
+	}
+}
+
+func TestMetricsEnabled(t *testing.T) {
+	old := os.Getenv(MetricsDisableEnvVar)
+	defer os.Setenv(MetricsDisableEnvVar, old)
+
+	os.Setenv(MetricsDisableEnvVar, "")
+	if !MetricsEnabled() {
+		t.Errorf("metrics disabled by default")
+	}
+	os.Setenv(MetricsDisableEnvVar, "1")
+	if MetricsEnabled() {
+		t.Errorf("metrics enabled despite %s being set", MetricsDisableEnvVar)
+	}
+}
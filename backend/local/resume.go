@@ -0,0 +1,176 @@
+package local
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/configs/configload"
+	"github.com/hashicorp/terraform/plans"
+	"github.com/hashicorp/terraform/plans/planfile"
+	"github.com/hashicorp/terraform/states"
+	"github.com/hashicorp/terraform/states/statefile"
+	"github.com/hashicorp/terraform/tfdiags"
+)
+
+// resumeDirName is the directory under the data dir where the remaining
+// changes from a partially-failed apply are saved so that a later
+// "terraform apply -resume" can continue them.
+const resumeDirName = "resume"
+
+const (
+	resumePlanFilename = "resume.tfplan"
+	resumeMetaFilename = "resume.json"
+)
+
+const resumeMetaVersion = 1
+
+// resumeMeta is the sidecar record written alongside the resume plan,
+// describing which of the originally-planned changes had already been
+// applied when the failure occurred.
+type resumeMeta struct {
+	Version   int      `json:"version"`
+	Workspace string   `json:"workspace"`
+	Total     int      `json:"total_changes"`
+	Completed []string `json:"completed_changes"`
+}
+
+// resumeDir returns the directory where the resume bundle is kept, under
+// the working directory's data dir.
+func (b *Local) resumeDir() string {
+	return filepath.Join(b.dataDir(), resumeDirName)
+}
+
+func (b *Local) resumePlanPath() string {
+	return filepath.Join(b.resumeDir(), resumePlanFilename)
+}
+
+func (b *Local) resumeMetaPath() string {
+	return filepath.Join(b.resumeDir(), resumeMetaFilename)
+}
+
+// resumeChangeKey produces the key used to track the completion of a single
+// planned change, distinguishing changes to deposed objects from changes to
+// the current object of the same instance.
+func resumeChangeKey(addr addrs.AbsResourceInstance, dk states.DeposedKey) string {
+	if dk == states.NotDeposed {
+		return addr.String()
+	}
+	return fmt.Sprintf("%s (deposed %s)", addr, dk)
+}
+
+// remainingPlan returns a copy of the given plan with the completed resource
+// changes removed, leaving only the changes that still need to be applied.
+func remainingPlan(plan *plans.Plan, completed []string) *plans.Plan {
+	completedSet := make(map[string]bool, len(completed))
+	for _, k := range completed {
+		completedSet[k] = true
+	}
+
+	remaining := *plan
+	remainingChanges := *plan.Changes
+	remainingChanges.Resources = nil
+	for _, rc := range plan.Changes.Resources {
+		if completedSet[resumeChangeKey(rc.Addr, rc.DeposedKey)] {
+			continue
+		}
+		remainingChanges.Resources = append(remainingChanges.Resources, rc)
+	}
+	remaining.Changes = &remainingChanges
+	return &remaining
+}
+
+// writeResumeBundle saves the changes from the given plan that have not yet
+// been applied, as a plan file whose embedded prior state is the state
+// persisted after the partial failure. Applying that plan file later via
+// -resume therefore reuses the usual saved-plan staleness check to verify
+// that the state has not changed again in the meantime.
+func (b *Local) writeResumeBundle(workspace string, configSnap *configload.Snapshot, stateFile *statefile.File, plan *plans.Plan, completed []string) error {
+	if err := os.MkdirAll(b.resumeDir(), 0755); err != nil {
+		return err
+	}
+
+	remaining := remainingPlan(plan, completed)
+	if err := planfile.Create(b.resumePlanPath(), configSnap, stateFile, remaining); err != nil {
+		return err
+	}
+
+	meta := &resumeMeta{
+		Version:   resumeMetaVersion,
+		Workspace: workspace,
+		Total:     len(plan.Changes.Resources),
+		Completed: completed,
+	}
+	src, err := json.MarshalIndent(meta, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(b.resumeMetaPath(), src, 0644)
+}
+
+// openResumeBundle opens a previously-saved resume bundle for the given
+// workspace, returning the plan file containing the remaining changes.
+func (b *Local) openResumeBundle(workspace string) (*planfile.Reader, *resumeMeta, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	src, err := ioutil.ReadFile(b.resumeMetaPath())
+	if os.IsNotExist(err) {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"No apply to resume",
+			"There is no saved record of a partially-failed apply to resume. Terraform saves one only when an apply fails after some of the planned changes were already applied.",
+		))
+		return nil, nil, diags
+	}
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to read resume file",
+			fmt.Sprintf("Could not read the saved resume record from %s: %s.", b.resumeMetaPath(), err),
+		))
+		return nil, nil, diags
+	}
+
+	meta := &resumeMeta{}
+	if err := json.Unmarshal(src, meta); err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to read resume file",
+			fmt.Sprintf("The saved resume record in %s is invalid: %s.", b.resumeMetaPath(), err),
+		))
+		return nil, nil, diags
+	}
+	if meta.Workspace != workspace {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Resume file belongs to another workspace",
+			fmt.Sprintf("The saved resume record was created in workspace %q, but %q is currently selected.", meta.Workspace, workspace),
+		))
+		return nil, nil, diags
+	}
+
+	pf, err := planfile.Open(b.resumePlanPath())
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to open resume plan",
+			fmt.Sprintf("Could not open the saved plan of remaining changes from %s: %s.", b.resumePlanPath(), err),
+		))
+		return nil, nil, diags
+	}
+
+	return pf, meta, diags
+}
+
+// clearResumeBundle removes any saved resume bundle, which is done after a
+// fully-successful apply since the record is stale at that point.
+func (b *Local) clearResumeBundle() error {
+	err := os.RemoveAll(b.resumeDir())
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,99 @@
+package local
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/plans"
+	"github.com/hashicorp/terraform/states"
+)
+
+func testResumeInstanceAddr(name string) addrs.AbsResourceInstance {
+	return addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_instance",
+		Name: name,
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+}
+
+func TestResumeChangeKey(t *testing.T) {
+	addr := testResumeInstanceAddr("foo")
+
+	if got, want := resumeChangeKey(addr, states.NotDeposed), "test_instance.foo"; got != want {
+		t.Errorf("wrong key %q; want %q", got, want)
+	}
+	got := resumeChangeKey(addr, states.DeposedKey("deadbeef"))
+	if want := "test_instance.foo (deposed deadbeef)"; got != want {
+		t.Errorf("wrong deposed key %q; want %q", got, want)
+	}
+}
+
+func TestRemainingPlan(t *testing.T) {
+	plan := &plans.Plan{
+		Changes: &plans.Changes{
+			Resources: []*plans.ResourceInstanceChangeSrc{
+				{
+					Addr: testResumeInstanceAddr("foo"),
+					ChangeSrc: plans.ChangeSrc{
+						Action: plans.Create,
+					},
+				},
+				{
+					Addr: testResumeInstanceAddr("bar"),
+					ChangeSrc: plans.ChangeSrc{
+						Action: plans.Create,
+					},
+				},
+				{
+					Addr:       testResumeInstanceAddr("bar"),
+					DeposedKey: states.DeposedKey("deadbeef"),
+					ChangeSrc: plans.ChangeSrc{
+						Action: plans.Delete,
+					},
+				},
+			},
+		},
+	}
+
+	remaining := remainingPlan(plan, []string{
+		"test_instance.foo",
+		"test_instance.bar (deposed deadbeef)",
+	})
+
+	if got, want := len(remaining.Changes.Resources), 1; got != want {
+		t.Fatalf("wrong number of remaining changes %d; want %d", got, want)
+	}
+	if got, want := remaining.Changes.Resources[0].Addr.String(), "test_instance.bar"; got != want {
+		t.Errorf("wrong remaining change %q; want %q", got, want)
+	}
+
+	// The original plan must not be modified.
+	if got, want := len(plan.Changes.Resources), 3; got != want {
+		t.Errorf("original plan was modified; have %d change(s), want %d", got, want)
+	}
+}
+
+func TestCompletionHook(t *testing.T) {
+	h := new(CompletionHook)
+
+	h.PostApply(testResumeInstanceAddr("foo"), states.CurrentGen, cty.DynamicVal, nil)
+	h.PostApply(testResumeInstanceAddr("bar"), states.CurrentGen, cty.DynamicVal, errors.New("boom"))
+	h.PostApply(testResumeInstanceAddr("baz"), states.DeposedKey("deadbeef"), cty.DynamicVal, nil)
+
+	got := h.Completed()
+	want := []string{
+		"test_instance.foo",
+		"test_instance.baz (deposed deadbeef)",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("wrong completed changes %#v; want %#v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("wrong completed change %d: %q; want %q", i, got[i], want[i])
+		}
+	}
+}
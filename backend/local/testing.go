@@ -32,6 +32,7 @@ func TestLocal(t *testing.T) (*Local, func()) {
 	local.StateOutPath = filepath.Join(tempDir, "state.tfstate")
 	local.StateBackupPath = filepath.Join(tempDir, "state.tfstate.bak")
 	local.StateWorkspaceDir = filepath.Join(tempDir, "state.tfstate.d")
+	local.DataDir = filepath.Join(tempDir, ".terraform")
 	local.ContextOpts = &terraform.ContextOpts{}
 
 	local.ShowDiagnostics = func(vals ...interface{}) {
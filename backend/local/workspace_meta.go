@@ -0,0 +1,159 @@
+package local
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	hcljson "github.com/hashicorp/hcl/v2/json"
+	"github.com/hashicorp/terraform/backend"
+	"github.com/hashicorp/terraform/configs"
+	"github.com/hashicorp/terraform/states/statemgr"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/terraform/tfdiags"
+)
+
+// DefaultWorkspaceMetaExtension is appended to a workspace's state path to
+// produce the path of its metadata document.
+const DefaultWorkspaceMetaExtension = ".meta.json"
+
+var _ backend.WorkspaceMetaStore = (*Local)(nil)
+
+// WorkspaceMetaPath returns the path where the metadata document for the
+// given workspace is stored, which is always alongside that workspace's
+// state snapshot file.
+func (b *Local) WorkspaceMetaPath(name string) string {
+	statePath, _, _ := b.StatePaths(name)
+	return statePath + DefaultWorkspaceMetaExtension
+}
+
+// WorkspaceMeta implements backend.WorkspaceMetaStore.
+func (b *Local) WorkspaceMeta(name string) (*statemgr.WorkspaceMeta, error) {
+	// If we have a backend handling state, defer to that.
+	if b.Backend != nil {
+		if ms, ok := b.Backend.(backend.WorkspaceMetaStore); ok {
+			return ms.WorkspaceMeta(name)
+		}
+		// The wrapped backend doesn't support metadata, so every workspace
+		// just has none.
+		return nil, nil
+	}
+
+	return statemgr.ReadWorkspaceMeta(b.WorkspaceMetaPath(name))
+}
+
+// SetWorkspaceMeta implements backend.WorkspaceMetaStore.
+func (b *Local) SetWorkspaceMeta(name string, meta *statemgr.WorkspaceMeta) error {
+	// If we have a backend handling state, defer to that.
+	if b.Backend != nil {
+		if ms, ok := b.Backend.(backend.WorkspaceMetaStore); ok {
+			return ms.SetWorkspaceMeta(name, meta)
+		}
+		return fmt.Errorf("the currently-configured backend does not support workspace metadata")
+	}
+
+	if err := b.createState(name); err != nil {
+		return err
+	}
+
+	if meta.CreatedAt.IsZero() {
+		// If the caller didn't say otherwise, the workspace is being
+		// created right now.
+		meta.CreatedAt = time.Now().UTC()
+	}
+
+	return statemgr.WriteWorkspaceMeta(b.WorkspaceMetaPath(name), meta)
+}
+
+// addWorkspaceVarFile extends the given map of unparsed variable values with
+// values from the workspace's default var-file, if the active workspace has
+// one recorded in its metadata.
+//
+// Values given explicitly for the operation always take precedence over
+// values from the workspace var-file, so only variables not already present
+// in the given map are added. The given map is never modified; if there's
+// anything to add then the result is a new map.
+func (b *Local) addWorkspaceVarFile(op *backend.Operation, existing map[string]backend.UnparsedVariableValue) (map[string]backend.UnparsedVariableValue, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	meta, err := b.WorkspaceMeta(op.Workspace)
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to read workspace metadata",
+			fmt.Sprintf("Error reading metadata for workspace %q: %s.", op.Workspace, err),
+		))
+		return existing, diags
+	}
+	if meta == nil || meta.VarFile == "" {
+		return existing, diags
+	}
+
+	// The var-file path is interpreted relative to the configuration
+	// directory, so that metadata remains portable between systems.
+	filename := meta.VarFile
+	if !filepath.IsAbs(filename) {
+		filename = filepath.Join(op.ConfigDir, filename)
+	}
+	log.Printf("[TRACE] backend/local: loading workspace var-file %s for workspace %q", filename, op.Workspace)
+
+	src, err := ioutil.ReadFile(filename)
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to read workspace variables file",
+			fmt.Sprintf("The variables file %s recorded in the metadata for workspace %q could not be read: %s.", meta.VarFile, op.Workspace, err),
+		))
+		return existing, diags
+	}
+
+	var f *hcl.File
+	var hclDiags hcl.Diagnostics
+	if strings.HasSuffix(filename, ".json") {
+		f, hclDiags = hcljson.Parse(src, filename)
+	} else {
+		f, hclDiags = hclsyntax.ParseConfig(src, filename, hcl.Pos{Line: 1, Column: 1})
+	}
+	diags = diags.Append(hclDiags)
+	if f == nil || f.Body == nil {
+		return existing, diags
+	}
+
+	attrs, hclDiags := f.Body.JustAttributes()
+	diags = diags.Append(hclDiags)
+
+	ret := make(map[string]backend.UnparsedVariableValue, len(existing)+len(attrs))
+	for name, attr := range attrs {
+		ret[name] = unparsedWorkspaceVariableValue{expr: attr.Expr}
+	}
+	for k, v := range existing {
+		ret[k] = v
+	}
+	return ret, diags
+}
+
+// unparsedWorkspaceVariableValue is a backend.UnparsedVariableValue
+// implementation for expressions found in a workspace's default var-file,
+// which was already parsed as part of loading it.
+type unparsedWorkspaceVariableValue struct {
+	expr hcl.Expression
+}
+
+var _ backend.UnparsedVariableValue = unparsedWorkspaceVariableValue{}
+
+func (v unparsedWorkspaceVariableValue) ParseVariableValue(mode configs.VariableParsingMode) (*terraform.InputValue, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+	val, hclDiags := v.expr.Value(nil) // nil because no function calls or variable references are allowed here
+	diags = diags.Append(hclDiags)
+
+	return &terraform.InputValue{
+		Value:       val,
+		SourceType:  terraform.ValueFromNamedFile,
+		SourceRange: tfdiags.SourceRangeFromHCL(v.expr.Range()),
+	}, diags
+}
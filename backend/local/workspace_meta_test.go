@@ -0,0 +1,52 @@
+package local
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform/states/statemgr"
+)
+
+func TestLocal_workspaceMeta(t *testing.T) {
+	defer testTmpDir(t)()
+	b := New()
+
+	// A workspace with no metadata recorded has nil metadata, not an error.
+	meta, err := b.WorkspaceMeta("default")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if meta != nil {
+		t.Fatalf("unexpected metadata for default workspace: %#v", meta)
+	}
+
+	if err := b.SetWorkspaceMeta("prod", &statemgr.WorkspaceMeta{
+		Description: "production environment",
+		VarFile:     "prod.tfvars",
+	}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	meta, err = b.WorkspaceMeta("prod")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if meta == nil {
+		t.Fatal("no metadata for workspace prod")
+	}
+	if got, want := meta.Description, "production environment"; got != want {
+		t.Errorf("wrong description %q; want %q", got, want)
+	}
+	if got, want := meta.VarFile, "prod.tfvars"; got != want {
+		t.Errorf("wrong var-file %q; want %q", got, want)
+	}
+	if meta.CreatedAt.IsZero() {
+		t.Error("CreatedAt was not populated on write")
+	}
+
+	// The metadata document must live alongside the workspace's state file.
+	wantPath := filepath.Join(DefaultWorkspaceDir, "prod", DefaultStateFilename+DefaultWorkspaceMetaExtension)
+	if got := b.WorkspaceMetaPath("prod"); got != wantPath {
+		t.Errorf("wrong metadata path %q; want %q", got, wantPath)
+	}
+}
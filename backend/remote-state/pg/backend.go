@@ -22,6 +22,7 @@ func New() backend.Backend {
 			"conn_str": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("PG_CONN_STR", nil),
 				Description: "Postgres connection string; a `postgres://` URL",
 			},
 
@@ -0,0 +1,169 @@
+package s3compat
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+
+	"github.com/hashicorp/terraform/backend"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// New creates a new backend for S3-compatible object stores such as MinIO
+// and Ceph RADOS Gateway.
+//
+// Unlike the "s3" backend this one always uses path-style addressing, takes
+// an explicit endpoint rather than deriving one from an AWS region, and
+// implements locking using a lock object in the same bucket rather than a
+// DynamoDB table, since S3-compatible stores generally don't have DynamoDB
+// available alongside them.
+func New() backend.Backend {
+	s := &schema.Backend{
+		Schema: map[string]*schema.Schema{
+			"endpoint": {
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("S3COMPAT_ENDPOINT", nil),
+				Description: "The URL of the S3-compatible endpoint",
+			},
+
+			"bucket": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the bucket",
+			},
+
+			"key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The path to the state file inside the bucket",
+				Default:     "terraform.tfstate",
+				ValidateFunc: func(v interface{}, s string) ([]string, []error) {
+					// s3 will strip leading slashes from an object, so while this will
+					// technically be accepted by s3, it will break our workspace hierarchy.
+					if strings.HasPrefix(v.(string), "/") {
+						return nil, []error{fmt.Errorf("key must not start with '/'")}
+					}
+					return nil, nil
+				},
+			},
+
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The region name to send in requests; many S3-compatible stores ignore this",
+				DefaultFunc: schema.EnvDefaultFunc("AWS_DEFAULT_REGION", "us-east-1"),
+			},
+
+			"access_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AWS_ACCESS_KEY_ID", nil),
+				Description: "The access key to authenticate with",
+			},
+
+			"secret_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AWS_SECRET_ACCESS_KEY", nil),
+				Description: "The secret key to authenticate with",
+				Sensitive:   true,
+			},
+
+			"workspace_key_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The prefix applied to the state path inside the bucket for non-default workspaces",
+				Default:     "env:",
+				ValidateFunc: func(v interface{}, s string) ([]string, []error) {
+					prefix := v.(string)
+					if strings.HasPrefix(prefix, "/") || strings.HasSuffix(prefix, "/") {
+						return nil, []error{fmt.Errorf("workspace_key_prefix must not start or end with '/'")}
+					}
+					return nil, nil
+				},
+			},
+
+			"skip_tls_verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Do not verify the TLS certificate of the endpoint",
+				Default:     false,
+			},
+
+			"lock": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether to hold an advisory lock object in the bucket during operations",
+				Default:     true,
+			},
+		},
+	}
+
+	result := &Backend{Backend: s}
+	result.Backend.ConfigureFunc = result.configure
+	return result
+}
+
+type Backend struct {
+	*schema.Backend
+
+	// The fields below are set from configure
+	s3Client           *s3.S3
+	bucketName         string
+	keyName            string
+	workspaceKeyPrefix string
+	doLock             bool
+}
+
+func (b *Backend) configure(ctx context.Context) error {
+	if b.s3Client != nil {
+		return nil
+	}
+
+	// Grab the resource data
+	data := schema.FromContextBackendConfig(ctx)
+
+	b.bucketName = data.Get("bucket").(string)
+	b.keyName = data.Get("key").(string)
+	b.workspaceKeyPrefix = data.Get("workspace_key_prefix").(string)
+	b.doLock = data.Get("lock").(bool)
+
+	cfg := &aws.Config{
+		Credentials: credentials.NewStaticCredentials(
+			data.Get("access_key").(string),
+			data.Get("secret_key").(string),
+			"",
+		),
+		Endpoint: aws.String(data.Get("endpoint").(string)),
+		Region:   aws.String(data.Get("region").(string)),
+
+		// Path-style addressing works against any S3-compatible store,
+		// while virtual-hosted style requires wildcard DNS that most
+		// self-hosted deployments don't have.
+		S3ForcePathStyle: aws.Bool(true),
+	}
+
+	if data.Get("skip_tls_verify").(bool) {
+		cfg.HTTPClient = &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+			},
+		}
+	}
+
+	sess, err := session.NewSession(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to configure S3-compatible client: %s", err)
+	}
+
+	b.s3Client = s3.New(sess)
+	return nil
+}
@@ -0,0 +1,54 @@
+package s3compat
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/backend"
+	"github.com/hashicorp/terraform/state/remote"
+)
+
+func TestBackend_impl(t *testing.T) {
+	var _ backend.Backend = new(Backend)
+}
+
+func TestRemoteClient_impl(t *testing.T) {
+	var _ remote.Client = new(RemoteClient)
+	var _ remote.ClientLocker = new(RemoteClient)
+}
+
+func TestBackendConfig(t *testing.T) {
+	config := map[string]interface{}{
+		"endpoint":   "http://127.0.0.1:9000",
+		"bucket":     "tf-test",
+		"key":        "state",
+		"access_key": "minioadmin",
+		"secret_key": "minioadmin",
+	}
+
+	b := backend.TestBackendConfig(t, New(), backend.TestWrapConfig(config)).(*Backend)
+
+	if b.s3Client == nil {
+		t.Fatal("s3 client not configured")
+	}
+	if b.bucketName != "tf-test" {
+		t.Fatalf("wrong bucket name %q", b.bucketName)
+	}
+	if b.keyName != "state" {
+		t.Fatalf("wrong key name %q", b.keyName)
+	}
+	if !b.doLock {
+		t.Fatal("locking should default to enabled")
+	}
+	if got, want := b.path(backend.DefaultStateName), "state"; got != want {
+		t.Fatalf("wrong default path %q; want %q", got, want)
+	}
+	if got, want := b.path("dev"), "env:/dev/state"; got != want {
+		t.Fatalf("wrong workspace path %q; want %q", got, want)
+	}
+	if got, want := b.keyEnv("env:/dev/state"), "dev"; got != want {
+		t.Fatalf("wrong workspace from key: %q; want %q", got, want)
+	}
+	if got := b.keyEnv("env:/dev/other"); got != "" {
+		t.Fatalf("unexpected workspace from foreign key: %q", got)
+	}
+}
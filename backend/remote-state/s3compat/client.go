@@ -0,0 +1,246 @@
+package s3compat
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	multierror "github.com/hashicorp/go-multierror"
+	uuid "github.com/hashicorp/go-uuid"
+
+	"github.com/hashicorp/terraform/state"
+	"github.com/hashicorp/terraform/state/remote"
+)
+
+// lockSuffix is appended to the state path to produce the key of the
+// advisory lock object.
+const lockSuffix = ".tflock"
+
+type RemoteClient struct {
+	s3Client   *s3.S3
+	bucketName string
+	path       string
+	doLock     bool
+}
+
+var (
+	_ remote.Client       = (*RemoteClient)(nil)
+	_ remote.ClientLocker = (*RemoteClient)(nil)
+)
+
+func (c *RemoteClient) Get() (*remote.Payload, error) {
+	output, err := c.s3Client.GetObject(&s3.GetObjectInput{
+		Bucket: &c.bucketName,
+		Key:    &c.path,
+	})
+	if err != nil {
+		if awserr, ok := err.(awserr.Error); ok {
+			switch awserr.Code() {
+			case s3.ErrCodeNoSuchBucket:
+				return nil, fmt.Errorf(errNoSuchBucket, err)
+			case s3.ErrCodeNoSuchKey:
+				return nil, nil
+			}
+		}
+		return nil, err
+	}
+
+	defer output.Body.Close()
+
+	buf := bytes.NewBuffer(nil)
+	if _, err := io.Copy(buf, output.Body); err != nil {
+		return nil, fmt.Errorf("failed to read remote state: %s", err)
+	}
+
+	sum := md5.Sum(buf.Bytes())
+	payload := &remote.Payload{
+		Data: buf.Bytes(),
+		MD5:  sum[:],
+	}
+
+	// If there was no data, then return nil
+	if len(payload.Data) == 0 {
+		return nil, nil
+	}
+
+	return payload, nil
+}
+
+func (c *RemoteClient) Put(data []byte) error {
+	contentType := "application/json"
+	contentLength := int64(len(data))
+
+	log.Printf("[DEBUG] Uploading remote state to S3-compatible store: %s/%s", c.bucketName, c.path)
+
+	_, err := c.s3Client.PutObject(&s3.PutObjectInput{
+		ContentType:   &contentType,
+		ContentLength: &contentLength,
+		Body:          bytes.NewReader(data),
+		Bucket:        &c.bucketName,
+		Key:           &c.path,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upload state: %s", err)
+	}
+
+	return nil
+}
+
+func (c *RemoteClient) Delete() error {
+	_, err := c.s3Client.DeleteObject(&s3.DeleteObjectInput{
+		Bucket: &c.bucketName,
+		Key:    &c.path,
+	})
+
+	return err
+}
+
+// Lock takes the advisory lock for this state by writing a lock object next
+// to the state object.
+//
+// Most S3-compatible stores don't support conditional writes, so there is a
+// small window in which two concurrent writers can both believe they created
+// the lock object. To narrow that window we read the lock object back after
+// writing it and verify that it still records our lock ID, failing if some
+// other writer overwrote it in the meantime.
+func (c *RemoteClient) Lock(info *state.LockInfo) (string, error) {
+	if !c.doLock {
+		return "", nil
+	}
+
+	info.Path = c.lockPath()
+
+	if info.ID == "" {
+		lockID, err := uuid.GenerateUUID()
+		if err != nil {
+			return "", err
+		}
+		info.ID = lockID
+	}
+
+	// If a lock object already exists then someone else holds the lock.
+	if existing, err := c.lockInfo(); err != nil {
+		return "", &state.LockError{Err: err}
+	} else if existing != nil {
+		return "", &state.LockError{
+			Info: existing,
+			Err:  fmt.Errorf("lock object %q already exists", c.lockPath()),
+		}
+	}
+
+	if err := c.putLockInfo(info); err != nil {
+		return "", &state.LockError{Err: err}
+	}
+
+	// Read the lock object back to make sure we weren't raced by another
+	// writer between our existence check and our write.
+	written, err := c.lockInfo()
+	if err != nil {
+		return "", &state.LockError{Err: err}
+	}
+	if written == nil || written.ID != info.ID {
+		return "", &state.LockError{
+			Info: written,
+			Err:  fmt.Errorf("lock object %q was taken by a concurrent writer", c.lockPath()),
+		}
+	}
+
+	return info.ID, nil
+}
+
+func (c *RemoteClient) Unlock(id string) error {
+	if !c.doLock {
+		return nil
+	}
+
+	lockErr := &state.LockError{}
+
+	existing, err := c.lockInfo()
+	if err != nil {
+		lockErr.Err = fmt.Errorf("failed to retrieve lock info: %s", err)
+		return lockErr
+	}
+	if existing == nil {
+		lockErr.Err = fmt.Errorf("state is not locked")
+		return lockErr
+	}
+	lockErr.Info = existing
+
+	if existing.ID != id {
+		lockErr.Err = fmt.Errorf("lock id %q does not match existing lock", id)
+		return lockErr
+	}
+
+	_, err = c.s3Client.DeleteObject(&s3.DeleteObjectInput{
+		Bucket: &c.bucketName,
+		Key:    aws.String(c.lockPath()),
+	})
+	if err != nil {
+		lockErr.Err = multierror.Append(lockErr.Err, err)
+		return lockErr
+	}
+
+	return nil
+}
+
+func (c *RemoteClient) lockPath() string {
+	return c.path + lockSuffix
+}
+
+// lockInfo reads the current lock object, returning nil if it doesn't exist.
+func (c *RemoteClient) lockInfo() (*state.LockInfo, error) {
+	output, err := c.s3Client.GetObject(&s3.GetObjectInput{
+		Bucket: &c.bucketName,
+		Key:    aws.String(c.lockPath()),
+	})
+	if err != nil {
+		if awserr, ok := err.(awserr.Error); ok && awserr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	defer output.Body.Close()
+
+	buf := bytes.NewBuffer(nil)
+	if _, err := io.Copy(buf, output.Body); err != nil {
+		return nil, fmt.Errorf("failed to read lock object: %s", err)
+	}
+
+	info := &state.LockInfo{}
+	if err := json.Unmarshal(buf.Bytes(), info); err != nil {
+		return nil, fmt.Errorf("invalid lock object %q: %s", c.lockPath(), err)
+	}
+
+	return info, nil
+}
+
+func (c *RemoteClient) putLockInfo(info *state.LockInfo) error {
+	data := info.Marshal()
+	contentType := "application/json"
+	contentLength := int64(len(data))
+
+	_, err := c.s3Client.PutObject(&s3.PutObjectInput{
+		ContentType:   &contentType,
+		ContentLength: &contentLength,
+		Body:          bytes.NewReader(data),
+		Bucket:        &c.bucketName,
+		Key:           aws.String(c.lockPath()),
+	})
+	return err
+}
+
+const errNoSuchBucket = `Bucket does not exist.
+
+The referenced bucket must have been previously created. If the bucket was
+created within the last minute, please wait for a minute or two and try
+again.
+
+Error: %s
+`
@@ -78,6 +78,7 @@ func (b *Remote) Context(op *backend.Operation) (*terraform.Context, statemgr.Fu
 	// Copy set options from the operation
 	opts.Destroy = op.Destroy
 	opts.Targets = op.Targets
+	opts.Excludes = op.Excludes
 	opts.UIInput = op.UIIn
 
 	// Load the latest state. If we enter contextFromPlanFile below then the
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/hashicorp/terraform/tfdiags"
 	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
 )
 
 // UnparsedVariableValue represents a variable value provided by the caller
@@ -42,6 +43,55 @@ type UnparsedVariableValue interface {
 // that were successfully processed, allowing for careful analysis of the
 // partial result.
 func ParseVariableValues(vv map[string]UnparsedVariableValue, decls map[string]*configs.Variable) (terraform.InputValues, tfdiags.Diagnostics) {
+	ret, diags := ParseVariableValuesExplicit(vv, decls)
+
+	// By this point we should've gathered all of the required root module
+	// variables from one of the many possible sources. We'll now populate
+	// any we haven't gathered as their defaults and fail if any of the
+	// missing ones are required.
+	for name, vc := range decls {
+		if _, defined := ret[name]; defined {
+			continue
+		}
+
+		if vc.Required() {
+			diags = diags.Append(&hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "No value for required variable",
+				Detail:   fmt.Sprintf("The root module input variable %q is not set, and has no default value. Use a -var or -var-file command line argument to provide a value for this variable.", name),
+				Subject:  vc.DeclRange.Ptr(),
+			})
+
+			// We'll include a placeholder value anyway, just so that our
+			// result is complete for any calling code that wants to cautiously
+			// analyze it for diagnostic purposes. Since our diagnostics now
+			// includes an error, normal processing will ignore this result.
+			ret[name] = &terraform.InputValue{
+				Value:       cty.DynamicVal,
+				SourceType:  terraform.ValueFromConfig,
+				SourceRange: tfdiags.SourceRangeFromHCL(vc.DeclRange),
+			}
+		} else {
+			ret[name] = &terraform.InputValue{
+				Value:       vc.Default,
+				SourceType:  terraform.ValueFromConfig,
+				SourceRange: tfdiags.SourceRangeFromHCL(vc.DeclRange),
+			}
+		}
+	}
+
+	return ret, diags
+}
+
+// ParseVariableValuesExplicit is like ParseVariableValues except that it
+// processes only the values that were actually given, without substituting
+// defaults for or raising errors about any declared variables that are not
+// included in the map.
+//
+// This is for situations where the caller already has a complete set of
+// variable values from another source, such as a saved plan, and the given
+// values serve only to override or validate against that complete set.
+func ParseVariableValuesExplicit(vv map[string]UnparsedVariableValue, decls map[string]*configs.Variable) (terraform.InputValues, tfdiags.Diagnostics) {
 	var diags tfdiags.Diagnostics
 	ret := make(terraform.InputValues, len(vv))
 
@@ -66,6 +116,36 @@ func ParseVariableValues(vv map[string]UnparsedVariableValue, decls map[string]*
 			continue
 		}
 
+		if declared && config.Type != cty.NilType {
+			// Convert the value to the declared type constraint now, so that
+			// any conversion error is reported against the location where the
+			// value was defined rather than deep in the expression evaluator.
+			converted, err := convert.Convert(val.Value, config.Type)
+			if err != nil {
+				if val.SourceRange.Filename != "" {
+					diags = diags.Append(&hcl.Diagnostic{
+						Severity: hcl.DiagError,
+						Summary:  "Invalid value for input variable",
+						Detail:   fmt.Sprintf("The value for variable %q is not compatible with the variable's type constraint: %s.", name, err),
+						Subject:  val.SourceRange.ToHCL().Ptr(),
+					})
+				} else {
+					diags = diags.Append(tfdiags.Sourceless(
+						tfdiags.Error,
+						"Invalid value for input variable",
+						fmt.Sprintf("The value for variable %q is not compatible with the variable's type constraint: %s.", name, err),
+					))
+				}
+				// We'll keep a placeholder value so that the result is
+				// complete for any calling code that wants to cautiously
+				// analyze it for diagnostic purposes, as with the
+				// missing-required placeholder below.
+				val.Value = cty.DynamicVal
+			} else {
+				val.Value = converted
+			}
+		}
+
 		if !declared {
 			switch val.SourceType {
 			case terraform.ValueFromConfig, terraform.ValueFromAutoFile, terraform.ValueFromNamedFile:
@@ -123,40 +203,5 @@ func ParseVariableValues(vv map[string]UnparsedVariableValue, decls map[string]*
 		})
 	}
 
-	// By this point we should've gathered all of the required root module
-	// variables from one of the many possible sources. We'll now populate
-	// any we haven't gathered as their defaults and fail if any of the
-	// missing ones are required.
-	for name, vc := range decls {
-		if _, defined := ret[name]; defined {
-			continue
-		}
-
-		if vc.Required() {
-			diags = diags.Append(&hcl.Diagnostic{
-				Severity: hcl.DiagError,
-				Summary:  "No value for required variable",
-				Detail:   fmt.Sprintf("The root module input variable %q is not set, and has no default value. Use a -var or -var-file command line argument to provide a value for this variable.", name),
-				Subject:  vc.DeclRange.Ptr(),
-			})
-
-			// We'll include a placeholder value anyway, just so that our
-			// result is complete for any calling code that wants to cautiously
-			// analyze it for diagnostic purposes. Since our diagnostics now
-			// includes an error, normal processing will ignore this result.
-			ret[name] = &terraform.InputValue{
-				Value:       cty.DynamicVal,
-				SourceType:  terraform.ValueFromConfig,
-				SourceRange: tfdiags.SourceRangeFromHCL(vc.DeclRange),
-			}
-		} else {
-			ret[name] = &terraform.InputValue{
-				Value:       vc.Default,
-				SourceType:  terraform.ValueFromConfig,
-				SourceRange: tfdiags.SourceRangeFromHCL(vc.DeclRange),
-			}
-		}
-	}
-
 	return ret, diags
 }
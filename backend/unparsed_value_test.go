@@ -117,6 +117,61 @@ func TestParseVariableValuesUndeclared(t *testing.T) {
 	}
 }
 
+func TestParseVariableValuesTypeConversion(t *testing.T) {
+	vv := map[string]UnparsedVariableValue{
+		"convertible":   testUnparsedVariableValue("5"),
+		"inconvertible": testUnparsedVariableValue("not a number"),
+	}
+	decls := map[string]*configs.Variable{
+		"convertible": {
+			Name:        "convertible",
+			Type:        cty.Number,
+			ParsingMode: configs.VariableParseLiteral,
+			DeclRange: hcl.Range{
+				Filename: "fake.tf",
+				Start:    hcl.Pos{Line: 2, Column: 1, Byte: 0},
+				End:      hcl.Pos{Line: 2, Column: 1, Byte: 0},
+			},
+		},
+		"inconvertible": {
+			Name:        "inconvertible",
+			Type:        cty.Number,
+			ParsingMode: configs.VariableParseLiteral,
+			DeclRange: hcl.Range{
+				Filename: "fake.tf",
+				Start:    hcl.Pos{Line: 3, Column: 1, Byte: 0},
+				End:      hcl.Pos{Line: 3, Column: 1, Byte: 0},
+			},
+		},
+	}
+
+	gotVals, diags := ParseVariableValues(vv, decls)
+	for _, diag := range diags {
+		t.Logf("%s: %s", diag.Description().Summary, diag.Description().Detail)
+	}
+	if got, want := len(diags), 1; got != want {
+		t.Fatalf("wrong number of diagnostics %d; want %d", got, want)
+	}
+	if got, want := diags[0].Description().Summary, `Invalid value for input variable`; got != want {
+		t.Errorf("wrong summary for diagnostic 0\ngot:  %s\nwant: %s", got, want)
+	}
+
+	got, defined := gotVals["convertible"]
+	if !defined {
+		t.Fatal("no value at all for \"convertible\"")
+	}
+	if want := cty.NumberIntVal(5); !got.Value.RawEquals(want) {
+		t.Errorf("wrong value for \"convertible\"\ngot:  %#v\nwant: %#v", got.Value, want)
+	}
+	got, defined = gotVals["inconvertible"]
+	if !defined {
+		t.Fatal("no value at all for \"inconvertible\"")
+	}
+	if want := cty.DynamicVal; !got.Value.RawEquals(want) {
+		t.Errorf("wrong placeholder for \"inconvertible\"\ngot:  %#v\nwant: %#v", got.Value, want)
+	}
+}
+
 type testUnparsedVariableValue string
 
 func (v testUnparsedVariableValue) ParseVariableValue(mode configs.VariableParsingMode) (*terraform.InputValue, tfdiags.Diagnostics) {
@@ -0,0 +1,200 @@
+package external
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"time"
+
+	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/hashicorp/terraform/providers"
+	"github.com/hashicorp/terraform/tfdiags"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/gocty"
+)
+
+func dataSourceExternalGetSchema() providers.Schema {
+	return providers.Schema{
+		Block: &configschema.Block{
+			Attributes: map[string]*configschema.Attribute{
+				"program": {
+					Type:     cty.List(cty.String),
+					Required: true,
+				},
+				"working_dir": {
+					Type:     cty.String,
+					Optional: true,
+				},
+				"query": {
+					Type:     cty.Map(cty.String),
+					Optional: true,
+				},
+				"timeout": {
+					Type:     cty.Number,
+					Optional: true,
+				},
+				"result": {
+					Type:     cty.Map(cty.String),
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceExternalValidate(cfg cty.Value) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+
+	program := cfg.GetAttr("program")
+	if program.IsKnown() {
+		if program.IsNull() || program.LengthInt() < 1 {
+			diags = diags.Append(tfdiags.AttributeValue(
+				tfdiags.Error,
+				"Invalid external program",
+				"The \"program\" attribute must contain at least one element, naming the program to run.",
+				cty.GetAttrPath("program"),
+			))
+		}
+	}
+
+	timeout := cfg.GetAttr("timeout")
+	if timeout.IsKnown() && !timeout.IsNull() {
+		var seconds int
+		if err := gocty.FromCtyValue(timeout, &seconds); err != nil || seconds < 1 {
+			diags = diags.Append(tfdiags.AttributeValue(
+				tfdiags.Error,
+				"Invalid external program timeout",
+				"The \"timeout\" attribute must be a whole number of seconds, at least 1.",
+				cty.GetAttrPath("timeout"),
+			))
+		}
+	}
+
+	return diags
+}
+
+func dataSourceExternalRead(cfg cty.Value) (cty.Value, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	var program []string
+	for it := cfg.GetAttr("program").ElementIterator(); it.Next(); {
+		_, v := it.Element()
+		if v.IsNull() {
+			diags = diags.Append(tfdiags.AttributeValue(
+				tfdiags.Error,
+				"Invalid external program",
+				"The elements of \"program\" must not be null.",
+				cty.GetAttrPath("program"),
+			))
+			return cty.NilVal, diags
+		}
+		program = append(program, v.AsString())
+	}
+	if len(program) < 1 {
+		diags = diags.Append(tfdiags.AttributeValue(
+			tfdiags.Error,
+			"Invalid external program",
+			"The \"program\" attribute must contain at least one element, naming the program to run.",
+			cty.GetAttrPath("program"),
+		))
+		return cty.NilVal, diags
+	}
+
+	query := map[string]string{}
+	if queryVal := cfg.GetAttr("query"); !queryVal.IsNull() {
+		for it := queryVal.ElementIterator(); it.Next(); {
+			k, v := it.Element()
+			if v.IsNull() {
+				continue
+			}
+			query[k.AsString()] = v.AsString()
+		}
+	}
+	queryJSON, err := json.Marshal(query)
+	if err != nil {
+		// Should never happen, since we constructed this map ourselves.
+		diags = diags.Append(err)
+		return cty.NilVal, diags
+	}
+
+	ctx := context.Background()
+	if timeoutVal := cfg.GetAttr("timeout"); !timeoutVal.IsNull() {
+		var seconds int
+		if err := gocty.FromCtyValue(timeoutVal, &seconds); err != nil {
+			diags = diags.Append(tfdiags.AttributeValue(
+				tfdiags.Error,
+				"Invalid external program timeout",
+				"The \"timeout\" attribute must be a whole number of seconds.",
+				cty.GetAttrPath("timeout"),
+			))
+			return cty.NilVal, diags
+		}
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(seconds)*time.Second)
+		defer cancel()
+	}
+
+	cmd := exec.CommandContext(ctx, program[0], program[1:]...)
+	cmd.Stdin = bytes.NewReader(queryJSON)
+	if workingDir := cfg.GetAttr("working_dir"); !workingDir.IsNull() {
+		cmd.Dir = workingDir.AsString()
+	}
+
+	stderr := &bytes.Buffer{}
+	cmd.Stderr = stderr
+
+	stdout, err := cmd.Output()
+	if ctx.Err() == context.DeadlineExceeded {
+		diags = diags.Append(tfdiags.AttributeValue(
+			tfdiags.Error,
+			"External program timed out",
+			fmt.Sprintf("The program %q did not complete within the configured timeout.", program[0]),
+			cty.GetAttrPath("program"),
+		))
+		return cty.NilVal, diags
+	}
+	if err != nil {
+		detail := fmt.Sprintf("The program %q failed: %s.", program[0], err)
+		if stderr.Len() > 0 {
+			detail = fmt.Sprintf("The program %q failed: %s.\n\nThe program reported:\n%s", program[0], err, stderr.String())
+		}
+		diags = diags.Append(tfdiags.AttributeValue(
+			tfdiags.Error,
+			"External program failed",
+			detail,
+			cty.GetAttrPath("program"),
+		))
+		return cty.NilVal, diags
+	}
+
+	result := map[string]string{}
+	if err := json.Unmarshal(stdout, &result); err != nil {
+		diags = diags.Append(tfdiags.AttributeValue(
+			tfdiags.Error,
+			"Invalid result from external program",
+			fmt.Sprintf("The program %q produced output that is not a JSON object of strings: %s.", program[0], err),
+			cty.GetAttrPath("program"),
+		))
+		return cty.NilVal, diags
+	}
+
+	resultVal := cty.MapValEmpty(cty.String)
+	if len(result) > 0 {
+		vals := make(map[string]cty.Value, len(result))
+		for k, v := range result {
+			vals[k] = cty.StringVal(v)
+		}
+		resultVal = cty.MapVal(vals)
+	}
+
+	newState := cty.ObjectVal(map[string]cty.Value{
+		"program":     cfg.GetAttr("program"),
+		"working_dir": cfg.GetAttr("working_dir"),
+		"query":       cfg.GetAttr("query"),
+		"timeout":     cfg.GetAttr("timeout"),
+		"result":      resultVal,
+	})
+	return newState, diags
+}
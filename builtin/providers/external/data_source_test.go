@@ -0,0 +1,211 @@
+package external
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestDataSourceSchema(t *testing.T) {
+	if err := dataSourceExternalGetSchema().Block.InternalValidate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	var tests = map[string]struct {
+		Config cty.Value
+		Err    bool
+	}{
+		"valid": {
+			testConfig(cty.ListVal([]cty.Value{cty.StringVal("cat")}), cty.NullVal(cty.Map(cty.String)), cty.NullVal(cty.String), cty.NullVal(cty.Number)),
+			false,
+		},
+		"empty program": {
+			testConfig(cty.ListValEmpty(cty.String), cty.NullVal(cty.Map(cty.String)), cty.NullVal(cty.String), cty.NullVal(cty.Number)),
+			true,
+		},
+		"unknown program": {
+			testConfig(cty.UnknownVal(cty.List(cty.String)), cty.NullVal(cty.Map(cty.String)), cty.NullVal(cty.String), cty.NullVal(cty.Number)),
+			false,
+		},
+		"invalid timeout": {
+			testConfig(cty.ListVal([]cty.Value{cty.StringVal("cat")}), cty.NullVal(cty.Map(cty.String)), cty.NullVal(cty.String), cty.NumberIntVal(0)),
+			true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			diags := dataSourceExternalValidate(test.Config)
+			if diags.HasErrors() != test.Err {
+				t.Fatalf("wrong result\ngot: %s\nwant error: %t", diags.Err(), test.Err)
+			}
+		})
+	}
+}
+
+func TestRead_basic(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test requires a unix shell")
+	}
+
+	config := testConfig(
+		cty.ListVal([]cty.Value{
+			cty.StringVal("sh"),
+			cty.StringVal("-c"),
+			cty.StringVal(`cat >/dev/null; echo '{"greeting":"hello"}'`),
+		}),
+		cty.MapVal(map[string]cty.Value{
+			"name": cty.StringVal("world"),
+		}),
+		cty.NullVal(cty.String),
+		cty.NullVal(cty.Number),
+	)
+
+	got, diags := dataSourceExternalRead(config)
+	if diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+
+	result := got.GetAttr("result")
+	want := cty.MapVal(map[string]cty.Value{
+		"greeting": cty.StringVal("hello"),
+	})
+	if !result.RawEquals(want) {
+		t.Errorf("wrong result\ngot:  %#v\nwant: %#v", result, want)
+	}
+}
+
+func TestRead_query(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test requires a unix shell")
+	}
+
+	// The program here just echoes its stdin back, so the result should
+	// match the query we pass in.
+	config := testConfig(
+		cty.ListVal([]cty.Value{
+			cty.StringVal("sh"),
+			cty.StringVal("-c"),
+			cty.StringVal("cat"),
+		}),
+		cty.MapVal(map[string]cty.Value{
+			"name": cty.StringVal("world"),
+		}),
+		cty.NullVal(cty.String),
+		cty.NullVal(cty.Number),
+	)
+
+	got, diags := dataSourceExternalRead(config)
+	if diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+
+	result := got.GetAttr("result")
+	want := cty.MapVal(map[string]cty.Value{
+		"name": cty.StringVal("world"),
+	})
+	if !result.RawEquals(want) {
+		t.Errorf("wrong result\ngot:  %#v\nwant: %#v", result, want)
+	}
+}
+
+func TestRead_workingDir(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test requires a unix shell")
+	}
+
+	config := testConfig(
+		cty.ListVal([]cty.Value{
+			cty.StringVal("sh"),
+			cty.StringVal("-c"),
+			cty.StringVal(`echo "{\"pwd\":\"$PWD\"}"`),
+		}),
+		cty.NullVal(cty.Map(cty.String)),
+		cty.StringVal("/"),
+		cty.NullVal(cty.Number),
+	)
+
+	got, diags := dataSourceExternalRead(config)
+	if diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+
+	result := got.GetAttr("result")
+	want := cty.MapVal(map[string]cty.Value{
+		"pwd": cty.StringVal("/"),
+	})
+	if !result.RawEquals(want) {
+		t.Errorf("wrong result\ngot:  %#v\nwant: %#v", result, want)
+	}
+}
+
+func TestRead_failure(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test requires a unix shell")
+	}
+
+	config := testConfig(
+		cty.ListVal([]cty.Value{
+			cty.StringVal("sh"),
+			cty.StringVal("-c"),
+			cty.StringVal("echo 'oh no' >&2; exit 1"),
+		}),
+		cty.NullVal(cty.Map(cty.String)),
+		cty.NullVal(cty.String),
+		cty.NullVal(cty.Number),
+	)
+
+	_, diags := dataSourceExternalRead(config)
+	if !diags.HasErrors() {
+		t.Fatal("unexpected success; want error")
+	}
+	for _, diag := range diags {
+		if desc := diag.Description(); desc.Summary == "External program failed" {
+			return
+		}
+	}
+	t.Fatalf("no diagnostic about program failure; got %s", diags.Err())
+}
+
+func TestRead_timeout(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test requires a unix shell")
+	}
+
+	config := testConfig(
+		cty.ListVal([]cty.Value{
+			cty.StringVal("sh"),
+			cty.StringVal("-c"),
+			cty.StringVal("sleep 10"),
+		}),
+		cty.NullVal(cty.Map(cty.String)),
+		cty.NullVal(cty.String),
+		cty.NumberIntVal(1),
+	)
+
+	_, diags := dataSourceExternalRead(config)
+	if !diags.HasErrors() {
+		t.Fatal("unexpected success; want timeout error")
+	}
+	for _, diag := range diags {
+		if desc := diag.Description(); desc.Summary == "External program timed out" {
+			return
+		}
+	}
+	t.Fatalf("no diagnostic about timeout; got %s", diags.Err())
+}
+
+// testConfig assembles a configuration object of the type implied by the
+// data source schema.
+func testConfig(program, query, workingDir, timeout cty.Value) cty.Value {
+	return cty.ObjectVal(map[string]cty.Value{
+		"program":     program,
+		"query":       query,
+		"working_dir": workingDir,
+		"timeout":     timeout,
+		"result":      cty.NullVal(cty.Map(cty.String)),
+	})
+}
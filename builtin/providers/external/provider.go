@@ -0,0 +1,128 @@
+package external
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/providers"
+)
+
+// Provider is an implementation of providers.Interface that supplies the
+// "external" data source, which runs a local program as an escape hatch
+// for integrating with systems that have no first-class provider.
+type Provider struct{}
+
+// NewProvider returns a new external provider
+func NewProvider() *Provider {
+	return &Provider{}
+}
+
+// GetSchema returns the complete schema for the provider.
+func (p *Provider) GetSchema() providers.GetSchemaResponse {
+	return providers.GetSchemaResponse{
+		DataSources: map[string]providers.Schema{
+			"external": dataSourceExternalGetSchema(),
+		},
+	}
+}
+
+// PrepareProviderConfig is used to validate the configuration values.
+func (p *Provider) PrepareProviderConfig(req providers.PrepareProviderConfigRequest) providers.PrepareProviderConfigResponse {
+	// At this moment there is nothing to configure for the external provider,
+	// so we will happily return without taking any action
+	var res providers.PrepareProviderConfigResponse
+	res.PreparedConfig = req.Config
+	return res
+}
+
+// ValidateDataSourceConfig is used to validate the data source configuration values.
+func (p *Provider) ValidateDataSourceConfig(req providers.ValidateDataSourceConfigRequest) providers.ValidateDataSourceConfigResponse {
+	var res providers.ValidateDataSourceConfigResponse
+
+	// This should not happen
+	if req.TypeName != "external" {
+		res.Diagnostics = res.Diagnostics.Append(fmt.Errorf("Error: unsupported data source %s", req.TypeName))
+		return res
+	}
+
+	res.Diagnostics = dataSourceExternalValidate(req.Config)
+	return res
+}
+
+// Configure configures and initializes the provider.
+func (p *Provider) Configure(providers.ConfigureRequest) providers.ConfigureResponse {
+	// At this moment there is nothing to configure for the external provider,
+	// so we will happily return without taking any action
+	var res providers.ConfigureResponse
+	return res
+}
+
+// ReadDataSource runs the configured program and returns its result.
+func (p *Provider) ReadDataSource(req providers.ReadDataSourceRequest) providers.ReadDataSourceResponse {
+	var res providers.ReadDataSourceResponse
+
+	// This should not happen
+	if req.TypeName != "external" {
+		res.Diagnostics = res.Diagnostics.Append(fmt.Errorf("Error: unsupported data source %s", req.TypeName))
+		return res
+	}
+
+	newState, diags := dataSourceExternalRead(req.Config)
+	res.State = newState
+	res.Diagnostics = diags
+	return res
+}
+
+// Stop is called when the provider should halt any in-flight actions.
+func (p *Provider) Stop() error {
+	log.Println("[DEBUG] external provider cannot Stop")
+	return nil
+}
+
+// All the Resource-specific functions are below.
+// The external provider supplies a single data source, `external`, and
+// no resources.
+
+// UpgradeResourceState is called when the state loader encounters an
+// instance state whose schema version is less than the one reported by the
+// currently-used version of the corresponding provider, and the upgraded
+// result is used for any further processing.
+func (p *Provider) UpgradeResourceState(providers.UpgradeResourceStateRequest) providers.UpgradeResourceStateResponse {
+	panic("unimplemented - external has no resources")
+}
+
+// ReadResource refreshes a resource and returns its current state.
+func (p *Provider) ReadResource(providers.ReadResourceRequest) providers.ReadResourceResponse {
+	panic("unimplemented - external has no resources")
+}
+
+// PlanResourceChange takes the current state and proposed state of a
+// resource, and returns the planned final state.
+func (p *Provider) PlanResourceChange(providers.PlanResourceChangeRequest) providers.PlanResourceChangeResponse {
+	panic("unimplemented - external has no resources")
+}
+
+// ApplyResourceChange takes the planned state for a resource, which may
+// yet contain unknown computed values, and applies the changes returning
+// the final state.
+func (p *Provider) ApplyResourceChange(providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeResponse {
+	panic("unimplemented - external has no resources")
+}
+
+// ImportResourceState requests that the given resource be imported.
+func (p *Provider) ImportResourceState(providers.ImportResourceStateRequest) providers.ImportResourceStateResponse {
+	panic("unimplemented - external has no resources")
+}
+
+// ValidateResourceTypeConfig is used to to validate the resource configuration values.
+func (p *Provider) ValidateResourceTypeConfig(providers.ValidateResourceTypeConfigRequest) providers.ValidateResourceTypeConfigResponse {
+	// At this moment there is nothing to configure for the external provider,
+	// so we will happily return without taking any action
+	var res providers.ValidateResourceTypeConfigResponse
+	return res
+}
+
+// Close is a noop for this provider, since it's run in-process.
+func (p *Provider) Close() error {
+	return nil
+}
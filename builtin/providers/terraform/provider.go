@@ -24,6 +24,9 @@ func NewProvider() *Provider {
 // GetSchema returns the complete schema for the provider.
 func (p *Provider) GetSchema() providers.GetSchemaResponse {
 	return providers.GetSchemaResponse{
+		ResourceTypes: map[string]providers.Schema{
+			"terraform_data": dataStoreResourceSchema(),
+		},
 		DataSources: map[string]providers.Schema{
 			"terraform_remote_state": dataSourceRemoteStateGetSchema(),
 		},
@@ -92,46 +95,70 @@ func (p *Provider) Stop() error {
 }
 
 // All the Resource-specific functions are below.
-// The terraform provider supplies a single data source, `terraform_remote_state`
-// and no resources.
+// The terraform provider supplies a single managed resource, `terraform_data`,
+// along with the `terraform_remote_state` data source.
 
 // UpgradeResourceState is called when the state loader encounters an
 // instance state whose schema version is less than the one reported by the
 // currently-used version of the corresponding provider, and the upgraded
 // result is used for any further processing.
-func (p *Provider) UpgradeResourceState(providers.UpgradeResourceStateRequest) providers.UpgradeResourceStateResponse {
-	panic("unimplemented - terraform_remote_state has no resources")
+func (p *Provider) UpgradeResourceState(req providers.UpgradeResourceStateRequest) providers.UpgradeResourceStateResponse {
+	if req.TypeName != "terraform_data" {
+		var res providers.UpgradeResourceStateResponse
+		res.Diagnostics = res.Diagnostics.Append(fmt.Errorf("Error: unsupported resource %s", req.TypeName))
+		return res
+	}
+	return upgradeDataStoreResourceState(req)
 }
 
 // ReadResource refreshes a resource and returns its current state.
-func (p *Provider) ReadResource(providers.ReadResourceRequest) providers.ReadResourceResponse {
-	panic("unimplemented - terraform_remote_state has no resources")
+func (p *Provider) ReadResource(req providers.ReadResourceRequest) providers.ReadResourceResponse {
+	if req.TypeName != "terraform_data" {
+		var res providers.ReadResourceResponse
+		res.Diagnostics = res.Diagnostics.Append(fmt.Errorf("Error: unsupported resource %s", req.TypeName))
+		return res
+	}
+	return readDataStoreResourceState(req)
 }
 
 // PlanResourceChange takes the current state and proposed state of a
 // resource, and returns the planned final state.
-func (p *Provider) PlanResourceChange(providers.PlanResourceChangeRequest) providers.PlanResourceChangeResponse {
-	panic("unimplemented - terraform_remote_state has no resources")
+func (p *Provider) PlanResourceChange(req providers.PlanResourceChangeRequest) providers.PlanResourceChangeResponse {
+	if req.TypeName != "terraform_data" {
+		var res providers.PlanResourceChangeResponse
+		res.Diagnostics = res.Diagnostics.Append(fmt.Errorf("Error: unsupported resource %s", req.TypeName))
+		return res
+	}
+	return planDataStoreResourceChange(req)
 }
 
 // ApplyResourceChange takes the planned state for a resource, which may
 // yet contain unknown computed values, and applies the changes returning
 // the final state.
-func (p *Provider) ApplyResourceChange(providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeResponse {
-	panic("unimplemented - terraform_remote_state has no resources")
+func (p *Provider) ApplyResourceChange(req providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeResponse {
+	if req.TypeName != "terraform_data" {
+		var res providers.ApplyResourceChangeResponse
+		res.Diagnostics = res.Diagnostics.Append(fmt.Errorf("Error: unsupported resource %s", req.TypeName))
+		return res
+	}
+	return applyDataStoreResourceChange(req)
 }
 
 // ImportResourceState requests that the given resource be imported.
 func (p *Provider) ImportResourceState(providers.ImportResourceStateRequest) providers.ImportResourceStateResponse {
-	panic("unimplemented - terraform_remote_state has no resources")
+	var res providers.ImportResourceStateResponse
+	res.Diagnostics = res.Diagnostics.Append(fmt.Errorf("Error: the terraform provider does not support importing resources"))
+	return res
 }
 
 // ValidateResourceTypeConfig is used to to validate the resource configuration values.
-func (p *Provider) ValidateResourceTypeConfig(providers.ValidateResourceTypeConfigRequest) providers.ValidateResourceTypeConfigResponse {
-	// At this moment there is nothing to configure for the terraform provider,
-	// so we will happily return without taking any action
-	var res providers.ValidateResourceTypeConfigResponse
-	return res
+func (p *Provider) ValidateResourceTypeConfig(req providers.ValidateResourceTypeConfigRequest) providers.ValidateResourceTypeConfigResponse {
+	if req.TypeName != "terraform_data" {
+		var res providers.ValidateResourceTypeConfigResponse
+		res.Diagnostics = res.Diagnostics.Append(fmt.Errorf("Error: unsupported resource %s", req.TypeName))
+		return res
+	}
+	return validateDataStoreResourceConfig(req)
 }
 
 // Close is a noop for this provider, since it's run in-process.
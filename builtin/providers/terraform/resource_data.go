@@ -0,0 +1,158 @@
+package terraform
+
+import (
+	"fmt"
+
+	uuid "github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/hashicorp/terraform/providers"
+	"github.com/hashicorp/terraform/tfdiags"
+	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+)
+
+// The terraform_data resource stores arbitrary values in state so that they
+// can be used elsewhere in the configuration, and provides somewhere to
+// attach provisioners without depending on a real infrastructure object.
+// It fills the same role as the null provider's null_resource, but without
+// requiring any plugin to be installed.
+
+func dataStoreResourceSchema() providers.Schema {
+	return providers.Schema{
+		Block: &configschema.Block{
+			Attributes: map[string]*configschema.Attribute{
+				"input": {
+					Type:     cty.DynamicPseudoType,
+					Optional: true,
+				},
+				"output": {
+					Type:     cty.DynamicPseudoType,
+					Computed: true,
+				},
+				"triggers_replace": {
+					Type:     cty.DynamicPseudoType,
+					Optional: true,
+				},
+				"id": {
+					Type:     cty.String,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func planDataStoreResourceChange(req providers.PlanResourceChangeRequest) providers.PlanResourceChangeResponse {
+	var res providers.PlanResourceChangeResponse
+
+	if req.ProposedNewState.IsNull() {
+		// A destroy plan needs no alteration.
+		res.PlannedState = req.ProposedNewState
+		return res
+	}
+
+	planned := req.ProposedNewState.AsValueMap()
+
+	input := req.ProposedNewState.GetAttr("input")
+	trigger := req.ProposedNewState.GetAttr("triggers_replace")
+
+	switch {
+	case req.PriorState.IsNull():
+		// Creation: the id and output won't be known until apply.
+		planned["id"] = cty.UnknownVal(cty.String)
+		planned["output"] = cty.UnknownVal(input.Type())
+
+	case !req.PriorState.GetAttr("triggers_replace").RawEquals(trigger):
+		// Any change to triggers_replace requires replacing the resource,
+		// which in turn re-generates the id and output.
+		res.RequiresReplace = append(res.RequiresReplace, cty.GetAttrPath("triggers_replace"))
+		planned["id"] = cty.UnknownVal(cty.String)
+		planned["output"] = cty.UnknownVal(input.Type())
+
+	case !req.PriorState.GetAttr("input").RawEquals(input):
+		// The output tracks the input, so it becomes unknown whenever the
+		// input changes.
+		planned["output"] = cty.UnknownVal(input.Type())
+	}
+
+	res.PlannedState = cty.ObjectVal(planned)
+	return res
+}
+
+func applyDataStoreResourceChange(req providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeResponse {
+	var res providers.ApplyResourceChangeResponse
+
+	if req.PlannedState.IsNull() {
+		// Destroy
+		res.NewState = req.PlannedState
+		return res
+	}
+
+	newState := req.PlannedState.AsValueMap()
+
+	if !req.PlannedState.GetAttr("id").IsKnown() {
+		idString, err := uuid.GenerateUUID()
+		if err != nil {
+			// This should never happen
+			res.Diagnostics = res.Diagnostics.Append(err)
+			return res
+		}
+		newState["id"] = cty.StringVal(idString)
+	}
+
+	newState["output"] = req.PlannedState.GetAttr("input")
+
+	res.NewState = cty.ObjectVal(newState)
+	return res
+}
+
+func validateDataStoreResourceConfig(req providers.ValidateResourceTypeConfigRequest) providers.ValidateResourceTypeConfigResponse {
+	var res providers.ValidateResourceTypeConfigResponse
+
+	if req.Config.IsNull() {
+		return res
+	}
+
+	// The output attribute is always derived from the input, so setting it
+	// in the configuration would always be an error.
+	if out := req.Config.GetAttr("output"); !out.IsNull() {
+		var diags tfdiags.Diagnostics
+		diags = diags.Append(tfdiags.AttributeValue(
+			tfdiags.Error,
+			"Invalid data store configuration",
+			`The "output" attribute is read-only and cannot be set in configuration.`,
+			cty.GetAttrPath("output"),
+		))
+		res.Diagnostics = diags
+	}
+
+	return res
+}
+
+func upgradeDataStoreResourceState(req providers.UpgradeResourceStateRequest) providers.UpgradeResourceStateResponse {
+	var res providers.UpgradeResourceStateResponse
+
+	if req.Version != 0 {
+		res.Diagnostics = res.Diagnostics.Append(fmt.Errorf("unsupported terraform_data schema version %d", req.Version))
+		return res
+	}
+
+	ty := dataStoreResourceSchema().Block.ImpliedType()
+	val, err := ctyjson.Unmarshal(req.RawStateJSON, ty)
+	if err != nil {
+		res.Diagnostics = res.Diagnostics.Append(err)
+		return res
+	}
+
+	res.UpgradedState = val
+	return res
+}
+
+func readDataStoreResourceState(req providers.ReadResourceRequest) providers.ReadResourceResponse {
+	// There is no backing store to consult, so whatever is in state is
+	// still current.
+	return providers.ReadResourceResponse{
+		NewState: req.PriorState,
+		Private:  req.Private,
+	}
+}
@@ -0,0 +1,144 @@
+package terraform
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/providers"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestDataStoreResourceSchema(t *testing.T) {
+	if err := dataStoreResourceSchema().Block.InternalValidate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func dataStoreObject(input, output, triggers, id cty.Value) cty.Value {
+	return cty.ObjectVal(map[string]cty.Value{
+		"input":            input,
+		"output":           output,
+		"triggers_replace": triggers,
+		"id":               id,
+	})
+}
+
+func TestDataStoreResource_createAndUpdate(t *testing.T) {
+	p := NewProvider()
+
+	// Creating a new instance should leave the id and output to be decided
+	// during apply.
+	proposed := dataStoreObject(
+		cty.StringVal("hello"),
+		cty.NullVal(cty.DynamicPseudoType),
+		cty.NullVal(cty.DynamicPseudoType),
+		cty.NullVal(cty.String),
+	)
+	planResp := p.PlanResourceChange(providers.PlanResourceChangeRequest{
+		TypeName:         "terraform_data",
+		PriorState:       cty.NullVal(proposed.Type()),
+		ProposedNewState: proposed,
+	})
+	if planResp.Diagnostics.HasErrors() {
+		t.Fatal(planResp.Diagnostics.Err())
+	}
+	if planResp.PlannedState.GetAttr("id").IsKnown() {
+		t.Fatal("id is known during create plan; want unknown")
+	}
+	if planResp.PlannedState.GetAttr("output").IsKnown() {
+		t.Fatal("output is known during create plan; want unknown")
+	}
+
+	applyResp := p.ApplyResourceChange(providers.ApplyResourceChangeRequest{
+		TypeName:     "terraform_data",
+		PriorState:   cty.NullVal(proposed.Type()),
+		PlannedState: planResp.PlannedState,
+	})
+	if applyResp.Diagnostics.HasErrors() {
+		t.Fatal(applyResp.Diagnostics.Err())
+	}
+	state := applyResp.NewState
+	if !state.GetAttr("id").IsKnown() || state.GetAttr("id").AsString() == "" {
+		t.Fatalf("no id was generated: %#v", state)
+	}
+	if got, want := state.GetAttr("output"), cty.StringVal("hello"); !got.RawEquals(want) {
+		t.Fatalf("wrong output %#v; want %#v", got, want)
+	}
+
+	// Changing only the input should be an update in-place, with the output
+	// becoming unknown until apply.
+	proposed = dataStoreObject(
+		cty.StringVal("world"),
+		state.GetAttr("output"),
+		cty.NullVal(cty.DynamicPseudoType),
+		state.GetAttr("id"),
+	)
+	planResp = p.PlanResourceChange(providers.PlanResourceChangeRequest{
+		TypeName:         "terraform_data",
+		PriorState:       state,
+		ProposedNewState: proposed,
+	})
+	if planResp.Diagnostics.HasErrors() {
+		t.Fatal(planResp.Diagnostics.Err())
+	}
+	if len(planResp.RequiresReplace) != 0 {
+		t.Fatalf("input change requires replace %#v; want update in-place", planResp.RequiresReplace)
+	}
+	if got, want := planResp.PlannedState.GetAttr("id"), state.GetAttr("id"); !got.RawEquals(want) {
+		t.Fatalf("id changed on update: %#v; want %#v", got, want)
+	}
+	if planResp.PlannedState.GetAttr("output").IsKnown() {
+		t.Fatal("output is known during update plan; want unknown")
+	}
+}
+
+func TestDataStoreResource_triggersReplace(t *testing.T) {
+	p := NewProvider()
+
+	prior := dataStoreObject(
+		cty.StringVal("hello"),
+		cty.StringVal("hello"),
+		cty.StringVal("rev1"),
+		cty.StringVal("8e79109b-a93b-44c4-8c22-0cbeba395994"),
+	)
+	proposed := dataStoreObject(
+		cty.StringVal("hello"),
+		cty.StringVal("hello"),
+		cty.StringVal("rev2"),
+		cty.StringVal("8e79109b-a93b-44c4-8c22-0cbeba395994"),
+	)
+
+	planResp := p.PlanResourceChange(providers.PlanResourceChangeRequest{
+		TypeName:         "terraform_data",
+		PriorState:       prior,
+		ProposedNewState: proposed,
+	})
+	if planResp.Diagnostics.HasErrors() {
+		t.Fatal(planResp.Diagnostics.Err())
+	}
+	if len(planResp.RequiresReplace) != 1 {
+		t.Fatalf("wrong RequiresReplace %#v; want just triggers_replace", planResp.RequiresReplace)
+	}
+	if got, want := planResp.RequiresReplace[0], cty.GetAttrPath("triggers_replace"); !got.Equals(want) {
+		t.Fatalf("wrong RequiresReplace path %#v; want %#v", got, want)
+	}
+	if planResp.PlannedState.GetAttr("id").IsKnown() {
+		t.Fatal("id is known during replace plan; want unknown")
+	}
+}
+
+func TestDataStoreResource_validate(t *testing.T) {
+	p := NewProvider()
+
+	resp := p.ValidateResourceTypeConfig(providers.ValidateResourceTypeConfigRequest{
+		TypeName: "terraform_data",
+		Config: dataStoreObject(
+			cty.StringVal("hello"),
+			cty.StringVal("not allowed"),
+			cty.NullVal(cty.DynamicPseudoType),
+			cty.NullVal(cty.String),
+		),
+	})
+	if !resp.Diagnostics.HasErrors() {
+		t.Fatal("config with output set validated; want error")
+	}
+}
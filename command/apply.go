@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/go-getter"
 	"github.com/hashicorp/terraform/addrs"
 	"github.com/hashicorp/terraform/backend"
+	viewsjson "github.com/hashicorp/terraform/command/views/json"
 	"github.com/hashicorp/terraform/configs/hcl2shim"
 	"github.com/hashicorp/terraform/repl"
 	"github.com/hashicorp/terraform/states"
@@ -27,7 +28,7 @@ type ApplyCommand struct {
 }
 
 func (c *ApplyCommand) Run(args []string) int {
-	var destroyForce, refresh, autoApprove bool
+	var destroyForce, refresh, autoApprove, jsonUI, resume, allowEphemeralVars bool
 	args, err := c.Meta.process(args, true)
 	if err != nil {
 		return 1
@@ -43,7 +44,12 @@ func (c *ApplyCommand) Run(args []string) int {
 	if c.Destroy {
 		cmdFlags.BoolVar(&destroyForce, "force", false, "deprecated: same as auto-approve")
 	}
+	if !c.Destroy {
+		cmdFlags.BoolVar(&resume, "resume", false, "resume a previously-failed apply")
+		cmdFlags.BoolVar(&allowEphemeralVars, "allow-ephemeral-variables", false, "allow variable values to differ from a saved plan")
+	}
 	cmdFlags.BoolVar(&refresh, "refresh", true, "refresh")
+	cmdFlags.BoolVar(&jsonUI, "json", false, "json")
 	cmdFlags.IntVar(&c.Meta.parallelism, "parallelism", DefaultParallelism, "parallelism")
 	cmdFlags.StringVar(&c.Meta.statePath, "state", "", "path")
 	cmdFlags.StringVar(&c.Meta.stateOutPath, "state-out", "", "path")
@@ -110,19 +116,22 @@ func (c *ApplyCommand) Run(args []string) int {
 		c.Ui.Error(fmt.Sprintf("Destroy can't be called with a plan file."))
 		return 1
 	}
+	if resume && planFile != nil {
+		c.Ui.Error("The -resume option cannot be used with a saved plan file, because resuming uses the remaining changes saved when the previous apply failed.")
+		return 1
+	}
+	if resume && !c.variableArgs.Empty() {
+		c.Ui.Error("The -var and -var-file options cannot be used with -resume, because the saved remaining changes include the variable values from the failed apply.")
+		return 1
+	}
 	if planFile != nil {
 		// Reset the config path for backend loading
 		configPath = ""
 
-		if !c.variableArgs.Empty() {
-			diags = diags.Append(tfdiags.Sourceless(
-				tfdiags.Error,
-				"Can't set variables when applying a saved plan",
-				"The -var and -var-file options cannot be used when applying a saved plan file, because a saved plan includes the variable values that were set when it was created.",
-			))
-			c.showDiagnostics(diags)
-			return 1
-		}
+		// Any variable values set for this command are checked against the
+		// values recorded in the plan file by the backend, so that applying
+		// with different values than were used at plan time is rejected
+		// unless -allow-ephemeral-variables is set.
 	}
 
 	// Load the backend
@@ -182,7 +191,12 @@ func (c *ApplyCommand) Run(args []string) int {
 	opReq.DestroyForce = destroyForce
 	opReq.PlanFile = planFile
 	opReq.PlanRefresh = refresh
+	opReq.Resume = resume
+	opReq.AllowEphemeralVariables = allowEphemeralVars
 	opReq.Type = backend.OperationTypeApply
+	if jsonUI {
+		opReq.JSONUIStream = viewsjson.NewStream(os.Stdout)
+	}
 
 	opReq.ConfigLoader, err = c.initConfigLoader()
 	if err != nil {
@@ -248,6 +262,11 @@ Usage: terraform apply [options] [DIR-OR-PLAN]
 
 Options:
 
+  -allow-ephemeral-variables  When applying a saved plan, allow variable
+                         values that differ from the values recorded in the
+                         plan file, using the new values instead. Without
+                         this option, differing values are rejected.
+
   -auto-approve          Skip interactive approval of plan before applying.
 
   -backup=path           Path to backup the existing state file before
@@ -258,12 +277,20 @@ Options:
                          accompanied by errors, show them in a more compact
                          form that includes only the summary messages.
 
+  -exclude=resource      Resource to exclude. The resource and anything that
+                         depends on it will be ignored by the operation. This
+                         flag can be used multiple times.
+
   -lock=true             Lock the state file when locking is supported.
 
   -lock-timeout=0s       Duration to retry a state lock.
 
   -input=true            Ask for input for variables if not directly set.
 
+  -json                  Additionally emit machine-readable JSON UI events on
+                         stdout, one JSON object per line, for consumption by
+                         wrapping programs.
+
   -no-color              If specified, output won't contain any color.
 
   -parallelism=n         Limit the number of parallel resource operations.
@@ -272,6 +299,12 @@ Options:
   -refresh=true          Update state prior to checking for differences. This
                          has no effect if a plan file is given to apply.
 
+  -resume                Continue a previous apply that failed partway
+                         through, applying only the changes that were not yet
+                         applied. Terraform first verifies that the state has
+                         not been changed by another operation since the
+                         failure.
+
   -state=path            Path to read and save state (unless state-out
                          is specified). Defaults to "terraform.tfstate".
 
@@ -309,6 +342,10 @@ Options:
 
   -auto-approve          Skip interactive approval before destroying.
 
+  -exclude=resource      Resource to exclude. The resource and anything that
+                         depends on it will be ignored by the operation. This
+                         flag can be used multiple times.
+
   -force                 Deprecated: same as auto-approve.
 
   -lock=true             Lock the state file when locking is supported.
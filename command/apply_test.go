@@ -1706,3 +1706,131 @@ const testApplyStateDiffStr = `
 ID = bar
 Tainted = false
 `
+
+// applyEphemeralVarsFixturePlanFile creates a plan file for the
+// "apply-ephemeral-variable" fixture, recording "bar" for var.foo and
+// "secret" for var.auth_token as the plan-time values.
+func applyEphemeralVarsFixturePlanFile(t *testing.T) string {
+	t.Helper()
+	_, snap := testModuleWithSnapshot(t, "apply-ephemeral-variable")
+	fooVal, err := plans.NewDynamicValue(cty.StringVal("bar"), cty.DynamicPseudoType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokenVal, err := plans.NewDynamicValue(cty.StringVal("secret"), cty.DynamicPseudoType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plannedVal := cty.ObjectVal(map[string]cty.Value{
+		"value": cty.StringVal("bar"),
+	})
+	priorValRaw, err := plans.NewDynamicValue(cty.NullVal(plannedVal.Type()), plannedVal.Type())
+	if err != nil {
+		t.Fatal(err)
+	}
+	plannedValRaw, err := plans.NewDynamicValue(plannedVal, plannedVal.Type())
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan := testPlan(t)
+	plan.VariableValues = map[string]plans.DynamicValue{
+		"foo":        fooVal,
+		"auth_token": tokenVal,
+	}
+	plan.Changes.SyncWrapper().AppendResourceInstanceChange(&plans.ResourceInstanceChangeSrc{
+		Addr: addrs.Resource{
+			Mode: addrs.ManagedResourceMode,
+			Type: "test_instance",
+			Name: "foo",
+		}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance),
+		ProviderAddr: addrs.AbsProviderConfig{
+			Provider: addrs.NewLegacyProvider("test"),
+			Module:   addrs.RootModuleInstance,
+		},
+		ChangeSrc: plans.ChangeSrc{
+			Action: plans.Create,
+			Before: priorValRaw,
+			After:  plannedValRaw,
+		},
+	})
+	return testPlanFile(
+		t,
+		snap,
+		states.NewState(),
+		plan,
+	)
+}
+
+func TestApply_planVarsEphemeral(t *testing.T) {
+	newCommand := func() (*ApplyCommand, *cli.MockUi, *terraform.MockProvider) {
+		p := testProvider()
+		p.GetSchemaReturn = &terraform.ProviderSchema{
+			ResourceTypes: map[string]*configschema.Block{
+				"test_instance": {
+					Attributes: map[string]*configschema.Attribute{
+						"value": {Type: cty.String, Optional: true},
+					},
+				},
+			},
+		}
+		p.PlanResourceChangeFn = func(req providers.PlanResourceChangeRequest) providers.PlanResourceChangeResponse {
+			return providers.PlanResourceChangeResponse{
+				PlannedState: req.ProposedNewState,
+			}
+		}
+		p.ApplyResourceChangeFn = func(req providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeResponse {
+			return providers.ApplyResourceChangeResponse{
+				NewState: req.PlannedState,
+			}
+		}
+		ui := new(cli.MockUi)
+		c := &ApplyCommand{
+			Meta: Meta{
+				testingOverrides: metaOverridesForProvider(p),
+				Ui:               ui,
+			},
+		}
+		return c, ui, p
+	}
+
+	t.Run("mismatch", func(t *testing.T) {
+		c, ui, _ := newCommand()
+		args := []string{
+			"-state", testTempFile(t),
+			"-var", "auth_token=changed",
+			applyEphemeralVarsFixturePlanFile(t),
+		}
+		if code := c.Run(args); code == 0 {
+			t.Fatal("succeeded; want a mismatch error")
+		}
+		if got, want := ui.ErrorWriter.String(), "Variable value changed since plan was created"; !strings.Contains(got, want) {
+			t.Errorf("wrong error\ngot:\n%s\n\nwant substring: %s", got, want)
+		}
+	})
+
+	t.Run("match", func(t *testing.T) {
+		c, ui, _ := newCommand()
+		args := []string{
+			"-state", testTempFile(t),
+			"-var", "foo=bar",
+			"-var", "auth_token=secret",
+			applyEphemeralVarsFixturePlanFile(t),
+		}
+		if code := c.Run(args); code != 0 {
+			t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+		}
+	})
+
+	t.Run("ephemeral override", func(t *testing.T) {
+		c, ui, _ := newCommand()
+		args := []string{
+			"-state", testTempFile(t),
+			"-allow-ephemeral-variables",
+			"-var", "auth_token=changed",
+			applyEphemeralVarsFixturePlanFile(t),
+		}
+		if code := c.Run(args); code != 0 {
+			t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+		}
+	})
+}
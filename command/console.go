@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform/addrs"
 	"github.com/hashicorp/terraform/backend"
 	"github.com/hashicorp/terraform/helper/wrappedstreams"
+	"github.com/hashicorp/terraform/lang"
 	"github.com/hashicorp/terraform/repl"
 	"github.com/hashicorp/terraform/tfdiags"
 
@@ -26,8 +27,10 @@ func (c *ConsoleCommand) Run(args []string) int {
 		return 1
 	}
 
+	var planMode bool
 	cmdFlags := c.Meta.extendedFlagSet("console")
 	cmdFlags.StringVar(&c.Meta.statePath, "state", DefaultStateFilename, "path")
+	cmdFlags.BoolVar(&planMode, "plan", false, "evaluate against planned values")
 	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
 	if err := cmdFlags.Parse(args); err != nil {
 		c.Ui.Error(fmt.Sprintf("Error parsing command line flags: %s\n", err.Error()))
@@ -120,7 +123,23 @@ func (c *ConsoleCommand) Run(args []string) int {
 	// Before we can evaluate expressions, we must compute and populate any
 	// derived values (input variables, local values, output values)
 	// that are not stored in the persistent state.
-	scope, scopeDiags := ctx.Eval(addrs.RootModuleInstance)
+	var scope *lang.Scope
+	var scopeDiags tfdiags.Diagnostics
+	if planMode {
+		// In plan mode we create a plan first and then build the evaluation
+		// scope against its planned new values, so resource references
+		// resolve to what the plan proposes, possibly including unknowns
+		// for values not decided until apply.
+		_, planDiags := ctx.Plan()
+		diags = diags.Append(planDiags)
+		if planDiags.HasErrors() {
+			c.showDiagnostics(diags)
+			return 1
+		}
+		scope, scopeDiags = ctx.EvalPlannedValues(addrs.RootModuleInstance)
+	} else {
+		scope, scopeDiags = ctx.Eval(addrs.RootModuleInstance)
+	}
 	diags = diags.Append(scopeDiags)
 	if scope == nil {
 		// scope is nil if there are errors so bad that we can't even build a scope.
@@ -152,9 +171,24 @@ func (c *ConsoleCommand) Run(args []string) int {
 
 func (c *ConsoleCommand) modePiped(session *repl.Session, ui cli.Ui) int {
 	var lastResult string
+	var buf strings.Builder
 	scanner := bufio.NewScanner(wrappedstreams.Stdin())
 	for scanner.Scan() {
-		result, exit, diags := session.Handle(strings.TrimSpace(scanner.Text()))
+		if buf.Len() > 0 {
+			buf.WriteByte('\n')
+		}
+		buf.WriteString(scanner.Text())
+
+		if consoleBracketState(buf.String()) > 0 {
+			// This looks like the middle of a multi-line expression, so
+			// we'll keep reading lines until all of the brackets are closed.
+			continue
+		}
+
+		line := strings.TrimSpace(buf.String())
+		buf.Reset()
+
+		result, exit, diags := session.Handle(line)
 		if diags.HasErrors() {
 			// In piped mode we'll exit immediately on error.
 			c.showDiagnostics(diags)
@@ -174,6 +208,49 @@ func (c *ConsoleCommand) modePiped(session *repl.Session, ui cli.Ui) int {
 	return 0
 }
 
+// consoleBracketState decides whether the given partial expression source
+// seems to be complete enough to try evaluating, or whether the user is
+// part-way through entering a multi-line expression.
+//
+// The result is the number of brackets that have been opened but not yet
+// closed, ignoring any brackets inside quoted string literals. A positive
+// result means that more input is needed before evaluation; zero or
+// negative means the input should be evaluated as-is, letting the main
+// expression parser report any remaining imbalance with a proper
+// diagnostic message.
+func consoleBracketState(src string) int {
+	var open int
+	var inQuote, escape bool
+	for _, r := range src {
+		if escape {
+			escape = false
+			continue
+		}
+		switch r {
+		case '\\':
+			if inQuote {
+				escape = true
+			}
+		case '"':
+			inQuote = !inQuote
+		case '\n':
+			// Quoted string literals can't span lines, so if we get here
+			// with a quote seemingly open then it's a syntax error that
+			// we'll leave for the real parser to report.
+			inQuote = false
+		case '(', '[', '{':
+			if !inQuote {
+				open++
+			}
+		case ')', ']', '}':
+			if !inQuote {
+				open--
+			}
+		}
+	}
+	return open
+}
+
 func (c *ConsoleCommand) Help() string {
 	helpText := `
 Usage: terraform console [options] [DIR]
@@ -195,6 +272,10 @@ Options:
 
   -state=path            Path to read state. Defaults to "terraform.tfstate"
 
+  -plan                  Create a plan first and evaluate expressions against
+                         the planned values of resources, instead of the
+                         values currently stored in the state.
+
   -var 'foo=bar'         Set a variable in the Terraform configuration. This
                          flag can be set multiple times.
 
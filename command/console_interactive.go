@@ -8,6 +8,7 @@ package command
 import (
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/hashicorp/terraform/helper/wrappedreadline"
 	"github.com/hashicorp/terraform/repl"
@@ -32,20 +33,41 @@ func (c *ConsoleCommand) modeInteractive(session *repl.Session, ui cli.Ui) int {
 	}
 	defer l.Close()
 
+	var inputBuf strings.Builder
 	for {
 		// Read a line
 		line, err := l.Readline()
 		if err == readline.ErrInterrupt {
-			if len(line) == 0 {
+			if len(line) == 0 && inputBuf.Len() == 0 {
 				break
 			} else {
+				// Interrupting throws away any partially-entered
+				// multi-line expression.
+				inputBuf.Reset()
+				l.SetPrompt("> ")
 				continue
 			}
 		} else if err == io.EOF {
 			break
 		}
 
-		out, exit, diags := session.Handle(line)
+		if inputBuf.Len() > 0 {
+			inputBuf.WriteByte('\n')
+		}
+		inputBuf.WriteString(line)
+
+		if consoleBracketState(inputBuf.String()) > 0 {
+			// This looks like the middle of a multi-line expression, so
+			// we'll keep reading lines until all of the brackets are closed.
+			l.SetPrompt("  ")
+			continue
+		}
+
+		input := inputBuf.String()
+		inputBuf.Reset()
+		l.SetPrompt("> ")
+
+		out, exit, diags := session.Handle(input)
 		if diags.HasErrors() {
 			c.showDiagnostics(diags)
 		}
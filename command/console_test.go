@@ -47,6 +47,59 @@ func TestConsole_basic(t *testing.T) {
 	}
 }
 
+func TestConsole_multiline(t *testing.T) {
+	tmp, cwd := testCwd(t)
+	defer testFixCwd(t, tmp, cwd)
+
+	p := testProvider()
+	ui := new(cli.MockUi)
+	c := &ConsoleCommand{
+		Meta: Meta{
+			testingOverrides: metaOverridesForProvider(p),
+			Ui:               ui,
+		},
+	}
+
+	var output bytes.Buffer
+	defer testStdinPipe(t, strings.NewReader("max(\n  1,\n  5,\n)\n"))()
+	outCloser := testStdoutCapture(t, &output)
+
+	args := []string{}
+	code := c.Run(args)
+	outCloser()
+	if code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	actual := output.String()
+	if actual != "5\n" {
+		t.Fatalf("bad: %q", actual)
+	}
+}
+
+func TestConsole_bracketState(t *testing.T) {
+	tests := []struct {
+		src  string
+		want int
+	}{
+		{``, 0},
+		{`1 + 5`, 0},
+		{`max(1, 5)`, 0},
+		{`max(1,`, 1},
+		{`[for x in [1, 2]:`, 1},
+		{`{`, 1},
+		{`"not ( a bracket"`, 0},
+		{`"escaped \" quote ("`, 0},
+		{`)`, -1},
+	}
+
+	for _, test := range tests {
+		if got := consoleBracketState(test.src); got != test.want {
+			t.Errorf("consoleBracketState(%q) = %d; want %d", test.src, got, test.want)
+		}
+	}
+}
+
 func TestConsole_tfvars(t *testing.T) {
 	tmp, cwd := testCwd(t)
 	defer testFixCwd(t, tmp, cwd)
@@ -51,7 +51,11 @@ func ResourceChange(
 
 	switch change.Action {
 	case plans.Create:
-		buf.WriteString(color.Color(fmt.Sprintf("[bold]  # %s[reset] will be created", dispAddr)))
+		if change.Importing {
+			buf.WriteString(color.Color(fmt.Sprintf("[bold]  # %s[reset] will be imported\n  # (id %q)", dispAddr, change.ImportID)))
+		} else {
+			buf.WriteString(color.Color(fmt.Sprintf("[bold]  # %s[reset] will be created", dispAddr)))
+		}
 	case plans.Read:
 		buf.WriteString(color.Color(fmt.Sprintf("[bold]  # %s[reset] will be read during apply\n  # (config refers to values not yet known)", dispAddr)))
 	case plans.Update:
@@ -64,6 +68,8 @@ func ResourceChange(
 		}
 	case plans.Delete:
 		buf.WriteString(color.Color(fmt.Sprintf("[bold]  # %s[reset] will be [bold][red]destroyed", dispAddr)))
+	case plans.Forget:
+		buf.WriteString(color.Color(fmt.Sprintf("[bold]  # %s[reset] will no longer be managed by Terraform, but [bold]will not be destroyed", dispAddr)))
 	default:
 		// should never happen, since the above is exhaustive
 		buf.WriteString(fmt.Sprintf("%s has an action the plan renderer doesn't support (this is a bug)", dispAddr))
@@ -466,6 +472,12 @@ func (p *blockBodyDiffPrinter) writeNestedBlockDiff(name string, label *string,
 }
 
 func (p *blockBodyDiffPrinter) writeValue(val cty.Value, action plans.Action, indent int) {
+	// Could check specifically for the sensitivity marker
+	if val.IsMarked() {
+		p.buf.WriteString("(sensitive)")
+		return
+	}
+
 	if !val.IsKnown() {
 		p.buf.WriteString("(known after apply)")
 		return
@@ -619,6 +631,17 @@ func (p *blockBodyDiffPrinter) writeValueDiff(old, new cty.Value, indent int, pa
 	ty := old.Type()
 	typesEqual := ctyTypesEqual(ty, new.Type())
 
+	// If either the old or new value is marked then we present the change
+	// as an opaque transition between two sensitive values, since showing
+	// a detailed diff could reveal parts of the sensitive data.
+	if old.IsMarked() || new.IsMarked() {
+		p.buf.WriteString("(sensitive)")
+		if p.pathForcesNewResource(path) {
+			p.buf.WriteString(p.color.Color(forcesNewResourceCaption))
+		}
+		return
+	}
+
 	// We have some specialized diff implementations for certain complex
 	// values where it's useful to see a visualization of the diff of
 	// the nested elements rather than just showing the entire old and
@@ -1206,6 +1229,8 @@ func DiffActionSymbol(action plans.Action) string {
 		return "  [green]+[reset]"
 	case plans.Delete:
 		return "  [red]-[reset]"
+	case plans.Forget:
+		return "  [red].[reset]"
 	case plans.Read:
 		return " [cyan]<=[reset]"
 	case plans.Update:
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/zclconf/go-cty/cty"
 
@@ -132,6 +133,12 @@ func formatStateModule(p blockBodyDiffPrinter, m *states.Module, schemas *terraf
 				header := obj.header
 				instance := obj.instance
 				p.buf.WriteString(header)
+				if instance != nil && !instance.CreatedAt.IsZero() {
+					p.buf.WriteString(fmt.Sprintf("# created_at = %s\n", instance.CreatedAt.UTC().Format(time.RFC3339)))
+				}
+				if instance != nil && !instance.UpdatedAt.IsZero() {
+					p.buf.WriteString(fmt.Sprintf("# updated_at = %s\n", instance.UpdatedAt.UTC().Format(time.RFC3339)))
+				}
 				if instance == nil {
 					// this shouldn't happen, but there's nothing to do here so
 					// don't panic below.
@@ -141,7 +148,7 @@ func formatStateModule(p blockBodyDiffPrinter, m *states.Module, schemas *terraf
 				var schema *configschema.Block
 
 				provider := m.Resources[key].ProviderConfig.Provider
-				if _, exists := schemas.Providers[provider]; !exists {
+				if schemas.ProviderSchema(provider) == nil {
 					// This should never happen in normal use because we should've
 					// loaded all of the schemas and checked things prior to this
 					// point. We can't return errors here, but since this is UI code
@@ -35,6 +35,7 @@ type plan struct {
 	// this time, but consistent.
 	ResourceChanges []resourceChange  `json:"resource_changes,omitempty"`
 	OutputChanges   map[string]change `json:"output_changes,omitempty"`
+	ChangeSummary   *changeSummary    `json:"change_summary,omitempty"`
 	PriorState      json.RawMessage   `json:"prior_state,omitempty"`
 	Config          json.RawMessage   `json:"configuration,omitempty"`
 }
@@ -72,6 +73,42 @@ type change struct {
 	AfterUnknown json.RawMessage `json:"after_unknown,omitempty"`
 }
 
+// actionCounts is the JSON representation of plans.ActionCounts.
+type actionCounts struct {
+	Create  int `json:"create"`
+	Update  int `json:"update"`
+	Replace int `json:"replace"`
+	Delete  int `json:"delete"`
+	Read    int `json:"read"`
+}
+
+// changeSummary is the JSON representation of plans.ChangesSummary,
+// describing the overall shape of the planned changes as counts so that
+// consumers can make gating decisions without walking resource_changes.
+type changeSummary struct {
+	Total actionCounts `json:"total"`
+
+	// ByModule is keyed by module instance address, with the root module
+	// keyed by the empty string.
+	ByModule map[string]actionCounts `json:"by_module,omitempty"`
+
+	// ByProvider is keyed by the fully-qualified provider name.
+	ByProvider map[string]actionCounts `json:"by_provider,omitempty"`
+
+	OutputChanges int  `json:"output_changes"`
+	OnlyOutputs   bool `json:"only_outputs"`
+}
+
+func marshalActionCounts(counts plans.ActionCounts) actionCounts {
+	return actionCounts{
+		Create:  counts.Create,
+		Update:  counts.Update,
+		Replace: counts.Replace,
+		Delete:  counts.Delete,
+		Read:    counts.Read,
+	}
+}
+
 type output struct {
 	Sensitive bool            `json:"sensitive"`
 	Value     json.RawMessage `json:"value,omitempty"`
@@ -118,6 +155,9 @@ func Marshal(
 		return nil, fmt.Errorf("error in marshaling output changes: %s", err)
 	}
 
+	// output.ChangeSummary
+	output.marshalChangeSummary(p.Changes)
+
 	// output.PriorState
 	if sf != nil && !sf.State.Empty() {
 		output.PriorState, err = jsonstate.Marshal(sf, schemas)
@@ -312,6 +352,35 @@ func (p *plan) marshalOutputChanges(changes *plans.Changes) error {
 	return nil
 }
 
+func (p *plan) marshalChangeSummary(changes *plans.Changes) {
+	if changes == nil {
+		// Nothing to do!
+		return
+	}
+
+	summary := changes.Summary()
+
+	ret := &changeSummary{
+		Total:         marshalActionCounts(summary.Total),
+		OutputChanges: summary.OutputChanges,
+		OnlyOutputs:   summary.OnlyOutputs,
+	}
+	if len(summary.ByModule) > 0 {
+		ret.ByModule = make(map[string]actionCounts, len(summary.ByModule))
+		for k, v := range summary.ByModule {
+			ret.ByModule[k] = marshalActionCounts(v)
+		}
+	}
+	if len(summary.ByProvider) > 0 {
+		ret.ByProvider = make(map[string]actionCounts, len(summary.ByProvider))
+		for k, v := range summary.ByProvider {
+			ret.ByProvider[k] = marshalActionCounts(v)
+		}
+	}
+
+	p.ChangeSummary = ret
+}
+
 func (p *plan) marshalPlannedValues(changes *plans.Changes, schemas *terraform.Schemas) error {
 	// marshal the planned changes into a module
 	plan, err := marshalPlannedValues(changes, schemas)
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"time"
 
 	"github.com/zclconf/go-cty/cty"
 	ctyjson "github.com/zclconf/go-cty/cty/json"
@@ -94,6 +95,19 @@ type resource struct {
 
 	// Deposed is set if the resource is deposed in terraform state.
 	DeposedKey string `json:"deposed_key,omitempty"`
+
+	// CreatedAt and UpdatedAt are RFC 3339 timestamps recording when the
+	// object was first created and most recently modified by an apply
+	// operation. They are omitted for objects created by Terraform versions
+	// that did not record this metadata.
+	CreatedAt string `json:"created_at,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+
+	// UniqueID is a stable identifier assigned to the object when it was
+	// first created, allowing external systems to correlate the object
+	// across renames, moves, and deposed generations. It is omitted for
+	// objects created by Terraform versions that did not record it.
+	UniqueID string `json:"unique_id,omitempty"`
 }
 
 // attributeValues is the JSON representation of the attribute values of the
@@ -304,6 +318,14 @@ func marshalResources(resources map[string]*states.Resource, schemas *terraform.
 				if riObj.Status == states.ObjectTainted {
 					current.Tainted = true
 				}
+
+				if !riObj.CreatedAt.IsZero() {
+					current.CreatedAt = riObj.CreatedAt.UTC().Format(time.RFC3339)
+				}
+				if !riObj.UpdatedAt.IsZero() {
+					current.UpdatedAt = riObj.UpdatedAt.UTC().Format(time.RFC3339)
+				}
+				current.UniqueID = riObj.UniqueID
 				ret = append(ret, current)
 			}
 
@@ -336,6 +358,14 @@ func marshalResources(resources map[string]*states.Resource, schemas *terraform.
 				if riObj.Status == states.ObjectTainted {
 					deposed.Tainted = true
 				}
+
+				if !riObj.CreatedAt.IsZero() {
+					deposed.CreatedAt = riObj.CreatedAt.UTC().Format(time.RFC3339)
+				}
+				if !riObj.UpdatedAt.IsZero() {
+					deposed.UpdatedAt = riObj.UpdatedAt.UTC().Format(time.RFC3339)
+				}
+				deposed.UniqueID = riObj.UniqueID
 				deposed.DeposedKey = deposedKey.String()
 				ret = append(ret, deposed)
 			}
@@ -28,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform/provisioners"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/hashicorp/terraform/tfdiags"
+	"github.com/hashicorp/terraform/version"
 	"github.com/mitchellh/cli"
 	"github.com/mitchellh/colorstring"
 )
@@ -130,6 +131,9 @@ type Meta struct {
 	// Targets for this context (private)
 	targets []addrs.Targetable
 
+	// Excludes for this context (private)
+	excludes []addrs.Targetable
+
 	// Internal fields
 	color bool
 	oldUi cli.Ui
@@ -343,6 +347,7 @@ func (m *Meta) contextOpts() *terraform.ContextOpts {
 	opts.Hooks = append(opts.Hooks, m.ExtraHooks...)
 
 	opts.Targets = m.targets
+	opts.Excludes = m.excludes
 	opts.UIInput = m.UIInput()
 	opts.Parallelism = m.parallelism
 
@@ -355,6 +360,20 @@ func (m *Meta) contextOpts() *terraform.ContextOpts {
 	} else {
 		opts.ProviderResolver = m.providerResolver()
 		opts.Provisioners = m.provisionerFactories()
+
+		// Provider developers can override specific providers with local
+		// builds or already-running plugin processes, bypassing
+		// installation and verification entirely.
+		devOverrides, err := providerDevOverrides()
+		if err != nil {
+			// Reported here rather than returned because an invalid
+			// override means the environment is misconfigured, and
+			// silently falling back to installed plugins would defeat
+			// the purpose of overriding.
+			log.Printf("[ERROR] ignoring provider developer overrides: %s", err)
+		} else {
+			opts.ProviderDevOverrides = devOverrides
+		}
 	}
 
 	opts.ProviderSHA256s = m.providerPluginsLock().Read()
@@ -363,7 +382,8 @@ func (m *Meta) contextOpts() *terraform.ContextOpts {
 	}
 
 	opts.Meta = &terraform.ContextMeta{
-		Env: m.Workspace(),
+		Env:     m.Workspace(),
+		Version: version.String(),
 	}
 
 	return &opts
@@ -387,6 +407,7 @@ func (m *Meta) extendedFlagSet(n string) *flag.FlagSet {
 
 	f.BoolVar(&m.input, "input", true, "input")
 	f.Var((*FlagTargetSlice)(&m.targets), "target", "resource to target")
+	f.Var((*FlagTargetSlice)(&m.excludes), "exclude", "resource to exclude")
 	f.BoolVar(&m.compactWarnings, "compact-warnings", false, "use compact warnings")
 
 	if m.variableArgs.items == nil {
@@ -495,6 +516,7 @@ func (m *Meta) showDiagnostics(vals ...interface{}) {
 		return
 	}
 
+	diags = diags.Deduplicate()
 	diags = diags.ConsolidateWarnings(1)
 
 	// Since warning messages are generally competing
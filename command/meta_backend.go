@@ -291,6 +291,7 @@ func (m *Meta) backendCLIOpts() *backend.CLIOpts {
 		StatePath:           m.statePath,
 		StateOutPath:        m.stateOutPath,
 		StateBackupPath:     m.backupPath,
+		DataDir:             m.DataDir(),
 		ContextOpts:         m.contextOpts(),
 		Input:               m.Input(),
 		RunningInAutomation: m.RunningInAutomation,
@@ -332,6 +333,7 @@ func (m *Meta) Operation(b backend.Backend) *backend.Operation {
 		PlanOutBackend:   planOutBackend,
 		Parallelism:      m.parallelism,
 		Targets:          m.targets,
+		Excludes:         m.excludes,
 		UIIn:             m.UIInput(),
 		UIOut:            m.Ui,
 		Workspace:        workspace,
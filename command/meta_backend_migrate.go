@@ -397,10 +397,56 @@ func (m *Meta) backendMigrateState_s_s(opts *backendMigrateOpts) error {
 			opts.OneType, opts.TwoType, err)
 	}
 
+	// Read the destination back and make sure it really holds the snapshot
+	// we just wrote, before the caller goes on to record the new backend
+	// configuration as current.
+	if err := m.backendMigrateVerify(stateOne, stateTwo, opts); err != nil {
+		return err
+	}
+
 	// And we're done.
 	return nil
 }
 
+// backendMigrateVerify checks that the destination state manager now holds
+// the state snapshot that was just migrated into it, so that we don't
+// record a new backend configuration as current when the destination
+// silently dropped the write. If both managers support full-fidelity
+// migration then the destination must also have adopted the source lineage
+// and a serial no older than the source's.
+func (m *Meta) backendMigrateVerify(stateOne, stateTwo state.State, opts *backendMigrateOpts) error {
+	log.Print("[TRACE] backendMigrateState: verifying migrated state in the destination backend")
+	if err := stateTwo.RefreshState(); err != nil {
+		return fmt.Errorf(strings.TrimSpace(errMigrateVerify),
+			opts.TwoType, err)
+	}
+
+	if !stateOne.State().Equal(stateTwo.State()) {
+		return fmt.Errorf(strings.TrimSpace(errMigrateVerify),
+			opts.TwoType, "the state read back from the destination does not match the state that was written to it")
+	}
+
+	// The snapshot metadata checks apply only when both managers took the
+	// full-fidelity path through statemgr.Migrate, since otherwise the
+	// destination legitimately starts a new lineage.
+	oneM, okOne := stateOne.(statemgr.Migrator)
+	twoM, okTwo := stateTwo.(statemgr.Migrator)
+	if okOne && okTwo {
+		metaOne := oneM.StateSnapshotMeta()
+		metaTwo := twoM.StateSnapshotMeta()
+		if metaTwo.Lineage != metaOne.Lineage {
+			return fmt.Errorf(strings.TrimSpace(errMigrateVerify),
+				opts.TwoType, fmt.Sprintf("the destination has lineage %q, but the migrated state has lineage %q", metaTwo.Lineage, metaOne.Lineage))
+		}
+		if metaTwo.Serial < metaOne.Serial {
+			return fmt.Errorf(strings.TrimSpace(errMigrateVerify),
+				opts.TwoType, fmt.Sprintf("the destination has state serial %d, which is older than the migrated serial %d", metaTwo.Serial, metaOne.Serial))
+		}
+	}
+
+	return nil
+}
+
 func (m *Meta) backendMigrateEmptyConfirm(one, two state.State, opts *backendMigrateOpts) (bool, error) {
 	inputOpts := &terraform.InputOpts{
 		Id:    "backend-migrate-copy-to-empty",
@@ -499,6 +545,16 @@ The state in the previous backend remains intact and unmodified. Please resolve
 the error above and try again.
 `
 
+const errMigrateVerify = `
+Error verifying migrated state in the %q backend:
+    %s
+
+Terraform wrote the migrated state to the newly configured backend but could
+not confirm that the backend now holds it. The state in the previous backend
+remains intact and unmodified. Please resolve the error above and run
+"terraform init" again to retry the migration.
+`
+
 const inputBackendMigrateEmpty = `
 Pre-existing state was found while migrating the previous %q backend to the
 newly configured %q backend. No existing state was found in the newly
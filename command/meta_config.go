@@ -68,6 +68,25 @@ func (m *Meta) loadConfig(rootDir string) (*configs.Config, tfdiags.Diagnostics)
 	return config, diags
 }
 
+// loadConfigConcurrent is a variant of loadConfig that parses descendent
+// modules concurrently, which is worthwhile for commands like validate whose
+// running time is dominated by configuration loading on configurations with
+// many modules.
+func (m *Meta) loadConfigConcurrent(rootDir string) (*configs.Config, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+	rootDir = m.normalizePath(rootDir)
+
+	loader, err := m.initConfigLoader()
+	if err != nil {
+		diags = diags.Append(err)
+		return nil, diags
+	}
+
+	config, hclDiags := loader.LoadConfigConcurrent(rootDir, 0)
+	diags = diags.Append(hclDiags)
+	return config, diags
+}
+
 // loadConfigEarly is a variant of loadConfig that uses the special
 // "early config" loader that is more forgiving of unexpected constructs and
 // legacy syntax.
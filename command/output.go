@@ -7,6 +7,8 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
 	ctyjson "github.com/zclconf/go-cty/cty/json"
 
 	"github.com/hashicorp/terraform/addrs"
@@ -29,9 +31,10 @@ func (c *OutputCommand) Run(args []string) int {
 	}
 
 	var module, statePath string
-	var jsonOutput bool
+	var jsonOutput, rawOutput bool
 	cmdFlags := c.Meta.defaultFlagSet("output")
 	cmdFlags.BoolVar(&jsonOutput, "json", false, "json")
+	cmdFlags.BoolVar(&rawOutput, "raw", false, "raw")
 	cmdFlags.StringVar(&statePath, "state", "", "path")
 	cmdFlags.StringVar(&module, "module", "", "module")
 	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
@@ -49,11 +52,23 @@ func (c *OutputCommand) Run(args []string) int {
 		return 1
 	}
 
+	if jsonOutput && rawOutput {
+		c.Ui.Error("The -raw and -json options are mutually exclusive.\n")
+		cmdFlags.Usage()
+		return 1
+	}
+
 	name := ""
 	if len(args) > 0 {
 		name = args[0]
 	}
 
+	if rawOutput && name == "" {
+		c.Ui.Error("You must give the name of a single output value when using the -raw option.\n")
+		cmdFlags.Usage()
+		return 1
+	}
+
 	if statePath != "" {
 		c.Meta.statePath = statePath
 	}
@@ -183,14 +198,56 @@ func (c *OutputCommand) Run(args []string) int {
 	}
 	v := os.Value
 
-	if jsonOutput {
+	switch {
+	case jsonOutput:
 		jsonOutput, err := ctyjson.Marshal(v, v.Type())
 		if err != nil {
 			return 1
 		}
 
 		c.Ui.Output(string(jsonOutput))
-	} else {
+	case rawOutput:
+		strV, err := convert.Convert(v, cty.String)
+		if err != nil {
+			// Only primitive types can be converted to string, so this is
+			// the main gate for -raw mode: we don't want to invent a
+			// serialization of complex types here, since -json already
+			// covers those faithfully.
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Unsupported value for raw output",
+				fmt.Sprintf(
+					"The -raw option only supports strings, numbers, and boolean values, but output value %q is %s.\n\nUse the -json option for machine-readable representations of output values that have complex types.",
+					name, v.Type().FriendlyName(),
+				),
+			))
+			c.showDiagnostics(diags)
+			return 1
+		}
+		if strV.IsNull() {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Unsupported value for raw output",
+				fmt.Sprintf("The value for output value %q is null, so -raw mode cannot print it.", name),
+			))
+			c.showDiagnostics(diags)
+			return 1
+		}
+		if !strV.IsKnown() {
+			// Since we're reading from state, this shouldn't typically
+			// happen, but an unknown value can be left behind by a partial
+			// failure during apply.
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Unsupported value for raw output",
+				fmt.Sprintf("The value for output value %q won't be known until after a successful terraform apply, so -raw mode cannot print it.", name),
+			))
+			c.showDiagnostics(diags)
+			return 1
+		}
+
+		c.Ui.Output(strV.AsString())
+	default:
 		// Our formatter still wants an old-style raw interface{} value, so
 		// for now we'll just shim it.
 		// FIXME: Port the formatter to work with cty.Value directly.
@@ -339,6 +396,11 @@ Options:
   -json            If specified, machine readable output will be
                    printed in JSON format
 
+  -raw             For value types that can be automatically converted
+                   to a string, will print the raw string directly,
+                   rather than a human-oriented representation of the
+                   value.
+
 `
 	return strings.TrimSpace(helpText)
 }
@@ -120,6 +120,117 @@ func TestOutput_json(t *testing.T) {
 	}
 }
 
+func TestOutput_raw(t *testing.T) {
+	originalState := states.BuildState(func(s *states.SyncState) {
+		s.SetOutputValue(
+			addrs.OutputValue{Name: "str"}.Absolute(addrs.RootModuleInstance),
+			cty.StringVal("bar"),
+			false,
+		)
+		s.SetOutputValue(
+			addrs.OutputValue{Name: "num"}.Absolute(addrs.RootModuleInstance),
+			cty.NumberIntVal(42),
+			false,
+		)
+		s.SetOutputValue(
+			addrs.OutputValue{Name: "list"}.Absolute(addrs.RootModuleInstance),
+			cty.ListVal([]cty.Value{cty.StringVal("a")}),
+			false,
+		)
+	})
+
+	statePath := testStateFile(t, originalState)
+
+	tests := map[string]struct {
+		name    string
+		want    string
+		wantErr string
+	}{
+		"string": {
+			name: "str",
+			want: "bar",
+		},
+		"number": {
+			name: "num",
+			want: "42",
+		},
+		"list": {
+			name:    "list",
+			wantErr: "Unsupported value for raw output",
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			ui := new(cli.MockUi)
+			c := &OutputCommand{
+				Meta: Meta{
+					testingOverrides: metaOverridesForProvider(testProvider()),
+					Ui:               ui,
+				},
+			}
+
+			args := []string{
+				"-state", statePath,
+				"-raw",
+				test.name,
+			}
+			code := c.Run(args)
+			if test.wantErr != "" {
+				if code == 0 {
+					t.Fatalf("succeeded, but want error\n%s", ui.OutputWriter.String())
+				}
+				if got := ui.ErrorWriter.String(); !strings.Contains(got, test.wantErr) {
+					t.Fatalf("wrong error\ngot:  %s\nwant substring: %s", got, test.wantErr)
+				}
+				return
+			}
+			if code != 0 {
+				t.Fatalf("bad: \n%s", ui.ErrorWriter.String())
+			}
+
+			actual := strings.TrimSpace(ui.OutputWriter.String())
+			if actual != test.want {
+				t.Fatalf("wrong output\ngot:  %#v\nwant: %#v", actual, test.want)
+			}
+		})
+	}
+}
+
+func TestOutput_rawWithJson(t *testing.T) {
+	originalState := states.BuildState(func(s *states.SyncState) {
+		s.SetOutputValue(
+			addrs.OutputValue{Name: "foo"}.Absolute(addrs.RootModuleInstance),
+			cty.StringVal("bar"),
+			false,
+		)
+	})
+
+	statePath := testStateFile(t, originalState)
+
+	ui := new(cli.MockUi)
+	c := &OutputCommand{
+		Meta: Meta{
+			testingOverrides: metaOverridesForProvider(testProvider()),
+			Ui:               ui,
+		},
+	}
+
+	args := []string{
+		"-state", statePath,
+		"-raw",
+		"-json",
+		"foo",
+	}
+	if code := c.Run(args); code != 1 {
+		t.Fatalf("bad: \n%s", ui.OutputWriter.String())
+	}
+
+	if got, want := ui.ErrorWriter.String(), "The -raw and -json options are mutually exclusive"; !strings.Contains(got, want) {
+		t.Fatalf("wrong error\ngot:  %s\nwant substring: %s", got, want)
+	}
+}
+
 func TestOutput_emptyOutputsErr(t *testing.T) {
 	originalState := states.NewState()
 	statePath := testStateFile(t, originalState)
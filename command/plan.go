@@ -2,11 +2,14 @@ package command
 
 import (
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/hashicorp/terraform/backend"
+	viewsjson "github.com/hashicorp/terraform/command/views/json"
 	"github.com/hashicorp/terraform/configs"
 	"github.com/hashicorp/terraform/plans"
+	"github.com/hashicorp/terraform/plans/ownership"
 	"github.com/hashicorp/terraform/tfdiags"
 )
 
@@ -17,8 +20,9 @@ type PlanCommand struct {
 }
 
 func (c *PlanCommand) Run(args []string) int {
-	var destroy, refresh, detailed bool
+	var destroy, refresh, refreshOnly, detailed, jsonUI, planCache bool
 	var outPath string
+	var ownershipPolicyPath, ownershipTeam string
 
 	args, err := c.Meta.process(args, true)
 	if err != nil {
@@ -28,10 +32,15 @@ func (c *PlanCommand) Run(args []string) int {
 	cmdFlags := c.Meta.extendedFlagSet("plan")
 	cmdFlags.BoolVar(&destroy, "destroy", false, "destroy")
 	cmdFlags.BoolVar(&refresh, "refresh", true, "refresh")
+	cmdFlags.BoolVar(&refreshOnly, "refresh-only", false, "refresh-only")
+	cmdFlags.BoolVar(&planCache, "plan-cache", true, "plan-cache")
 	cmdFlags.StringVar(&outPath, "out", "", "path")
 	cmdFlags.IntVar(&c.Meta.parallelism, "parallelism", DefaultParallelism, "parallelism")
 	cmdFlags.StringVar(&c.Meta.statePath, "state", "", "path")
 	cmdFlags.BoolVar(&detailed, "detailed-exitcode", false, "detailed-exitcode")
+	cmdFlags.BoolVar(&jsonUI, "json", false, "json")
+	cmdFlags.StringVar(&ownershipPolicyPath, "ownership-policy", "", "ownership policy file")
+	cmdFlags.StringVar(&ownershipTeam, "ownership-team", "", "ownership team")
 	cmdFlags.BoolVar(&c.Meta.stateLock, "lock", true, "lock state")
 	cmdFlags.DurationVar(&c.Meta.stateLockTimeout, "lock-timeout", 0, "lock timeout")
 	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
@@ -45,6 +54,11 @@ func (c *PlanCommand) Run(args []string) int {
 		return 1
 	}
 
+	if refreshOnly && destroy {
+		c.Ui.Error("The -refresh-only option is not compatible with -destroy.")
+		return 1
+	}
+
 	// Check for user-supplied plugin path
 	if c.pluginPath, err = c.loadPluginPath(); err != nil {
 		c.Ui.Error(fmt.Sprintf("Error loading plugin path: %s", err))
@@ -98,7 +112,25 @@ func (c *PlanCommand) Run(args []string) int {
 	opReq.Destroy = destroy
 	opReq.PlanOutPath = outPath
 	opReq.PlanRefresh = refresh
+	opReq.PlanRefreshOnly = refreshOnly
+	opReq.PlanCache = planCache
 	opReq.Type = backend.OperationTypePlan
+	if jsonUI {
+		opReq.JSONUIStream = viewsjson.NewStream(os.Stdout)
+	}
+	if ownershipPolicyPath != "" {
+		policy, policyDiags := ownership.LoadPolicyFile(ownershipPolicyPath)
+		if policyDiags.HasErrors() {
+			c.showDiagnostics(policyDiags)
+			return 1
+		}
+		if ownershipTeam == "" {
+			c.Ui.Error("The -ownership-team option is required when -ownership-policy is set.")
+			return 1
+		}
+		opReq.OwnershipPolicy = policy
+		opReq.OwnershipTeam = ownershipTeam
+	}
 
 	opReq.ConfigLoader, err = c.initConfigLoader()
 	if err != nil {
@@ -214,8 +246,16 @@ Options:
                       1 - Errored
                       2 - Succeeded, there is a diff
 
+  -exclude=resource   Resource to exclude. The resource and anything that
+                      depends on it will be ignored by the operation. This
+                      flag can be used multiple times.
+
   -input=true         Ask for input for variables if not directly set.
 
+  -json               Additionally emit machine-readable JSON UI events on
+                      stdout, one JSON object per line, for consumption by
+                      wrapping programs.
+
   -lock=true          Lock the state file when locking is supported.
 
   -lock-timeout=0s    Duration to retry a state lock.
@@ -225,10 +265,33 @@ Options:
   -out=path           Write a plan file to the given path. This can be used as
                       input to the "apply" command.
 
+  -ownership-policy=path
+                      Path to a JSON file mapping module and resource
+                      addresses to owning teams. When set, planned changes
+                      to addresses owned by a team other than the one given
+                      with -ownership-team are reported per the policy's
+                      enforcement level.
+
+  -ownership-team=name
+                      The team whose ownership scope this operation runs
+                      under. Required when -ownership-policy is set.
+
   -parallelism=n      Limit the number of concurrent operations. Defaults to 10.
 
+  -plan-cache=true    Retain the plan in the working directory so that the
+                      next plan can reuse its results for resource instances
+                      whose configuration and state have not changed since,
+                      skipping the provider plan call for those instances.
+                      Set to false to neither retain this plan nor reuse a
+                      previously retained one.
+
   -refresh=true       Update state prior to checking for differences.
 
+  -refresh-only       Instead of computing a plan, refresh all resources and
+                      report how the remote objects have drifted from the
+                      state, without proposing any changes. Cannot be
+                      combined with -destroy or -out.
+
   -state=statefile    Path to a Terraform state file to use to look
                       up Terraform-managed resources. By default it will
                       use the state "terraform.tfstate" if it exists.
@@ -6,16 +6,19 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
+	"net"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"runtime"
 	"strings"
 
+	hclog "github.com/hashicorp/go-hclog"
 	plugin "github.com/hashicorp/go-plugin"
 	"github.com/kardianos/osext"
 
 	"github.com/hashicorp/terraform/addrs"
+	externalProvider "github.com/hashicorp/terraform/builtin/providers/external"
 	terraformProvider "github.com/hashicorp/terraform/builtin/providers/terraform"
 	tfplugin "github.com/hashicorp/terraform/plugin"
 	"github.com/hashicorp/terraform/plugin/discovery"
@@ -286,6 +289,9 @@ func (m *Meta) internalProviders() map[addrs.Provider]providers.Factory {
 		addrs.NewLegacyProvider("terraform"): func() (providers.Interface, error) {
 			return terraformProvider.NewProvider(), nil
 		},
+		addrs.NewLegacyProvider("external"): func() (providers.Interface, error) {
+			return externalProvider.NewProvider(), nil
+		},
 	}
 }
 
@@ -382,6 +388,20 @@ func providerFactory(meta discovery.PluginMeta) providers.Factory {
 		// store the client so that the plugin can kill the child process
 		p := raw.(*tfplugin.GRPCProvider)
 		p.PluginClient = client
+		p.ProtocolVersion = client.NegotiatedVersion()
+
+		// Allow the provider to reuse a schema cached by a previous run,
+		// as long as the plugin binary is unchanged. Failure to hash the
+		// binary just means the cache is not used.
+		if digest, err := meta.SHA256(); err == nil {
+			p.SchemaCache = tfplugin.NewSchemaCache(filepath.Join(DefaultDataDir, "schemas"))
+			p.SchemaCacheKey = tfplugin.SchemaCacheKey{
+				ProviderAddr: addrs.NewLegacyProvider(meta.Name).String(),
+				Version:      string(meta.Version),
+				PluginHash:   digest,
+			}
+		}
+
 		return p, nil
 	}
 }
@@ -421,3 +441,118 @@ func newProvisionerClient(client *plugin.Client) (provisioners.Interface, error)
 	p.PluginClient = client
 	return p, nil
 }
+
+// ProviderReattachEnvVar is the name of the environment variable holding a
+// JSON map from provider name to the reattach configuration of an
+// already-running plugin process, allowing provider developers to serve a
+// provider from a debugger or test harness instead of an installed plugin.
+//
+// Each entry looks like:
+//
+//	{"aws": {"Protocol": "grpc", "Pid": 1234, "Addr": {"Network": "unix", "String": "/tmp/plugin1234"}}}
+const ProviderReattachEnvVar = "TF_REATTACH_PROVIDERS"
+
+// ProviderDevOverridesEnvVar is the name of the environment variable holding
+// a JSON map from provider name to the path of a locally-built provider
+// plugin binary that should be used in place of any installed version.
+const ProviderDevOverridesEnvVar = "TF_PROVIDER_DEV_OVERRIDES"
+
+// reattachConfig is the wire representation of a single entry in
+// TF_REATTACH_PROVIDERS.
+type reattachConfig struct {
+	Protocol string
+	Pid      int
+	Addr     struct {
+		Network string
+		String  string
+	}
+}
+
+// providerDevOverrides produces provider factories for any developer
+// overrides given in the process environment, either reattaching to running
+// plugin processes or launching locally-built plugin binaries directly.
+func providerDevOverrides() (map[addrs.Provider]providers.Factory, error) {
+	var ret map[addrs.Provider]providers.Factory
+
+	if v := os.Getenv(ProviderReattachEnvVar); v != "" {
+		var configs map[string]reattachConfig
+		if err := json.Unmarshal([]byte(v), &configs); err != nil {
+			return nil, fmt.Errorf("invalid format for %s: %s", ProviderReattachEnvVar, err)
+		}
+		for name, cfg := range configs {
+			var addr net.Addr
+			var err error
+			switch cfg.Addr.Network {
+			case "unix":
+				addr, err = net.ResolveUnixAddr("unix", cfg.Addr.String)
+			case "tcp":
+				addr, err = net.ResolveTCPAddr("tcp", cfg.Addr.String)
+			default:
+				err = fmt.Errorf("unknown address network %q", cfg.Addr.Network)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("invalid reattach configuration for provider %q in %s: %s", name, ProviderReattachEnvVar, err)
+			}
+			if ret == nil {
+				ret = make(map[addrs.Provider]providers.Factory)
+			}
+			ret[addrs.NewLegacyProvider(name)] = unmanagedProviderFactory(&plugin.ReattachConfig{
+				Protocol: plugin.Protocol(cfg.Protocol),
+				Pid:      cfg.Pid,
+				Addr:     addr,
+			})
+		}
+	}
+
+	if v := os.Getenv(ProviderDevOverridesEnvVar); v != "" {
+		var paths map[string]string
+		if err := json.Unmarshal([]byte(v), &paths); err != nil {
+			return nil, fmt.Errorf("invalid format for %s: %s", ProviderDevOverridesEnvVar, err)
+		}
+		for name, path := range paths {
+			if ret == nil {
+				ret = make(map[addrs.Provider]providers.Factory)
+			}
+			ret[addrs.NewLegacyProvider(name)] = providerFactory(discovery.PluginMeta{
+				Name: name,
+				Path: path,
+			})
+		}
+	}
+
+	return ret, nil
+}
+
+// unmanagedProviderFactory produces a provider factory that connects to an
+// already-running plugin process rather than launching a new one, and never
+// kills that process since its lifecycle is managed elsewhere.
+func unmanagedProviderFactory(reattach *plugin.ReattachConfig) providers.Factory {
+	return func() (providers.Interface, error) {
+		client := plugin.NewClient(&plugin.ClientConfig{
+			HandshakeConfig: tfplugin.Handshake,
+			Logger: hclog.New(&hclog.LoggerOptions{
+				Name:   "unmanaged-plugin",
+				Level:  hclog.Trace,
+				Output: os.Stderr,
+			}),
+			VersionedPlugins: tfplugin.VersionedPlugins,
+			Reattach:         reattach,
+			AllowedProtocols: []plugin.Protocol{plugin.ProtocolGRPC},
+		})
+
+		rpcClient, err := client.Client()
+		if err != nil {
+			return nil, err
+		}
+
+		raw, err := rpcClient.Dispense(tfplugin.ProviderPluginName)
+		if err != nil {
+			return nil, err
+		}
+
+		p := raw.(*tfplugin.GRPCProvider)
+		p.PluginClient = client
+		p.ProtocolVersion = client.NegotiatedVersion()
+		return p, nil
+	}
+}
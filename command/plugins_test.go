@@ -209,3 +209,52 @@ func (cb callbackPluginInstaller) PurgeUnused(map[string]discovery.PluginMeta) (
 	// does nothing
 	return make(discovery.PluginMetaSet), nil
 }
+
+func TestProviderDevOverrides(t *testing.T) {
+	t.Run("none", func(t *testing.T) {
+		os.Unsetenv(ProviderReattachEnvVar)
+		os.Unsetenv(ProviderDevOverridesEnvVar)
+		got, err := providerDevOverrides()
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if len(got) != 0 {
+			t.Fatalf("unexpected overrides: %#v", got)
+		}
+	})
+
+	t.Run("reattach", func(t *testing.T) {
+		os.Setenv(ProviderReattachEnvVar, `{"test": {"Protocol": "grpc", "Pid": 1234, "Addr": {"Network": "unix", "String": "/tmp/plugin1234"}}}`)
+		defer os.Unsetenv(ProviderReattachEnvVar)
+
+		got, err := providerDevOverrides()
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if _, exists := got[addrs.NewLegacyProvider("test")]; !exists {
+			t.Fatalf("missing override for provider \"test\"; got %#v", got)
+		}
+	})
+
+	t.Run("local binary", func(t *testing.T) {
+		os.Setenv(ProviderDevOverridesEnvVar, `{"test": "/tmp/terraform-provider-test"}`)
+		defer os.Unsetenv(ProviderDevOverridesEnvVar)
+
+		got, err := providerDevOverrides()
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if _, exists := got[addrs.NewLegacyProvider("test")]; !exists {
+			t.Fatalf("missing override for provider \"test\"; got %#v", got)
+		}
+	})
+
+	t.Run("invalid reattach", func(t *testing.T) {
+		os.Setenv(ProviderReattachEnvVar, `{"test": {"Addr": {"Network": "carrier-pigeon"}}}`)
+		defer os.Unsetenv(ProviderReattachEnvVar)
+
+		if _, err := providerDevOverrides(); err == nil {
+			t.Fatal("succeeded; want error for unknown address network")
+		}
+	})
+}
@@ -1,6 +1,8 @@
 package command
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"path/filepath"
 
@@ -32,7 +34,9 @@ func (c *ProvidersCommand) Run(args []string) int {
 		return 1
 	}
 
+	var jsonOutput bool
 	cmdFlags := c.Meta.defaultFlagSet("providers")
+	cmdFlags.BoolVar(&jsonOutput, "json", false, "produce JSON output")
 	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
 	if err := cmdFlags.Parse(args); err != nil {
 		c.Ui.Error(fmt.Sprintf("Error parsing command-line flags: %s\n", err.Error()))
@@ -104,10 +108,20 @@ func (c *ProvidersCommand) Run(args []string) int {
 	depTree := terraform.ConfigTreeDependencies(config, s)
 	depTree.SortDescendents()
 
-	printRoot := treeprint.New()
-	providersCommandPopulateTreeNode(printRoot, depTree)
+	if jsonOutput {
+		jsonReport, err := json.MarshalIndent(depTree.Report(), "", "  ")
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Failed to serialize provider report: %s", err))
+			return 1
+		}
+		c.Ui.Output(string(jsonReport))
+	} else {
+		printRoot := treeprint.New()
+		providersCommandPopulateTreeNode(printRoot, depTree)
 
-	c.Ui.Output(printRoot.String())
+		c.Ui.Output(printRoot.String())
+		c.Ui.Output(providersRequirementsReport(depTree))
+	}
 
 	c.showDiagnostics(diags)
 	if diags.HasErrors() {
@@ -145,8 +159,29 @@ func providersCommandPopulateTreeNode(node treeprint.Tree, deps *moduledeps.Modu
 	}
 }
 
+// providersRequirementsReport renders the effective version constraint for
+// each provider across the whole configuration, along with which modules
+// contributed constraints to it. The effective constraint is the
+// intersection of all contributions, with the root module's constraints
+// cascading over every descendent module.
+func providersRequirementsReport(deps *moduledeps.Module) string {
+	var buf bytes.Buffer
+	buf.WriteString("Effective provider requirements:\n")
+	for _, req := range deps.EffectiveRequirements() {
+		constraints := req.Constraints
+		if constraints == "" {
+			constraints = "(any version)"
+		}
+		fmt.Fprintf(&buf, "* %s: %s\n", req.Provider, constraints)
+		for _, contrib := range req.Contributions {
+			fmt.Fprintf(&buf, "    %s from %s\n", contrib.Constraints, contrib.Module)
+		}
+	}
+	return buf.String()
+}
+
 const providersCommandHelp = `
-Usage: terraform providers [dir]
+Usage: terraform providers [options] [dir]
 
   Prints out a tree of modules in the referenced configuration annotated with
   their provider requirements.
@@ -155,4 +190,9 @@ Usage: terraform providers [dir]
   referenced modules, as an aid to understanding why particular provider
   plugins are needed and why particular versions are selected.
 
+Options:
+
+  -json          Produce the report in a machine-readable JSON format
+                 instead of the human-oriented tree rendering.
+
 `
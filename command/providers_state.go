@@ -0,0 +1,162 @@
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform/backend"
+	"github.com/hashicorp/terraform/tfdiags"
+)
+
+// ProvidersStateCommand is a Command implementation that prints out which
+// provider configuration manages each resource instance in the state.
+type ProvidersStateCommand struct {
+	Meta
+}
+
+func (c *ProvidersStateCommand) Help() string {
+	return providersStateCommandHelp
+}
+
+func (c *ProvidersStateCommand) Synopsis() string {
+	return "Prints the provider configuration managing each resource in the state"
+}
+
+func (c *ProvidersStateCommand) Run(args []string) int {
+	args, err := c.Meta.process(args, false)
+	if err != nil {
+		return 1
+	}
+
+	cmdFlags := c.Meta.defaultFlagSet("providers state")
+	var jsonOutput bool
+	cmdFlags.BoolVar(&jsonOutput, "json", false, "produce JSON output")
+
+	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
+	if err := cmdFlags.Parse(args); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error parsing command-line flags: %s\n", err.Error()))
+		return 1
+	}
+
+	// Check for user-supplied plugin path
+	if c.pluginPath, err = c.loadPluginPath(); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error loading plugin path: %s", err))
+		return 1
+	}
+
+	var diags tfdiags.Diagnostics
+
+	// Load the backend
+	b, backendDiags := c.Backend(nil)
+	diags = diags.Append(backendDiags)
+	if backendDiags.HasErrors() {
+		c.showDiagnostics(diags)
+		return 1
+	}
+
+	// We require a local backend
+	local, ok := b.(backend.Local)
+	if !ok {
+		c.showDiagnostics(diags) // in case of any warnings in here
+		c.Ui.Error(ErrUnsupportedLocalOp)
+		return 1
+	}
+
+	// we expect that the config dir is the cwd
+	cwd, err := os.Getwd()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error getting cwd: %s", err))
+		return 1
+	}
+
+	// Build the operation
+	opReq := c.Operation(b)
+	opReq.ConfigDir = cwd
+	opReq.ConfigLoader, err = c.initConfigLoader()
+	opReq.AllowUnsetVariables = true
+	if err != nil {
+		diags = diags.Append(err)
+		c.showDiagnostics(diags)
+		return 1
+	}
+
+	// Get the context
+	ctx, _, ctxDiags := local.Context(opReq)
+	diags = diags.Append(ctxDiags)
+	if ctxDiags.HasErrors() {
+		c.showDiagnostics(diags)
+		return 1
+	}
+
+	allocs := ctx.ProviderAllocations()
+
+	if jsonOutput {
+		type allocJSON struct {
+			Resource       string `json:"resource"`
+			StateProvider  string `json:"state_provider"`
+			ConfigProvider string `json:"config_provider,omitempty"`
+			Mismatch       bool   `json:"mismatch"`
+		}
+		out := struct {
+			FormatVersion string      `json:"format_version"`
+			Allocations   []allocJSON `json:"allocations"`
+		}{
+			FormatVersion: "0.1",
+			Allocations:   make([]allocJSON, 0, len(allocs)),
+		}
+		for _, alloc := range allocs {
+			aj := allocJSON{
+				Resource:      alloc.Resource.String(),
+				StateProvider: alloc.StateProvider.String(),
+				Mismatch:      alloc.Mismatch,
+			}
+			if alloc.ConfigProvider != nil {
+				aj.ConfigProvider = alloc.ConfigProvider.String()
+			}
+			out.Allocations = append(out.Allocations, aj)
+		}
+		j, err := json.MarshalIndent(out, "", "  ")
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Failed to marshal provider allocations to json: %s", err))
+			return 1
+		}
+		c.Ui.Output(string(j))
+		return 0
+	}
+
+	if len(allocs) == 0 {
+		c.Ui.Output("No resources are tracked in the state.")
+		return 0
+	}
+
+	mismatches := 0
+	for _, alloc := range allocs {
+		c.Ui.Output(fmt.Sprintf("%s\n  provider: %s", alloc.Resource, alloc.StateProvider))
+		if alloc.Mismatch {
+			mismatches++
+			if alloc.ConfigProvider != nil {
+				c.Ui.Output(fmt.Sprintf("  MISMATCH: configuration now selects %s", alloc.ConfigProvider))
+			} else {
+				c.Ui.Output("  MISMATCH: resource is no longer in the configuration")
+			}
+		}
+	}
+	if mismatches > 0 {
+		c.Ui.Output(fmt.Sprintf(
+			"\n%d resource(s) are recorded against a provider configuration that no\nlonger matches the configuration. The next operation will associate them\nwith the provider configuration shown for each mismatch above.",
+			mismatches,
+		))
+	}
+
+	return 0
+}
+
+const providersStateCommandHelp = `
+Usage: terraform providers state [-json]
+
+  Prints out which provider configuration manages each resource instance
+  tracked in the state, including aliased and module-inherited provider
+  configurations, and flags resources whose recorded provider no longer
+  matches what the configuration would select.
+`
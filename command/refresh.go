@@ -128,6 +128,10 @@ Options:
                       accompanied by errors, show them in a more compact form
                       that includes only the summary messages.
 
+  -exclude=resource   Resource to exclude. The resource and anything that
+                      depends on it will be ignored by the operation. This
+                      flag can be used multiple times.
+
   -input=true         Ask for input for variables if not directly set.
 
   -lock=true          Lock the state file when locking is supported.
@@ -0,0 +1,355 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/command/clistate"
+	"github.com/mitchellh/cli"
+)
+
+// StateDeposedCommand is a Command implementation that just shows help for
+// the subcommands nested below it.
+type StateDeposedCommand struct {
+	StateMeta
+}
+
+func (c *StateDeposedCommand) Run(args []string) int {
+	return cli.RunResultHelp
+}
+
+func (c *StateDeposedCommand) Help() string {
+	helpText := `
+Usage: terraform state deposed <subcommand> [options] [args]
+
+  This command has subcommands for managing deposed resource instance
+  objects in the Terraform state.
+
+  A deposed object is a former "current" object that Terraform was unable
+  to destroy after its create_before_destroy replacement was created. In
+  normal use Terraform destroys deposed objects on the next apply, but
+  these subcommands allow listing them, restoring one as the current
+  object, and removing one from the state without destroying it.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *StateDeposedCommand) Synopsis() string {
+	return "Manage deposed resource instance objects in the state"
+}
+
+// StateDeposedListCommand is a Command implementation that lists the deposed
+// objects recorded in the state.
+type StateDeposedListCommand struct {
+	StateMeta
+}
+
+func (c *StateDeposedListCommand) Run(args []string) int {
+	args, err := c.Meta.process(args, true)
+	if err != nil {
+		return 1
+	}
+
+	cmdFlags := c.Meta.defaultFlagSet("state deposed list")
+	cmdFlags.StringVar(&c.statePath, "state", "", "path")
+	if err := cmdFlags.Parse(args); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error parsing command-line flags: %s\n", err.Error()))
+		return cli.RunResultHelp
+	}
+	args = cmdFlags.Args()
+
+	// Get the state
+	stateMgr, err := c.State()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf(errStateLoadingState, err))
+		return 1
+	}
+	if err := stateMgr.RefreshState(); err != nil {
+		c.Ui.Error(fmt.Sprintf("Failed to load state: %s", err))
+		return 1
+	}
+
+	state := stateMgr.State()
+	if state == nil {
+		c.Ui.Error(fmt.Sprintf(errStateNotFound))
+		return 1
+	}
+
+	instAddrs, diags := c.lookupDeposedInstanceAddrs(state, args...)
+	if diags.HasErrors() {
+		c.showDiagnostics(diags)
+		return 1
+	}
+
+	ss := state.SyncWrapper()
+	for _, addr := range instAddrs {
+		for _, key := range ss.DeposedInstanceObjectKeys(addr) {
+			c.Ui.Output(fmt.Sprintf("%s deposed %s", addr.String(), key))
+		}
+	}
+
+	c.showDiagnostics(diags)
+
+	return 0
+}
+
+func (c *StateDeposedListCommand) Help() string {
+	helpText := `
+Usage: terraform state deposed list [options] [address...]
+
+  List deposed resource instance objects in the Terraform state.
+
+  This command lists the deposed objects in the Terraform state, one per
+  line in the form "ADDRESS deposed KEY". The address arguments can be used
+  to filter the result by resource or module, in the same way as for
+  "terraform state list". If no addresses are given, all deposed objects
+  are listed.
+
+Options:
+
+  -state=statefile    Path to a Terraform state file to use to look
+                      up Terraform-managed resources. By default, Terraform
+                      will consult the state of the currently-selected
+                      workspace.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *StateDeposedListCommand) Synopsis() string {
+	return "List deposed resource instance objects in the state"
+}
+
+// StateDeposedRestoreCommand is a Command implementation that promotes a
+// deposed object to be the current object for its resource instance.
+type StateDeposedRestoreCommand struct {
+	StateMeta
+}
+
+func (c *StateDeposedRestoreCommand) Run(args []string) int {
+	args, err := c.Meta.process(args, true)
+	if err != nil {
+		return 1
+	}
+
+	cmdFlags := c.Meta.defaultFlagSet("state deposed restore")
+	cmdFlags.StringVar(&c.backupPath, "backup", "-", "backup")
+	cmdFlags.BoolVar(&c.Meta.stateLock, "lock", true, "lock state")
+	cmdFlags.DurationVar(&c.Meta.stateLockTimeout, "lock-timeout", 0, "lock timeout")
+	cmdFlags.StringVar(&c.statePath, "state", "", "path")
+	if err := cmdFlags.Parse(args); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error parsing command-line flags: %s\n", err.Error()))
+		return 1
+	}
+
+	args = cmdFlags.Args()
+	if len(args) != 2 {
+		c.Ui.Error("Exactly two arguments expected: ADDRESS KEY.\n")
+		return cli.RunResultHelp
+	}
+
+	// Get the state
+	stateMgr, err := c.State()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf(errStateLoadingState, err))
+		return 1
+	}
+
+	if c.stateLock {
+		stateLocker := clistate.NewLocker(context.Background(), c.stateLockTimeout, c.Ui, c.Colorize())
+		if err := stateLocker.Lock(stateMgr, "state-deposed-restore"); err != nil {
+			c.Ui.Error(fmt.Sprintf("Error locking state: %s", err))
+			return 1
+		}
+		defer stateLocker.Unlock(nil)
+	}
+
+	if err := stateMgr.RefreshState(); err != nil {
+		c.Ui.Error(fmt.Sprintf("Failed to refresh state: %s", err))
+		return 1
+	}
+
+	state := stateMgr.State()
+	if state == nil {
+		c.Ui.Error(fmt.Sprintf(errStateNotFound))
+		return 1
+	}
+
+	addr, key, diags := c.lookupDeposedObjectAddr(state, args[0], args[1])
+	if diags.HasErrors() {
+		c.showDiagnostics(diags)
+		return 1
+	}
+
+	ss := state.SyncWrapper()
+	if !ss.MaybeRestoreResourceInstanceDeposed(addr, key) {
+		c.Ui.Error(fmt.Sprintf(
+			"Cannot restore deposed object %s for %s: the instance already has a current object.\n\nUse \"terraform state rm %s\" first if you intend to replace the current object with this deposed one.",
+			key, addr, addr,
+		))
+		return 1
+	}
+
+	if err := stateMgr.WriteState(state); err != nil {
+		c.Ui.Error(fmt.Sprintf(errStateDeposedPersist, err))
+		return 1
+	}
+	if err := stateMgr.PersistState(); err != nil {
+		c.Ui.Error(fmt.Sprintf(errStateDeposedPersist, err))
+		return 1
+	}
+
+	c.Ui.Output(fmt.Sprintf("Restored deposed object %s as the current object for %s.", key, addr))
+	return 0
+}
+
+func (c *StateDeposedRestoreCommand) Help() string {
+	helpText := `
+Usage: terraform state deposed restore [options] ADDRESS KEY
+
+  Restore a deposed resource instance object as the current object for its
+  instance.
+
+  This promotes the deposed object identified by the given resource instance
+  address and deposed key back to being the "current" object for that
+  instance, so that subsequent plans will manage it in place rather than
+  planning to destroy it.
+
+  Restoring is possible only if the instance does not already have a current
+  object. Use "terraform state deposed list" to find the deposed keys
+  recorded in the state.
+
+Options:
+
+  -backup=PATH        Path where Terraform should write the backup
+                      state.
+
+  -lock=true          Lock the state file when locking is supported.
+
+  -lock-timeout=0s    Duration to retry a state lock.
+
+  -state=statefile    Path to the state file to update. Defaults to the
+                      current workspace state.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *StateDeposedRestoreCommand) Synopsis() string {
+	return "Restore a deposed object as the current object for its instance"
+}
+
+// StateDeposedRmCommand is a Command implementation that removes a deposed
+// object from the state without destroying it.
+type StateDeposedRmCommand struct {
+	StateMeta
+}
+
+func (c *StateDeposedRmCommand) Run(args []string) int {
+	args, err := c.Meta.process(args, true)
+	if err != nil {
+		return 1
+	}
+
+	cmdFlags := c.Meta.defaultFlagSet("state deposed rm")
+	cmdFlags.StringVar(&c.backupPath, "backup", "-", "backup")
+	cmdFlags.BoolVar(&c.Meta.stateLock, "lock", true, "lock state")
+	cmdFlags.DurationVar(&c.Meta.stateLockTimeout, "lock-timeout", 0, "lock timeout")
+	cmdFlags.StringVar(&c.statePath, "state", "", "path")
+	if err := cmdFlags.Parse(args); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error parsing command-line flags: %s\n", err.Error()))
+		return 1
+	}
+
+	args = cmdFlags.Args()
+	if len(args) != 2 {
+		c.Ui.Error("Exactly two arguments expected: ADDRESS KEY.\n")
+		return cli.RunResultHelp
+	}
+
+	// Get the state
+	stateMgr, err := c.State()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf(errStateLoadingState, err))
+		return 1
+	}
+
+	if c.stateLock {
+		stateLocker := clistate.NewLocker(context.Background(), c.stateLockTimeout, c.Ui, c.Colorize())
+		if err := stateLocker.Lock(stateMgr, "state-deposed-rm"); err != nil {
+			c.Ui.Error(fmt.Sprintf("Error locking state: %s", err))
+			return 1
+		}
+		defer stateLocker.Unlock(nil)
+	}
+
+	if err := stateMgr.RefreshState(); err != nil {
+		c.Ui.Error(fmt.Sprintf("Failed to refresh state: %s", err))
+		return 1
+	}
+
+	state := stateMgr.State()
+	if state == nil {
+		c.Ui.Error(fmt.Sprintf(errStateNotFound))
+		return 1
+	}
+
+	addr, key, diags := c.lookupDeposedObjectAddr(state, args[0], args[1])
+	if diags.HasErrors() {
+		c.showDiagnostics(diags)
+		return 1
+	}
+
+	ss := state.SyncWrapper()
+	ss.ForgetResourceInstanceDeposed(addr, key)
+	ss.RemoveResourceIfEmpty(addr.ContainingResource())
+
+	if err := stateMgr.WriteState(state); err != nil {
+		c.Ui.Error(fmt.Sprintf(errStateDeposedPersist, err))
+		return 1
+	}
+	if err := stateMgr.PersistState(); err != nil {
+		c.Ui.Error(fmt.Sprintf(errStateDeposedPersist, err))
+		return 1
+	}
+
+	c.Ui.Output(fmt.Sprintf("Removed deposed object %s for %s.", key, addr))
+	return 0
+}
+
+func (c *StateDeposedRmCommand) Help() string {
+	helpText := `
+Usage: terraform state deposed rm [options] ADDRESS KEY
+
+  Remove a deposed resource instance object from the Terraform state,
+  causing Terraform to "forget" that object without first destroying it in
+  the remote system.
+
+  Use "terraform state deposed list" to find the deposed keys recorded in
+  the state. The current object for the instance, if any, is unaffected.
+
+Options:
+
+  -backup=PATH        Path where Terraform should write the backup
+                      state.
+
+  -lock=true          Lock the state file when locking is supported.
+
+  -lock-timeout=0s    Duration to retry a state lock.
+
+  -state=statefile    Path to the state file to update. Defaults to the
+                      current workspace state.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *StateDeposedRmCommand) Synopsis() string {
+	return "Remove a deposed object from the state without destroying it"
+}
+
+const errStateDeposedPersist = `Error saving the state: %s
+
+The state was not saved. No items in your state were changed.`
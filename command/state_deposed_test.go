@@ -0,0 +1,213 @@
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mitchellh/cli"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/states"
+)
+
+// testStateWithDeposed returns a state where test_instance.foo has both a
+// current object and one deposed object.
+func testStateWithDeposed(t *testing.T) *states.State {
+	t.Helper()
+	return states.BuildState(func(s *states.SyncState) {
+		addr := addrs.Resource{
+			Mode: addrs.ManagedResourceMode,
+			Type: "test_instance",
+			Name: "foo",
+		}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+		providerAddr := addrs.AbsProviderConfig{
+			Provider: addrs.NewLegacyProvider("test"),
+			Module:   addrs.RootModuleInstance,
+		}
+		s.SetResourceInstanceCurrent(
+			addr,
+			&states.ResourceInstanceObjectSrc{
+				AttrsJSON: []byte(`{"id":"bar"}`),
+				Status:    states.ObjectReady,
+			},
+			providerAddr,
+		)
+		s.SetResourceInstanceDeposed(
+			addr,
+			states.DeposedKey("00000001"),
+			&states.ResourceInstanceObjectSrc{
+				AttrsJSON: []byte(`{"id":"old"}`),
+				Status:    states.ObjectReady,
+			},
+			providerAddr,
+		)
+	})
+}
+
+func TestStateDeposedList(t *testing.T) {
+	statePath := testStateFile(t, testStateWithDeposed(t))
+
+	p := testProvider()
+	ui := new(cli.MockUi)
+	c := &StateDeposedListCommand{
+		StateMeta{
+			Meta: Meta{
+				testingOverrides: metaOverridesForProvider(p),
+				Ui:               ui,
+			},
+		},
+	}
+
+	args := []string{
+		"-state", statePath,
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	if got, want := strings.TrimSpace(ui.OutputWriter.String()), "test_instance.foo deposed 00000001"; got != want {
+		t.Fatalf("wrong output\ngot:  %s\nwant: %s", got, want)
+	}
+}
+
+func TestStateDeposedRestore(t *testing.T) {
+	instAddr := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_instance",
+		Name: "foo",
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+
+	t.Run("with current object", func(t *testing.T) {
+		statePath := testStateFile(t, testStateWithDeposed(t))
+
+		p := testProvider()
+		ui := new(cli.MockUi)
+		c := &StateDeposedRestoreCommand{
+			StateMeta{
+				Meta: Meta{
+					testingOverrides: metaOverridesForProvider(p),
+					Ui:               ui,
+				},
+			},
+		}
+
+		args := []string{
+			"-state", statePath,
+			"test_instance.foo",
+			"00000001",
+		}
+		if code := c.Run(args); code != 1 {
+			t.Fatalf("expected error, got: %d\n\n%s", code, ui.OutputWriter.String())
+		}
+		if got, want := ui.ErrorWriter.String(), "already has a current object"; !strings.Contains(got, want) {
+			t.Fatalf("wrong error\ngot:  %s\nwant substring: %s", got, want)
+		}
+	})
+
+	t.Run("without current object", func(t *testing.T) {
+		state := testStateWithDeposed(t)
+		state.SyncWrapper().SetResourceInstanceCurrent(instAddr, nil, addrs.AbsProviderConfig{
+			Provider: addrs.NewLegacyProvider("test"),
+			Module:   addrs.RootModuleInstance,
+		})
+		statePath := testStateFile(t, state)
+
+		p := testProvider()
+		ui := new(cli.MockUi)
+		c := &StateDeposedRestoreCommand{
+			StateMeta{
+				Meta: Meta{
+					testingOverrides: metaOverridesForProvider(p),
+					Ui:               ui,
+				},
+			},
+		}
+
+		args := []string{
+			"-state", statePath,
+			"test_instance.foo",
+			"00000001",
+		}
+		if code := c.Run(args); code != 0 {
+			t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+		}
+
+		newState := testStateRead(t, statePath)
+		is := newState.ResourceInstance(instAddr)
+		if is == nil || !is.HasCurrent() {
+			t.Fatal("instance has no current object after restore")
+		}
+		if is.HasAnyDeposed() {
+			t.Fatal("instance still has deposed objects after restore")
+		}
+		if got, want := string(is.Current.AttrsJSON), `"old"`; !strings.Contains(got, want) {
+			t.Fatalf("wrong current object\ngot:  %s\nwant substring: %s", got, want)
+		}
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		statePath := testStateFile(t, testStateWithDeposed(t))
+
+		p := testProvider()
+		ui := new(cli.MockUi)
+		c := &StateDeposedRestoreCommand{
+			StateMeta{
+				Meta: Meta{
+					testingOverrides: metaOverridesForProvider(p),
+					Ui:               ui,
+				},
+			},
+		}
+
+		args := []string{
+			"-state", statePath,
+			"test_instance.foo",
+			"deadbeef",
+		}
+		if code := c.Run(args); code != 1 {
+			t.Fatalf("expected error, got: %d\n\n%s", code, ui.OutputWriter.String())
+		}
+		if got, want := ui.ErrorWriter.String(), "Unknown deposed object"; !strings.Contains(got, want) {
+			t.Fatalf("wrong error\ngot:  %s\nwant substring: %s", got, want)
+		}
+	})
+}
+
+func TestStateDeposedRm(t *testing.T) {
+	statePath := testStateFile(t, testStateWithDeposed(t))
+
+	p := testProvider()
+	ui := new(cli.MockUi)
+	c := &StateDeposedRmCommand{
+		StateMeta{
+			Meta: Meta{
+				testingOverrides: metaOverridesForProvider(p),
+				Ui:               ui,
+			},
+		},
+	}
+
+	args := []string{
+		"-state", statePath,
+		"test_instance.foo",
+		"00000001",
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	instAddr := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_instance",
+		Name: "foo",
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+
+	newState := testStateRead(t, statePath)
+	is := newState.ResourceInstance(instAddr)
+	if is == nil || !is.HasCurrent() {
+		t.Fatal("instance lost its current object")
+	}
+	if is.HasAnyDeposed() {
+		t.Fatal("instance still has deposed objects")
+	}
+}
@@ -181,6 +181,37 @@ func (c *StateMeta) lookupAllResourceInstanceAddrs(state *states.State) ([]addrs
 	return ret, diags
 }
 
+// lookupDeposedInstanceAddrs is like lookupResourceInstanceAddrs except that
+// with no arguments it returns all of the resource instances in the state,
+// for commands that treat their address arguments as an optional filter.
+func (c *StateMeta) lookupDeposedInstanceAddrs(state *states.State, addrStrs ...string) ([]addrs.AbsResourceInstance, tfdiags.Diagnostics) {
+	if len(addrStrs) == 0 {
+		return c.lookupAllResourceInstanceAddrs(state)
+	}
+	return c.lookupResourceInstanceAddrs(state, addrStrs...)
+}
+
+// lookupDeposedObjectAddr parses the given resource instance address and
+// deposed key strings and verifies that the state actually records a deposed
+// object with that key for that instance.
+func (c *StateMeta) lookupDeposedObjectAddr(state *states.State, addrStr, keyStr string) (addrs.AbsResourceInstance, states.DeposedKey, tfdiags.Diagnostics) {
+	addr, diags := addrs.ParseAbsResourceInstanceStr(addrStr)
+	if diags.HasErrors() {
+		return addr, states.NotDeposed, diags
+	}
+
+	key := states.DeposedKey(keyStr)
+	is := state.ResourceInstance(addr)
+	if is == nil || !is.HasDeposed(key) {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Unknown deposed object",
+			fmt.Sprintf(`The current state records no deposed object %s for %s. Use "terraform state deposed list" to see the deposed objects in the state.`, key, addr),
+		))
+	}
+	return addr, key, diags
+}
+
 func (c *StateMeta) collectModuleResourceInstances(ms *states.Module) []addrs.AbsResourceInstance {
 	var ret []addrs.AbsResourceInstance
 	for _, rs := range ms.Resources {
@@ -3,10 +3,12 @@ package command
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform/addrs"
 	"github.com/hashicorp/terraform/command/clistate"
+	"github.com/hashicorp/terraform/terraform"
 	"github.com/hashicorp/terraform/tfdiags"
 	"github.com/mitchellh/cli"
 )
@@ -81,6 +83,54 @@ func (c *StateRmCommand) Run(args []string) int {
 		return 1
 	}
 
+	// Before removing anything, check for other state contents that refer
+	// to the instances we're about to remove so that the user can decide
+	// whether to create dangling references.
+	if !dryRun {
+		removing := make(map[string]bool)
+		for _, addr := range addrs {
+			removing[addr.ContainingResource().String()] = true
+		}
+		dangling := make(map[string]bool)
+		for _, addr := range addrs {
+			impact := state.RemovalImpact(addr)
+			for _, r := range impact.DependentResources {
+				if !removing[r.String()] {
+					dangling["resource "+r.String()] = true
+				}
+			}
+			for _, o := range impact.DependentOutputs {
+				dangling["output "+o.String()] = true
+			}
+		}
+		if len(dangling) > 0 {
+			refs := make([]string, 0, len(dangling))
+			for ref := range dangling {
+				refs = append(refs, "  - "+ref)
+			}
+			sort.Strings(refs)
+
+			desc := "Other parts of the state refer to the instance(s) being removed:\n" +
+				strings.Join(refs, "\n") + "\n" +
+				"Removing them will leave these references dangling. Only 'yes' will be\n" +
+				"accepted to confirm."
+
+			v, err := c.UIInput().Input(context.Background(), &terraform.InputOpts{
+				Id:          "state-rm-dependents",
+				Query:       "Do you want to remove these instances anyway?",
+				Description: desc,
+			})
+			if err != nil {
+				c.Ui.Error(fmt.Sprintf("Error asking for confirmation: %s", err))
+				return 1
+			}
+			if v != "yes" {
+				c.Ui.Output("state rm cancelled.")
+				return 1
+			}
+		}
+	}
+
 	prefix := "Removed "
 	if dryRun {
 		prefix = "Would remove "
@@ -0,0 +1,222 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/command/clistate"
+	"github.com/hashicorp/terraform/states"
+	"github.com/hashicorp/terraform/states/statemgr"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mitchellh/cli"
+)
+
+// StateRollbackCommand is a Command implementation that restores a previous
+// state snapshot retained by a backend that supports snapshot history.
+type StateRollbackCommand struct {
+	Meta
+	StateMeta
+}
+
+func (c *StateRollbackCommand) Run(args []string) int {
+	args, err := c.Meta.process(args, true)
+	if err != nil {
+		return 1
+	}
+
+	var flagForce bool
+	cmdFlags := c.Meta.defaultFlagSet("state rollback")
+	cmdFlags.BoolVar(&flagForce, "force", false, "force")
+	cmdFlags.BoolVar(&c.Meta.stateLock, "lock", true, "lock state")
+	cmdFlags.DurationVar(&c.Meta.stateLockTimeout, "lock-timeout", 0, "lock timeout")
+	if err := cmdFlags.Parse(args); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error parsing command-line flags: %s\n", err.Error()))
+		return 1
+	}
+	args = cmdFlags.Args()
+
+	if len(args) != 1 {
+		c.Ui.Error("Exactly one argument expected: the serial of the snapshot to restore.\n")
+		return cli.RunResultHelp
+	}
+
+	serial, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Invalid serial %q: must be a whole number.", args[0]))
+		return 1
+	}
+
+	// Load the backend
+	b, backendDiags := c.Backend(nil)
+	if backendDiags.HasErrors() {
+		c.showDiagnostics(backendDiags)
+		return 1
+	}
+
+	// Get the state manager for the currently-selected workspace
+	env := c.Workspace()
+	stateMgr, err := b.StateMgr(env)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Failed to load state: %s", err))
+		return 1
+	}
+
+	hist, ok := stateMgr.(statemgr.SnapshotHistory)
+	if !ok {
+		c.Ui.Error("The current backend does not retain state snapshot history, so there is nothing to roll back to.")
+		return 1
+	}
+
+	if err := stateMgr.RefreshState(); err != nil {
+		c.Ui.Error(fmt.Sprintf("Failed to refresh state: %s", err))
+		return 1
+	}
+
+	target, err := hist.HistorySnapshot(serial)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Failed to load snapshot: %s", err))
+		return 1
+	}
+
+	// Show how the snapshot differs from the current state before asking
+	// for confirmation, since rolling back discards the differences.
+	diffLines := stateRollbackDiff(stateMgr.State(), target)
+	if len(diffLines) == 0 {
+		c.Ui.Output("The chosen snapshot is identical to the current state.")
+	} else {
+		c.Ui.Output(fmt.Sprintf(
+			"Rolling back to serial %d would change the following resources:\n\n%s\n",
+			serial, strings.Join(diffLines, "\n"),
+		))
+	}
+
+	if !flagForce {
+		v, err := c.UIInput().Input(context.Background(), &terraform.InputOpts{
+			Id:          "state-rollback",
+			Query:       fmt.Sprintf("Do you want to roll back to serial %d?", serial),
+			Description: "Only 'yes' will be accepted to confirm.",
+		})
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error asking for confirmation: %s", err))
+			return 1
+		}
+		if v != "yes" {
+			c.Ui.Output("Rollback cancelled.")
+			return 1
+		}
+	}
+
+	if c.stateLock {
+		stateLocker := clistate.NewLocker(context.Background(), c.stateLockTimeout, c.Ui, c.Colorize())
+		if err := stateLocker.Lock(stateMgr, "state-rollback"); err != nil {
+			c.Ui.Error(fmt.Sprintf("Error locking state: %s", err))
+			return 1
+		}
+		defer stateLocker.Unlock(nil)
+	}
+
+	if err := stateMgr.WriteState(target); err != nil {
+		c.Ui.Error(fmt.Sprintf("Failed to write state: %s", err))
+		return 1
+	}
+	if err := stateMgr.PersistState(); err != nil {
+		c.Ui.Error(fmt.Sprintf("Failed to persist state: %s", err))
+		return 1
+	}
+
+	c.Ui.Output(fmt.Sprintf("State rolled back to serial %d.", serial))
+	return 0
+}
+
+// stateRollbackDiff summarizes the resource instances that differ between
+// the current state and the snapshot being restored, one line each.
+func stateRollbackDiff(current, target *states.State) []string {
+	if current == nil {
+		current = states.NewState()
+	}
+	if target == nil {
+		target = states.NewState()
+	}
+
+	currentInsts := stateResourceInstances(current)
+	targetInsts := stateResourceInstances(target)
+
+	addrs := make([]string, 0, len(currentInsts)+len(targetInsts))
+	for addr := range currentInsts {
+		addrs = append(addrs, addr)
+	}
+	for addr := range targetInsts {
+		if _, exists := currentInsts[addr]; !exists {
+			addrs = append(addrs, addr)
+		}
+	}
+	sort.Strings(addrs)
+
+	var lines []string
+	for _, addr := range addrs {
+		cur, inCurrent := currentInsts[addr]
+		tgt, inTarget := targetInsts[addr]
+		switch {
+		case !inTarget:
+			lines = append(lines, fmt.Sprintf("  - %s (not present in snapshot)", addr))
+		case !inCurrent:
+			lines = append(lines, fmt.Sprintf("  + %s (only present in snapshot)", addr))
+		case cur != tgt:
+			lines = append(lines, fmt.Sprintf("  ~ %s (attributes differ)", addr))
+		}
+	}
+	return lines
+}
+
+// stateResourceInstances returns the current objects of all resource
+// instances in the given state, keyed by instance address and with the raw
+// attribute data as values for simple equality comparison.
+func stateResourceInstances(state *states.State) map[string]string {
+	ret := map[string]string{}
+	for _, ms := range state.Modules {
+		for _, rs := range ms.Resources {
+			for key, is := range rs.Instances {
+				if is.Current == nil {
+					continue
+				}
+				addr := rs.Addr.Instance(key).Absolute(ms.Addr).String()
+				ret[addr] = string(is.Current.AttrsJSON) + string(is.Current.AttrsFlat["id"])
+			}
+		}
+	}
+	return ret
+}
+
+func (c *StateRollbackCommand) Help() string {
+	helpText := `
+Usage: terraform state rollback [options] SERIAL
+
+  Restore a previous state snapshot retained by the backend.
+
+  Backends that retain snapshot history (such as the local backend, which
+  keeps recent snapshots under .terraform/history) allow the state to be
+  rolled back to an earlier serial, for example after an apply that damaged
+  the state. The differences between the current state and the chosen
+  snapshot are shown before the rollback is confirmed.
+
+  Rolling back only rewrites the state; it does not change real remote
+  objects, so a plan after rolling back will usually show changes.
+
+Options:
+
+  -force              Roll back without prompting for confirmation.
+
+  -lock=true          Lock the state file when locking is supported.
+
+  -lock-timeout=0s    Duration to retry a state lock.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *StateRollbackCommand) Synopsis() string {
+	return "Restore a previous state snapshot"
+}
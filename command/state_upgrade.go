@@ -0,0 +1,177 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform/backend"
+	"github.com/hashicorp/terraform/command/clistate"
+	"github.com/hashicorp/terraform/states/upgrade"
+	"github.com/hashicorp/terraform/tfdiags"
+)
+
+// StateUpgradeCommand is a Command implementation that upgrades any legacy
+// flatmap attribute data remaining in the state to the current schema-typed
+// format.
+type StateUpgradeCommand struct {
+	Meta
+}
+
+func (c *StateUpgradeCommand) Run(args []string) int {
+	args, err := c.Meta.process(args, true)
+	if err != nil {
+		return 1
+	}
+
+	cmdFlags := c.Meta.defaultFlagSet("state upgrade")
+	cmdFlags.StringVar(&c.Meta.statePath, "state", "", "path")
+	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
+	if err := cmdFlags.Parse(args); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error parsing command-line flags: %s\n", err.Error()))
+		return 1
+	}
+	if len(cmdFlags.Args()) > 0 {
+		c.Ui.Error("The state upgrade command expects no arguments.\n")
+		cmdFlags.Usage()
+		return 1
+	}
+
+	// Check for user-supplied plugin path
+	if c.pluginPath, err = c.loadPluginPath(); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error loading plugin path: %s", err))
+		return 1
+	}
+
+	var diags tfdiags.Diagnostics
+
+	// Load the backend
+	b, backendDiags := c.Backend(nil)
+	diags = diags.Append(backendDiags)
+	if backendDiags.HasErrors() {
+		c.showDiagnostics(diags)
+		return 1
+	}
+
+	// We require a local backend, since we need direct access to provider
+	// schemas in order to decode the legacy attribute data.
+	local, ok := b.(backend.Local)
+	if !ok {
+		c.showDiagnostics(diags) // in case of any warnings in here
+		c.Ui.Error(ErrUnsupportedLocalOp)
+		return 1
+	}
+
+	// We expect the config dir to always be the cwd
+	cwd, err := os.Getwd()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error getting cwd: %s", err))
+		return 1
+	}
+
+	// Build the operation (required to get the schemas)
+	opReq := c.Operation(b)
+	opReq.AllowUnsetVariables = true
+	opReq.ConfigDir = cwd
+
+	opReq.ConfigLoader, err = c.initConfigLoader()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error initializing config loader: %s", err))
+		return 1
+	}
+
+	// Get the context (required to get the schemas)
+	ctx, _, ctxDiags := local.Context(opReq)
+	diags = diags.Append(ctxDiags)
+	if ctxDiags.HasErrors() {
+		c.showDiagnostics(diags)
+		return 1
+	}
+	schemas := ctx.Schemas()
+
+	// Get the state
+	env := c.Workspace()
+	stateMgr, err := b.StateMgr(env)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf(errStateLoadingState, err))
+		return 1
+	}
+
+	if c.stateLock {
+		stateLocker := clistate.NewLocker(context.Background(), c.stateLockTimeout, c.Ui, c.Colorize())
+		if err := stateLocker.Lock(stateMgr, "state upgrade"); err != nil {
+			c.Ui.Error(fmt.Sprintf("Error locking state: %s", err))
+			return 1
+		}
+		defer stateLocker.Unlock(nil)
+	}
+
+	if err := stateMgr.RefreshState(); err != nil {
+		c.Ui.Error(fmt.Sprintf("Failed to refresh state: %s", err))
+		return 1
+	}
+
+	state := stateMgr.State()
+	if state == nil {
+		c.Ui.Error(fmt.Sprintf(errStateNotFound))
+		return 1
+	}
+
+	if !upgrade.NeedsUpgrade(state) {
+		c.Ui.Output("State is already in the current format. No upgrade is needed.")
+		return 0
+	}
+
+	diags = diags.Append(upgrade.State(state, schemas))
+	if diags.HasErrors() {
+		// We intentionally don't write anything back in this case, so the
+		// state on disk is either fully upgraded or untouched.
+		c.showDiagnostics(diags)
+		return 1
+	}
+
+	if err := stateMgr.WriteState(state); err != nil {
+		c.Ui.Error(fmt.Sprintf(errStateRmPersist, err))
+		return 1
+	}
+	if err := stateMgr.PersistState(); err != nil {
+		c.Ui.Error(fmt.Sprintf(errStateRmPersist, err))
+		return 1
+	}
+
+	c.showDiagnostics(diags)
+	c.Ui.Output("State has been successfully upgraded to the current format.")
+	return 0
+}
+
+func (c *StateUpgradeCommand) Help() string {
+	helpText := `
+Usage: terraform state upgrade [options]
+
+  Upgrades resource instance data recorded by Terraform 0.11 or earlier
+  to the format used by the current version of Terraform.
+
+  Normally this conversion happens implicitly during other operations,
+  but running it explicitly reports any resource instances whose legacy
+  attribute data cannot be upgraded, rather than failing on the first
+  problem. The state is written back only if every instance upgrades
+  successfully.
+
+Options:
+
+  -state=statefile    Path to a Terraform state file to upgrade. By
+                      default it will use the state "terraform.tfstate"
+                      if it exists.
+
+  -lock=true          Lock the state file when locking is supported.
+
+  -lock-timeout=0s    Duration to retry a state lock.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *StateUpgradeCommand) Synopsis() string {
+	return "Upgrade legacy resource instance data in the state"
+}
@@ -0,0 +1,135 @@
+package command
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	backendLocal "github.com/hashicorp/terraform/backend/local"
+)
+
+// StatsCommand is a Command implementation that prints the per-resource
+// timing statistics recorded during previous apply and refresh operations.
+type StatsCommand struct {
+	Meta
+}
+
+func (c *StatsCommand) Help() string {
+	return strings.TrimSpace(statsCommandHelp)
+}
+
+func (c *StatsCommand) Synopsis() string {
+	return "Prints recorded per-resource timing statistics"
+}
+
+func (c *StatsCommand) Run(args []string) int {
+	args, err := c.Meta.process(args, false)
+	if err != nil {
+		return 1
+	}
+
+	var jsonOutput bool
+	cmdFlags := c.Meta.defaultFlagSet("stats")
+	cmdFlags.BoolVar(&jsonOutput, "json", false, "produce JSON output")
+	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
+	if err := cmdFlags.Parse(args); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error parsing command-line flags: %s\n", err.Error()))
+		return 1
+	}
+
+	path := backendLocal.MetricsPath()
+	timings, err := backendLocal.LoadResourceTimings(path)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error reading timing statistics from %s: %s", path, err))
+		return 1
+	}
+
+	typeNames, typeAvgs := timings.TypeAverages()
+	instanceAddrs, instanceAvgs := timings.InstanceAverages()
+
+	if jsonOutput {
+		type statJSON struct {
+			AverageMs int64 `json:"average_ms"`
+			Samples   int   `json:"samples"`
+		}
+		output := struct {
+			FormatVersion string              `json:"format_version"`
+			Types         map[string]statJSON `json:"types"`
+			Instances     map[string]statJSON `json:"instances"`
+		}{
+			FormatVersion: "0.1",
+			Types:         make(map[string]statJSON),
+			Instances:     make(map[string]statJSON),
+		}
+		for _, name := range typeNames {
+			output.Types[name] = statJSON{
+				AverageMs: int64(typeAvgs[name] / time.Millisecond),
+				Samples:   len(timings.Types[name]),
+			}
+		}
+		for _, addr := range instanceAddrs {
+			output.Instances[addr] = statJSON{
+				AverageMs: int64(instanceAvgs[addr] / time.Millisecond),
+				Samples:   len(timings.Instances[addr]),
+			}
+		}
+		j, err := json.MarshalIndent(&output, "", "  ")
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Failed to marshal statistics to JSON: %s", err))
+			return 1
+		}
+		c.Ui.Output(string(j))
+		return 0
+	}
+
+	if len(typeNames) == 0 && len(instanceAddrs) == 0 {
+		c.Ui.Output(fmt.Sprintf(
+			"No timing statistics have been recorded yet in %s.\n\n"+
+				"Statistics are recorded during apply and refresh operations, unless\n"+
+				"disabled by setting %s.",
+			path, backendLocal.MetricsDisableEnvVar,
+		))
+		return 0
+	}
+
+	var buf bytes.Buffer
+	if len(typeNames) > 0 {
+		buf.WriteString("Average duration by resource type:\n")
+		for _, name := range typeNames {
+			fmt.Fprintf(&buf, "  %s: %s (%d sample(s))\n", name, typeAvgs[name], len(timings.Types[name]))
+		}
+	}
+	if len(instanceAddrs) > 0 {
+		if buf.Len() > 0 {
+			buf.WriteString("\n")
+		}
+		buf.WriteString("Average duration by resource instance:\n")
+		for _, addr := range instanceAddrs {
+			fmt.Fprintf(&buf, "  %s: %s (%d sample(s))\n", addr, instanceAvgs[addr], len(timings.Instances[addr]))
+		}
+	}
+	c.Ui.Output(strings.TrimRight(buf.String(), "\n"))
+	return 0
+}
+
+const statsCommandHelp = `
+Usage: terraform stats [options]
+
+  Prints the per-resource timing statistics recorded during previous apply
+  and refresh operations.
+
+  Terraform records how long each resource instance takes to apply and
+  refresh, keeping the most recent samples for each instance and for each
+  resource type. Later operations use the per-instance averages to start
+  the slowest work first when the parallelism limit forces a choice.
+
+  Recording can be disabled by setting the TF_METRICS_DISABLE environment
+  variable, and the statistics file location can be overridden with
+  TF_METRICS_PATH.
+
+Options:
+
+  -json    Output the statistics in a machine-readable JSON format.
+`
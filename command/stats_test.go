@@ -0,0 +1,92 @@
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	backendLocal "github.com/hashicorp/terraform/backend/local"
+	"github.com/mitchellh/cli"
+)
+
+func TestStats(t *testing.T) {
+	path := filepath.Join(testTempDir(t), "metrics.json")
+	timings := &backendLocal.ResourceTimings{}
+	timings.Record("test_instance.foo", "test_instance", 2*time.Second)
+	if err := timings.Write(path); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	old := os.Getenv(backendLocal.MetricsPathEnvVar)
+	defer os.Setenv(backendLocal.MetricsPathEnvVar, old)
+	os.Setenv(backendLocal.MetricsPathEnvVar, path)
+
+	ui := new(cli.MockUi)
+	c := &StatsCommand{
+		Meta: Meta{
+			Ui: ui,
+		},
+	}
+
+	if code := c.Run([]string{}); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	output := ui.OutputWriter.String()
+	if !strings.Contains(output, "test_instance.foo") {
+		t.Errorf("output does not mention test_instance.foo:\n%s", output)
+	}
+}
+
+func TestStats_json(t *testing.T) {
+	path := filepath.Join(testTempDir(t), "metrics.json")
+	timings := &backendLocal.ResourceTimings{}
+	timings.Record("test_instance.foo", "test_instance", 2*time.Second)
+	if err := timings.Write(path); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	old := os.Getenv(backendLocal.MetricsPathEnvVar)
+	defer os.Setenv(backendLocal.MetricsPathEnvVar, old)
+	os.Setenv(backendLocal.MetricsPathEnvVar, path)
+
+	ui := new(cli.MockUi)
+	c := &StatsCommand{
+		Meta: Meta{
+			Ui: ui,
+		},
+	}
+
+	if code := c.Run([]string{"-json"}); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	output := ui.OutputWriter.String()
+	if !strings.Contains(output, `"average_ms": 2000`) {
+		t.Errorf("output does not contain the expected average:\n%s", output)
+	}
+}
+
+func TestStats_noStats(t *testing.T) {
+	old := os.Getenv(backendLocal.MetricsPathEnvVar)
+	defer os.Setenv(backendLocal.MetricsPathEnvVar, old)
+	os.Setenv(backendLocal.MetricsPathEnvVar, filepath.Join(testTempDir(t), "nonexist.json"))
+
+	ui := new(cli.MockUi)
+	c := &StatsCommand{
+		Meta: Meta{
+			Ui: ui,
+		},
+	}
+
+	if code := c.Run([]string{}); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	output := ui.OutputWriter.String()
+	if !strings.Contains(output, "No timing statistics") {
+		t.Errorf("unexpected output:\n%s", output)
+	}
+}
@@ -7,7 +7,6 @@ import (
 
 	"github.com/hashicorp/terraform/addrs"
 	"github.com/hashicorp/terraform/command/clistate"
-	"github.com/hashicorp/terraform/states"
 	"github.com/hashicorp/terraform/tfdiags"
 )
 
@@ -114,8 +113,7 @@ func (c *TaintCommand) Run(args []string) int {
 
 	ss := state.SyncWrapper()
 
-	// Get the resource and instance we're going to taint
-	rs := ss.Resource(addr.ContainingResource())
+	// Get the instance we're going to taint
 	is := ss.ResourceInstance(addr)
 	if is == nil {
 		if allowMissing {
@@ -151,8 +149,15 @@ func (c *TaintCommand) Run(args []string) int {
 		return 1
 	}
 
-	obj.Status = states.ObjectTainted
-	ss.SetResourceInstanceCurrent(addr, obj, rs.ProviderConfig)
+	if err := ss.Taint(addr); err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Cannot taint resource instance",
+			err.Error(),
+		))
+		c.showDiagnostics(diags)
+		return 1
+	}
 
 	if err := stateMgr.WriteState(state); err != nil {
 		c.Ui.Error(fmt.Sprintf("Error writing state file: %s", err))
@@ -0,0 +1,9 @@
+variable "foo" {}
+
+# Not used by any resource, so changing it cannot invalidate the planned
+# changes.
+variable "auth_token" {}
+
+resource "test_instance" "foo" {
+  value = var.foo
+}
@@ -76,11 +76,11 @@ func (c *UnlockCommand) Run(args []string) int {
 		return 1
 	}
 
-	_, isLocal := stateMgr.(*statemgr.Filesystem)
+	localMgr, isLocal := stateMgr.(*statemgr.Filesystem)
 
 	if !force {
-		// Forcing this doesn't do anything, but doesn't break anything either,
-		// and allows us to run the basic command test too.
+		// Without -force we can only remove locks held by this process,
+		// which for local state means there is nothing useful to do.
 		if isLocal {
 			c.Ui.Error("Local state cannot be unlocked by another process")
 			return 1
@@ -105,7 +105,14 @@ func (c *UnlockCommand) Run(args []string) int {
 		}
 	}
 
-	if err := stateMgr.Unlock(lockID); err != nil {
+	if isLocal {
+		// For local state the lock is normally held by another process, so
+		// all we can do is remove the stale lock metadata it left behind.
+		err = localMgr.ForceUnlock(lockID)
+	} else {
+		err = stateMgr.Unlock(lockID)
+	}
+	if err != nil {
 		c.Ui.Error(fmt.Sprintf("Failed to unlock state: %s", err))
 		return 1
 	}
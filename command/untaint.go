@@ -109,8 +109,7 @@ func (c *UntaintCommand) Run(args []string) int {
 
 	ss := state.SyncWrapper()
 
-	// Get the resource and instance we're going to taint
-	rs := ss.Resource(addr.ContainingResource())
+	// Get the instance we're going to untaint
 	is := ss.ResourceInstance(addr)
 	if is == nil {
 		if allowMissing {
@@ -155,8 +154,15 @@ func (c *UntaintCommand) Run(args []string) int {
 		c.showDiagnostics(diags)
 		return 1
 	}
-	obj.Status = states.ObjectReady
-	ss.SetResourceInstanceCurrent(addr, obj, rs.ProviderConfig)
+	if err := ss.Untaint(addr); err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Cannot untaint resource instance",
+			err.Error(),
+		))
+		c.showDiagnostics(diags)
+		return 1
+	}
 
 	if err := stateMgr.WriteState(state); err != nil {
 		c.Ui.Error(fmt.Sprintf("Error writing state file: %s", err))
@@ -8,6 +8,7 @@ import (
 
 	"github.com/zclconf/go-cty/cty"
 
+	"github.com/hashicorp/terraform/configs"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/hashicorp/terraform/tfdiags"
 )
@@ -71,29 +72,31 @@ func (c *ValidateCommand) Run(args []string) int {
 	dir, err := filepath.Abs(dirPath)
 	if err != nil {
 		diags = diags.Append(fmt.Errorf("unable to locate module: %s", err))
-		return c.showResults(diags, jsonOutput)
+		return c.showResults(diags, nil, jsonOutput)
 	}
 
 	// Check for user-supplied plugin path
 	if c.pluginPath, err = c.loadPluginPath(); err != nil {
 		diags = diags.Append(fmt.Errorf("error loading plugin path: %s", err))
-		return c.showResults(diags, jsonOutput)
+		return c.showResults(diags, nil, jsonOutput)
 	}
 
-	validateDiags := c.validate(dir)
+	cfg, validateDiags := c.validate(dir)
 	diags = diags.Append(validateDiags)
 
-	return c.showResults(diags, jsonOutput)
+	return c.showResults(diags, cfg, jsonOutput)
 }
 
-func (c *ValidateCommand) validate(dir string) tfdiags.Diagnostics {
+func (c *ValidateCommand) validate(dir string) (*configs.Config, tfdiags.Diagnostics) {
 	var diags tfdiags.Diagnostics
 
-	cfg, cfgDiags := c.loadConfig(dir)
+	cfg, cfgDiags := c.loadConfigConcurrent(dir)
 	diags = diags.Append(cfgDiags)
 
-	if diags.HasErrors() {
-		return diags
+	if cfg == nil || cfg.Module == nil || diags.HasErrors() {
+		// Without a loadable configuration there's nothing deeper to check,
+		// so we'll just report the loader's own findings.
+		return cfg, diags
 	}
 
 	// "validate" is to check if the given module is valid regardless of
@@ -121,37 +124,34 @@ func (c *ValidateCommand) validate(dir string) tfdiags.Diagnostics {
 	tfCtx, ctxDiags := terraform.NewContext(opts)
 	diags = diags.Append(ctxDiags)
 	if ctxDiags.HasErrors() {
-		return diags
+		return cfg, diags
 	}
 
+	// Context.Validate loads the schemas for all of the providers the
+	// configuration uses before walking the graph, so the findings below
+	// include full type checking of resource arguments, and the walk
+	// collects diagnostics from the whole configuration rather than
+	// stopping at the first problem.
 	validateDiags := tfCtx.Validate()
 	diags = diags.Append(validateDiags)
-	return diags
+	return cfg, diags
 }
 
-func (c *ValidateCommand) showResults(diags tfdiags.Diagnostics, jsonOutput bool) int {
+func (c *ValidateCommand) showResults(diags tfdiags.Diagnostics, cfg *configs.Config, jsonOutput bool) int {
 	switch {
 	case jsonOutput:
-		// FIXME: Eventually we'll probably want to factor this out somewhere
-		// to support machine-readable outputs for other commands too, but for
-		// now it's simplest to do this inline here.
-		type Pos struct {
-			Line   int `json:"line"`
-			Column int `json:"column"`
-			Byte   int `json:"byte"`
-		}
-		type Range struct {
-			Filename string `json:"filename"`
-			Start    Pos    `json:"start"`
-			End      Pos    `json:"end"`
-		}
+		// Diagnostic is a tfdiags.DiagnosticJSON annotated with the address
+		// of the module whose source the diagnostic's range falls in, so
+		// that consumers can group findings by module.
 		type Diagnostic struct {
-			Severity string `json:"severity,omitempty"`
-			Summary  string `json:"summary,omitempty"`
-			Detail   string `json:"detail,omitempty"`
-			Range    *Range `json:"range,omitempty"`
+			*tfdiags.DiagnosticJSON
+			ModuleAddr string `json:"module_address,omitempty"`
 		}
 		type Output struct {
+			// FormatVersion identifies this structure so that consumers can
+			// detect incompatible future changes to it.
+			FormatVersion string `json:"format_version"`
+
 			// We include some summary information that is actually redundant
 			// with the detailed diagnostics, but avoids the need for callers
 			// to re-implement our logic for deciding these.
@@ -162,47 +162,26 @@ func (c *ValidateCommand) showResults(diags tfdiags.Diagnostics, jsonOutput bool
 		}
 
 		var output Output
+		output.FormatVersion = "0.1"
 		output.Valid = true // until proven otherwise
 		for _, diag := range diags {
-			var jsonDiag Diagnostic
 			switch diag.Severity() {
 			case tfdiags.Error:
-				jsonDiag.Severity = "error"
 				output.ErrorCount++
 				output.Valid = false
 			case tfdiags.Warning:
-				jsonDiag.Severity = "warning"
 				output.WarningCount++
 			}
+		}
 
-			desc := diag.Description()
-			jsonDiag.Summary = desc.Summary
-			jsonDiag.Detail = desc.Detail
-
-			ranges := diag.Source()
-			if ranges.Subject != nil {
-				subj := ranges.Subject
-				jsonDiag.Range = &Range{
-					Filename: subj.Filename,
-					Start: Pos{
-						Line:   subj.Start.Line,
-						Column: subj.Start.Column,
-						Byte:   subj.Start.Byte,
-					},
-					End: Pos{
-						Line:   subj.End.Line,
-						Column: subj.End.Column,
-						Byte:   subj.End.Byte,
-					},
-				}
+		moduleAddrs := moduleAddrsBySourceDir(cfg)
+		output.Diagnostics = make([]Diagnostic, 0, len(diags))
+		for _, jsonDiag := range diags.ToJSON(c.configSources()) {
+			outDiag := Diagnostic{DiagnosticJSON: jsonDiag}
+			if jsonDiag.Range != nil {
+				outDiag.ModuleAddr = moduleAddrForFilename(jsonDiag.Range.Filename, moduleAddrs)
 			}
-
-			output.Diagnostics = append(output.Diagnostics, jsonDiag)
-		}
-		if output.Diagnostics == nil {
-			// Make sure this always appears as an array in our output, since
-			// this is easier to consume for dynamically-typed languages.
-			output.Diagnostics = []Diagnostic{}
+			output.Diagnostics = append(output.Diagnostics, outDiag)
 		}
 
 		j, err := json.MarshalIndent(&output, "", "  ")
@@ -230,6 +209,37 @@ func (c *ValidateCommand) showResults(diags tfdiags.Diagnostics, jsonOutput bool
 	return 0
 }
 
+// moduleAddrsBySourceDir returns the address of each module in the given
+// configuration keyed by the directory its source files were loaded from,
+// for annotating diagnostics with the module they belong to. The root
+// module's address is the empty string.
+func moduleAddrsBySourceDir(cfg *configs.Config) map[string]string {
+	ret := map[string]string{}
+	if cfg == nil {
+		return ret
+	}
+	cfg.DeepEach(func(c *configs.Config) {
+		if c.Module != nil {
+			ret[filepath.Clean(c.Module.SourceDir)] = c.Path.String()
+		}
+	})
+	return ret
+}
+
+// moduleAddrForFilename finds the module whose source directory contains
+// the given filename, preferring the most specific directory when modules
+// are nested inside one another.
+func moduleAddrForFilename(filename string, moduleAddrs map[string]string) string {
+	for dir := filepath.Clean(filepath.Dir(filename)); ; dir = filepath.Dir(dir) {
+		if addr, ok := moduleAddrs[dir]; ok {
+			return addr
+		}
+		if dir == "." || dir == filepath.Dir(dir) {
+			return ""
+		}
+	}
+}
+
 func (c *ValidateCommand) Synopsis() string {
 	return "Validates the Terraform files"
 }
@@ -268,6 +278,13 @@ Options:
                use in text editor integrations and other automated systems.
                Always disables color.
 
+               The JSON output is an object with "format_version", "valid",
+               "error_count", "warning_count" and "diagnostics" properties,
+               where "diagnostics" is an array of objects each describing one
+               finding with its severity, summary, detail, machine-readable
+               code, source range, source code snippet and the address of the
+               module it belongs to.
+
   -no-color    If specified, output won't contain any color.
 `
 	return strings.TrimSpace(helpText)
@@ -1,6 +1,7 @@
 package command
 
 import (
+	"encoding/json"
 	"os"
 	"strings"
 	"testing"
@@ -77,6 +78,50 @@ func TestValidateCommandWithTfvarsFile(t *testing.T) {
 	}
 }
 
+func TestValidateCommandJSON(t *testing.T) {
+	ui, code := setupTest("validate-invalid", "-json")
+	if code != 1 {
+		t.Fatalf("unexpected exit code %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	var output struct {
+		FormatVersion string `json:"format_version"`
+		Valid         bool   `json:"valid"`
+		ErrorCount    int    `json:"error_count"`
+		Diagnostics   []struct {
+			Severity string `json:"severity"`
+			Summary  string `json:"summary"`
+			Range    *struct {
+				Filename string `json:"filename"`
+			} `json:"range"`
+		} `json:"diagnostics"`
+	}
+	if err := json.Unmarshal(ui.OutputWriter.Bytes(), &output); err != nil {
+		t.Fatalf("invalid JSON output: %s\n\n%s", err, ui.OutputWriter.String())
+	}
+
+	if output.FormatVersion == "" {
+		t.Errorf("output has no format_version")
+	}
+	if output.Valid {
+		t.Errorf("output claims the configuration is valid")
+	}
+	if output.ErrorCount == 0 {
+		t.Errorf("output has a zero error_count")
+	}
+	if len(output.Diagnostics) == 0 {
+		t.Fatalf("output has no diagnostics")
+	}
+	for _, diag := range output.Diagnostics {
+		if diag.Severity != "error" && diag.Severity != "warning" {
+			t.Errorf("diagnostic has unexpected severity %q", diag.Severity)
+		}
+		if diag.Summary == "" {
+			t.Errorf("diagnostic has an empty summary")
+		}
+	}
+}
+
 func TestValidateFailingCommand(t *testing.T) {
 	if ui, code := setupTest("validate-invalid"); code != 1 {
 		t.Fatalf("Should have failed: %d\n\n%s", code, ui.ErrorWriter.String())
@@ -0,0 +1,64 @@
+package json
+
+import (
+	"github.com/hashicorp/terraform/tfdiags"
+)
+
+// diagnosticData is the payload for diagnostic events.
+type diagnosticData struct {
+	Severity string         `json:"severity"`
+	Summary  string         `json:"summary"`
+	Detail   string         `json:"detail,omitempty"`
+	Code     string         `json:"code,omitempty"`
+	Range    *diagnosticPos `json:"range,omitempty"`
+
+	// Module, InstanceKey, and Expression identify which instance of a
+	// counted or for_each object was being evaluated when the diagnostic
+	// was produced, when that information is available.
+	Module      string `json:"module,omitempty"`
+	InstanceKey string `json:"instance_key,omitempty"`
+	Expression  string `json:"expression,omitempty"`
+}
+
+type diagnosticPos struct {
+	Filename string `json:"filename"`
+	Line     int    `json:"line"`
+	Column   int    `json:"column"`
+}
+
+// Diagnostics emits one diagnostic event per diagnostic in the given set.
+func Diagnostics(s *Stream, diags tfdiags.Diagnostics) {
+	for _, diag := range diags {
+		desc := diag.Description()
+
+		data := diagnosticData{
+			Summary: desc.Summary,
+			Detail:  desc.Detail,
+			Code:    tfdiags.CodeForDiagnostic(diag),
+		}
+		switch diag.Severity() {
+		case tfdiags.Error:
+			data.Severity = "error"
+		case tfdiags.Warning:
+			data.Severity = "warning"
+		default:
+			data.Severity = "unknown"
+		}
+		if subject := diag.Source().Subject; subject != nil {
+			data.Range = &diagnosticPos{
+				Filename: subject.Filename,
+				Line:     subject.Start.Line,
+				Column:   subject.Start.Column,
+			}
+		}
+		if instCtx := tfdiags.InstanceContextForDiagnostic(diag); instCtx != nil {
+			data.Module = instCtx.ModuleAddr
+			data.InstanceKey = instCtx.InstanceKey
+		}
+		if fromExpr := diag.FromExpr(); fromExpr != nil && fromExpr.Expression != nil {
+			data.Expression = fromExpr.Expression.Range().String()
+		}
+
+		s.Emit("diagnostic", desc.Summary, data)
+	}
+}
@@ -0,0 +1,52 @@
+package json
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// resourceDriftData is the payload for resource_drift events.
+type resourceDriftData struct {
+	Addr         string   `json:"addr"`
+	Deleted      bool     `json:"deleted,omitempty"`
+	ChangedAttrs []string `json:"changed_attrs,omitempty"`
+}
+
+// driftSummaryData is the payload for drift_summary events.
+type driftSummaryData struct {
+	Changed int `json:"changed"`
+	Deleted int `json:"deleted"`
+}
+
+// Drift emits one resource_drift event per instance in the given report,
+// followed by a drift_summary event with the totals.
+func Drift(s *Stream, report *terraform.DriftReport) {
+	var summary driftSummaryData
+
+	for _, inst := range report.Instances {
+		var message string
+		switch {
+		case inst.Deleted:
+			summary.Deleted++
+			message = fmt.Sprintf("%s: deleted outside of Terraform", inst.Addr)
+		case len(inst.ChangedAttrs) > 0:
+			summary.Changed++
+			message = fmt.Sprintf("%s: changed (%s)", inst.Addr, strings.Join(inst.ChangedAttrs, ", "))
+		default:
+			summary.Changed++
+			message = fmt.Sprintf("%s: changed", inst.Addr)
+		}
+
+		s.Emit("resource_drift", message, resourceDriftData{
+			Addr:         inst.Addr.String(),
+			Deleted:      inst.Deleted,
+			ChangedAttrs: inst.ChangedAttrs,
+		})
+	}
+
+	s.Emit("drift_summary", fmt.Sprintf(
+		"Drift: %d changed, %d deleted", summary.Changed, summary.Deleted,
+	), summary)
+}
@@ -0,0 +1,160 @@
+package json
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/plans"
+	"github.com/hashicorp/terraform/states"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Hook is a terraform.Hook that translates per-resource progress into
+// events on a Stream.
+type Hook struct {
+	terraform.NilHook
+
+	stream *Stream
+
+	mu       sync.Mutex
+	applying map[string]time.Time
+}
+
+var _ terraform.Hook = (*Hook)(nil)
+
+// NewHook creates a hook emitting events on the given stream.
+func NewHook(stream *Stream) *Hook {
+	return &Hook{
+		stream:   stream,
+		applying: make(map[string]time.Time),
+	}
+}
+
+// applyData is the payload for apply_start, apply_complete and apply_errored
+// events.
+type applyData struct {
+	Addr           string  `json:"addr"`
+	Action         string  `json:"action"`
+	ElapsedSeconds float64 `json:"elapsed_seconds,omitempty"`
+	Error          string  `json:"error,omitempty"`
+}
+
+// provisionData is the payload for provision_start, provision_output,
+// provision_complete and provision_errored events.
+type provisionData struct {
+	Addr        string `json:"addr"`
+	Provisioner string `json:"provisioner"`
+	Output      string `json:"output,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// refreshData is the payload for refresh_start and refresh_complete events.
+type refreshData struct {
+	Addr string `json:"addr"`
+}
+
+// uniqueIDData is the payload for apply_unique_id events.
+type uniqueIDData struct {
+	Addr     string `json:"addr"`
+	UniqueID string `json:"unique_id"`
+}
+
+func (h *Hook) PreApply(addr addrs.AbsResourceInstance, gen states.Generation, action plans.Action, priorState, plannedNewState cty.Value) (terraform.HookAction, error) {
+	key := addr.String()
+
+	h.mu.Lock()
+	h.applying[key] = time.Now()
+	h.mu.Unlock()
+
+	h.stream.Emit("apply_start", fmt.Sprintf("%s: %s", key, action), applyData{
+		Addr:   key,
+		Action: action.String(),
+	})
+	return terraform.HookActionContinue, nil
+}
+
+func (h *Hook) PostApply(addr addrs.AbsResourceInstance, gen states.Generation, newState cty.Value, err error) (terraform.HookAction, error) {
+	key := addr.String()
+
+	h.mu.Lock()
+	started, ok := h.applying[key]
+	delete(h.applying, key)
+	h.mu.Unlock()
+
+	data := applyData{Addr: key}
+	if ok {
+		data.ElapsedSeconds = time.Since(started).Seconds()
+	}
+
+	if err != nil {
+		data.Error = err.Error()
+		h.stream.Emit("apply_errored", fmt.Sprintf("%s: error: %s", key, err), data)
+	} else {
+		h.stream.Emit("apply_complete", fmt.Sprintf("%s: complete", key), data)
+	}
+	return terraform.HookActionContinue, nil
+}
+
+func (h *Hook) StillApplying(addr addrs.AbsResourceInstance, gen states.Generation, elapsed time.Duration) (terraform.HookAction, error) {
+	h.stream.Emit("apply_progress", fmt.Sprintf("%s: still applying... [%s elapsed]", addr, elapsed.Round(time.Second)), applyData{
+		Addr:           addr.String(),
+		ElapsedSeconds: elapsed.Seconds(),
+	})
+	return terraform.HookActionContinue, nil
+}
+
+func (h *Hook) PostApplyUniqueID(addr addrs.AbsResourceInstance, gen states.Generation, uniqueID string) (terraform.HookAction, error) {
+	h.stream.Emit("apply_unique_id", fmt.Sprintf("%s: unique ID %s", addr, uniqueID), uniqueIDData{
+		Addr:     addr.String(),
+		UniqueID: uniqueID,
+	})
+	return terraform.HookActionContinue, nil
+}
+
+func (h *Hook) PreProvisionInstanceStep(addr addrs.AbsResourceInstance, typeName string) (terraform.HookAction, error) {
+	h.stream.Emit("provision_start", fmt.Sprintf("%s: provisioning with %q", addr, typeName), provisionData{
+		Addr:        addr.String(),
+		Provisioner: typeName,
+	})
+	return terraform.HookActionContinue, nil
+}
+
+func (h *Hook) PostProvisionInstanceStep(addr addrs.AbsResourceInstance, typeName string, err error) (terraform.HookAction, error) {
+	data := provisionData{
+		Addr:        addr.String(),
+		Provisioner: typeName,
+	}
+	if err != nil {
+		data.Error = err.Error()
+		h.stream.Emit("provision_errored", fmt.Sprintf("%s: provisioner %q errored: %s", addr, typeName, err), data)
+	} else {
+		h.stream.Emit("provision_complete", fmt.Sprintf("%s: provisioner %q complete", addr, typeName), data)
+	}
+	return terraform.HookActionContinue, nil
+}
+
+func (h *Hook) ProvisionOutput(addr addrs.AbsResourceInstance, typeName string, line string) {
+	h.stream.Emit("provision_output", fmt.Sprintf("%s: %s", addr, line), provisionData{
+		Addr:        addr.String(),
+		Provisioner: typeName,
+		Output:      line,
+	})
+}
+
+func (h *Hook) PreRefresh(addr addrs.AbsResourceInstance, gen states.Generation, priorState cty.Value) (terraform.HookAction, error) {
+	h.stream.Emit("refresh_start", fmt.Sprintf("%s: refreshing", addr), refreshData{
+		Addr: addr.String(),
+	})
+	return terraform.HookActionContinue, nil
+}
+
+func (h *Hook) PostRefresh(addr addrs.AbsResourceInstance, gen states.Generation, priorState cty.Value, newState cty.Value) (terraform.HookAction, error) {
+	h.stream.Emit("refresh_complete", fmt.Sprintf("%s: refresh complete", addr), refreshData{
+		Addr: addr.String(),
+	})
+	return terraform.HookActionContinue, nil
+}
@@ -0,0 +1,55 @@
+package json
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/plans"
+)
+
+// plannedChangeData is the payload for planned_change events.
+type plannedChangeData struct {
+	Addr   string `json:"addr"`
+	Action string `json:"action"`
+}
+
+// changeSummaryData is the payload for change_summary events.
+type changeSummaryData struct {
+	Add    int `json:"add"`
+	Change int `json:"change"`
+	Remove int `json:"remove"`
+}
+
+// PlannedChanges emits one planned_change event per non-no-op resource
+// change in the given change set, followed by a change_summary event with
+// the familiar add/change/destroy counts.
+func PlannedChanges(s *Stream, changes *plans.Changes) {
+	var summary changeSummaryData
+
+	for _, rc := range changes.Resources {
+		if rc.Action == plans.NoOp {
+			continue
+		}
+
+		s.Emit("planned_change", fmt.Sprintf("%s: %s", rc.Addr, rc.Action), plannedChangeData{
+			Addr:   rc.Addr.String(),
+			Action: rc.Action.String(),
+		})
+
+		switch {
+		case rc.Action == plans.Create:
+			summary.Add++
+		case rc.Action == plans.Delete:
+			summary.Remove++
+		case rc.Action.IsReplace():
+			summary.Add++
+			summary.Remove++
+		default:
+			summary.Change++
+		}
+	}
+
+	s.Emit("change_summary", fmt.Sprintf(
+		"Plan: %d to add, %d to change, %d to destroy",
+		summary.Add, summary.Change, summary.Remove,
+	), summary)
+}
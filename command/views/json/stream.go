@@ -0,0 +1,71 @@
+// Package json implements a machine-readable UI event stream for plan and
+// apply operations, so that wrapping programs can build their own UIs
+// without parsing the human-oriented output.
+//
+// Each event is emitted as a single JSON object on its own line, carrying a
+// schema version and a timestamp along with an event type and a
+// type-specific data payload.
+package json
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"sync"
+	"time"
+)
+
+// SchemaVersion identifies the shape of the events emitted by this package.
+// It must be incremented whenever an existing event type changes
+// incompatibly; adding new event types is not a breaking change.
+const SchemaVersion = 1
+
+// Event is the wire representation of a single UI event.
+type Event struct {
+	SchemaVersion int         `json:"schema_version"`
+	Timestamp     time.Time   `json:"timestamp"`
+	Type          string      `json:"type"`
+	Message       string      `json:"message,omitempty"`
+	Data          interface{} `json:"data,omitempty"`
+}
+
+// Stream emits events as JSON lines on an underlying writer. It is safe for
+// concurrent use, which is necessary because hooks fire from parallel graph
+// walks.
+type Stream struct {
+	mu  sync.Mutex
+	w   io.Writer
+	now func() time.Time // replaced in tests for deterministic timestamps
+}
+
+// NewStream creates a Stream writing to the given writer, which must remain
+// valid for the life of the stream.
+func NewStream(w io.Writer) *Stream {
+	return &Stream{
+		w:   w,
+		now: time.Now,
+	}
+}
+
+// Emit writes a single event of the given type. The data value must be
+// JSON-serializable; events that fail to serialize are dropped with a log
+// message rather than corrupting the stream.
+func (s *Stream) Emit(eventType, message string, data interface{}) {
+	evt := Event{
+		SchemaVersion: SchemaVersion,
+		Timestamp:     s.now().UTC(),
+		Type:          eventType,
+		Message:       message,
+		Data:          data,
+	}
+
+	buf, err := json.Marshal(evt)
+	if err != nil {
+		log.Printf("[ERROR] views/json: dropping unserializable %q event: %s", eventType, err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.w.Write(append(buf, '\n'))
+}
@@ -0,0 +1,164 @@
+package json
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/plans"
+	"github.com/hashicorp/terraform/tfdiags"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func testStream() (*Stream, *bytes.Buffer) {
+	buf := &bytes.Buffer{}
+	s := NewStream(buf)
+	s.now = func() time.Time {
+		return time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	}
+	return s, buf
+}
+
+func decodeEvents(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	t.Helper()
+	var ret []map[string]interface{}
+	dec := json.NewDecoder(buf)
+	for dec.More() {
+		evt := map[string]interface{}{}
+		if err := dec.Decode(&evt); err != nil {
+			t.Fatalf("invalid event stream: %s", err)
+		}
+		ret = append(ret, evt)
+	}
+	return ret
+}
+
+func TestStream_emit(t *testing.T) {
+	s, buf := testStream()
+	s.Emit("test_event", "hello", map[string]string{"foo": "bar"})
+
+	events := decodeEvents(t, buf)
+	if len(events) != 1 {
+		t.Fatalf("wrong number of events %d; want 1", len(events))
+	}
+	evt := events[0]
+	if got, want := evt["schema_version"], float64(SchemaVersion); got != want {
+		t.Errorf("wrong schema version %v; want %v", got, want)
+	}
+	if got, want := evt["timestamp"], "2020-01-02T03:04:05Z"; got != want {
+		t.Errorf("wrong timestamp %v; want %v", got, want)
+	}
+	if got, want := evt["type"], "test_event"; got != want {
+		t.Errorf("wrong type %v; want %v", got, want)
+	}
+	if got, want := evt["message"], "hello"; got != want {
+		t.Errorf("wrong message %v; want %v", got, want)
+	}
+}
+
+func TestPlannedChanges(t *testing.T) {
+	s, buf := testStream()
+
+	changes := plans.NewChanges()
+	addr := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_object",
+		Name: "a",
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+	changes.Resources = append(changes.Resources, &plans.ResourceInstanceChangeSrc{
+		Addr: addr,
+		ChangeSrc: plans.ChangeSrc{
+			Action: plans.Create,
+		},
+	})
+
+	PlannedChanges(s, changes)
+
+	events := decodeEvents(t, buf)
+	if len(events) != 2 {
+		t.Fatalf("wrong number of events %d; want 2", len(events))
+	}
+	if got, want := events[0]["type"], "planned_change"; got != want {
+		t.Errorf("wrong first event type %v; want %v", got, want)
+	}
+	data := events[0]["data"].(map[string]interface{})
+	if got, want := data["addr"], "test_object.a"; got != want {
+		t.Errorf("wrong change addr %v; want %v", got, want)
+	}
+	if got, want := data["action"], "Create"; got != want {
+		t.Errorf("wrong change action %v; want %v", got, want)
+	}
+	if got, want := events[1]["type"], "change_summary"; got != want {
+		t.Errorf("wrong second event type %v; want %v", got, want)
+	}
+	summary := events[1]["data"].(map[string]interface{})
+	if got, want := summary["add"], float64(1); got != want {
+		t.Errorf("wrong add count %v; want %v", got, want)
+	}
+}
+
+func TestDiagnostics(t *testing.T) {
+	s, buf := testStream()
+
+	var diags tfdiags.Diagnostics
+	diags = diags.Append(&hcl.Diagnostic{
+		Severity: hcl.DiagError,
+		Summary:  "Something bad",
+		Detail:   "It went wrong.",
+		Subject: &hcl.Range{
+			Filename: "main.tf",
+			Start:    hcl.Pos{Line: 4, Column: 2, Byte: 30},
+			End:      hcl.Pos{Line: 4, Column: 5, Byte: 33},
+		},
+	})
+	diags = diags.Append(tfdiags.SimpleWarning("Just a warning"))
+
+	Diagnostics(s, diags)
+
+	events := decodeEvents(t, buf)
+	if len(events) != 2 {
+		t.Fatalf("wrong number of events %d; want 2", len(events))
+	}
+	data := events[0]["data"].(map[string]interface{})
+	if got, want := data["severity"], "error"; got != want {
+		t.Errorf("wrong severity %v; want %v", got, want)
+	}
+	rng := data["range"].(map[string]interface{})
+	if got, want := fmt.Sprintf("%v:%v", rng["filename"], rng["line"]), "main.tf:4"; got != want {
+		t.Errorf("wrong range %v; want %v", got, want)
+	}
+	warnData := events[1]["data"].(map[string]interface{})
+	if got, want := warnData["severity"], "warning"; got != want {
+		t.Errorf("wrong severity %v; want %v", got, want)
+	}
+}
+
+func TestHook_applyLifecycle(t *testing.T) {
+	s, buf := testStream()
+	h := NewHook(s)
+
+	addr := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_object",
+		Name: "a",
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+
+	h.PreApply(addr, nil, plans.Create, cty.NilVal, cty.NilVal)
+	h.ProvisionOutput(addr, "local-exec", "doing things")
+	h.PostApply(addr, nil, cty.NilVal, nil)
+
+	events := decodeEvents(t, buf)
+	if len(events) != 3 {
+		t.Fatalf("wrong number of events %d; want 3", len(events))
+	}
+	wantTypes := []string{"apply_start", "provision_output", "apply_complete"}
+	for i, want := range wantTypes {
+		if got := events[i]["type"]; got != want {
+			t.Errorf("wrong event %d type %v; want %v", i, got, want)
+		}
+	}
+}
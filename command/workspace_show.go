@@ -1,9 +1,13 @@
 package command
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform/backend"
+	"github.com/hashicorp/terraform/tfdiags"
 	"github.com/posener/complete"
 )
 
@@ -17,7 +21,9 @@ func (c *WorkspaceShowCommand) Run(args []string) int {
 		return 1
 	}
 
+	var jsonOutput bool
 	cmdFlags := c.Meta.extendedFlagSet("workspace show")
+	cmdFlags.BoolVar(&jsonOutput, "json", false, "produce JSON output")
 	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
 	if err := cmdFlags.Parse(args); err != nil {
 		c.Ui.Error(fmt.Sprintf("Error parsing command-line flags: %s\n", err.Error()))
@@ -25,17 +31,79 @@ func (c *WorkspaceShowCommand) Run(args []string) int {
 	}
 
 	workspace := c.Workspace()
-	c.Ui.Output(workspace)
+
+	if !jsonOutput {
+		c.Ui.Output(workspace)
+		return 0
+	}
+
+	// For JSON output we include the workspace's metadata, if the backend
+	// stores any, so we need to load the backend first.
+	var diags tfdiags.Diagnostics
+
+	backendConfig, backendDiags := c.loadBackendConfig(".")
+	diags = diags.Append(backendDiags)
+	if diags.HasErrors() {
+		c.showDiagnostics(diags)
+		return 1
+	}
+
+	b, backendDiags := c.Backend(&BackendOpts{
+		Config: backendConfig,
+	})
+	diags = diags.Append(backendDiags)
+	if backendDiags.HasErrors() {
+		c.showDiagnostics(diags)
+		return 1
+	}
+
+	doc := workspaceShowJSON{
+		Name: workspace,
+	}
+	if ms, ok := b.(backend.WorkspaceMetaStore); ok {
+		meta, err := ms.WorkspaceMeta(workspace)
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error reading workspace metadata: %s", err))
+			return 1
+		}
+		if meta != nil {
+			doc.Description = meta.Description
+			doc.VarFile = meta.VarFile
+			if !meta.CreatedAt.IsZero() {
+				createdAt := meta.CreatedAt
+				doc.CreatedAt = &createdAt
+			}
+		}
+	}
+
+	jsonDoc, err := json.MarshalIndent(&doc, "", "  ")
+	if err != nil {
+		// Should never happen, since the document is always marshalable.
+		c.Ui.Error(fmt.Sprintf("Failed to serialize workspace as JSON: %s", err))
+		return 1
+	}
+	c.Ui.Output(string(jsonDoc))
 
 	return 0
 }
 
+// workspaceShowJSON is the top-level object of the JSON output from
+// "terraform workspace show -json".
+type workspaceShowJSON struct {
+	Name        string     `json:"name"`
+	Description string     `json:"description,omitempty"`
+	VarFile     string     `json:"var_file,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+}
+
 func (c *WorkspaceShowCommand) AutocompleteArgs() complete.Predictor {
 	return complete.PredictNothing
 }
 
 func (c *WorkspaceShowCommand) AutocompleteFlags() complete.Flags {
-	return nil
+	return complete.Flags{
+		"-json": complete.PredictNothing,
+	}
 }
 
 func (c *WorkspaceShowCommand) Help() string {
@@ -43,6 +111,11 @@ func (c *WorkspaceShowCommand) Help() string {
 Usage: terraform workspace show
 
   Show the name of the current workspace.
+
+Options:
+
+    -json               If specified, output the workspace name and any
+                        metadata stored for it in a machine-readable form.
 `
 	return strings.TrimSpace(helpText)
 }
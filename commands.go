@@ -220,6 +220,12 @@ func initCommands(config *cliconfig.Config, services *disco.Disco, providerSrc g
 			}, nil
 		},
 
+		"providers state": func() (cli.Command, error) {
+			return &command.ProvidersStateCommand{
+				Meta: meta,
+			}, nil
+		},
+
 		"push": func() (cli.Command, error) {
 			return &command.PushCommand{
 				Meta: meta,
@@ -238,6 +244,12 @@ func initCommands(config *cliconfig.Config, services *disco.Disco, providerSrc g
 			}, nil
 		},
 
+		"stats": func() (cli.Command, error) {
+			return &command.StatsCommand{
+				Meta: meta,
+			}, nil
+		},
+
 		"taint": func() (cli.Command, error) {
 			return &command.TaintCommand{
 				Meta: meta,
@@ -328,6 +340,34 @@ func initCommands(config *cliconfig.Config, services *disco.Disco, providerSrc g
 			return &command.StateCommand{}, nil
 		},
 
+		"state deposed": func() (cli.Command, error) {
+			return &command.StateDeposedCommand{}, nil
+		},
+
+		"state deposed list": func() (cli.Command, error) {
+			return &command.StateDeposedListCommand{
+				StateMeta: command.StateMeta{
+					Meta: meta,
+				},
+			}, nil
+		},
+
+		"state deposed restore": func() (cli.Command, error) {
+			return &command.StateDeposedRestoreCommand{
+				StateMeta: command.StateMeta{
+					Meta: meta,
+				},
+			}, nil
+		},
+
+		"state deposed rm": func() (cli.Command, error) {
+			return &command.StateDeposedRmCommand{
+				StateMeta: command.StateMeta{
+					Meta: meta,
+				},
+			}, nil
+		},
+
 		"state list": func() (cli.Command, error) {
 			return &command.StateListCommand{
 				Meta: meta,
@@ -362,11 +402,23 @@ func initCommands(config *cliconfig.Config, services *disco.Disco, providerSrc g
 			}, nil
 		},
 
+		"state rollback": func() (cli.Command, error) {
+			return &command.StateRollbackCommand{
+				Meta: meta,
+			}, nil
+		},
+
 		"state show": func() (cli.Command, error) {
 			return &command.StateShowCommand{
 				Meta: meta,
 			}, nil
 		},
+
+		"state upgrade": func() (cli.Command, error) {
+			return &command.StateUpgradeCommand{
+				Meta: meta,
+			}, nil
+		},
 	}
 }
 
@@ -781,34 +781,120 @@ func BastionConnectFunc(
 	bConf *ssh.ClientConfig,
 	proto string,
 	addr string) func() (net.Conn, error) {
+	return BastionChainConnectFunc(bProto, []string{bAddr}, bConf, proto, addr, 0)
+}
+
+// BastionChainConnectFunc is a generalization of BastionConnectFunc that
+// connects to the final host through a chain of one or more bastion hosts,
+// establishing each hop in order. The same client configuration is used to
+// authenticate against every hop. The given timeout limits how long we will
+// wait to establish each individual hop; zero means no limit.
+func BastionChainConnectFunc(
+	bProto string,
+	bAddrs []string,
+	bConf *ssh.ClientConfig,
+	proto string,
+	addr string,
+	timeout time.Duration) func() (net.Conn, error) {
 	return func() (net.Conn, error) {
-		log.Printf("[DEBUG] Connecting to bastion: %s", bAddr)
-		bastion, err := ssh.Dial(bProto, bAddr, bConf)
-		if err != nil {
-			return nil, fmt.Errorf("Error connecting to bastion: %s", err)
+		var chain []*ssh.Client
+		closeChain := func() {
+			for i := len(chain) - 1; i >= 0; i-- {
+				chain[i].Close()
+			}
+		}
+
+		for _, bAddr := range bAddrs {
+			log.Printf("[DEBUG] Connecting to bastion: %s", bAddr)
+
+			var client *ssh.Client
+			if len(chain) == 0 {
+				// The first hop is a direct connection, so we can rely on
+				// the ssh package's own dial timeout handling.
+				conf := *bConf
+				conf.Timeout = timeout
+				c, err := ssh.Dial(bProto, bAddr, &conf)
+				if err != nil {
+					return nil, fmt.Errorf("Error connecting to bastion %s: %s", bAddr, err)
+				}
+				client = c
+			} else {
+				// Subsequent hops are tunnelled through the previous one.
+				// Tunnelled connections don't support deadlines, so we
+				// enforce the per-hop timeout by racing the dial and SSH
+				// handshake against a timer instead.
+				prev := chain[len(chain)-1]
+				hopAddr := bAddr
+				type hopResult struct {
+					client *ssh.Client
+					err    error
+				}
+				resCh := make(chan hopResult, 1)
+				go func() {
+					conn, err := prev.Dial(bProto, hopAddr)
+					if err != nil {
+						resCh <- hopResult{nil, err}
+						return
+					}
+					sshConn, sshChan, req, err := ssh.NewClientConn(conn, hopAddr, bConf)
+					if err != nil {
+						conn.Close()
+						resCh <- hopResult{nil, err}
+						return
+					}
+					resCh <- hopResult{ssh.NewClient(sshConn, sshChan, req), nil}
+				}()
+
+				var res hopResult
+				if timeout > 0 {
+					select {
+					case res = <-resCh:
+					case <-time.After(timeout):
+						res.err = fmt.Errorf("timeout after %s", timeout)
+					}
+				} else {
+					res = <-resCh
+				}
+				if res.err != nil {
+					// Closing the chain also tears down the tunnel that any
+					// still-pending dial is using, so the goroutine above
+					// cannot leak.
+					closeChain()
+					return nil, fmt.Errorf("Error connecting to bastion %s: %s", bAddr, res.err)
+				}
+				client = res.client
+			}
+			chain = append(chain, client)
 		}
 
-		log.Printf("[DEBUG] Connecting via bastion (%s) to host: %s", bAddr, addr)
-		conn, err := bastion.Dial(proto, addr)
+		last := chain[len(chain)-1]
+		log.Printf("[DEBUG] Connecting via bastion (%s) to host: %s", bAddrs[len(bAddrs)-1], addr)
+		conn, err := last.Dial(proto, addr)
 		if err != nil {
-			bastion.Close()
+			closeChain()
 			return nil, err
 		}
 
-		// Wrap it up so we close both things properly
+		// Wrap it up so we close the whole chain properly
 		return &bastionConn{
-			Conn:    conn,
-			Bastion: bastion,
+			Conn:  conn,
+			chain: chain,
 		}, nil
 	}
 }
 
 type bastionConn struct {
 	net.Conn
-	Bastion *ssh.Client
+	chain []*ssh.Client
 }
 
 func (c *bastionConn) Close() error {
 	c.Conn.Close()
-	return c.Bastion.Close()
+	var err error
+	for i := len(c.chain) - 1; i >= 0; i-- {
+		if cerr := c.chain[i].Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+	return err
 }
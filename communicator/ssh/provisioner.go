@@ -60,6 +60,13 @@ type connectionInfo struct {
 	BastionHost        string `mapstructure:"bastion_host"`
 	BastionHostKey     string `mapstructure:"bastion_host_key"`
 	BastionPort        int    `mapstructure:"bastion_port"`
+	BastionTimeout     string `mapstructure:"bastion_timeout"`
+
+	// BastionHosts is BastionHost split into the individual hosts of a
+	// multi-hop chain, in connection order. BastionTimeoutVal limits how
+	// long we'll wait to establish each individual hop.
+	BastionHosts      []string      `mapstructure:"-"`
+	BastionTimeoutVal time.Duration `mapstructure:"-"`
 
 	AgentIdentity string `mapstructure:"agent_identity"`
 }
@@ -111,9 +118,19 @@ func parseConnectionInfo(s *terraform.InstanceState) (*connectionInfo, error) {
 
 	// Default all bastion config attrs to their non-bastion counterparts
 	if connInfo.BastionHost != "" {
-		// Format the bastion host if needed.
-		// Needed for IPv6 support.
-		connInfo.BastionHost = shared.IpFormat(connInfo.BastionHost)
+		// The bastion host may be a comma-separated chain of hosts that we
+		// connect through in order. Each host is formatted individually so
+		// that IPv6 addresses are handled, as for the main host.
+		for _, h := range strings.Split(connInfo.BastionHost, ",") {
+			connInfo.BastionHosts = append(connInfo.BastionHosts, shared.IpFormat(strings.TrimSpace(h)))
+		}
+		connInfo.BastionHost = strings.Join(connInfo.BastionHosts, ",")
+
+		if connInfo.BastionTimeout != "" {
+			connInfo.BastionTimeoutVal = safeDuration(connInfo.BastionTimeout, connInfo.TimeoutVal)
+		} else {
+			connInfo.BastionTimeoutVal = connInfo.TimeoutVal
+		}
 
 		if connInfo.BastionUser == "" {
 			connInfo.BastionUser = connInfo.User
@@ -171,12 +188,15 @@ func prepareSSHConfig(connInfo *connectionInfo) (*sshConfig, error) {
 	connectFunc := ConnectFunc("tcp", host)
 
 	var bastionConf *ssh.ClientConfig
-	if connInfo.BastionHost != "" {
-		bastionHost := fmt.Sprintf("%s:%d", connInfo.BastionHost, connInfo.BastionPort)
+	if len(connInfo.BastionHosts) > 0 {
+		bastionAddrs := make([]string, len(connInfo.BastionHosts))
+		for i, h := range connInfo.BastionHosts {
+			bastionAddrs[i] = fmt.Sprintf("%s:%d", h, connInfo.BastionPort)
+		}
 
 		bastionConf, err = buildSSHClientConfig(sshClientConfigOpts{
 			user:        connInfo.BastionUser,
-			host:        bastionHost,
+			host:        bastionAddrs[0],
 			privateKey:  connInfo.BastionPrivateKey,
 			password:    connInfo.BastionPassword,
 			hostKey:     connInfo.HostKey,
@@ -187,7 +207,7 @@ func prepareSSHConfig(connInfo *connectionInfo) (*sshConfig, error) {
 			return nil, err
 		}
 
-		connectFunc = BastionConnectFunc("tcp", bastionHost, bastionConf, "tcp", host)
+		connectFunc = BastionChainConnectFunc("tcp", bastionAddrs, bastionConf, "tcp", host, connInfo.BastionTimeoutVal)
 	}
 
 	config := &sshConfig{
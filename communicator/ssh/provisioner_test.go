@@ -2,6 +2,7 @@ package ssh
 
 import (
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -70,6 +71,72 @@ func TestProvisioner_connInfo(t *testing.T) {
 	}
 }
 
+func TestProvisioner_connInfoBastionChain(t *testing.T) {
+	r := &terraform.InstanceState{
+		Ephemeral: terraform.EphemeralState{
+			ConnInfo: map[string]string{
+				"type":     "ssh",
+				"user":     "root",
+				"password": "supersecret",
+				"host":     "127.0.0.1",
+				"port":     "22",
+				"timeout":  "30s",
+
+				"bastion_host":    "jump1.example.com, ::1 ,jump2.example.com",
+				"bastion_timeout": "45s",
+			},
+		},
+	}
+
+	conf, err := parseConnectionInfo(r)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	if got, want := len(conf.BastionHosts), 3; got != want {
+		t.Fatalf("wrong number of bastion hosts %d; want %d", got, want)
+	}
+	if conf.BastionHosts[0] != "jump1.example.com" {
+		t.Fatalf("bad: %v", conf.BastionHosts)
+	}
+	if conf.BastionHosts[1] != "[::1]" {
+		t.Fatalf("bad: %v", conf.BastionHosts)
+	}
+	if conf.BastionHosts[2] != "jump2.example.com" {
+		t.Fatalf("bad: %v", conf.BastionHosts)
+	}
+	if conf.BastionTimeoutVal != 45*time.Second {
+		t.Fatalf("bad: %v", conf.BastionTimeoutVal)
+	}
+	if conf.BastionUser != "root" {
+		t.Fatalf("bad: %v", conf)
+	}
+}
+
+func TestProvisioner_connInfoBastionTimeoutDefault(t *testing.T) {
+	r := &terraform.InstanceState{
+		Ephemeral: terraform.EphemeralState{
+			ConnInfo: map[string]string{
+				"type":         "ssh",
+				"user":         "root",
+				"host":         "127.0.0.1",
+				"timeout":      "30s",
+				"bastion_host": "127.0.1.1",
+			},
+		},
+	}
+
+	conf, err := parseConnectionInfo(r)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	// With no bastion_timeout of its own, each hop uses the main timeout.
+	if conf.BastionTimeoutVal != conf.TimeoutVal {
+		t.Fatalf("bad: %v", conf.BastionTimeoutVal)
+	}
+}
+
 func TestProvisioner_connInfoIpv6(t *testing.T) {
 	r := &terraform.InstanceState{
 		Ephemeral: terraform.EphemeralState{
@@ -5,22 +5,27 @@ import (
 	"io"
 	"log"
 	"math/rand"
+	"net"
+	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform/communicator/remote"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/masterzen/winrm"
 	"github.com/packer-community/winrmcp/winrmcp"
+	"golang.org/x/net/proxy"
 )
 
 // Communicator represents the WinRM communicator
 type Communicator struct {
-	connInfo *connectionInfo
-	client   *winrm.Client
-	endpoint *winrm.Endpoint
-	rand     *rand.Rand
+	connInfo  *connectionInfo
+	client    *winrm.Client
+	endpoint  *winrm.Endpoint
+	rand      *rand.Rand
+	transport func() winrm.Transporter
 }
 
 // New creates a new communicator implementation over WinRM.
@@ -40,16 +45,53 @@ func New(s *terraform.InstanceState) (*Communicator, error) {
 		endpoint.CACert = []byte(connInfo.CACert)
 	}
 
+	transport, err := transportDecorator(connInfo)
+	if err != nil {
+		return nil, err
+	}
+
 	comm := &Communicator{
 		connInfo: connInfo,
 		endpoint: endpoint,
 		// Seed our own rand source so that script paths are not deterministic
-		rand: rand.New(rand.NewSource(time.Now().UnixNano())),
+		rand:      rand.New(rand.NewSource(time.Now().UnixNano())),
+		transport: transport,
 	}
 
 	return comm, nil
 }
 
+// transportDecorator returns the transport decorator to use for both the
+// command client and the file copy client based on the connection settings,
+// or nil if the library's default transport is sufficient. HTTP proxies are
+// already honored from the environment by the default transport, so proxy_url
+// is only needed for SOCKS5 proxies.
+func transportDecorator(connInfo *connectionInfo) (func() winrm.Transporter, error) {
+	var dial func(network, addr string) (net.Conn, error)
+	if connInfo.ProxyURL != "" {
+		u, err := url.Parse(connInfo.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy_url %q: %s", connInfo.ProxyURL, err)
+		}
+		dialer, err := proxy.FromURL(u, proxy.Direct)
+		if err != nil {
+			return nil, fmt.Errorf("unsupported proxy_url %q: %s", connInfo.ProxyURL, err)
+		}
+		dial = dialer.Dial
+	}
+
+	switch {
+	case connInfo.NTLM && dial != nil:
+		return func() winrm.Transporter { return winrm.NewClientNTLMWithDial(dial) }, nil
+	case connInfo.NTLM:
+		return func() winrm.Transporter { return &winrm.ClientNTLM{} }, nil
+	case dial != nil:
+		return func() winrm.Transporter { return winrm.NewClientWithDial(dial) }, nil
+	default:
+		return nil, nil
+	}
+}
+
 // Connect implementation of communicator.Communicator interface
 func (c *Communicator) Connect(o terraform.UIOutput) error {
 	if c.client != nil {
@@ -58,8 +100,8 @@ func (c *Communicator) Connect(o terraform.UIOutput) error {
 
 	params := winrm.DefaultParameters
 	params.Timeout = formatDuration(c.Timeout())
-	if c.connInfo.NTLM == true {
-		params.TransportDecorator = func() winrm.Transporter { return &winrm.ClientNTLM{} }
+	if c.transport != nil {
+		params.TransportDecorator = c.transport
 	}
 
 	client, err := winrm.NewClientWithParameters(
@@ -78,6 +120,7 @@ func (c *Communicator) Connect(o terraform.UIOutput) error {
 				"  HTTPS: %t\n"+
 				"  Insecure: %t\n"+
 				"  NTLM: %t\n"+
+				"  Proxy: %t\n"+
 				"  CACert: %t",
 			c.connInfo.Host,
 			c.connInfo.Port,
@@ -86,6 +129,7 @@ func (c *Communicator) Connect(o terraform.UIOutput) error {
 			c.connInfo.HTTPS,
 			c.connInfo.Insecure,
 			c.connInfo.NTLM,
+			c.connInfo.ProxyURL != "",
 			c.connInfo.CACert != "",
 		))
 	}
@@ -144,8 +188,42 @@ func (c *Communicator) Start(rc *remote.Cmd) error {
 		}
 	}
 
-	status, err := c.client.Run(rc.Command, rc.Stdout, rc.Stderr)
-	rc.SetExitStatus(status, err)
+	shell, err := c.client.CreateShell()
+	if err != nil {
+		return err
+	}
+
+	cmd, err := shell.Execute(rc.Command)
+	if err != nil {
+		shell.Close()
+		return err
+	}
+
+	// Stream the output as the command produces it rather than collecting it
+	// all and delivering it only once the command exits, so that hooks
+	// watching a long-running script can report its progress incrementally.
+	go func() {
+		defer shell.Close()
+		defer cmd.Close()
+
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			io.Copy(rc.Stdout, cmd.Stdout)
+		}()
+		go func() {
+			defer wg.Done()
+			io.Copy(rc.Stderr, cmd.Stderr)
+		}()
+
+		cmd.Wait()
+		wg.Wait()
+
+		status := cmd.ExitCode()
+		log.Printf("[DEBUG] remote command exited with '%d': %s", status, rc.Command)
+		rc.SetExitStatus(status, nil)
+	}()
 
 	return nil
 }
@@ -189,8 +267,8 @@ func (c *Communicator) newCopyClient() (*winrmcp.Winrmcp, error) {
 		MaxOperationsPerShell: 15, // lowest common denominator
 	}
 
-	if c.connInfo.NTLM == true {
-		config.TransportDecorator = func() winrm.Transporter { return &winrm.ClientNTLM{} }
+	if c.transport != nil {
+		config.TransportDecorator = c.transport
 	}
 
 	if c.connInfo.CACert != "" {
@@ -42,6 +42,7 @@ type connectionInfo struct {
 	Insecure   bool
 	NTLM       bool   `mapstructure:"use_ntlm"`
 	CACert     string `mapstructure:"cacert"`
+	ProxyURL   string `mapstructure:"proxy_url"`
 	Timeout    string
 	ScriptPath string        `mapstructure:"script_path"`
 	TimeoutVal time.Duration `mapstructure:"-"`
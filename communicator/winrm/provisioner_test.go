@@ -78,6 +78,43 @@ func TestProvisioner_connInfo(t *testing.T) {
 	}
 }
 
+func TestProvisioner_connInfoProxy(t *testing.T) {
+	r := &terraform.InstanceState{
+		Ephemeral: terraform.EphemeralState{
+			ConnInfo: map[string]string{
+				"type":      "winrm",
+				"user":      "Administrator",
+				"password":  "supersecret",
+				"host":      "127.0.0.1",
+				"proxy_url": "socks5://127.0.0.1:1080",
+			},
+		},
+	}
+
+	conf, err := parseConnectionInfo(r)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	if conf.ProxyURL != "socks5://127.0.0.1:1080" {
+		t.Fatalf("expected: %v: got: %v", "socks5://127.0.0.1:1080", conf)
+	}
+
+	if _, err := transportDecorator(conf); err != nil {
+		t.Fatalf("err: %v", err)
+	}
+}
+
+func TestProvisioner_connInfoProxyInvalid(t *testing.T) {
+	conf := &connectionInfo{
+		ProxyURL: "ftp://127.0.0.1:21",
+	}
+
+	if _, err := transportDecorator(conf); err == nil {
+		t.Fatal("expected unsupported proxy scheme to error")
+	}
+}
+
 func TestProvisioner_connInfoCACert(t *testing.T) {
 	caCert := `
 -----BEGIN CERTIFICATE-----
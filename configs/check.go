@@ -0,0 +1,148 @@
+package configs
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+// CheckRule represents a configuration-defined validation rule, as declared
+// in "precondition" and "postcondition" blocks inside resource "lifecycle"
+// blocks and output value declarations.
+type CheckRule struct {
+	// Condition is an expression that must evaluate to true if the check is
+	// to pass. Which objects are in scope, and what "self" refers to if
+	// anything, depends on where the rule was declared.
+	Condition hcl.Expression
+
+	// ErrorMessage is an expression that evaluates to a string describing
+	// the problem to the user if the condition doesn't pass. It has the
+	// same scope as Condition.
+	ErrorMessage hcl.Expression
+
+	DeclRange hcl.Range
+}
+
+func decodeCheckRuleBlock(block *hcl.Block, override bool) (*CheckRule, hcl.Diagnostics) {
+	var diags hcl.Diagnostics
+	cr := &CheckRule{
+		DeclRange: block.DefRange,
+	}
+
+	if override {
+		// For now we'll just forbid overriding check blocks, to simplify
+		// the initial design. We can relax this in future if we find a
+		// compelling use-case and a way to define the merging behavior
+		// that isn't confusing.
+		diags = append(diags, &hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  fmt.Sprintf("Can't override %s blocks", block.Type),
+			Detail:   fmt.Sprintf("Override files cannot override %q blocks.", block.Type),
+			Subject:  cr.DeclRange.Ptr(),
+		})
+		return cr, diags
+	}
+
+	content, moreDiags := block.Body.Content(checkRuleBlockSchema)
+	diags = append(diags, moreDiags...)
+
+	if attr, exists := content.Attributes["condition"]; exists {
+		cr.Condition = attr.Expr
+	}
+
+	if attr, exists := content.Attributes["error_message"]; exists {
+		cr.ErrorMessage = attr.Expr
+	}
+
+	return cr, diags
+}
+
+// Check represents a top-level "check" block, which declares a set of
+// assertions about the final state of the configuration's objects that
+// Terraform verifies after each apply and reports on without blocking
+// subsequent operations.
+type Check struct {
+	Name string
+
+	// Asserts are the individual assertions declared in "assert" blocks
+	// inside the check block. Each one uses the same condition and
+	// error_message arguments as the precondition and postcondition
+	// blocks.
+	Asserts []*CheckRule
+
+	DeclRange hcl.Range
+}
+
+func decodeCheckBlock(block *hcl.Block, override bool) (*Check, hcl.Diagnostics) {
+	var diags hcl.Diagnostics
+	c := &Check{
+		Name:      block.Labels[0],
+		DeclRange: block.DefRange,
+	}
+
+	if override {
+		// As with the nested check rule blocks, we don't currently support
+		// overriding check blocks from override files.
+		diags = append(diags, &hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Can't override check blocks",
+			Detail:   "Override files cannot override \"check\" blocks.",
+			Subject:  c.DeclRange.Ptr(),
+		})
+		return c, diags
+	}
+
+	if !hclsyntax.ValidIdentifier(c.Name) {
+		diags = append(diags, &hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Invalid check block name",
+			Detail:   badIdentifierDetail,
+			Subject:  &block.LabelRanges[0],
+		})
+	}
+
+	content, moreDiags := block.Body.Content(checkBlockSchema)
+	diags = append(diags, moreDiags...)
+
+	for _, innerBlock := range content.Blocks {
+		switch innerBlock.Type {
+		case "assert":
+			cr, crDiags := decodeCheckRuleBlock(innerBlock, override)
+			diags = append(diags, crDiags...)
+			c.Asserts = append(c.Asserts, cr)
+		}
+	}
+
+	if len(c.Asserts) == 0 && !diags.HasErrors() {
+		diags = append(diags, &hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Missing assertions",
+			Detail:   "A check block must declare at least one \"assert\" block.",
+			Subject:  c.DeclRange.Ptr(),
+		})
+	}
+
+	return c, diags
+}
+
+var checkBlockSchema = &hcl.BodySchema{
+	Blocks: []hcl.BlockHeaderSchema{
+		{
+			Type: "assert",
+		},
+	},
+}
+
+var checkRuleBlockSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{
+		{
+			Name:     "condition",
+			Required: true,
+		},
+		{
+			Name:     "error_message",
+			Required: true,
+		},
+	},
+}
@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"sort"
 
+	"github.com/apparentlymart/go-versions/versions/constraints"
 	version "github.com/hashicorp/go-version"
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/internal/getproviders"
 )
 
 // A Config is a node in the tree of modules within a configuration.
@@ -185,6 +187,188 @@ func (c *Config) ProviderTypes() []addrs.Provider {
 	return ret
 }
 
+// ProvisionerTypes returns the type names of each distinct provisioner
+// used in the receiving configuration, across the whole module tree.
+//
+// This is a helper for determining which provisioner plugins are required
+// to apply the configuration. Provisioners are not versioned, so the result
+// is just a sorted list of names.
+func (c *Config) ProvisionerTypes() []string {
+	m := make(map[string]struct{})
+	c.gatherProvisionerTypes(m)
+
+	ret := make([]string, 0, len(m))
+	for k := range m {
+		ret = append(ret, k)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+func (c *Config) gatherProvisionerTypes(m map[string]struct{}) {
+	if c == nil {
+		return
+	}
+
+	for _, rc := range c.Module.ManagedResources {
+		for _, pc := range rc.Managed.Provisioners {
+			m[pc.Type] = struct{}{}
+		}
+	}
+
+	// Must also visit our child modules, recursively.
+	for _, cc := range c.Children {
+		cc.gatherProvisionerTypes(m)
+	}
+}
+
+// ProviderRequirements searches the full tree of modules under the receiver
+// for both explicit and implicit dependencies on providers, merging the
+// version constraints declared for each distinct provider across the whole
+// tree.
+//
+// The result is suitable for passing to the provider installer in the
+// internal/getproviders package, and is also what "terraform providers"
+// displays. An entry with no version constraints means that the provider
+// is required but that any version is acceptable.
+//
+// If the returned diagnostics contains errors then the resulting
+// requirements may be incomplete.
+func (c *Config) ProviderRequirements() (getproviders.Requirements, hcl.Diagnostics) {
+	reqs := make(getproviders.Requirements)
+	pins := make(map[addrs.Provider]providerPin)
+	diags := c.addProviderRequirements(reqs, pins)
+	return reqs, diags
+}
+
+// providerPin records where in the module tree a provider was required at an
+// exact version, so that a conflicting exact requirement elsewhere in the
+// tree can be reported against both declarations.
+type providerPin struct {
+	version   string
+	declRange hcl.Range
+}
+
+// addProviderRequirements collects the provider requirements of the module
+// in the receiver and of all of its descendent modules into the given
+// requirements map.
+func (c *Config) addProviderRequirements(reqs getproviders.Requirements, pins map[addrs.Provider]providerPin) hcl.Diagnostics {
+	var diags hcl.Diagnostics
+
+	// The main source of requirements is the required_providers blocks,
+	// which carry both provider addresses and version constraints.
+	for _, pr := range c.Module.ProviderRequirements {
+		fqn := pr.Type
+		if _, ok := reqs[fqn]; !ok {
+			// We'll at least have an unconstrained dependency then.
+			reqs[fqn] = nil
+		}
+		for _, vc := range pr.VersionConstraints {
+			moreDiags := addVersionConstraint(reqs, pins, fqn, vc)
+			diags = append(diags, moreDiags...)
+		}
+	}
+
+	// "version" arguments inside provider configuration blocks contribute
+	// additional constraints for providers that may or may not also appear
+	// in required_providers.
+	for _, pc := range c.Module.ProviderConfigs {
+		fqn := c.Module.ProviderForLocalConfig(addrs.LocalProviderConfig{LocalName: pc.Name})
+		if _, ok := reqs[fqn]; !ok {
+			reqs[fqn] = nil
+		}
+		if pc.Version.Required != nil {
+			moreDiags := addVersionConstraint(reqs, pins, fqn, pc.Version)
+			diags = append(diags, moreDiags...)
+		}
+	}
+
+	// Each resource implies a dependency on its provider even when no
+	// explicit requirement for that provider is declared anywhere.
+	for _, rc := range c.Module.ManagedResources {
+		fqn := c.Module.ProviderForLocalConfig(rc.ProviderConfigAddr())
+		if _, ok := reqs[fqn]; !ok {
+			reqs[fqn] = nil
+		}
+	}
+	for _, rc := range c.Module.DataResources {
+		fqn := c.Module.ProviderForLocalConfig(rc.ProviderConfigAddr())
+		if _, ok := reqs[fqn]; !ok {
+			reqs[fqn] = nil
+		}
+	}
+
+	// Must also visit our child modules, recursively.
+	for _, child := range c.Children {
+		moreDiags := child.addProviderRequirements(reqs, pins)
+		diags = append(diags, moreDiags...)
+	}
+
+	return diags
+}
+
+// addVersionConstraint converts one declared version constraint into the
+// representation used by the getproviders package and merges it into the
+// given requirements, reporting a diagnostic if it conflicts with an exact
+// version requirement declared elsewhere in the tree.
+func addVersionConstraint(reqs getproviders.Requirements, pins map[addrs.Provider]providerPin, fqn addrs.Provider, vc VersionConstraint) hcl.Diagnostics {
+	var diags hcl.Diagnostics
+	if vc.Required == nil {
+		return diags
+	}
+
+	constraints, err := getproviders.ParseVersionConstraints(vc.Required.String())
+	if err != nil {
+		// This should not happen because the constraint was already parsed
+		// by the configuration decoder, but the two parsers don't accept
+		// exactly the same language so we'll be robust here.
+		diags = append(diags, &hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Invalid version constraint",
+			Detail:   fmt.Sprintf("Provider %s has an invalid version constraint %q: %s.", fqn.String(), vc.Required.String(), err),
+			Subject:  vc.DeclRange.Ptr(),
+		})
+		return diags
+	}
+	reqs[fqn] = append(reqs[fqn], constraints...)
+
+	// An exact version requirement can conflict with one declared in another
+	// module, in which case no single version of the provider can satisfy
+	// the whole configuration. More subtle conflicts between range
+	// constraints are left for the installer to detect, since it knows which
+	// versions actually exist.
+	if v, exact := exactVersionPin(constraints); exact {
+		if prev, exists := pins[fqn]; exists {
+			if prev.version != v {
+				diags = append(diags, &hcl.Diagnostic{
+					Severity: hcl.DiagError,
+					Summary:  "Conflicting provider version constraints",
+					Detail: fmt.Sprintf(
+						"Provider %s is required at exactly version %s here, but at exactly version %s at %s. No single provider version can satisfy the whole configuration.",
+						fqn.String(), v, prev.version, prev.declRange,
+					),
+					Subject: vc.DeclRange.Ptr(),
+				})
+			}
+		} else {
+			pins[fqn] = providerPin{version: v, declRange: vc.DeclRange}
+		}
+	}
+
+	return diags
+}
+
+// exactVersionPin returns the version that the given constraints require
+// exactly, if any.
+func exactVersionPin(spec getproviders.VersionConstraints) (string, bool) {
+	for _, sel := range spec {
+		if sel.Operator == constraints.OpEqual && sel.Boundary.IsExact() {
+			return sel.Boundary.String(), true
+		}
+	}
+	return "", false
+}
+
 func (c *Config) gatherProviderTypes(m map[addrs.Provider]struct{}) {
 	if c == nil {
 		return
@@ -238,7 +422,7 @@ func (c *Config) ResolveAbsProviderAddr(addr addrs.ProviderConfig, inModule addr
 		}
 
 		var provider addrs.Provider
-		if providerReq, exists := c.Module.ProviderRequirements[addr.LocalName]; exists {
+		if providerReq, exists := mc.Module.ProviderRequirements[addr.LocalName]; exists {
 			provider = providerReq.Type
 		} else {
 			// FIXME: For now we're returning a _legacy_ address as fallback here,
@@ -259,6 +443,38 @@ func (c *Config) ResolveAbsProviderAddr(addr addrs.ProviderConfig, inModule addr
 
 }
 
+// ResolveAbsProviderAddrStrict is a variant of ResolveAbsProviderAddr that
+// returns error diagnostics if the given address uses a local name that the
+// target module does not declare in its required_providers, instead of
+// falling back to guessing a provider address from the local name alone.
+func (c *Config) ResolveAbsProviderAddrStrict(addr addrs.ProviderConfig, inModule addrs.ModuleInstance) (addrs.AbsProviderConfig, hcl.Diagnostics) {
+	var diags hcl.Diagnostics
+	if local, ok := addr.(addrs.LocalProviderConfig); ok {
+		mc := c.DescendentForInstance(inModule)
+		if mc == nil {
+			diags = append(diags, &hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Undeclared module",
+				Detail:   fmt.Sprintf("There is no module %s declared in the configuration.", inModule.String()),
+			})
+			return addrs.AbsProviderConfig{}, diags
+		}
+		if _, exists := mc.Module.ProviderRequirements[local.LocalName]; !exists {
+			moduleDesc := "the root module"
+			if !inModule.IsRoot() {
+				moduleDesc = inModule.String()
+			}
+			diags = append(diags, &hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Undeclared provider local name",
+				Detail:   fmt.Sprintf("The provider local name %q is not declared in the required_providers block for %s.", local.LocalName, moduleDesc),
+			})
+			return addrs.AbsProviderConfig{}, diags
+		}
+	}
+	return c.ResolveAbsProviderAddr(addr, inModule), diags
+}
+
 // ProviderForConfigAddr returns the FQN for a given addrs.ProviderConfig, first
 // by checking for the provider in module.ProviderRequirements and falling
 // back to addrs.NewLegacyProvider if it is not found.
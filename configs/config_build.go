@@ -1,7 +1,9 @@
 package configs
 
 import (
+	"runtime"
 	"sort"
+	"sync"
 
 	version "github.com/hashicorp/go-version"
 	"github.com/hashicorp/hcl/v2"
@@ -78,12 +80,124 @@ func buildChildModules(parent *Config, walker ModuleWalker) (map[string]*Config,
 		child.Children, modDiags = buildChildModules(child, walker)
 		diags = append(diags, modDiags...)
 
+		diags = append(diags, validateProviderConfigurationAliases(call, child)...)
+
 		ret[call.Name] = child
 	}
 
 	return ret, diags
 }
 
+// BuildConfigConcurrent is like BuildConfig except that descendent modules
+// are loaded concurrently, using up to the given number of workers at a
+// time. If parallelism is less than one then the number of processors is
+// used instead.
+//
+// The given walker must be safe for concurrent calls to LoadModule. The
+// result is identical to what BuildConfig would produce for the same
+// inputs: at each level of the tree the diagnostics are merged in module
+// call name order, regardless of the order in which the loads complete.
+func BuildConfigConcurrent(root *Module, walker ModuleWalker, parallelism int) (*Config, hcl.Diagnostics) {
+	if parallelism < 1 {
+		parallelism = runtime.NumCPU()
+	}
+
+	var diags hcl.Diagnostics
+	cfg := &Config{
+		Module: root,
+	}
+	cfg.Root = cfg // Root module is self-referential.
+	sem := make(chan struct{}, parallelism)
+	cfg.Children, diags = buildChildModulesConcurrent(cfg, walker, sem)
+	return cfg, diags
+}
+
+func buildChildModulesConcurrent(parent *Config, walker ModuleWalker, sem chan struct{}) (map[string]*Config, hcl.Diagnostics) {
+	var diags hcl.Diagnostics
+	ret := map[string]*Config{}
+
+	calls := parent.Module.ModuleCalls
+
+	// As in buildChildModules, we sort the calls by their local names, both
+	// for predictable logging order and so that we can merge the resulting
+	// diagnostics deterministically below no matter which loads complete
+	// first.
+	callNames := make([]string, 0, len(calls))
+	for k := range calls {
+		callNames = append(callNames, k)
+	}
+	sort.Strings(callNames)
+
+	children := make([]*Config, len(callNames))
+	childDiags := make([]hcl.Diagnostics, len(callNames))
+	var wg sync.WaitGroup
+	for i, callName := range callNames {
+		call := calls[callName]
+		wg.Add(1)
+		go func(i int, call *ModuleCall) {
+			defer wg.Done()
+
+			path := make([]string, len(parent.Path)+1)
+			copy(path, parent.Path)
+			path[len(path)-1] = call.Name
+
+			req := ModuleRequest{
+				Name:              call.Name,
+				Path:              path,
+				SourceAddr:        call.SourceAddr,
+				SourceAddrRange:   call.SourceAddrRange,
+				VersionConstraint: call.Version,
+				Parent:            parent,
+				CallRange:         call.DeclRange,
+			}
+
+			// The semaphore bounds only the loading itself; we release it
+			// before recursing so that the grandchild loads can use the
+			// workers without risk of deadlock.
+			sem <- struct{}{}
+			mod, ver, modDiags := walker.LoadModule(&req)
+			<-sem
+
+			childDiags[i] = modDiags
+			if mod == nil {
+				// nil can be returned if the source address was invalid and so
+				// nothing could be loaded whatsoever. LoadModule should've
+				// returned at least one error diagnostic in that case.
+				return
+			}
+
+			child := &Config{
+				Parent:          parent,
+				Root:            parent.Root,
+				Path:            path,
+				Module:          mod,
+				CallRange:       call.DeclRange,
+				SourceAddr:      call.SourceAddr,
+				SourceAddrRange: call.SourceAddrRange,
+				Version:         ver,
+			}
+
+			var moreDiags hcl.Diagnostics
+			child.Children, moreDiags = buildChildModulesConcurrent(child, walker, sem)
+			childDiags[i] = append(childDiags[i], moreDiags...)
+
+			childDiags[i] = append(childDiags[i], validateProviderConfigurationAliases(call, child)...)
+
+			children[i] = child
+		}(i, call)
+	}
+	wg.Wait()
+
+	for i, callName := range callNames {
+		diags = append(diags, childDiags[i]...)
+		if children[i] != nil {
+			ret[callName] = children[i]
+		}
+	}
+
+	return ret, diags
+}
+
 // A ModuleWalker knows how to find and load a child module given details about
 // the module to be loaded and a reference to its partially-loaded parent
 // Config.
@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"sort"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/davecgh/go-spew/spew"
@@ -70,6 +71,55 @@ func TestBuildConfig(t *testing.T) {
 	}
 }
 
+func TestBuildConfigConcurrent(t *testing.T) {
+	parser := NewParser(nil)
+	mod, diags := parser.LoadConfigDir("testdata/config-build")
+	assertNoDiagnostics(t, diags)
+	if mod == nil {
+		t.Fatal("got nil root module; want non-nil")
+	}
+
+	// The walker must be safe for concurrent calls, so each call parses
+	// with its own parser and we guard our shared version counter.
+	var mu sync.Mutex
+	versionI := 0
+	cfg, diags := BuildConfigConcurrent(mod, ModuleWalkerFunc(
+		func(req *ModuleRequest) (*Module, *version.Version, hcl.Diagnostics) {
+			// For the sake of this test we're going to just treat our
+			// SourceAddr as a path relative to our fixture directory.
+			sourcePath := filepath.Join("testdata/config-build", req.SourceAddr)
+
+			mod, diags := NewParser(nil).LoadConfigDir(sourcePath)
+			mu.Lock()
+			version, _ := version.NewVersion(fmt.Sprintf("1.0.%d", versionI))
+			versionI++
+			mu.Unlock()
+			return mod, version, diags
+		},
+	), 4)
+	assertNoDiagnostics(t, diags)
+	if cfg == nil {
+		t.Fatal("got nil config; want non-nil")
+	}
+
+	var got []string
+	cfg.DeepEach(func(c *Config) {
+		got = append(got, strings.Join(c.Path, "."))
+	})
+	sort.Strings(got)
+	want := []string{
+		"",
+		"child_a",
+		"child_a.child_c",
+		"child_b",
+		"child_b.child_c",
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("wrong result\ngot: %swant: %s", spew.Sdump(got), spew.Sdump(want))
+	}
+}
+
 func TestBuildConfigDiags(t *testing.T) {
 	parser := NewParser(nil)
 	mod, diags := parser.LoadConfigDir("testdata/nested-errors")
@@ -114,3 +164,48 @@ func TestBuildConfigDiags(t *testing.T) {
 		t.Fatalf("wrong result\ngot: %swant: %s", spew.Sdump(got), spew.Sdump(want))
 	}
 }
+
+func TestBuildConfig_providerConfigurationAliases(t *testing.T) {
+	walkerForFixture := func(fixtureDir string) ModuleWalker {
+		parser := NewParser(nil)
+		return ModuleWalkerFunc(
+			func(req *ModuleRequest) (*Module, *version.Version, hcl.Diagnostics) {
+				sourcePath := filepath.Join(fixtureDir, req.SourceAddr)
+				mod, diags := parser.LoadConfigDir(sourcePath)
+				return mod, nil, diags
+			},
+		)
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		parser := NewParser(nil)
+		mod, diags := parser.LoadConfigDir("testdata/config-build-provider-aliases")
+		assertNoDiagnostics(t, diags)
+
+		_, buildDiags := BuildConfig(mod, walkerForFixture("testdata/config-build-provider-aliases"))
+		assertNoDiagnostics(t, buildDiags)
+	})
+
+	t.Run("missing and undeclared", func(t *testing.T) {
+		parser := NewParser(nil)
+		mod, diags := parser.LoadConfigDir("testdata/config-build-provider-aliases-errors")
+		assertNoDiagnostics(t, diags)
+
+		_, buildDiags := BuildConfig(mod, walkerForFixture("testdata/config-build-provider-aliases-errors"))
+		if len(buildDiags) != 2 {
+			t.Fatalf("wrong number of diagnostics %d; want 2\n%s", len(buildDiags), buildDiags.Error())
+		}
+		if got, want := buildDiags[0].Summary, "Missing required provider configuration"; got != want {
+			t.Errorf("wrong first summary %q; want %q", got, want)
+		}
+		if !strings.Contains(buildDiags[0].Detail, "aws.east") {
+			t.Errorf("first diagnostic does not name the missing alias:\n%s", buildDiags[0].Detail)
+		}
+		if got, want := buildDiags[1].Summary, "Provider configuration alias not declared"; got != want {
+			t.Errorf("wrong second summary %q; want %q", got, want)
+		}
+		if !strings.Contains(buildDiags[1].Detail, "aws.west") {
+			t.Errorf("second diagnostic does not name the extra alias:\n%s", buildDiags[1].Detail)
+		}
+	})
+}
@@ -1,11 +1,16 @@
 package configs
 
 import (
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/go-test/deep"
 
+	version "github.com/hashicorp/go-version"
+	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/internal/getproviders"
 )
 
 func TestConfigProviderTypes(t *testing.T) {
@@ -94,4 +99,93 @@ func TestConfigResolveAbsProviderAddr(t *testing.T) {
 			t.Errorf("wrong result\ngot:  %s\nwant: %s", got, want)
 		}
 	})
+	t.Run("strict, declared", func(t *testing.T) {
+		addr := addrs.LocalProviderConfig{
+			LocalName: "foo-test", // this is explicitly set in the config
+		}
+		got, diags := cfg.ResolveAbsProviderAddrStrict(addr, addrs.RootModuleInstance)
+		if diags.HasErrors() {
+			t.Fatal(diags.Error())
+		}
+		want := addrs.AbsProviderConfig{
+			Module:   addrs.RootModuleInstance,
+			Provider: addrs.NewLegacyProvider("foo-test"),
+		}
+		if got, want := got.String(), want.String(); got != want {
+			t.Errorf("wrong result\ngot:  %s\nwant: %s", got, want)
+		}
+	})
+	t.Run("strict, undeclared", func(t *testing.T) {
+		addr := addrs.LocalProviderConfig{
+			LocalName: "undeclared",
+		}
+		_, diags := cfg.ResolveAbsProviderAddrStrict(addr, addrs.RootModuleInstance)
+		if !diags.HasErrors() {
+			t.Fatal("success; want error about undeclared local name")
+		}
+		if got, want := diags.Error(), "Undeclared provider local name"; !strings.Contains(got, want) {
+			t.Errorf("wrong error\ngot:  %s\nwant substring: %s", got, want)
+		}
+	})
+}
+
+// testNestedModuleConfigFromDir reads configuration from the given path and
+// builds it into a full config tree, treating module source addresses as
+// paths relative to that directory.
+func testNestedModuleConfigFromDir(t *testing.T, path string) *Config {
+	t.Helper()
+
+	parser := NewParser(nil)
+	mod, diags := parser.LoadConfigDir(path)
+	if diags.HasErrors() {
+		t.Fatal(diags.Error())
+	}
+
+	cfg, buildDiags := BuildConfig(mod, ModuleWalkerFunc(
+		func(req *ModuleRequest) (*Module, *version.Version, hcl.Diagnostics) {
+			sourcePath := filepath.Join(path, req.SourceAddr)
+			mod, diags := parser.LoadConfigDir(sourcePath)
+			return mod, nil, diags
+		},
+	))
+	if buildDiags.HasErrors() {
+		t.Fatal(buildDiags.Error())
+	}
+	return cfg
+}
+
+func TestConfigProviderRequirements(t *testing.T) {
+	cfg := testNestedModuleConfigFromDir(t, "testdata/provider-reqs")
+
+	reqs, diags := cfg.ProviderRequirements()
+	if diags.HasErrors() {
+		t.Fatal(diags.Error())
+	}
+
+	got := make(map[string]string, len(reqs))
+	for fqn, constraints := range reqs {
+		got[fqn.String()] = getproviders.VersionConstraintsString(constraints)
+	}
+	want := map[string]string{
+		addrs.NewLegacyProvider("null").String():     "~> 2.0.0, >= 2.0.1",
+		addrs.NewLegacyProvider("random").String():   ">= 1.2.0, < 2.0.0",
+		addrs.NewLegacyProvider("tls").String():      "~> 3.0.1",
+		addrs.NewLegacyProvider("aws").String():      "",
+		addrs.NewLegacyProvider("template").String(): "",
+	}
+	for _, problem := range deep.Equal(got, want) {
+		t.Error(problem)
+	}
+}
+
+func TestConfigProviderRequirements_conflict(t *testing.T) {
+	cfg := testNestedModuleConfigFromDir(t, "testdata/provider-reqs-conflict")
+
+	_, diags := cfg.ProviderRequirements()
+	if !diags.HasErrors() {
+		t.Fatal("succeeded; want a conflict error")
+	}
+	if got, want := diags.Error(), "Conflicting provider version constraints"; !strings.Contains(got, want) {
+		t.Errorf("wrong error %q; want %q", got, want)
+	}
 }
@@ -3,6 +3,7 @@ package configload
 import (
 	"fmt"
 	"path/filepath"
+	"sync"
 
 	"github.com/hashicorp/terraform-svchost/disco"
 	"github.com/hashicorp/terraform/configs"
@@ -23,6 +24,10 @@ type Loader struct {
 	// modules is used to install and locate descendent modules that are
 	// referenced (directly or indirectly) from the root module.
 	modules moduleMgr
+
+	// mu guards updates to parser from concurrent module loading, which
+	// parses with separate parsers and then merges the sources back in.
+	mu sync.Mutex
 }
 
 // Config is used with NewLoader to specify configuration arguments for the
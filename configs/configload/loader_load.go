@@ -30,10 +30,50 @@ func (l *Loader) LoadConfig(rootDir string) (*configs.Config, hcl.Diagnostics) {
 	return cfg, diags
 }
 
+// LoadConfigConcurrent is like LoadConfig except that descendent modules are
+// parsed concurrently, using up to the given number of workers at a time. If
+// parallelism is less than one then the number of processors is used instead.
+//
+// The result is identical to what LoadConfig would produce for the same
+// directory, including the order of any diagnostics, so this is worthwhile
+// for configurations with many modules where loading time is dominated by
+// parsing.
+func (l *Loader) LoadConfigConcurrent(rootDir string, parallelism int) (*configs.Config, hcl.Diagnostics) {
+	rootMod, diags := l.parser.LoadConfigDir(rootDir)
+	if rootMod == nil {
+		return nil, diags
+	}
+
+	cfg, cDiags := configs.BuildConfigConcurrent(rootMod, configs.ModuleWalkerFunc(l.moduleWalkerLoadConcurrent), parallelism)
+	diags = append(diags, cDiags...)
+
+	return cfg, diags
+}
+
 // moduleWalkerLoad is a configs.ModuleWalkerFunc for loading modules that
 // are presumed to have already been installed. A different function
 // (moduleWalkerInstall) is used for installation.
 func (l *Loader) moduleWalkerLoad(req *configs.ModuleRequest) (*configs.Module, *version.Version, hcl.Diagnostics) {
+	return l.moduleWalkerLoadWithParser(l.parser, req)
+}
+
+// moduleWalkerLoadConcurrent is a variant of moduleWalkerLoad that is safe
+// for concurrent calls: since a configs.Parser is not safe for concurrent
+// use, each call parses with its own parser and then merges the parsed
+// sources back into the loader's main parser so that diagnostic rendering
+// can find them later.
+func (l *Loader) moduleWalkerLoadConcurrent(req *configs.ModuleRequest) (*configs.Module, *version.Version, hcl.Diagnostics) {
+	parser := configs.NewParser(l.modules.FS)
+	mod, ver, diags := l.moduleWalkerLoadWithParser(parser, req)
+
+	l.mu.Lock()
+	l.parser.MergeSources(parser)
+	l.mu.Unlock()
+
+	return mod, ver, diags
+}
+
+func (l *Loader) moduleWalkerLoadWithParser(parser *configs.Parser, req *configs.ModuleRequest) (*configs.Module, *version.Version, hcl.Diagnostics) {
 	// Since we're just loading here, we expect that all referenced modules
 	// will be already installed and described in our manifest. However, we
 	// do verify that the manifest and the configuration are in agreement
@@ -84,7 +124,7 @@ func (l *Loader) moduleWalkerLoad(req *configs.ModuleRequest) (*configs.Module,
 		})
 	}
 
-	mod, mDiags := l.parser.LoadConfigDir(record.Dir)
+	mod, mDiags := parser.LoadConfigDir(record.Dir)
 	diags = append(diags, mDiags...)
 	if mod == nil {
 		// nil specifically indicates that the directory does not exist or
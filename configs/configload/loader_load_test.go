@@ -59,6 +59,52 @@ func TestLoaderLoadConfig_okay(t *testing.T) {
 	})
 }
 
+func TestLoaderLoadConfigConcurrent(t *testing.T) {
+	fixtureDir := filepath.Clean("testdata/already-installed")
+	loader, err := NewLoader(&Config{
+		ModulesDir: filepath.Join(fixtureDir, ".terraform/modules"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error from NewLoader: %s", err)
+	}
+
+	cfg, diags := loader.LoadConfigConcurrent(fixtureDir, 4)
+	assertNoDiagnostics(t, diags)
+	if cfg == nil {
+		t.Fatalf("config is nil; want non-nil")
+	}
+
+	var gotPaths []string
+	cfg.DeepEach(func(c *configs.Config) {
+		gotPaths = append(gotPaths, strings.Join(c.Path, "."))
+	})
+	sort.Strings(gotPaths)
+	wantPaths := []string{
+		"", // root module
+		"child_a",
+		"child_a.child_c",
+		"child_b",
+		"child_b.child_d",
+	}
+
+	if !reflect.DeepEqual(gotPaths, wantPaths) {
+		t.Fatalf("wrong module paths\ngot: %swant %s", spew.Sdump(gotPaths), spew.Sdump(wantPaths))
+	}
+
+	// The sources of every module must have been merged back into the main
+	// parser so that diagnostics can be rendered with source snippets.
+	sources := loader.Sources()
+	for _, wantFile := range []string{
+		filepath.Join(fixtureDir, "root.tf"),
+		filepath.Join(fixtureDir, ".terraform/modules/child_a/child_a.tf"),
+		filepath.Join(fixtureDir, ".terraform/modules/child_b.child_d/child_d.tf"),
+	} {
+		if _, exists := sources[wantFile]; !exists {
+			t.Errorf("source for %s not present in the loader's parser", wantFile)
+		}
+	}
+}
+
 func TestLoaderLoadConfig_addVersion(t *testing.T) {
 	// This test is for what happens when there is a version constraint added
 	// to a module that previously didn't have one.
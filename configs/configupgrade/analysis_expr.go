@@ -143,6 +143,11 @@ func (d analysisData) GetTerraformAttr(addrs.TerraformAttr, tfdiags.SourceRange)
 	return cty.UnknownVal(cty.String), nil
 }
 
+func (d analysisData) GetFederatedState(addrs.StateReference, tfdiags.SourceRange) (cty.Value, tfdiags.Diagnostics) {
+	// Federated states are not something we can analyze statically.
+	return cty.DynamicVal, nil
+}
+
 func (d analysisData) GetInputVariable(addr addrs.InputVariable, rng tfdiags.SourceRange) (cty.Value, tfdiags.Diagnostics) {
 	// TODO: Collect shallow type information (list vs. map vs. string vs. unknown)
 	// in analysis and then return a similarly-approximate type here.
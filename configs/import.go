@@ -0,0 +1,81 @@
+package configs
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+
+	"github.com/hashicorp/terraform/addrs"
+)
+
+// Import represents an "import" block in a module, which asks Terraform to
+// adopt an existing remote object into state at a particular resource
+// instance address during the next plan and apply, instead of requiring a
+// separate "terraform import" command run.
+type Import struct {
+	// To is the address of the resource instance that the imported object
+	// should be tracked at. Import blocks are only supported in the root
+	// module, so this address is interpreted as absolute.
+	To addrs.AbsResourceInstance
+
+	// ID is an expression producing the provider-specific id of the remote
+	// object to import, as would be given to "terraform import" on the
+	// command line.
+	ID hcl.Expression
+
+	DeclRange hcl.Range
+}
+
+func decodeImportBlock(block *hcl.Block) (*Import, hcl.Diagnostics) {
+	var diags hcl.Diagnostics
+	imp := &Import{
+		DeclRange: block.DefRange,
+	}
+
+	content, moreDiags := block.Body.Content(importBlockSchema)
+	diags = append(diags, moreDiags...)
+
+	if attr, exists := content.Attributes["to"]; exists {
+		traversal, travDiags := hcl.AbsTraversalForExpr(attr.Expr)
+		diags = append(diags, travDiags...)
+		if !travDiags.HasErrors() {
+			to, toDiags := addrs.ParseAbsResourceInstance(traversal)
+			if toDiags.HasErrors() {
+				diags = append(diags, &hcl.Diagnostic{
+					Severity: hcl.DiagError,
+					Summary:  "Invalid \"import\" address",
+					Detail:   fmt.Sprintf("Terraform cannot interpret this as the address of a resource instance: %s.", toDiags.Err()),
+					Subject:  attr.Expr.Range().Ptr(),
+				})
+			} else if to.Resource.Resource.Mode != addrs.ManagedResourceMode {
+				diags = append(diags, &hcl.Diagnostic{
+					Severity: hcl.DiagError,
+					Summary:  "Invalid \"import\" address",
+					Detail:   "Only managed resources can be imported; data resources are read on every operation and have no state to import into.",
+					Subject:  attr.Expr.Range().Ptr(),
+				})
+			} else {
+				imp.To = to
+			}
+		}
+	}
+
+	if attr, exists := content.Attributes["id"]; exists {
+		imp.ID = attr.Expr
+	}
+
+	return imp, diags
+}
+
+var importBlockSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{
+		{
+			Name:     "to",
+			Required: true,
+		},
+		{
+			Name:     "id",
+			Required: true,
+		},
+	},
+}
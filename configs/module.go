@@ -41,6 +41,12 @@ type Module struct {
 
 	ManagedResources map[string]*Resource
 	DataResources    map[string]*Resource
+
+	Checks map[string]*Check
+
+	Moved   []*Moved
+	Imports []*Import
+	Removed []*Removed
 }
 
 // File describes the contents of a single configuration file.
@@ -71,6 +77,12 @@ type File struct {
 
 	ManagedResources []*Resource
 	DataResources    []*Resource
+
+	Checks []*Check
+
+	Moved   []*Moved
+	Imports []*Import
+	Removed []*Removed
 }
 
 // NewModule takes a list of primary files and a list of override files and
@@ -93,6 +105,7 @@ func NewModule(primaryFiles, overrideFiles []*File) (*Module, hcl.Diagnostics) {
 		ModuleCalls:          map[string]*ModuleCall{},
 		ManagedResources:     map[string]*Resource{},
 		DataResources:        map[string]*Resource{},
+		Checks:               map[string]*Check{},
 	}
 
 	for _, file := range primaryFiles {
@@ -190,8 +203,14 @@ func (m *Module) appendFile(file *File) hcl.Diagnostics {
 				panic("provider fqn mismatch")
 			}
 			existing.VersionConstraints = append(existing.VersionConstraints, reqd.Requirement)
+			existing.ConfigurationAliases = append(existing.ConfigurationAliases, reqd.ConfigurationAliases...)
+			m.ProviderRequirements[reqd.Name] = existing
 		} else {
-			m.ProviderRequirements[reqd.Name] = ProviderRequirements{Type: fqn, VersionConstraints: []VersionConstraint{reqd.Requirement}}
+			m.ProviderRequirements[reqd.Name] = ProviderRequirements{
+				Type:                 fqn,
+				VersionConstraints:   []VersionConstraint{reqd.Requirement},
+				ConfigurationAliases: reqd.ConfigurationAliases,
+			}
 		}
 	}
 
@@ -271,6 +290,28 @@ func (m *Module) appendFile(file *File) hcl.Diagnostics {
 		m.DataResources[key] = r
 	}
 
+	for _, c := range file.Checks {
+		if existing, exists := m.Checks[c.Name]; exists {
+			diags = append(diags, &hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Duplicate check block",
+				Detail:   fmt.Sprintf("A check named %q was already declared at %s. Check names must be unique within a module.", existing.Name, existing.DeclRange),
+				Subject:  &c.DeclRange,
+			})
+			continue
+		}
+		m.Checks[c.Name] = c
+	}
+
+	// Moved blocks just accumulate, because it's valid (and expected, after
+	// repeated refactoring) for several of them to coexist in one module.
+	m.Moved = append(m.Moved, file.Moved...)
+
+	// Import blocks also accumulate; duplicate target addresses are caught
+	// later, once the full module is assembled.
+	m.Imports = append(m.Imports, file.Imports...)
+	m.Removed = append(m.Removed, file.Removed...)
+
 	return diags
 }
 
@@ -460,8 +501,28 @@ func (m *Module) LocalNameForProvider(p addrs.Provider) string {
 
 // ProviderForLocalConfig returns the provider FQN for a given LocalProviderConfig
 func (m *Module) ProviderForLocalConfig(pc addrs.LocalProviderConfig) addrs.Provider {
-	if provider, exists := m.ProviderRequirements[pc.String()]; exists {
+	if provider, exists := m.ProviderRequirements[pc.LocalName]; exists {
 		return provider.Type
 	}
 	return addrs.NewLegacyProvider(pc.LocalName)
 }
+
+// DeclaresConfigurationAlias returns true if the module declares the given
+// additional (aliased) provider configuration via the configuration_aliases
+// argument in its required_providers, meaning that a caller is expected to
+// supply that configuration through the providers argument on the module call.
+func (m *Module) DeclaresConfigurationAlias(pc addrs.LocalProviderConfig) bool {
+	if pc.Alias == "" {
+		return false
+	}
+	reqs, exists := m.ProviderRequirements[pc.LocalName]
+	if !exists {
+		return false
+	}
+	for _, ca := range reqs.ConfigurationAliases {
+		if ca.Alias == pc.Alias {
+			return true
+		}
+	}
+	return false
+}
@@ -44,7 +44,11 @@ func mergeProviderVersionConstraints(recv map[string]ProviderRequirements, ovrd
 	}
 	for _, reqd := range ovrd {
 		fqn := addrs.NewLegacyProvider(reqd.Name)
-		recv[reqd.Name] = ProviderRequirements{Type: fqn, VersionConstraints: []VersionConstraint{reqd.Requirement}}
+		recv[reqd.Name] = ProviderRequirements{
+			Type:                 fqn,
+			VersionConstraints:   []VersionConstraint{reqd.Requirement},
+			ConfigurationAliases: reqd.ConfigurationAliases,
+		}
 	}
 }
 
@@ -55,6 +59,10 @@ func (v *Variable) merge(ov *Variable) hcl.Diagnostics {
 		v.Description = ov.Description
 		v.DescriptionSet = ov.DescriptionSet
 	}
+	if ov.SensitiveSet {
+		v.Sensitive = ov.Sensitive
+		v.SensitiveSet = ov.SensitiveSet
+	}
 	if ov.Default != cty.NilVal {
 		v.Default = ov.Default
 	}
@@ -0,0 +1,97 @@
+package configs
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+
+	"github.com/hashicorp/terraform/addrs"
+)
+
+// Moved represents a "moved" block in a module, which records that an
+// object formerly tracked at one address is now tracked at another, so
+// that a refactored configuration can adopt existing state rather than
+// planning to destroy and re-create.
+type Moved struct {
+	// From and To are the old and new addresses respectively, interpreted
+	// as relative to the module where the block was declared.
+	From *addrs.Target
+	To   *addrs.Target
+
+	DeclRange hcl.Range
+}
+
+func decodeMovedBlock(block *hcl.Block) (*Moved, hcl.Diagnostics) {
+	var diags hcl.Diagnostics
+	moved := &Moved{
+		DeclRange: block.DefRange,
+	}
+
+	content, moreDiags := block.Body.Content(movedBlockSchema)
+	diags = append(diags, moreDiags...)
+
+	if attr, exists := content.Attributes["from"]; exists {
+		from, fromDiags := decodeMovedAddr(attr)
+		diags = append(diags, fromDiags...)
+		moved.From = from
+	}
+	if attr, exists := content.Attributes["to"]; exists {
+		to, toDiags := decodeMovedAddr(attr)
+		diags = append(diags, toDiags...)
+		moved.To = to
+	}
+
+	if moved.From != nil && moved.To != nil {
+		// Both addresses must be of broadly the same kind: we can move
+		// a module to a module address, or a resource (instance) to a
+		// resource (instance) address, but not between the two kinds.
+		_, fromIsModule := moved.From.Subject.(addrs.ModuleInstance)
+		_, toIsModule := moved.To.Subject.(addrs.ModuleInstance)
+		if fromIsModule != toIsModule {
+			diags = append(diags, &hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Invalid \"moved\" addresses",
+				Detail:   "The \"from\" and \"to\" addresses must either both refer to modules or both refer to resources.",
+				Subject:  &moved.DeclRange,
+			})
+		}
+	}
+
+	return moved, diags
+}
+
+func decodeMovedAddr(attr *hcl.Attribute) (*addrs.Target, hcl.Diagnostics) {
+	var diags hcl.Diagnostics
+
+	traversal, travDiags := hcl.AbsTraversalForExpr(attr.Expr)
+	diags = append(diags, travDiags...)
+	if travDiags.HasErrors() {
+		return nil, diags
+	}
+
+	target, targetDiags := addrs.ParseTarget(traversal)
+	if targetDiags.HasErrors() {
+		diags = append(diags, &hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Invalid \"moved\" address",
+			Detail:   fmt.Sprintf("Terraform cannot interpret this as the address of a module or resource: %s.", targetDiags.Err()),
+			Subject:  attr.Expr.Range().Ptr(),
+		})
+		return nil, diags
+	}
+
+	return target, diags
+}
+
+var movedBlockSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{
+		{
+			Name:     "from",
+			Required: true,
+		},
+		{
+			Name:     "to",
+			Required: true,
+		},
+	},
+}
@@ -98,3 +98,16 @@ func (p *Parser) ForceFileSource(filename string, src []byte) {
 		Bytes: src,
 	})
 }
+
+// MergeSources copies all of the files parsed by the given other parser into
+// the receiver's cache, so that diagnostics referring to those files can be
+// rendered with snippets from the receiver's sources.
+//
+// This is intended for use with concurrent loading, where independent parsing
+// work is done with separate parsers (a Parser is not itself safe for
+// concurrent use) whose results are then consolidated into one.
+func (p *Parser) MergeSources(other *Parser) {
+	for name, f := range other.p.Files() {
+		p.p.AddFile(name, f)
+	}
+}
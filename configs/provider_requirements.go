@@ -1,9 +1,12 @@
 package configs
 
 import (
+	"fmt"
+
 	version "github.com/hashicorp/go-version"
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/terraform/addrs"
+	"github.com/zclconf/go-cty/cty"
 )
 
 // RequiredProvider represents a declaration of a dependency on a particular
@@ -13,6 +16,13 @@ type RequiredProvider struct {
 	Name        string
 	Source      string // TODO
 	Requirement VersionConstraint
+
+	// ConfigurationAliases lists the additional (aliased) provider
+	// configurations the declaring module expects to be passed in by its
+	// caller, as given in the configuration_aliases argument.
+	ConfigurationAliases []*ProviderConfigRef
+
+	DeclRange hcl.Range
 }
 
 // ProviderRequirements represents merged provider version constraints.
@@ -21,12 +31,28 @@ type RequiredProvider struct {
 type ProviderRequirements struct {
 	Type               addrs.Provider
 	VersionConstraints []VersionConstraint
+
+	// ConfigurationAliases are the aliased provider configurations that a
+	// caller of this module must supply via the providers argument, merged
+	// from the module's required_providers declarations.
+	ConfigurationAliases []*ProviderConfigRef
 }
 
 func decodeRequiredProvidersBlock(block *hcl.Block) ([]*RequiredProvider, hcl.Diagnostics) {
 	attrs, diags := block.Body.JustAttributes()
 	var reqs []*RequiredProvider
 	for name, attr := range attrs {
+		// If the entry is an object constructor then we analyze it
+		// statically rather than by evaluation, because the
+		// configuration_aliases argument contains bare references to
+		// provider configurations which cannot be evaluated as values.
+		if pairs, mapDiags := hcl.ExprMap(attr.Expr); !mapDiags.HasErrors() {
+			req, reqDiags := decodeRequiredProviderObject(name, attr, pairs)
+			diags = append(diags, reqDiags...)
+			reqs = append(reqs, req)
+			continue
+		}
+
 		expr, err := attr.Expr.Value(nil)
 		if err != nil {
 			diags = append(diags, err...)
@@ -39,9 +65,10 @@ func decodeRequiredProvidersBlock(block *hcl.Block) ([]*RequiredProvider, hcl.Di
 			reqs = append(reqs, &RequiredProvider{
 				Name:        name,
 				Requirement: vc,
+				DeclRange:   attr.Range,
 			})
 		case expr.Type().IsObjectType():
-			ret := &RequiredProvider{Name: name}
+			ret := &RequiredProvider{Name: name, DeclRange: attr.Range}
 			if expr.Type().HasAttribute("version") {
 				vc := VersionConstraint{
 					DeclRange: attr.Range,
@@ -74,9 +101,88 @@ func decodeRequiredProvidersBlock(block *hcl.Block) ([]*RequiredProvider, hcl.Di
 				Detail:   "provider_requirements entries must be strings or objects.",
 				Subject:  attr.Expr.Range().Ptr(),
 			})
-			reqs = append(reqs, &RequiredProvider{Name: name})
+			reqs = append(reqs, &RequiredProvider{Name: name, DeclRange: attr.Range})
 			return reqs, diags
 		}
 	}
 	return reqs, diags
 }
+
+// decodeRequiredProviderObject decodes the object form of a required_providers
+// entry from its statically-analyzed key/value pairs, which allows the
+// configuration_aliases argument to contain bare provider configuration
+// references.
+func decodeRequiredProviderObject(name string, attr *hcl.Attribute, pairs []hcl.KeyValuePair) (*RequiredProvider, hcl.Diagnostics) {
+	var diags hcl.Diagnostics
+	ret := &RequiredProvider{Name: name, DeclRange: attr.Range}
+
+	for _, pair := range pairs {
+		key, keyDiags := pair.Key.Value(nil)
+		if keyDiags.HasErrors() {
+			diags = append(diags, keyDiags...)
+			continue
+		}
+
+		switch key.AsString() {
+		case "version":
+			vc, vcDiags := decodeVersionConstraint(&hcl.Attribute{
+				Name:      "version",
+				Expr:      pair.Value,
+				Range:     pair.Value.Range(),
+				NameRange: pair.Key.Range(),
+			})
+			diags = append(diags, vcDiags...)
+			ret.Requirement = vc
+		case "source":
+			source, sourceDiags := pair.Value.Value(nil)
+			if sourceDiags.HasErrors() || source.Type() != cty.String {
+				diags = append(diags, &hcl.Diagnostic{
+					Severity: hcl.DiagError,
+					Summary:  "Invalid source",
+					Detail:   "The source argument must be a string.",
+					Subject:  pair.Value.Range().Ptr(),
+				})
+				continue
+			}
+			ret.Source = source.AsString()
+		case "configuration_aliases":
+			exprs, listDiags := hcl.ExprList(pair.Value)
+			diags = append(diags, listDiags...)
+			for _, expr := range exprs {
+				ref, refDiags := decodeProviderConfigRef(expr, "configuration_aliases")
+				diags = append(diags, refDiags...)
+				if refDiags.HasErrors() {
+					continue
+				}
+				if ref.Name != name {
+					diags = append(diags, &hcl.Diagnostic{
+						Severity: hcl.DiagError,
+						Summary:  "Invalid configuration alias",
+						Detail:   fmt.Sprintf("Configuration aliases for provider %q must use that provider's local name, like %q.", name, name+".alternate"),
+						Subject:  expr.Range().Ptr(),
+					})
+					continue
+				}
+				if ref.Alias == "" {
+					diags = append(diags, &hcl.Diagnostic{
+						Severity: hcl.DiagError,
+						Summary:  "Invalid configuration alias",
+						Detail:   fmt.Sprintf("A configuration alias must include an alias, like %q. The default configuration for %q is always available to the module.", name+".alternate", name),
+						Subject:  expr.Range().Ptr(),
+					})
+					continue
+				}
+				ret.ConfigurationAliases = append(ret.ConfigurationAliases, ref)
+			}
+		default:
+			diags = append(diags, &hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Invalid provider_requirements syntax",
+				Detail:   fmt.Sprintf("The name %q is not expected within a required_providers entry.", key.AsString()),
+				Subject:  pair.Key.Range().Ptr(),
+			})
+		}
+	}
+
+	return ret, diags
+}
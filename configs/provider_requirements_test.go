@@ -9,6 +9,7 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	version "github.com/hashicorp/go-version"
 	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/hashicorp/hcl/v2/hcltest"
 	"github.com/zclconf/go-cty/cty"
 )
@@ -189,3 +190,80 @@ func testVC(ver string) VersionConstraint {
 		DeclRange: hcl.Range{},
 	}
 }
+
+func TestDecodeRequiredProvidersBlock_configurationAliases(t *testing.T) {
+	expr, parseDiags := hclsyntax.ParseExpression([]byte(`{
+		version               = "2.0.0"
+		configuration_aliases = [aws.east, aws.west]
+	}`), "", hcl.Pos{Line: 1, Column: 1})
+	if parseDiags.HasErrors() {
+		t.Fatalf("unexpected parse error: %s", parseDiags.Error())
+	}
+
+	block := &hcl.Block{
+		Type: "required_providers",
+		Body: hcltest.MockBody(&hcl.BodyContent{
+			Attributes: hcl.Attributes{
+				"aws": {
+					Name: "aws",
+					Expr: expr,
+				},
+			},
+		}),
+	}
+
+	got, diags := decodeRequiredProvidersBlock(block)
+	if diags.HasErrors() {
+		t.Fatalf("unexpected error: %s", diags.Error())
+	}
+	if len(got) != 1 {
+		t.Fatalf("wrong number of results, got %d, wanted 1", len(got))
+	}
+
+	want := &RequiredProvider{
+		Name:        "aws",
+		Requirement: testVC("2.0.0"),
+	}
+	if !cmp.Equal(got[0], want, ignoreUnexported, comparer) {
+		t.Fatalf("wrong result:\n %s", cmp.Diff(got[0], want, ignoreUnexported, comparer))
+	}
+
+	var aliases []string
+	for _, ca := range got[0].ConfigurationAliases {
+		aliases = append(aliases, ca.Name+"."+ca.Alias)
+	}
+	if !cmp.Equal(aliases, []string{"aws.east", "aws.west"}) {
+		t.Fatalf("wrong configuration aliases:\n %s", cmp.Diff(aliases, []string{"aws.east", "aws.west"}))
+	}
+}
+
+func TestDecodeRequiredProvidersBlock_invalidConfigurationAliases(t *testing.T) {
+	expr, parseDiags := hclsyntax.ParseExpression([]byte(`{
+		configuration_aliases = [google.alternate, aws]
+	}`), "", hcl.Pos{Line: 1, Column: 1})
+	if parseDiags.HasErrors() {
+		t.Fatalf("unexpected parse error: %s", parseDiags.Error())
+	}
+
+	block := &hcl.Block{
+		Type: "required_providers",
+		Body: hcltest.MockBody(&hcl.BodyContent{
+			Attributes: hcl.Attributes{
+				"aws": {
+					Name: "aws",
+					Expr: expr,
+				},
+			},
+		}),
+	}
+
+	_, diags := decodeRequiredProvidersBlock(block)
+	if len(diags) != 2 {
+		t.Fatalf("wrong number of diagnostics %d; want 2\n%s", len(diags), diags.Error())
+	}
+	for _, diag := range diags {
+		if diag.Summary != "Invalid configuration alias" {
+			t.Errorf("unexpected diagnostic: %s", diag.Error())
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package configs
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+)
+
+// validateProviderConfigurationAliases checks that the given module call
+// passes a provider configuration for each additional (aliased) configuration
+// the child module declares via configuration_aliases, and that it does not
+// pass aliased configurations the child module does not declare.
+func validateProviderConfigurationAliases(call *ModuleCall, child *Config) hcl.Diagnostics {
+	var diags hcl.Diagnostics
+
+	// Sort the provider local names so that any diagnostics we produce
+	// appear in a predictable order.
+	names := make([]string, 0, len(child.Module.ProviderRequirements))
+	for name := range child.Module.ProviderRequirements {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		reqs := child.Module.ProviderRequirements[name]
+		if len(reqs.ConfigurationAliases) == 0 {
+			continue
+		}
+
+		passed := make(map[string]*ProviderConfigRef)
+		for _, pp := range call.Providers {
+			if pp.InChild.Name == name && pp.InChild.Alias != "" {
+				passed[pp.InChild.Alias] = pp.InChild
+			}
+		}
+
+		declared := make(map[string]*ProviderConfigRef)
+		for _, ca := range reqs.ConfigurationAliases {
+			declared[ca.Alias] = ca
+			if _, exists := passed[ca.Alias]; !exists {
+				diags = append(diags, &hcl.Diagnostic{
+					Severity: hcl.DiagError,
+					Summary:  "Missing required provider configuration",
+					Detail: fmt.Sprintf(
+						"The child module declares an additional provider configuration %s.%s, at %s, which must be passed in by its caller. Add an entry for %s.%s to this module call's \"providers\" argument.",
+						name, ca.Alias, ca.NameRange, name, ca.Alias,
+					),
+					Subject: &call.DeclRange,
+				})
+			}
+		}
+
+		passedAliases := make([]string, 0, len(passed))
+		for alias := range passed {
+			passedAliases = append(passedAliases, alias)
+		}
+		sort.Strings(passedAliases)
+
+		for _, alias := range passedAliases {
+			if _, exists := declared[alias]; !exists {
+				diags = append(diags, &hcl.Diagnostic{
+					Severity: hcl.DiagError,
+					Summary:  "Provider configuration alias not declared",
+					Detail: fmt.Sprintf(
+						"The child module does not declare a configuration alias %s.%s in the configuration_aliases argument of its required_providers entry for %q, at %s.",
+						name, alias, name, reqs.ConfigurationAliases[0].NameRange,
+					),
+					Subject: passed[alias].NameRange.Ptr(),
+				})
+			}
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,101 @@
+package configs
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/gohcl"
+
+	"github.com/hashicorp/terraform/addrs"
+)
+
+// Removed represents a "removed" block in a module, which records that a
+// resource formerly declared in the configuration should be removed from
+// state, either destroying the remote object it tracks or simply forgetting
+// it depending on the block's lifecycle settings.
+type Removed struct {
+	// From is the address of the resource to remove. Removed blocks are only
+	// supported in the root module, so this address is interpreted as
+	// absolute.
+	From *addrs.Target
+
+	// Destroy is true if the remote object should be destroyed as part of
+	// removing it from state, rather than left in place and merely
+	// forgotten. It defaults to true, matching what would happen if the
+	// resource block were deleted without a removed block.
+	Destroy bool
+
+	DeclRange hcl.Range
+}
+
+func decodeRemovedBlock(block *hcl.Block) (*Removed, hcl.Diagnostics) {
+	var diags hcl.Diagnostics
+	removed := &Removed{
+		Destroy:   true,
+		DeclRange: block.DefRange,
+	}
+
+	content, moreDiags := block.Body.Content(removedBlockSchema)
+	diags = append(diags, moreDiags...)
+
+	if attr, exists := content.Attributes["from"]; exists {
+		traversal, travDiags := hcl.AbsTraversalForExpr(attr.Expr)
+		diags = append(diags, travDiags...)
+		if !travDiags.HasErrors() {
+			from, fromDiags := addrs.ParseTarget(traversal)
+			if fromDiags.HasErrors() {
+				diags = append(diags, &hcl.Diagnostic{
+					Severity: hcl.DiagError,
+					Summary:  "Invalid \"removed\" address",
+					Detail:   fmt.Sprintf("Terraform cannot interpret this as the address of a resource: %s.", fromDiags.Err()),
+					Subject:  attr.Expr.Range().Ptr(),
+				})
+			} else if _, isModule := from.Subject.(addrs.ModuleInstance); isModule {
+				diags = append(diags, &hcl.Diagnostic{
+					Severity: hcl.DiagError,
+					Summary:  "Invalid \"removed\" address",
+					Detail:   "The \"from\" address must refer to a resource or resource instance, not a whole module.",
+					Subject:  attr.Expr.Range().Ptr(),
+				})
+			} else {
+				removed.From = from
+			}
+		}
+	}
+
+	for _, innerBlock := range content.Blocks {
+		switch innerBlock.Type {
+		case "lifecycle":
+			lcContent, lcDiags := innerBlock.Body.Content(removedLifecycleBlockSchema)
+			diags = append(diags, lcDiags...)
+			if attr, exists := lcContent.Attributes["destroy"]; exists {
+				valDiags := gohcl.DecodeExpression(attr.Expr, nil, &removed.Destroy)
+				diags = append(diags, valDiags...)
+			}
+		}
+	}
+
+	return removed, diags
+}
+
+var removedBlockSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{
+		{
+			Name:     "from",
+			Required: true,
+		},
+	},
+	Blocks: []hcl.BlockHeaderSchema{
+		{
+			Type: "lifecycle",
+		},
+	},
+}
+
+var removedLifecycleBlockSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{
+		{
+			Name: "destroy",
+		},
+	},
+}
@@ -2,6 +2,7 @@ package configs
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/gohcl"
@@ -44,6 +45,25 @@ type ManagedResource struct {
 
 	CreateBeforeDestroySet bool
 	PreventDestroySet      bool
+
+	Preconditions  []*CheckRule
+	Postconditions []*CheckRule
+
+	// Timeouts, if non-nil, caps how long apply-time operations for this
+	// resource may run, independently of any timeouts the provider itself
+	// might enforce.
+	Timeouts *ResourceTimeouts
+}
+
+// ResourceTimeouts represents a "timeouts" block inside a resource's
+// lifecycle block, capping the duration of apply-time operations. A zero
+// duration means no limit is configured for that operation.
+type ResourceTimeouts struct {
+	Create time.Duration
+	Update time.Duration
+	Delete time.Duration
+
+	DeclRange hcl.Range
 }
 
 func (r *Resource) moduleUniqueKey() string {
@@ -247,6 +267,32 @@ func decodeResourceBlock(block *hcl.Block) (*Resource, hcl.Diagnostics) {
 
 			}
 
+			for _, subBlock := range lcContent.Blocks {
+				switch subBlock.Type {
+				case "precondition":
+					cr, crDiags := decodeCheckRuleBlock(subBlock, false)
+					diags = append(diags, crDiags...)
+					r.Managed.Preconditions = append(r.Managed.Preconditions, cr)
+				case "postcondition":
+					cr, crDiags := decodeCheckRuleBlock(subBlock, false)
+					diags = append(diags, crDiags...)
+					r.Managed.Postconditions = append(r.Managed.Postconditions, cr)
+				case "timeouts":
+					if r.Managed.Timeouts != nil {
+						diags = append(diags, &hcl.Diagnostic{
+							Severity: hcl.DiagError,
+							Summary:  "Duplicate timeouts block",
+							Detail:   fmt.Sprintf("This resource already has a timeouts block at %s.", r.Managed.Timeouts.DeclRange),
+							Subject:  &subBlock.DefRange,
+						})
+						continue
+					}
+					to, toDiags := decodeResourceTimeoutsBlock(subBlock)
+					diags = append(diags, toDiags...)
+					r.Managed.Timeouts = to
+				}
+			}
+
 		case "connection":
 			if seenConnection != nil {
 				diags = append(diags, &hcl.Diagnostic{
@@ -297,6 +343,51 @@ func decodeResourceBlock(block *hcl.Block) (*Resource, hcl.Diagnostics) {
 	return r, diags
 }
 
+// decodeResourceTimeoutsBlock decodes a "timeouts" block inside a resource's
+// lifecycle block. Each attribute must be a constant string in the syntax
+// accepted by time.ParseDuration, such as "30s" or "10m".
+func decodeResourceTimeoutsBlock(block *hcl.Block) (*ResourceTimeouts, hcl.Diagnostics) {
+	var diags hcl.Diagnostics
+	ret := &ResourceTimeouts{
+		DeclRange: block.DefRange,
+	}
+
+	content, moreDiags := block.Body.Content(resourceTimeoutsBlockSchema)
+	diags = append(diags, moreDiags...)
+
+	decodeDuration := func(attr *hcl.Attribute, into *time.Duration) {
+		var raw string
+		valDiags := gohcl.DecodeExpression(attr.Expr, nil, &raw)
+		diags = append(diags, valDiags...)
+		if valDiags.HasErrors() {
+			return
+		}
+		d, err := time.ParseDuration(raw)
+		if err != nil || d < 0 {
+			diags = append(diags, &hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Invalid timeout value",
+				Detail:   fmt.Sprintf("The value %q is not a valid duration. Use a non-negative duration string such as \"30s\" or \"10m\".", raw),
+				Subject:  attr.Expr.Range().Ptr(),
+			})
+			return
+		}
+		*into = d
+	}
+
+	if attr, exists := content.Attributes["create"]; exists {
+		decodeDuration(attr, &ret.Create)
+	}
+	if attr, exists := content.Attributes["update"]; exists {
+		decodeDuration(attr, &ret.Update)
+	}
+	if attr, exists := content.Attributes["delete"]; exists {
+		decodeDuration(attr, &ret.Delete)
+	}
+
+	return ret, diags
+}
+
 func decodeDataBlock(block *hcl.Block) (*Resource, hcl.Diagnostics) {
 	r := &Resource{
 		Mode:      addrs.DataResourceMode,
@@ -517,4 +608,23 @@ var resourceLifecycleBlockSchema = &hcl.BodySchema{
 			Name: "ignore_changes",
 		},
 	},
+	Blocks: []hcl.BlockHeaderSchema{
+		{Type: "precondition"},
+		{Type: "postcondition"},
+		{Type: "timeouts"},
+	},
+}
+
+var resourceTimeoutsBlockSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{
+		{
+			Name: "create",
+		},
+		{
+			Name: "update",
+		},
+		{
+			Name: "delete",
+		},
+	},
 }
@@ -0,0 +1,11 @@
+provider "aws" {
+  alias = "west"
+}
+
+module "child" {
+  source = "child"
+
+  providers = {
+    aws.west = aws.west
+  }
+}
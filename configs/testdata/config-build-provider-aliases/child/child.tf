@@ -0,0 +1,8 @@
+terraform {
+  required_providers {
+    aws = {
+      version               = "1.0.0"
+      configuration_aliases = [aws.east]
+    }
+  }
+}
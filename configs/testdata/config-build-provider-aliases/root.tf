@@ -0,0 +1,15 @@
+provider "aws" {
+}
+
+provider "aws" {
+  alias = "east"
+}
+
+module "child" {
+  source = "child"
+
+  providers = {
+    aws      = aws
+    aws.east = aws.east
+  }
+}
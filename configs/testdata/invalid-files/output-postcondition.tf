@@ -0,0 +1,9 @@
+output "foo" {
+  value = "bar"
+
+  # Output values can have preconditions, but not postconditions.
+  postcondition {
+    condition     = true
+    error_message = "Impossible."
+  }
+}
@@ -0,0 +1,7 @@
+resource "aws_instance" "web" {
+  lifecycle {
+    timeouts {
+      create = "not-a-duration" # ERROR: Invalid timeout value
+    }
+  }
+}
@@ -1,8 +1,3 @@
-
-terraform {
-  experiments = [variable_validation]
-}
-
 locals {
   foo = 1
 }
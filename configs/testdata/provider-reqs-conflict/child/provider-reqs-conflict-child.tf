@@ -0,0 +1,5 @@
+terraform {
+  required_providers {
+    null = "2.0.1"
+  }
+}
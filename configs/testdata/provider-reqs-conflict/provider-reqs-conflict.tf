@@ -0,0 +1,9 @@
+terraform {
+  required_providers {
+    null = "2.0.0"
+  }
+}
+
+module "child" {
+  source = "./child"
+}
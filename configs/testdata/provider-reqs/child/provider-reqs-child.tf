@@ -0,0 +1,9 @@
+terraform {
+  required_providers {
+    null = ">= 2.0.1"
+  }
+}
+
+data "template_file" "conf" {
+  template = "irrelevant"
+}
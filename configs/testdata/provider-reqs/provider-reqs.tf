@@ -0,0 +1,17 @@
+terraform {
+  required_providers {
+    null   = "~> 2.0.0"
+    random = ">= 1.2.0, < 2.0.0"
+  }
+}
+
+provider "tls" {
+  version = "~> 3.0.1"
+}
+
+resource "aws_instance" "web" {
+}
+
+module "child" {
+  source = "./child"
+}
@@ -0,0 +1,23 @@
+resource "aws_instance" "web" {
+  ami = "ami-1234"
+
+  lifecycle {
+    precondition {
+      condition     = length(var.ami_ids) > 0
+      error_message = "At least one AMI id must be provided."
+    }
+    postcondition {
+      condition     = self.private_dns != ""
+      error_message = "Server must be in a VPC."
+    }
+  }
+}
+
+output "instance_ip_addr" {
+  value = aws_instance.web.private_ip
+
+  precondition {
+    condition     = aws_instance.web.tags["Environment"] == "production"
+    error_message = "Only production instances may be exposed."
+  }
+}
@@ -0,0 +1,11 @@
+resource "aws_instance" "web" {
+  ami = "ami-1234"
+
+  lifecycle {
+    timeouts {
+      create = "10m"
+      update = "5m"
+      delete = "30m"
+    }
+  }
+}
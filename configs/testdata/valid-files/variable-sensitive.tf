@@ -0,0 +1,9 @@
+variable "credentials" {
+  type      = string
+  sensitive = true
+}
+
+variable "not_secret" {
+  type      = string
+  sensitive = false
+}
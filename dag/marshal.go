@@ -1,6 +1,7 @@
 package dag
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"sort"
@@ -190,6 +191,14 @@ func newMarshalGraph(name string, g *Graph) *marshalGraph {
 	return mg
 }
 
+// MarshalJSON returns a JSON representation of the graph using the same
+// structure as the Dot rendering: vertices, edges, any subgraphs, and any
+// detected cycles. This is intended for debugging tools that want to
+// process a graph programmatically rather than visualize it.
+func (g *Graph) MarshalJSON() ([]byte, error) {
+	return json.MarshalIndent(newMarshalGraph("", g), "", "  ")
+}
+
 // Attempt to return a unique ID for any vertex.
 func marshalVertexID(v Vertex) string {
 	val := reflect.ValueOf(v)
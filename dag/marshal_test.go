@@ -1,10 +1,48 @@
 package dag
 
 import (
+	"encoding/json"
 	"strings"
 	"testing"
 )
 
+func TestGraphMarshalJSON(t *testing.T) {
+	var g Graph
+	g.Add(1)
+	g.Add(2)
+	g.Add(3)
+	g.Connect(BasicEdge(1, 3))
+
+	j, err := g.MarshalJSON()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	var decoded struct {
+		Type     string
+		Vertices []struct {
+			Name string
+		}
+		Edges []struct {
+			Source string
+			Target string
+		}
+	}
+	if err := json.Unmarshal(j, &decoded); err != nil {
+		t.Fatalf("invalid JSON produced: %s", err)
+	}
+
+	if decoded.Type != "Graph" {
+		t.Errorf("wrong type %q; want %q", decoded.Type, "Graph")
+	}
+	if len(decoded.Vertices) != 3 {
+		t.Errorf("wrong number of vertices %d; want 3", len(decoded.Vertices))
+	}
+	if len(decoded.Edges) != 1 {
+		t.Errorf("wrong number of edges %d; want 1", len(decoded.Edges))
+	}
+}
+
 func TestGraphDot_empty(t *testing.T) {
 	var g Graph
 	g.Add(1)
@@ -140,3 +140,9 @@ require (
 )
 
 go 1.12
+
+// Our fork of HCL carries backported support for cty value marks, so that
+// expressions involving Terraform's sensitive values can be evaluated
+// without panicking. The fork applies in both vendored and module-mode
+// builds, where direct edits to the vendor directory would not.
+replace github.com/hashicorp/hcl/v2 => ./internal/hcl2
@@ -1,6 +1,12 @@
 package earlyconfig
 
 import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/hashicorp/terraform-config-inspect/tfconfig"
 	"github.com/hashicorp/terraform/tfdiags"
 )
@@ -9,5 +15,71 @@ import (
 // directory.
 func LoadModule(dir string) (*tfconfig.Module, tfdiags.Diagnostics) {
 	mod, diags := tfconfig.LoadModule(dir)
+	diags = suppressRequiredProvidersDiags(dir, diags)
 	return mod, wrapDiagnostics(diags)
 }
+
+// suppressRequiredProvidersDiags drops diagnostics that point inside a
+// required_providers block. The early configuration parser predates some of
+// the extended required_providers syntax, such as configuration_aliases,
+// whose bare provider configuration references it misreports as evaluation
+// errors. The main configuration loader decodes these blocks in full, so any
+// genuine problems in them are still caught.
+func suppressRequiredProvidersDiags(dir string, diags tfconfig.Diagnostics) tfconfig.Diagnostics {
+	if len(diags) == 0 {
+		return diags
+	}
+
+	// Locate the source ranges of any required_providers blocks so we can
+	// recognize diagnostics that fall within them. If anything goes wrong
+	// here we just return the diagnostics unfiltered, since this is only
+	// a cosmetic fixup for a best-effort parser.
+	var ranges []hcl.Range
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return diags
+	}
+	for _, info := range entries {
+		name := info.Name()
+		if info.IsDir() || !strings.HasSuffix(name, ".tf") {
+			continue
+		}
+		path := filepath.Join(dir, name)
+		src, err := ioutil.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		f, _ := hclsyntax.ParseConfig(src, path, hcl.InitialPos)
+		body, ok := f.Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+		for _, block := range body.Blocks {
+			if block.Type != "terraform" {
+				continue
+			}
+			for _, inner := range block.Body.Blocks {
+				if inner.Type == "required_providers" {
+					ranges = append(ranges, hcl.RangeBetween(inner.DefRange(), inner.CloseBraceRange))
+				}
+			}
+		}
+	}
+	if len(ranges) == 0 {
+		return diags
+	}
+
+	ret := make(tfconfig.Diagnostics, 0, len(diags))
+Diags:
+	for _, diag := range diags {
+		if diag.Pos != nil {
+			for _, rng := range ranges {
+				if diag.Pos.Filename == rng.Filename && diag.Pos.Line >= rng.Start.Line && diag.Pos.Line <= rng.End.Line {
+					continue Diags
+				}
+			}
+		}
+		ret = append(ret, diag)
+	}
+	return ret
+}
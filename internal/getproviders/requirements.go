@@ -0,0 +1,69 @@
+package getproviders
+
+import (
+	"strings"
+
+	"github.com/apparentlymart/go-versions/versions/constraints"
+	"github.com/hashicorp/terraform/addrs"
+)
+
+// Requirements gathers together requirements for many different providers
+// into a single data structure, as a convenient way to represent the full
+// set of requirements for a particular configuration or state or both.
+//
+// If an entry in a Requirements has a zero-length VersionConstraints then
+// that indicates that the provider is required but that any version is
+// acceptable.
+type Requirements map[addrs.Provider]VersionConstraints
+
+// Merge takes the requirements in the receiver and the requirements in the
+// other given value and produces a new value that combines all of the
+// requirements of both.
+//
+// The resulting requirements will permit only selections that both of the
+// source requirements would've allowed.
+func (r Requirements) Merge(other Requirements) Requirements {
+	ret := make(Requirements)
+	for addr, constraints := range r {
+		ret[addr] = constraints
+	}
+	for addr, constraints := range other {
+		ret[addr] = append(ret[addr], constraints...)
+	}
+	return ret
+}
+
+// VersionConstraintsString returns a canonical string representation of
+// a VersionConstraints value, of the sort that a user might write in
+// configuration and that ParseVersionConstraints would accept.
+func VersionConstraintsString(spec VersionConstraints) string {
+	var b strings.Builder
+	sep := ""
+	for _, sel := range spec {
+		b.WriteString(sep)
+		switch sel.Operator {
+		case constraints.OpGreaterThan:
+			b.WriteString("> ")
+		case constraints.OpLessThan:
+			b.WriteString("< ")
+		case constraints.OpGreaterThanOrEqual:
+			b.WriteString(">= ")
+		case constraints.OpGreaterThanOrEqualPatchOnly, constraints.OpGreaterThanOrEqualMinorOnly:
+			b.WriteString("~> ")
+		case constraints.OpLessThanOrEqual:
+			b.WriteString("<= ")
+		case constraints.OpEqual:
+			// Pragmatically we treat "=" as the default operator and just
+			// print the boundary version alone.
+		case constraints.OpNotEqual:
+			b.WriteString("!= ")
+		default:
+			// The above covers all of the operators the ruby-style
+			// constraint syntax can produce, so we should not get here.
+			b.WriteString("??? ")
+		}
+		b.WriteString(sel.Boundary.String())
+		sep = ", "
+	}
+	return b.String()
+}
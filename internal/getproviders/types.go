@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/apparentlymart/go-versions/versions"
+	"github.com/apparentlymart/go-versions/versions/constraints"
 	"github.com/hashicorp/terraform/addrs"
 )
 
@@ -24,6 +25,28 @@ func ParseVersion(str string) (Version, error) {
 	return versions.ParseVersion(str)
 }
 
+// VersionConstraints represents a set of constraints on acceptable versions
+// of a provider, which a caller can use to filter the full set of available
+// versions down to those that are acceptable.
+type VersionConstraints = constraints.IntersectionSpec
+
+// ParseVersionConstraints parses a "Ruby-like" version constraint string
+// into a VersionConstraints value, which is the constraint syntax we use
+// for provider version constraints.
+func ParseVersionConstraints(str string) (VersionConstraints, error) {
+	return constraints.ParseRubyStyleMulti(str)
+}
+
+// MustParseVersionConstraints is a variant of ParseVersionConstraints that
+// panics if it encounters an error while parsing.
+func MustParseVersionConstraints(str string) VersionConstraints {
+	ret, err := ParseVersionConstraints(str)
+	if err != nil {
+		panic(err)
+	}
+	return ret
+}
+
 // Platform represents a target platform that a provider is or might be
 // available for.
 type Platform struct {
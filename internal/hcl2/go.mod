@@ -0,0 +1,26 @@
+// This is Terraform's fork of HCL v2.3.0, wired in through a replace
+// directive in the main module's go.mod. It carries backported support for
+// cty value marks in expression evaluation (hclsyntax and the index/attr
+// operations), so that Terraform's sensitive values can flow through
+// templates and other expressions without panicking.
+module github.com/hashicorp/hcl/v2
+
+require (
+	github.com/agext/levenshtein v1.2.1
+	github.com/apparentlymart/go-dump v0.0.0-20180507223929-23540a00eaa3
+	github.com/apparentlymart/go-textseg v1.0.0
+	github.com/davecgh/go-spew v1.1.1
+	github.com/go-test/deep v1.0.3
+	github.com/google/go-cmp v0.3.1
+	github.com/kr/pretty v0.1.0
+	github.com/kylelemons/godebug v0.0.0-20170820004349-d65d576e9348
+	github.com/mitchellh/go-wordwrap v0.0.0-20150314170334-ad45545899c7
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/sergi/go-diff v1.0.0
+	github.com/spf13/pflag v1.0.2
+	github.com/stretchr/testify v1.2.2 // indirect
+	github.com/zclconf/go-cty v1.2.0
+	golang.org/x/crypto v0.0.0-20190426145343-a29dc8fdc734
+	golang.org/x/sys v0.0.0-20190502175342-a43fa875dd82 // indirect
+	golang.org/x/text v0.3.2 // indirect
+)
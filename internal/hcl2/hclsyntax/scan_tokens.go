@@ -0,0 +1,5265 @@
+//line scan_tokens.rl:1
+
+package hclsyntax
+
+import (
+	"bytes"
+
+	"github.com/hashicorp/hcl/v2"
+)
+
+// This file is generated from scan_tokens.rl. DO NOT EDIT.
+
+//line scan_tokens.go:15
+var _hcltok_actions []byte = []byte{
+	0, 1, 0, 1, 1, 1, 3, 1, 4,
+	1, 7, 1, 8, 1, 9, 1, 10,
+	1, 11, 1, 12, 1, 13, 1, 14,
+	1, 15, 1, 16, 1, 17, 1, 18,
+	1, 19, 1, 20, 1, 23, 1, 24,
+	1, 25, 1, 26, 1, 27, 1, 28,
+	1, 29, 1, 30, 1, 31, 1, 32,
+	1, 35, 1, 36, 1, 37, 1, 38,
+	1, 39, 1, 40, 1, 41, 1, 42,
+	1, 43, 1, 44, 1, 47, 1, 48,
+	1, 49, 1, 50, 1, 51, 1, 52,
+	1, 53, 1, 56, 1, 57, 1, 58,
+	1, 59, 1, 60, 1, 61, 1, 62,
+	1, 63, 1, 64, 1, 65, 1, 66,
+	1, 67, 1, 68, 1, 69, 1, 70,
+	1, 71, 1, 72, 1, 73, 1, 74,
+	1, 75, 1, 76, 1, 77, 1, 78,
+	1, 79, 1, 80, 1, 81, 1, 82,
+	1, 83, 1, 84, 1, 85, 2, 0,
+	14, 2, 0, 25, 2, 0, 29, 2,
+	0, 37, 2, 0, 41, 2, 1, 2,
+	2, 4, 5, 2, 4, 6, 2, 4,
+	21, 2, 4, 22, 2, 4, 33, 2,
+	4, 34, 2, 4, 45, 2, 4, 46,
+	2, 4, 54, 2, 4, 55,
+}
+
+var _hcltok_key_offsets []int16 = []int16{
+	0, 0, 1, 2, 4, 9, 13, 15,
+	57, 98, 144, 145, 149, 155, 155, 157,
+	159, 168, 174, 181, 182, 185, 186, 190,
+	195, 204, 208, 212, 220, 222, 224, 226,
+	229, 261, 263, 265, 269, 273, 276, 287,
+	300, 319, 332, 348, 360, 376, 391, 412,
+	422, 434, 445, 459, 474, 484, 496, 505,
+	517, 519, 523, 544, 553, 563, 569, 575,
+	576, 625, 627, 631, 633, 639, 646, 654,
+	661, 664, 670, 674, 678, 680, 684, 688,
+	692, 698, 706, 714, 720, 722, 726, 728,
+	734, 738, 742, 746, 750, 755, 762, 768,
+	770, 772, 776, 778, 784, 788, 792, 802,
+	807, 821, 836, 838, 846, 848, 853, 867,
+	872, 874, 878, 879, 883, 889, 895, 905,
+	915, 926, 934, 937, 940, 944, 948, 950,
+	953, 953, 956, 958, 988, 990, 992, 996,
+	1001, 1005, 1010, 1012, 1014, 1016, 1025, 1029,
+	1033, 1039, 1041, 1049, 1057, 1069, 1072, 1078,
+	1082, 1084, 1088, 1108, 1110, 1112, 1123, 1129,
+	1131, 1133, 1135, 1139, 1145, 1151, 1153, 1158,
+	1162, 1164, 1172, 1190, 1230, 1240, 1244, 1246,
+	1248, 1249, 1253, 1257, 1261, 1265, 1269, 1274,
+	1278, 1282, 1286, 1288, 1290, 1294, 1304, 1308,
+	1310, 1314, 1318, 1322, 1335, 1337, 1339, 1343,
+	1345, 1349, 1351, 1353, 1383, 1387, 1391, 1395,
+	1398, 1405, 1410, 1421, 1425, 1441, 1455, 1459,
+	1464, 1468, 1472, 1478, 1480, 1486, 1488, 1492,
+	1494, 1500, 1505, 1510, 1520, 1522, 1524, 1528,
+	1532, 1534, 1547, 1549, 1553, 1557, 1565, 1567,
+	1571, 1573, 1574, 1577, 1582, 1584, 1586, 1590,
+	1592, 1596, 1602, 1622, 1628, 1634, 1636, 1637,
+	1647, 1648, 1656, 1663, 1665, 1668, 1670, 1672,
+	1674, 1679, 1683, 1687, 1692, 1702, 1712, 1716,
+	1720, 1734, 1760, 1770, 1772, 1774, 1777, 1779,
+	1782, 1784, 1788, 1790, 1791, 1795, 1797, 1800,
+	1807, 1815, 1817, 1819, 1823, 1825, 1831, 1842,
+	1845, 1847, 1851, 1856, 1886, 1891, 1893, 1896,
+	1901, 1915, 1922, 1936, 1941, 1954, 1958, 1971,
+	1976, 1994, 1995, 2004, 2008, 2020, 2025, 2032,
+	2039, 2046, 2048, 2052, 2074, 2079, 2080, 2084,
+	2086, 2136, 2139, 2150, 2154, 2156, 2162, 2168,
+	2170, 2175, 2177, 2181, 2183, 2184, 2186, 2188,
+	2194, 2196, 2198, 2202, 2208, 2221, 2223, 2229,
+	2233, 2241, 2252, 2260, 2263, 2293, 2299, 2302,
+	2307, 2309, 2313, 2317, 2321, 2323, 2330, 2332,
+	2341, 2348, 2356, 2358, 2378, 2390, 2394, 2396,
+	2414, 2453, 2455, 2459, 2461, 2468, 2472, 2500,
+	2502, 2504, 2506, 2508, 2511, 2513, 2517, 2521,
+	2523, 2526, 2528, 2530, 2533, 2535, 2537, 2538,
+	2540, 2542, 2546, 2550, 2553, 2566, 2568, 2574,
+	2578, 2580, 2584, 2588, 2602, 2605, 2614, 2616,
+	2620, 2626, 2626, 2628, 2630, 2639, 2645, 2652,
+	2653, 2656, 2657, 2661, 2666, 2675, 2679, 2683,
+	2691, 2693, 2695, 2697, 2700, 2732, 2734, 2736,
+	2740, 2744, 2747, 2758, 2771, 2790, 2803, 2819,
+	2831, 2847, 2862, 2883, 2893, 2905, 2916, 2930,
+	2945, 2955, 2967, 2976, 2988, 2990, 2994, 3015,
+	3024, 3034, 3040, 3046, 3047, 3096, 3098, 3102,
+	3104, 3110, 3117, 3125, 3132, 3135, 3141, 3145,
+	3149, 3151, 3155, 3159, 3163, 3169, 3177, 3185,
+	3191, 3193, 3197, 3199, 3205, 3209, 3213, 3217,
+	3221, 3226, 3233, 3239, 3241, 3243, 3247, 3249,
+	3255, 3259, 3263, 3273, 3278, 3292, 3307, 3309,
+	3317, 3319, 3324, 3338, 3343, 3345, 3349, 3350,
+	3354, 3360, 3366, 3376, 3386, 3397, 3405, 3408,
+	3411, 3415, 3419, 3421, 3424, 3424, 3427, 3429,
+	3459, 3461, 3463, 3467, 3472, 3476, 3481, 3483,
+	3485, 3487, 3496, 3500, 3504, 3510, 3512, 3520,
+	3528, 3540, 3543, 3549, 3553, 3555, 3559, 3579,
+	3581, 3583, 3594, 3600, 3602, 3604, 3606, 3610,
+	3616, 3622, 3624, 3629, 3633, 3635, 3643, 3661,
+	3701, 3711, 3715, 3717, 3719, 3720, 3724, 3728,
+	3732, 3736, 3740, 3745, 3749, 3753, 3757, 3759,
+	3761, 3765, 3775, 3779, 3781, 3785, 3789, 3793,
+	3806, 3808, 3810, 3814, 3816, 3820, 3822, 3824,
+	3854, 3858, 3862, 3866, 3869, 3876, 3881, 3892,
+	3896, 3912, 3926, 3930, 3935, 3939, 3943, 3949,
+	3951, 3957, 3959, 3963, 3965, 3971, 3976, 3981,
+	3991, 3993, 3995, 3999, 4003, 4005, 4018, 4020,
+	4024, 4028, 4036, 4038, 4042, 4044, 4045, 4048,
+	4053, 4055, 4057, 4061, 4063, 4067, 4073, 4093,
+	4099, 4105, 4107, 4108, 4118, 4119, 4127, 4134,
+	4136, 4139, 4141, 4143, 4145, 4150, 4154, 4158,
+	4163, 4173, 4183, 4187, 4191, 4205, 4231, 4241,
+	4243, 4245, 4248, 4250, 4253, 4255, 4259, 4261,
+	4262, 4266, 4268, 4270, 4277, 4281, 4288, 4295,
+	4304, 4320, 4332, 4350, 4361, 4373, 4381, 4399,
+	4407, 4437, 4440, 4450, 4460, 4472, 4483, 4492,
+	4505, 4517, 4521, 4527, 4554, 4563, 4566, 4571,
+	4577, 4582, 4603, 4607, 4613, 4613, 4620, 4629,
+	4637, 4640, 4644, 4650, 4656, 4659, 4663, 4670,
+	4676, 4685, 4694, 4698, 4702, 4706, 4710, 4717,
+	4721, 4725, 4735, 4741, 4745, 4751, 4755, 4758,
+	4764, 4770, 4782, 4786, 4790, 4800, 4804, 4815,
+	4817, 4819, 4823, 4835, 4840, 4864, 4868, 4874,
+	4896, 4905, 4909, 4912, 4913, 4921, 4929, 4935,
+	4945, 4952, 4970, 4973, 4976, 4984, 4990, 4994,
+	4998, 5002, 5008, 5016, 5021, 5027, 5031, 5039,
+	5046, 5050, 5057, 5063, 5071, 5079, 5085, 5091,
+	5102, 5106, 5118, 5127, 5144, 5161, 5164, 5168,
+	5170, 5176, 5178, 5182, 5197, 5201, 5205, 5209,
+	5213, 5217, 5219, 5225, 5230, 5234, 5240, 5247,
+	5250, 5268, 5270, 5315, 5321, 5327, 5331, 5335,
+	5341, 5345, 5351, 5357, 5364, 5366, 5372, 5378,
+	5382, 5386, 5394, 5407, 5413, 5420, 5428, 5434,
+	5443, 5449, 5453, 5458, 5462, 5470, 5474, 5478,
+	5508, 5514, 5520, 5526, 5532, 5539, 5545, 5552,
+	5557, 5567, 5571, 5578, 5584, 5588, 5595, 5599,
+	5605, 5608, 5612, 5616, 5620, 5624, 5629, 5634,
+	5638, 5649, 5653, 5657, 5663, 5671, 5675, 5692,
+	5696, 5702, 5712, 5718, 5724, 5727, 5732, 5741,
+	5745, 5749, 5755, 5759, 5765, 5773, 5791, 5792,
+	5802, 5803, 5812, 5820, 5822, 5825, 5827, 5829,
+	5831, 5836, 5849, 5853, 5868, 5897, 5908, 5910,
+	5914, 5918, 5923, 5927, 5929, 5936, 5940, 5948,
+	5952, 5964, 5966, 5968, 5970, 5972, 5974, 5975,
+	5977, 5979, 5981, 5983, 5985, 5986, 5988, 5990,
+	5992, 5994, 5996, 6000, 6006, 6006, 6008, 6010,
+	6019, 6025, 6032, 6033, 6036, 6037, 6041, 6046,
+	6055, 6059, 6063, 6071, 6073, 6075, 6077, 6080,
+	6112, 6114, 6116, 6120, 6124, 6127, 6138, 6151,
+	6170, 6183, 6199, 6211, 6227, 6242, 6263, 6273,
+	6285, 6296, 6310, 6325, 6335, 6347, 6356, 6368,
+	6370, 6374, 6395, 6404, 6414, 6420, 6426, 6427,
+	6476, 6478, 6482, 6484, 6490, 6497, 6505, 6512,
+	6515, 6521, 6525, 6529, 6531, 6535, 6539, 6543,
+	6549, 6557, 6565, 6571, 6573, 6577, 6579, 6585,
+	6589, 6593, 6597, 6601, 6606, 6613, 6619, 6621,
+	6623, 6627, 6629, 6635, 6639, 6643, 6653, 6658,
+	6672, 6687, 6689, 6697, 6699, 6704, 6718, 6723,
+	6725, 6729, 6730, 6734, 6740, 6746, 6756, 6766,
+	6777, 6785, 6788, 6791, 6795, 6799, 6801, 6804,
+	6804, 6807, 6809, 6839, 6841, 6843, 6847, 6852,
+	6856, 6861, 6863, 6865, 6867, 6876, 6880, 6884,
+	6890, 6892, 6900, 6908, 6920, 6923, 6929, 6933,
+	6935, 6939, 6959, 6961, 6963, 6974, 6980, 6982,
+	6984, 6986, 6990, 6996, 7002, 7004, 7009, 7013,
+	7015, 7023, 7041, 7081, 7091, 7095, 7097, 7099,
+	7100, 7104, 7108, 7112, 7116, 7120, 7125, 7129,
+	7133, 7137, 7139, 7141, 7145, 7155, 7159, 7161,
+	7165, 7169, 7173, 7186, 7188, 7190, 7194, 7196,
+	7200, 7202, 7204, 7234, 7238, 7242, 7246, 7249,
+	7256, 7261, 7272, 7276, 7292, 7306, 7310, 7315,
+	7319, 7323, 7329, 7331, 7337, 7339, 7343, 7345,
+	7351, 7356, 7361, 7371, 7373, 7375, 7379, 7383,
+	7385, 7398, 7400, 7404, 7408, 7416, 7418, 7422,
+	7424, 7425, 7428, 7433, 7435, 7437, 7441, 7443,
+	7447, 7453, 7473, 7479, 7485, 7487, 7488, 7498,
+	7499, 7507, 7514, 7516, 7519, 7521, 7523, 7525,
+	7530, 7534, 7538, 7543, 7553, 7563, 7567, 7571,
+	7585, 7611, 7621, 7623, 7625, 7628, 7630, 7633,
+	7635, 7639, 7641, 7642, 7646, 7648, 7650, 7657,
+	7661, 7668, 7675, 7684, 7700, 7712, 7730, 7741,
+	7753, 7761, 7779, 7787, 7817, 7820, 7830, 7840,
+	7852, 7863, 7872, 7885, 7897, 7901, 7907, 7934,
+	7943, 7946, 7951, 7957, 7962, 7983, 7987, 7993,
+	7993, 8000, 8009, 8017, 8020, 8024, 8030, 8036,
+	8039, 8043, 8050, 8056, 8065, 8074, 8078, 8082,
+	8086, 8090, 8097, 8101, 8105, 8115, 8121, 8125,
+	8131, 8135, 8138, 8144, 8150, 8162, 8166, 8170,
+	8180, 8184, 8195, 8197, 8199, 8203, 8215, 8220,
+	8244, 8248, 8254, 8276, 8285, 8289, 8292, 8293,
+	8301, 8309, 8315, 8325, 8332, 8350, 8353, 8356,
+	8364, 8370, 8374, 8378, 8382, 8388, 8396, 8401,
+	8407, 8411, 8419, 8426, 8430, 8437, 8443, 8451,
+	8459, 8465, 8471, 8482, 8486, 8498, 8507, 8524,
+	8541, 8544, 8548, 8550, 8556, 8558, 8562, 8577,
+	8581, 8585, 8589, 8593, 8597, 8599, 8605, 8610,
+	8614, 8620, 8627, 8630, 8648, 8650, 8695, 8701,
+	8707, 8711, 8715, 8721, 8725, 8731, 8737, 8744,
+	8746, 8752, 8758, 8762, 8766, 8774, 8787, 8793,
+	8800, 8808, 8814, 8823, 8829, 8833, 8838, 8842,
+	8850, 8854, 8858, 8888, 8894, 8900, 8906, 8912,
+	8919, 8925, 8932, 8937, 8947, 8951, 8958, 8964,
+	8968, 8975, 8979, 8985, 8988, 8992, 8996, 9000,
+	9004, 9009, 9014, 9018, 9029, 9033, 9037, 9043,
+	9051, 9055, 9072, 9076, 9082, 9092, 9098, 9104,
+	9107, 9112, 9121, 9125, 9129, 9135, 9139, 9145,
+	9153, 9171, 9172, 9182, 9183, 9192, 9200, 9202,
+	9205, 9207, 9209, 9211, 9216, 9229, 9233, 9248,
+	9277, 9288, 9290, 9294, 9298, 9303, 9307, 9309,
+	9316, 9320, 9328, 9332, 9407, 9409, 9410, 9411,
+	9412, 9413, 9414, 9416, 9421, 9423, 9425, 9426,
+	9470, 9471, 9472, 9474, 9479, 9483, 9483, 9485,
+	9487, 9498, 9508, 9516, 9517, 9519, 9520, 9524,
+	9528, 9538, 9542, 9549, 9560, 9567, 9571, 9577,
+	9588, 9620, 9669, 9684, 9699, 9704, 9706, 9711,
+	9743, 9751, 9753, 9775, 9797, 9799, 9815, 9831,
+	9833, 9835, 9835, 9836, 9837, 9838, 9840, 9841,
+	9853, 9855, 9857, 9859, 9873, 9887, 9889, 9892,
+	9895, 9897, 9898, 9899, 9901, 9903, 9905, 9919,
+	9933, 9935, 9938, 9941, 9943, 9944, 9945, 9947,
+	9949, 9951, 10000, 10044, 10046, 10051, 10055, 10055,
+	10057, 10059, 10070, 10080, 10088, 10089, 10091, 10092,
+	10096, 10100, 10110, 10114, 10121, 10132, 10139, 10143,
+	10149, 10160, 10192, 10241, 10256, 10271, 10276, 10278,
+	10283, 10315, 10323, 10325, 10347, 10369,
+}
+
+var _hcltok_trans_keys []byte = []byte{
+	46, 42, 42, 47, 46, 69, 101, 48,
+	57, 43, 45, 48, 57, 48, 57, 45,
+	95, 194, 195, 198, 199, 203, 205, 206,
+	207, 210, 212, 213, 214, 215, 216, 217,
+	219, 220, 221, 222, 223, 224, 225, 226,
+	227, 228, 233, 234, 237, 239, 240, 65,
+	90, 97, 122, 196, 202, 208, 218, 229,
+	236, 95, 194, 195, 198, 199, 203, 205,
+	206, 207, 210, 212, 213, 214, 215, 216,
+	217, 219, 220, 221, 222, 223, 224, 225,
+	226, 227, 228, 233, 234, 237, 239, 240,
+	65, 90, 97, 122, 196, 202, 208, 218,
+	229, 236, 10, 13, 45, 95, 194, 195,
+	198, 199, 203, 204, 205, 206, 207, 210,
+	212, 213, 214, 215, 216, 217, 219, 220,
+	221, 222, 223, 224, 225, 226, 227, 228,
+	233, 234, 237, 239, 240, 243, 48, 57,
+	65, 90, 97, 122, 196, 218, 229, 236,
+	10, 170, 181, 183, 186, 128, 150, 152,
+	182, 184, 255, 192, 255, 128, 255, 173,
+	130, 133, 146, 159, 165, 171, 175, 255,
+	181, 190, 184, 185, 192, 255, 140, 134,
+	138, 142, 161, 163, 255, 182, 130, 136,
+	137, 176, 151, 152, 154, 160, 190, 136,
+	144, 192, 255, 135, 129, 130, 132, 133,
+	144, 170, 176, 178, 144, 154, 160, 191,
+	128, 169, 174, 255, 148, 169, 157, 158,
+	189, 190, 192, 255, 144, 255, 139, 140,
+	178, 255, 186, 128, 181, 160, 161, 162,
+	163, 164, 165, 166, 167, 168, 169, 170,
+	171, 172, 173, 174, 175, 176, 177, 178,
+	179, 180, 181, 182, 183, 184, 185, 186,
+	187, 188, 189, 190, 191, 128, 173, 128,
+	155, 160, 180, 182, 189, 148, 161, 163,
+	255, 176, 164, 165, 132, 169, 177, 141,
+	142, 145, 146, 179, 181, 186, 187, 158,
+	133, 134, 137, 138, 143, 150, 152, 155,
+	164, 165, 178, 255, 188, 129, 131, 133,
+	138, 143, 144, 147, 168, 170, 176, 178,
+	179, 181, 182, 184, 185, 190, 255, 157,
+	131, 134, 137, 138, 142, 144, 146, 152,
+	159, 165, 182, 255, 129, 131, 133, 141,
+	143, 145, 147, 168, 170, 176, 178, 179,
+	181, 185, 188, 255, 134, 138, 142, 143,
+	145, 159, 164, 165, 176, 184, 186, 255,
+	129, 131, 133, 140, 143, 144, 147, 168,
+	170, 176, 178, 179, 181, 185, 188, 191,
+	177, 128, 132, 135, 136, 139, 141, 150,
+	151, 156, 157, 159, 163, 166, 175, 156,
+	130, 131, 133, 138, 142, 144, 146, 149,
+	153, 154, 158, 159, 163, 164, 168, 170,
+	174, 185, 190, 191, 144, 151, 128, 130,
+	134, 136, 138, 141, 166, 175, 128, 131,
+	133, 140, 142, 144, 146, 168, 170, 185,
+	189, 255, 133, 137, 151, 142, 148, 155,
+	159, 164, 165, 176, 255, 128, 131, 133,
+	140, 142, 144, 146, 168, 170, 179, 181,
+	185, 188, 191, 158, 128, 132, 134, 136,
+	138, 141, 149, 150, 160, 163, 166, 175,
+	177, 178, 129, 131, 133, 140, 142, 144,
+	146, 186, 189, 255, 133, 137, 143, 147,
+	152, 158, 164, 165, 176, 185, 192, 255,
+	189, 130, 131, 133, 150, 154, 177, 179,
+	187, 138, 150, 128, 134, 143, 148, 152,
+	159, 166, 175, 178, 179, 129, 186, 128,
+	142, 144, 153, 132, 138, 141, 165, 167,
+	129, 130, 135, 136, 148, 151, 153, 159,
+	161, 163, 170, 171, 173, 185, 187, 189,
+	134, 128, 132, 136, 141, 144, 153, 156,
+	159, 128, 181, 183, 185, 152, 153, 160,
+	169, 190, 191, 128, 135, 137, 172, 177,
+	191, 128, 132, 134, 151, 153, 188, 134,
+	128, 129, 130, 131, 137, 138, 139, 140,
+	141, 142, 143, 144, 153, 154, 155, 156,
+	157, 158, 159, 160, 161, 162, 163, 164,
+	165, 166, 167, 168, 169, 170, 173, 175,
+	176, 177, 178, 179, 181, 182, 183, 188,
+	189, 190, 191, 132, 152, 172, 184, 185,
+	187, 128, 191, 128, 137, 144, 255, 158,
+	159, 134, 187, 136, 140, 142, 143, 137,
+	151, 153, 142, 143, 158, 159, 137, 177,
+	142, 143, 182, 183, 191, 255, 128, 130,
+	133, 136, 150, 152, 255, 145, 150, 151,
+	155, 156, 160, 168, 178, 255, 128, 143,
+	160, 255, 182, 183, 190, 255, 129, 255,
+	173, 174, 192, 255, 129, 154, 160, 255,
+	171, 173, 185, 255, 128, 140, 142, 148,
+	160, 180, 128, 147, 160, 172, 174, 176,
+	178, 179, 148, 150, 152, 155, 158, 159,
+	170, 255, 139, 141, 144, 153, 160, 255,
+	184, 255, 128, 170, 176, 255, 182, 255,
+	128, 158, 160, 171, 176, 187, 134, 173,
+	176, 180, 128, 171, 176, 255, 138, 143,
+	155, 255, 128, 155, 160, 255, 159, 189,
+	190, 192, 255, 167, 128, 137, 144, 153,
+	176, 189, 140, 143, 154, 170, 180, 255,
+	180, 255, 128, 183, 128, 137, 141, 189,
+	128, 136, 144, 146, 148, 182, 184, 185,
+	128, 181, 187, 191, 150, 151, 158, 159,
+	152, 154, 156, 158, 134, 135, 142, 143,
+	190, 255, 190, 128, 180, 182, 188, 130,
+	132, 134, 140, 144, 147, 150, 155, 160,
+	172, 178, 180, 182, 188, 128, 129, 130,
+	131, 132, 133, 134, 176, 177, 178, 179,
+	180, 181, 182, 183, 191, 255, 129, 147,
+	149, 176, 178, 190, 192, 255, 144, 156,
+	161, 144, 156, 165, 176, 130, 135, 149,
+	164, 166, 168, 138, 147, 152, 157, 170,
+	185, 188, 191, 142, 133, 137, 160, 255,
+	137, 255, 128, 174, 176, 255, 159, 165,
+	170, 180, 255, 167, 173, 128, 165, 176,
+	255, 168, 174, 176, 190, 192, 255, 128,
+	150, 160, 166, 168, 174, 176, 182, 184,
+	190, 128, 134, 136, 142, 144, 150, 152,
+	158, 160, 191, 128, 129, 130, 131, 132,
+	133, 134, 135, 144, 145, 255, 133, 135,
+	161, 175, 177, 181, 184, 188, 160, 151,
+	152, 187, 192, 255, 133, 173, 177, 255,
+	143, 159, 187, 255, 176, 191, 182, 183,
+	184, 191, 192, 255, 150, 255, 128, 146,
+	147, 148, 152, 153, 154, 155, 156, 158,
+	159, 160, 161, 162, 163, 164, 165, 166,
+	167, 168, 169, 170, 171, 172, 173, 174,
+	175, 176, 129, 255, 141, 255, 144, 189,
+	141, 143, 172, 255, 191, 128, 175, 180,
+	189, 151, 159, 162, 255, 175, 137, 138,
+	184, 255, 183, 255, 168, 255, 128, 179,
+	188, 134, 143, 154, 159, 184, 186, 190,
+	255, 128, 173, 176, 255, 148, 159, 189,
+	255, 129, 142, 154, 159, 191, 255, 128,
+	182, 128, 141, 144, 153, 160, 182, 186,
+	255, 128, 130, 155, 157, 160, 175, 178,
+	182, 129, 134, 137, 142, 145, 150, 160,
+	166, 168, 174, 176, 255, 155, 166, 175,
+	128, 170, 172, 173, 176, 185, 158, 159,
+	160, 255, 164, 175, 135, 138, 188, 255,
+	164, 169, 171, 172, 173, 174, 175, 180,
+	181, 182, 183, 184, 185, 187, 188, 189,
+	190, 191, 165, 186, 174, 175, 154, 255,
+	190, 128, 134, 147, 151, 157, 168, 170,
+	182, 184, 188, 128, 129, 131, 132, 134,
+	255, 147, 255, 190, 255, 144, 145, 136,
+	175, 188, 255, 128, 143, 160, 175, 179,
+	180, 141, 143, 176, 180, 182, 255, 189,
+	255, 191, 144, 153, 161, 186, 129, 154,
+	166, 255, 191, 255, 130, 135, 138, 143,
+	146, 151, 154, 156, 144, 145, 146, 147,
+	148, 150, 151, 152, 155, 157, 158, 160,
+	170, 171, 172, 175, 161, 169, 128, 129,
+	130, 131, 133, 135, 138, 139, 140, 141,
+	142, 143, 144, 145, 146, 147, 148, 149,
+	152, 156, 157, 160, 161, 162, 163, 164,
+	166, 168, 169, 170, 171, 172, 173, 174,
+	176, 177, 153, 155, 178, 179, 128, 139,
+	141, 166, 168, 186, 188, 189, 191, 255,
+	142, 143, 158, 255, 187, 255, 128, 180,
+	189, 128, 156, 160, 255, 145, 159, 161,
+	255, 128, 159, 176, 255, 139, 143, 187,
+	255, 128, 157, 160, 255, 144, 132, 135,
+	150, 255, 158, 159, 170, 175, 148, 151,
+	188, 255, 128, 167, 176, 255, 164, 255,
+	183, 255, 128, 149, 160, 167, 136, 188,
+	128, 133, 138, 181, 183, 184, 191, 255,
+	150, 159, 183, 255, 128, 158, 160, 178,
+	180, 181, 128, 149, 160, 185, 128, 183,
+	190, 191, 191, 128, 131, 133, 134, 140,
+	147, 149, 151, 153, 179, 184, 186, 160,
+	188, 128, 156, 128, 135, 137, 166, 128,
+	181, 128, 149, 160, 178, 128, 145, 128,
+	178, 129, 130, 131, 132, 133, 135, 136,
+	138, 139, 140, 141, 144, 145, 146, 147,
+	150, 151, 152, 153, 154, 155, 156, 162,
+	163, 171, 176, 177, 178, 128, 134, 135,
+	165, 176, 190, 144, 168, 176, 185, 128,
+	180, 182, 191, 182, 144, 179, 155, 133,
+	137, 141, 143, 157, 255, 190, 128, 145,
+	147, 183, 136, 128, 134, 138, 141, 143,
+	157, 159, 168, 176, 255, 171, 175, 186,
+	255, 128, 131, 133, 140, 143, 144, 147,
+	168, 170, 176, 178, 179, 181, 185, 188,
+	191, 144, 151, 128, 132, 135, 136, 139,
+	141, 157, 163, 166, 172, 176, 180, 128,
+	138, 144, 153, 134, 136, 143, 154, 255,
+	128, 181, 184, 255, 129, 151, 158, 255,
+	129, 131, 133, 143, 154, 255, 128, 137,
+	128, 153, 157, 171, 176, 185, 160, 255,
+	170, 190, 192, 255, 128, 184, 128, 136,
+	138, 182, 184, 191, 128, 144, 153, 178,
+	255, 168, 144, 145, 183, 255, 128, 142,
+	145, 149, 129, 141, 144, 146, 147, 148,
+	175, 255, 132, 255, 128, 144, 129, 143,
+	144, 153, 145, 152, 135, 255, 160, 168,
+	169, 171, 172, 173, 174, 188, 189, 190,
+	191, 161, 167, 185, 255, 128, 158, 160,
+	169, 144, 173, 176, 180, 128, 131, 144,
+	153, 163, 183, 189, 255, 144, 255, 133,
+	143, 191, 255, 143, 159, 160, 128, 129,
+	255, 159, 160, 171, 172, 255, 173, 255,
+	179, 255, 128, 176, 177, 178, 128, 129,
+	171, 175, 189, 255, 128, 136, 144, 153,
+	157, 158, 133, 134, 137, 144, 145, 146,
+	147, 148, 149, 154, 155, 156, 157, 158,
+	159, 168, 169, 170, 150, 153, 165, 169,
+	173, 178, 187, 255, 131, 132, 140, 169,
+	174, 255, 130, 132, 149, 157, 173, 186,
+	188, 160, 161, 163, 164, 167, 168, 132,
+	134, 149, 157, 186, 139, 140, 191, 255,
+	134, 128, 132, 138, 144, 146, 255, 166,
+	167, 129, 155, 187, 149, 181, 143, 175,
+	137, 169, 131, 140, 141, 192, 255, 128,
+	182, 187, 255, 173, 180, 182, 255, 132,
+	155, 159, 161, 175, 128, 160, 163, 164,
+	165, 184, 185, 186, 161, 162, 128, 134,
+	136, 152, 155, 161, 163, 164, 166, 170,
+	133, 143, 151, 255, 139, 143, 154, 255,
+	164, 167, 185, 187, 128, 131, 133, 159,
+	161, 162, 169, 178, 180, 183, 130, 135,
+	137, 139, 148, 151, 153, 155, 157, 159,
+	164, 190, 141, 143, 145, 146, 161, 162,
+	167, 170, 172, 178, 180, 183, 185, 188,
+	128, 137, 139, 155, 161, 163, 165, 169,
+	171, 187, 155, 156, 151, 255, 156, 157,
+	160, 181, 255, 186, 187, 255, 162, 255,
+	160, 168, 161, 167, 158, 255, 160, 132,
+	135, 133, 134, 176, 255, 170, 181, 186,
+	191, 176, 180, 182, 183, 186, 189, 134,
+	140, 136, 138, 142, 161, 163, 255, 130,
+	137, 136, 255, 144, 170, 176, 178, 160,
+	191, 128, 138, 174, 175, 177, 255, 148,
+	150, 164, 167, 173, 176, 185, 189, 190,
+	192, 255, 144, 146, 175, 141, 255, 166,
+	176, 178, 255, 186, 138, 170, 180, 181,
+	160, 161, 162, 164, 165, 166, 167, 168,
+	169, 170, 171, 172, 173, 174, 175, 176,
+	177, 178, 179, 180, 181, 182, 184, 186,
+	187, 188, 189, 190, 183, 185, 154, 164,
+	168, 128, 149, 128, 152, 189, 132, 185,
+	144, 152, 161, 177, 255, 169, 177, 129,
+	132, 141, 142, 145, 146, 179, 181, 186,
+	188, 190, 255, 142, 156, 157, 159, 161,
+	176, 177, 133, 138, 143, 144, 147, 168,
+	170, 176, 178, 179, 181, 182, 184, 185,
+	158, 153, 156, 178, 180, 189, 133, 141,
+	143, 145, 147, 168, 170, 176, 178, 179,
+	181, 185, 144, 185, 160, 161, 189, 133,
+	140, 143, 144, 147, 168, 170, 176, 178,
+	179, 181, 185, 177, 156, 157, 159, 161,
+	131, 156, 133, 138, 142, 144, 146, 149,
+	153, 154, 158, 159, 163, 164, 168, 170,
+	174, 185, 144, 189, 133, 140, 142, 144,
+	146, 168, 170, 185, 152, 154, 160, 161,
+	128, 189, 133, 140, 142, 144, 146, 168,
+	170, 179, 181, 185, 158, 160, 161, 177,
+	178, 189, 133, 140, 142, 144, 146, 186,
+	142, 148, 150, 159, 161, 186, 191, 189,
+	133, 150, 154, 177, 179, 187, 128, 134,
+	129, 176, 178, 179, 132, 138, 141, 165,
+	167, 189, 129, 130, 135, 136, 148, 151,
+	153, 159, 161, 163, 170, 171, 173, 176,
+	178, 179, 134, 128, 132, 156, 159, 128,
+	128, 135, 137, 172, 136, 140, 128, 129,
+	130, 131, 137, 138, 139, 140, 141, 142,
+	143, 144, 153, 154, 155, 156, 157, 158,
+	159, 160, 161, 162, 163, 164, 165, 166,
+	167, 168, 169, 170, 172, 173, 174, 175,
+	176, 177, 178, 179, 180, 181, 182, 184,
+	188, 189, 190, 191, 132, 152, 185, 187,
+	191, 128, 170, 161, 144, 149, 154, 157,
+	165, 166, 174, 176, 181, 255, 130, 141,
+	143, 159, 155, 255, 128, 140, 142, 145,
+	160, 177, 128, 145, 160, 172, 174, 176,
+	151, 156, 170, 128, 168, 176, 255, 138,
+	255, 128, 150, 160, 255, 149, 255, 167,
+	133, 179, 133, 139, 131, 160, 174, 175,
+	186, 255, 166, 255, 128, 163, 141, 143,
+	154, 189, 169, 172, 174, 177, 181, 182,
+	129, 130, 132, 133, 134, 176, 177, 178,
+	179, 180, 181, 182, 183, 177, 191, 165,
+	170, 175, 177, 180, 255, 168, 174, 176,
+	255, 128, 134, 136, 142, 144, 150, 152,
+	158, 128, 129, 130, 131, 132, 133, 134,
+	135, 144, 145, 255, 133, 135, 161, 169,
+	177, 181, 184, 188, 160, 151, 154, 128,
+	146, 147, 148, 152, 153, 154, 155, 156,
+	158, 159, 160, 161, 162, 163, 164, 165,
+	166, 167, 168, 169, 170, 171, 172, 173,
+	174, 175, 176, 129, 255, 141, 143, 160,
+	169, 172, 255, 191, 128, 174, 130, 134,
+	139, 163, 255, 130, 179, 187, 189, 178,
+	183, 138, 165, 176, 255, 135, 159, 189,
+	255, 132, 178, 143, 160, 164, 166, 175,
+	186, 190, 128, 168, 186, 128, 130, 132,
+	139, 160, 182, 190, 255, 176, 178, 180,
+	183, 184, 190, 255, 128, 130, 155, 157,
+	160, 170, 178, 180, 128, 162, 164, 169,
+	171, 172, 173, 174, 175, 180, 181, 182,
+	183, 185, 186, 187, 188, 189, 190, 191,
+	165, 179, 157, 190, 128, 134, 147, 151,
+	159, 168, 170, 182, 184, 188, 176, 180,
+	182, 255, 161, 186, 144, 145, 146, 147,
+	148, 150, 151, 152, 155, 157, 158, 160,
+	170, 171, 172, 175, 161, 169, 128, 129,
+	130, 131, 133, 138, 139, 140, 141, 142,
+	143, 144, 145, 146, 147, 148, 149, 152,
+	156, 157, 160, 161, 162, 163, 164, 166,
+	168, 169, 170, 171, 172, 173, 174, 176,
+	177, 153, 155, 178, 179, 145, 255, 139,
+	143, 182, 255, 158, 175, 128, 144, 147,
+	149, 151, 153, 179, 128, 135, 137, 164,
+	128, 130, 131, 132, 133, 134, 135, 136,
+	138, 139, 140, 141, 144, 145, 146, 147,
+	150, 151, 152, 153, 154, 156, 162, 163,
+	171, 176, 177, 178, 131, 183, 131, 175,
+	144, 168, 131, 166, 182, 144, 178, 131,
+	178, 154, 156, 129, 132, 128, 145, 147,
+	171, 159, 255, 144, 157, 161, 135, 138,
+	128, 175, 135, 132, 133, 128, 174, 152,
+	155, 132, 128, 170, 128, 153, 160, 190,
+	192, 255, 128, 136, 138, 174, 128, 178,
+	255, 160, 168, 169, 171, 172, 173, 174,
+	188, 189, 190, 191, 161, 167, 144, 173,
+	128, 131, 163, 183, 189, 255, 133, 143,
+	145, 255, 147, 159, 128, 176, 177, 178,
+	128, 136, 144, 153, 144, 145, 146, 147,
+	148, 149, 154, 155, 156, 157, 158, 159,
+	150, 153, 131, 140, 255, 160, 163, 164,
+	165, 184, 185, 186, 161, 162, 133, 255,
+	170, 181, 183, 186, 128, 150, 152, 182,
+	184, 255, 192, 255, 128, 255, 173, 130,
+	133, 146, 159, 165, 171, 175, 255, 181,
+	190, 184, 185, 192, 255, 140, 134, 138,
+	142, 161, 163, 255, 182, 130, 136, 137,
+	176, 151, 152, 154, 160, 190, 136, 144,
+	192, 255, 135, 129, 130, 132, 133, 144,
+	170, 176, 178, 144, 154, 160, 191, 128,
+	169, 174, 255, 148, 169, 157, 158, 189,
+	190, 192, 255, 144, 255, 139, 140, 178,
+	255, 186, 128, 181, 160, 161, 162, 163,
+	164, 165, 166, 167, 168, 169, 170, 171,
+	172, 173, 174, 175, 176, 177, 178, 179,
+	180, 181, 182, 183, 184, 185, 186, 187,
+	188, 189, 190, 191, 128, 173, 128, 155,
+	160, 180, 182, 189, 148, 161, 163, 255,
+	176, 164, 165, 132, 169, 177, 141, 142,
+	145, 146, 179, 181, 186, 187, 158, 133,
+	134, 137, 138, 143, 150, 152, 155, 164,
+	165, 178, 255, 188, 129, 131, 133, 138,
+	143, 144, 147, 168, 170, 176, 178, 179,
+	181, 182, 184, 185, 190, 255, 157, 131,
+	134, 137, 138, 142, 144, 146, 152, 159,
+	165, 182, 255, 129, 131, 133, 141, 143,
+	145, 147, 168, 170, 176, 178, 179, 181,
+	185, 188, 255, 134, 138, 142, 143, 145,
+	159, 164, 165, 176, 184, 186, 255, 129,
+	131, 133, 140, 143, 144, 147, 168, 170,
+	176, 178, 179, 181, 185, 188, 191, 177,
+	128, 132, 135, 136, 139, 141, 150, 151,
+	156, 157, 159, 163, 166, 175, 156, 130,
+	131, 133, 138, 142, 144, 146, 149, 153,
+	154, 158, 159, 163, 164, 168, 170, 174,
+	185, 190, 191, 144, 151, 128, 130, 134,
+	136, 138, 141, 166, 175, 128, 131, 133,
+	140, 142, 144, 146, 168, 170, 185, 189,
+	255, 133, 137, 151, 142, 148, 155, 159,
+	164, 165, 176, 255, 128, 131, 133, 140,
+	142, 144, 146, 168, 170, 179, 181, 185,
+	188, 191, 158, 128, 132, 134, 136, 138,
+	141, 149, 150, 160, 163, 166, 175, 177,
+	178, 129, 131, 133, 140, 142, 144, 146,
+	186, 189, 255, 133, 137, 143, 147, 152,
+	158, 164, 165, 176, 185, 192, 255, 189,
+	130, 131, 133, 150, 154, 177, 179, 187,
+	138, 150, 128, 134, 143, 148, 152, 159,
+	166, 175, 178, 179, 129, 186, 128, 142,
+	144, 153, 132, 138, 141, 165, 167, 129,
+	130, 135, 136, 148, 151, 153, 159, 161,
+	163, 170, 171, 173, 185, 187, 189, 134,
+	128, 132, 136, 141, 144, 153, 156, 159,
+	128, 181, 183, 185, 152, 153, 160, 169,
+	190, 191, 128, 135, 137, 172, 177, 191,
+	128, 132, 134, 151, 153, 188, 134, 128,
+	129, 130, 131, 137, 138, 139, 140, 141,
+	142, 143, 144, 153, 154, 155, 156, 157,
+	158, 159, 160, 161, 162, 163, 164, 165,
+	166, 167, 168, 169, 170, 173, 175, 176,
+	177, 178, 179, 181, 182, 183, 188, 189,
+	190, 191, 132, 152, 172, 184, 185, 187,
+	128, 191, 128, 137, 144, 255, 158, 159,
+	134, 187, 136, 140, 142, 143, 137, 151,
+	153, 142, 143, 158, 159, 137, 177, 142,
+	143, 182, 183, 191, 255, 128, 130, 133,
+	136, 150, 152, 255, 145, 150, 151, 155,
+	156, 160, 168, 178, 255, 128, 143, 160,
+	255, 182, 183, 190, 255, 129, 255, 173,
+	174, 192, 255, 129, 154, 160, 255, 171,
+	173, 185, 255, 128, 140, 142, 148, 160,
+	180, 128, 147, 160, 172, 174, 176, 178,
+	179, 148, 150, 152, 155, 158, 159, 170,
+	255, 139, 141, 144, 153, 160, 255, 184,
+	255, 128, 170, 176, 255, 182, 255, 128,
+	158, 160, 171, 176, 187, 134, 173, 176,
+	180, 128, 171, 176, 255, 138, 143, 155,
+	255, 128, 155, 160, 255, 159, 189, 190,
+	192, 255, 167, 128, 137, 144, 153, 176,
+	189, 140, 143, 154, 170, 180, 255, 180,
+	255, 128, 183, 128, 137, 141, 189, 128,
+	136, 144, 146, 148, 182, 184, 185, 128,
+	181, 187, 191, 150, 151, 158, 159, 152,
+	154, 156, 158, 134, 135, 142, 143, 190,
+	255, 190, 128, 180, 182, 188, 130, 132,
+	134, 140, 144, 147, 150, 155, 160, 172,
+	178, 180, 182, 188, 128, 129, 130, 131,
+	132, 133, 134, 176, 177, 178, 179, 180,
+	181, 182, 183, 191, 255, 129, 147, 149,
+	176, 178, 190, 192, 255, 144, 156, 161,
+	144, 156, 165, 176, 130, 135, 149, 164,
+	166, 168, 138, 147, 152, 157, 170, 185,
+	188, 191, 142, 133, 137, 160, 255, 137,
+	255, 128, 174, 176, 255, 159, 165, 170,
+	180, 255, 167, 173, 128, 165, 176, 255,
+	168, 174, 176, 190, 192, 255, 128, 150,
+	160, 166, 168, 174, 176, 182, 184, 190,
+	128, 134, 136, 142, 144, 150, 152, 158,
+	160, 191, 128, 129, 130, 131, 132, 133,
+	134, 135, 144, 145, 255, 133, 135, 161,
+	175, 177, 181, 184, 188, 160, 151, 152,
+	187, 192, 255, 133, 173, 177, 255, 143,
+	159, 187, 255, 176, 191, 182, 183, 184,
+	191, 192, 255, 150, 255, 128, 146, 147,
+	148, 152, 153, 154, 155, 156, 158, 159,
+	160, 161, 162, 163, 164, 165, 166, 167,
+	168, 169, 170, 171, 172, 173, 174, 175,
+	176, 129, 255, 141, 255, 144, 189, 141,
+	143, 172, 255, 191, 128, 175, 180, 189,
+	151, 159, 162, 255, 175, 137, 138, 184,
+	255, 183, 255, 168, 255, 128, 179, 188,
+	134, 143, 154, 159, 184, 186, 190, 255,
+	128, 173, 176, 255, 148, 159, 189, 255,
+	129, 142, 154, 159, 191, 255, 128, 182,
+	128, 141, 144, 153, 160, 182, 186, 255,
+	128, 130, 155, 157, 160, 175, 178, 182,
+	129, 134, 137, 142, 145, 150, 160, 166,
+	168, 174, 176, 255, 155, 166, 175, 128,
+	170, 172, 173, 176, 185, 158, 159, 160,
+	255, 164, 175, 135, 138, 188, 255, 164,
+	169, 171, 172, 173, 174, 175, 180, 181,
+	182, 183, 184, 185, 187, 188, 189, 190,
+	191, 165, 186, 174, 175, 154, 255, 190,
+	128, 134, 147, 151, 157, 168, 170, 182,
+	184, 188, 128, 129, 131, 132, 134, 255,
+	147, 255, 190, 255, 144, 145, 136, 175,
+	188, 255, 128, 143, 160, 175, 179, 180,
+	141, 143, 176, 180, 182, 255, 189, 255,
+	191, 144, 153, 161, 186, 129, 154, 166,
+	255, 191, 255, 130, 135, 138, 143, 146,
+	151, 154, 156, 144, 145, 146, 147, 148,
+	150, 151, 152, 155, 157, 158, 160, 170,
+	171, 172, 175, 161, 169, 128, 129, 130,
+	131, 133, 135, 138, 139, 140, 141, 142,
+	143, 144, 145, 146, 147, 148, 149, 152,
+	156, 157, 160, 161, 162, 163, 164, 166,
+	168, 169, 170, 171, 172, 173, 174, 176,
+	177, 153, 155, 178, 179, 128, 139, 141,
+	166, 168, 186, 188, 189, 191, 255, 142,
+	143, 158, 255, 187, 255, 128, 180, 189,
+	128, 156, 160, 255, 145, 159, 161, 255,
+	128, 159, 176, 255, 139, 143, 187, 255,
+	128, 157, 160, 255, 144, 132, 135, 150,
+	255, 158, 159, 170, 175, 148, 151, 188,
+	255, 128, 167, 176, 255, 164, 255, 183,
+	255, 128, 149, 160, 167, 136, 188, 128,
+	133, 138, 181, 183, 184, 191, 255, 150,
+	159, 183, 255, 128, 158, 160, 178, 180,
+	181, 128, 149, 160, 185, 128, 183, 190,
+	191, 191, 128, 131, 133, 134, 140, 147,
+	149, 151, 153, 179, 184, 186, 160, 188,
+	128, 156, 128, 135, 137, 166, 128, 181,
+	128, 149, 160, 178, 128, 145, 128, 178,
+	129, 130, 131, 132, 133, 135, 136, 138,
+	139, 140, 141, 144, 145, 146, 147, 150,
+	151, 152, 153, 154, 155, 156, 162, 163,
+	171, 176, 177, 178, 128, 134, 135, 165,
+	176, 190, 144, 168, 176, 185, 128, 180,
+	182, 191, 182, 144, 179, 155, 133, 137,
+	141, 143, 157, 255, 190, 128, 145, 147,
+	183, 136, 128, 134, 138, 141, 143, 157,
+	159, 168, 176, 255, 171, 175, 186, 255,
+	128, 131, 133, 140, 143, 144, 147, 168,
+	170, 176, 178, 179, 181, 185, 188, 191,
+	144, 151, 128, 132, 135, 136, 139, 141,
+	157, 163, 166, 172, 176, 180, 128, 138,
+	144, 153, 134, 136, 143, 154, 255, 128,
+	181, 184, 255, 129, 151, 158, 255, 129,
+	131, 133, 143, 154, 255, 128, 137, 128,
+	153, 157, 171, 176, 185, 160, 255, 170,
+	190, 192, 255, 128, 184, 128, 136, 138,
+	182, 184, 191, 128, 144, 153, 178, 255,
+	168, 144, 145, 183, 255, 128, 142, 145,
+	149, 129, 141, 144, 146, 147, 148, 175,
+	255, 132, 255, 128, 144, 129, 143, 144,
+	153, 145, 152, 135, 255, 160, 168, 169,
+	171, 172, 173, 174, 188, 189, 190, 191,
+	161, 167, 185, 255, 128, 158, 160, 169,
+	144, 173, 176, 180, 128, 131, 144, 153,
+	163, 183, 189, 255, 144, 255, 133, 143,
+	191, 255, 143, 159, 160, 128, 129, 255,
+	159, 160, 171, 172, 255, 173, 255, 179,
+	255, 128, 176, 177, 178, 128, 129, 171,
+	175, 189, 255, 128, 136, 144, 153, 157,
+	158, 133, 134, 137, 144, 145, 146, 147,
+	148, 149, 154, 155, 156, 157, 158, 159,
+	168, 169, 170, 150, 153, 165, 169, 173,
+	178, 187, 255, 131, 132, 140, 169, 174,
+	255, 130, 132, 149, 157, 173, 186, 188,
+	160, 161, 163, 164, 167, 168, 132, 134,
+	149, 157, 186, 139, 140, 191, 255, 134,
+	128, 132, 138, 144, 146, 255, 166, 167,
+	129, 155, 187, 149, 181, 143, 175, 137,
+	169, 131, 140, 141, 192, 255, 128, 182,
+	187, 255, 173, 180, 182, 255, 132, 155,
+	159, 161, 175, 128, 160, 163, 164, 165,
+	184, 185, 186, 161, 162, 128, 134, 136,
+	152, 155, 161, 163, 164, 166, 170, 133,
+	143, 151, 255, 139, 143, 154, 255, 164,
+	167, 185, 187, 128, 131, 133, 159, 161,
+	162, 169, 178, 180, 183, 130, 135, 137,
+	139, 148, 151, 153, 155, 157, 159, 164,
+	190, 141, 143, 145, 146, 161, 162, 167,
+	170, 172, 178, 180, 183, 185, 188, 128,
+	137, 139, 155, 161, 163, 165, 169, 171,
+	187, 155, 156, 151, 255, 156, 157, 160,
+	181, 255, 186, 187, 255, 162, 255, 160,
+	168, 161, 167, 158, 255, 160, 132, 135,
+	133, 134, 176, 255, 128, 191, 154, 164,
+	168, 128, 149, 150, 191, 128, 152, 153,
+	191, 181, 128, 159, 160, 189, 190, 191,
+	189, 128, 131, 132, 185, 186, 191, 144,
+	128, 151, 152, 161, 162, 176, 177, 255,
+	169, 177, 129, 132, 141, 142, 145, 146,
+	179, 181, 186, 188, 190, 191, 192, 255,
+	142, 158, 128, 155, 156, 161, 162, 175,
+	176, 177, 178, 191, 169, 177, 180, 183,
+	128, 132, 133, 138, 139, 142, 143, 144,
+	145, 146, 147, 185, 186, 191, 157, 128,
+	152, 153, 158, 159, 177, 178, 180, 181,
+	191, 142, 146, 169, 177, 180, 189, 128,
+	132, 133, 185, 186, 191, 144, 185, 128,
+	159, 160, 161, 162, 191, 169, 177, 180,
+	189, 128, 132, 133, 140, 141, 142, 143,
+	144, 145, 146, 147, 185, 186, 191, 158,
+	177, 128, 155, 156, 161, 162, 191, 131,
+	145, 155, 157, 128, 132, 133, 138, 139,
+	141, 142, 149, 150, 152, 153, 159, 160,
+	162, 163, 164, 165, 167, 168, 170, 171,
+	173, 174, 185, 186, 191, 144, 128, 191,
+	141, 145, 169, 189, 128, 132, 133, 185,
+	186, 191, 128, 151, 152, 154, 155, 159,
+	160, 161, 162, 191, 128, 141, 145, 169,
+	180, 189, 129, 132, 133, 185, 186, 191,
+	158, 128, 159, 160, 161, 162, 176, 177,
+	178, 179, 191, 141, 145, 189, 128, 132,
+	133, 186, 187, 191, 142, 128, 147, 148,
+	150, 151, 158, 159, 161, 162, 185, 186,
+	191, 178, 188, 128, 132, 133, 150, 151,
+	153, 154, 189, 190, 191, 128, 134, 135,
+	191, 128, 177, 129, 179, 180, 191, 128,
+	131, 137, 141, 152, 160, 164, 166, 172,
+	177, 189, 129, 132, 133, 134, 135, 138,
+	139, 147, 148, 167, 168, 169, 170, 179,
+	180, 191, 133, 128, 134, 135, 155, 156,
+	159, 160, 191, 128, 129, 191, 136, 128,
+	172, 173, 191, 128, 135, 136, 140, 141,
+	191, 191, 128, 170, 171, 190, 161, 128,
+	143, 144, 149, 150, 153, 154, 157, 158,
+	164, 165, 166, 167, 173, 174, 176, 177,
+	180, 181, 255, 130, 141, 143, 159, 134,
+	187, 136, 140, 142, 143, 137, 151, 153,
+	142, 143, 158, 159, 137, 177, 191, 142,
+	143, 182, 183, 192, 255, 129, 151, 128,
+	133, 134, 135, 136, 255, 145, 150, 151,
+	155, 191, 192, 255, 128, 143, 144, 159,
+	160, 255, 182, 183, 190, 191, 192, 255,
+	128, 129, 255, 173, 174, 192, 255, 128,
+	129, 154, 155, 159, 160, 255, 171, 173,
+	185, 191, 192, 255, 141, 128, 145, 146,
+	159, 160, 177, 178, 191, 173, 128, 145,
+	146, 159, 160, 176, 177, 191, 128, 179,
+	180, 191, 151, 156, 128, 191, 128, 159,
+	160, 255, 184, 191, 192, 255, 169, 128,
+	170, 171, 175, 176, 255, 182, 191, 192,
+	255, 128, 158, 159, 191, 128, 143, 144,
+	173, 174, 175, 176, 180, 181, 191, 128,
+	171, 172, 175, 176, 255, 138, 191, 192,
+	255, 128, 150, 151, 159, 160, 255, 149,
+	191, 192, 255, 167, 128, 191, 128, 132,
+	133, 179, 180, 191, 128, 132, 133, 139,
+	140, 191, 128, 130, 131, 160, 161, 173,
+	174, 175, 176, 185, 186, 255, 166, 191,
+	192, 255, 128, 163, 164, 191, 128, 140,
+	141, 143, 144, 153, 154, 189, 190, 191,
+	128, 136, 137, 191, 173, 128, 168, 169,
+	177, 178, 180, 181, 182, 183, 191, 0,
+	127, 192, 255, 150, 151, 158, 159, 152,
+	154, 156, 158, 134, 135, 142, 143, 190,
+	191, 192, 255, 181, 189, 191, 128, 190,
+	133, 181, 128, 129, 130, 140, 141, 143,
+	144, 147, 148, 149, 150, 155, 156, 159,
+	160, 172, 173, 177, 178, 188, 189, 191,
+	177, 191, 128, 190, 128, 143, 144, 156,
+	157, 191, 130, 135, 148, 164, 166, 168,
+	128, 137, 138, 149, 150, 151, 152, 157,
+	158, 169, 170, 185, 186, 187, 188, 191,
+	142, 128, 132, 133, 137, 138, 159, 160,
+	255, 137, 191, 192, 255, 175, 128, 255,
+	159, 165, 170, 175, 177, 180, 191, 192,
+	255, 166, 173, 128, 167, 168, 175, 176,
+	255, 168, 174, 176, 191, 192, 255, 167,
+	175, 183, 191, 128, 150, 151, 159, 160,
+	190, 135, 143, 151, 128, 158, 159, 191,
+	128, 132, 133, 135, 136, 160, 161, 169,
+	170, 176, 177, 181, 182, 183, 184, 188,
+	189, 191, 160, 151, 154, 187, 192, 255,
+	128, 132, 133, 173, 174, 176, 177, 255,
+	143, 159, 187, 191, 192, 255, 128, 175,
+	176, 191, 150, 191, 192, 255, 141, 191,
+	192, 255, 128, 143, 144, 189, 190, 191,
+	141, 143, 160, 169, 172, 191, 192, 255,
+	191, 128, 174, 175, 190, 128, 157, 158,
+	159, 160, 255, 176, 191, 192, 255, 128,
+	150, 151, 159, 160, 161, 162, 255, 175,
+	137, 138, 184, 191, 192, 255, 128, 182,
+	183, 255, 130, 134, 139, 163, 191, 192,
+	255, 128, 129, 130, 179, 180, 191, 187,
+	189, 128, 177, 178, 183, 184, 191, 128,
+	137, 138, 165, 166, 175, 176, 255, 135,
+	159, 189, 191, 192, 255, 128, 131, 132,
+	178, 179, 191, 143, 165, 191, 128, 159,
+	160, 175, 176, 185, 186, 190, 128, 168,
+	169, 191, 131, 186, 128, 139, 140, 159,
+	160, 182, 183, 189, 190, 255, 176, 178,
+	180, 183, 184, 190, 191, 192, 255, 129,
+	128, 130, 131, 154, 155, 157, 158, 159,
+	160, 170, 171, 177, 178, 180, 181, 191,
+	128, 167, 175, 129, 134, 135, 136, 137,
+	142, 143, 144, 145, 150, 151, 159, 160,
+	255, 155, 166, 175, 128, 162, 163, 191,
+	164, 175, 135, 138, 188, 191, 192, 255,
+	174, 175, 154, 191, 192, 255, 157, 169,
+	183, 189, 191, 128, 134, 135, 146, 147,
+	151, 152, 158, 159, 190, 130, 133, 128,
+	255, 178, 191, 192, 255, 128, 146, 147,
+	255, 190, 191, 192, 255, 128, 143, 144,
+	255, 144, 145, 136, 175, 188, 191, 192,
+	255, 181, 128, 175, 176, 255, 189, 191,
+	192, 255, 128, 160, 161, 186, 187, 191,
+	128, 129, 154, 155, 165, 166, 255, 191,
+	192, 255, 128, 129, 130, 135, 136, 137,
+	138, 143, 144, 145, 146, 151, 152, 153,
+	154, 156, 157, 191, 128, 191, 128, 129,
+	130, 131, 133, 138, 139, 140, 141, 142,
+	143, 144, 145, 146, 147, 148, 149, 152,
+	156, 157, 160, 161, 162, 163, 164, 166,
+	168, 169, 170, 171, 172, 173, 174, 176,
+	177, 132, 151, 153, 155, 158, 175, 178,
+	179, 180, 191, 140, 167, 187, 190, 128,
+	255, 142, 143, 158, 191, 192, 255, 187,
+	191, 192, 255, 128, 180, 181, 191, 128,
+	156, 157, 159, 160, 255, 145, 191, 192,
+	255, 128, 159, 160, 175, 176, 255, 139,
+	143, 182, 191, 192, 255, 144, 132, 135,
+	150, 191, 192, 255, 158, 175, 148, 151,
+	188, 191, 192, 255, 128, 167, 168, 175,
+	176, 255, 164, 191, 192, 255, 183, 191,
+	192, 255, 128, 149, 150, 159, 160, 167,
+	168, 191, 136, 182, 188, 128, 133, 134,
+	137, 138, 184, 185, 190, 191, 255, 150,
+	159, 183, 191, 192, 255, 179, 128, 159,
+	160, 181, 182, 191, 128, 149, 150, 159,
+	160, 185, 186, 191, 128, 183, 184, 189,
+	190, 191, 128, 148, 152, 129, 143, 144,
+	179, 180, 191, 128, 159, 160, 188, 189,
+	191, 128, 156, 157, 191, 136, 128, 164,
+	165, 191, 128, 181, 182, 191, 128, 149,
+	150, 159, 160, 178, 179, 191, 128, 145,
+	146, 191, 128, 178, 179, 191, 128, 130,
+	131, 132, 133, 134, 135, 136, 138, 139,
+	140, 141, 144, 145, 146, 147, 150, 151,
+	152, 153, 154, 156, 162, 163, 171, 176,
+	177, 178, 129, 191, 128, 130, 131, 183,
+	184, 191, 128, 130, 131, 175, 176, 191,
+	128, 143, 144, 168, 169, 191, 128, 130,
+	131, 166, 167, 191, 182, 128, 143, 144,
+	178, 179, 191, 128, 130, 131, 178, 179,
+	191, 128, 154, 156, 129, 132, 133, 191,
+	146, 128, 171, 172, 191, 135, 137, 142,
+	158, 128, 168, 169, 175, 176, 255, 159,
+	191, 192, 255, 144, 128, 156, 157, 161,
+	162, 191, 128, 134, 135, 138, 139, 191,
+	128, 175, 176, 191, 134, 128, 131, 132,
+	135, 136, 191, 128, 174, 175, 191, 128,
+	151, 152, 155, 156, 191, 132, 128, 191,
+	128, 170, 171, 191, 128, 153, 154, 191,
+	160, 190, 192, 255, 128, 184, 185, 191,
+	137, 128, 174, 175, 191, 128, 129, 177,
+	178, 255, 144, 191, 192, 255, 128, 142,
+	143, 144, 145, 146, 149, 129, 148, 150,
+	191, 175, 191, 192, 255, 132, 191, 192,
+	255, 128, 144, 129, 143, 145, 191, 144,
+	153, 128, 143, 145, 152, 154, 191, 135,
+	191, 192, 255, 160, 168, 169, 171, 172,
+	173, 174, 188, 189, 190, 191, 128, 159,
+	161, 167, 170, 187, 185, 191, 192, 255,
+	128, 143, 144, 173, 174, 191, 128, 131,
+	132, 162, 163, 183, 184, 188, 189, 255,
+	133, 143, 145, 191, 192, 255, 128, 146,
+	147, 159, 160, 191, 160, 128, 191, 128,
+	129, 191, 192, 255, 159, 160, 171, 128,
+	170, 172, 191, 192, 255, 173, 191, 192,
+	255, 179, 191, 192, 255, 128, 176, 177,
+	178, 129, 191, 128, 129, 130, 191, 171,
+	175, 189, 191, 192, 255, 128, 136, 137,
+	143, 144, 153, 154, 191, 144, 145, 146,
+	147, 148, 149, 154, 155, 156, 157, 158,
+	159, 128, 143, 150, 153, 160, 191, 149,
+	157, 173, 186, 188, 160, 161, 163, 164,
+	167, 168, 132, 134, 149, 157, 186, 191,
+	139, 140, 192, 255, 133, 145, 128, 134,
+	135, 137, 138, 255, 166, 167, 129, 155,
+	187, 149, 181, 143, 175, 137, 169, 131,
+	140, 191, 192, 255, 160, 163, 164, 165,
+	184, 185, 186, 128, 159, 161, 162, 166,
+	191, 133, 191, 192, 255, 132, 160, 163,
+	167, 179, 184, 186, 128, 164, 165, 168,
+	169, 187, 188, 191, 130, 135, 137, 139,
+	144, 147, 151, 153, 155, 157, 159, 163,
+	171, 179, 184, 189, 191, 128, 140, 141,
+	148, 149, 160, 161, 164, 165, 166, 167,
+	190, 138, 164, 170, 128, 155, 156, 160,
+	161, 187, 188, 191, 128, 191, 155, 156,
+	128, 191, 151, 191, 192, 255, 156, 157,
+	160, 128, 191, 181, 191, 192, 255, 158,
+	159, 186, 128, 185, 187, 191, 192, 255,
+	162, 191, 192, 255, 160, 168, 128, 159,
+	161, 167, 169, 191, 158, 191, 192, 255,
+	10, 13, 128, 191, 192, 223, 224, 239,
+	240, 247, 248, 255, 128, 191, 128, 191,
+	128, 191, 128, 191, 128, 191, 10, 128,
+	191, 128, 191, 128, 191, 36, 123, 37,
+	123, 10, 128, 191, 128, 191, 128, 191,
+	36, 123, 37, 123, 170, 181, 183, 186,
+	128, 150, 152, 182, 184, 255, 192, 255,
+	128, 255, 173, 130, 133, 146, 159, 165,
+	171, 175, 255, 181, 190, 184, 185, 192,
+	255, 140, 134, 138, 142, 161, 163, 255,
+	182, 130, 136, 137, 176, 151, 152, 154,
+	160, 190, 136, 144, 192, 255, 135, 129,
+	130, 132, 133, 144, 170, 176, 178, 144,
+	154, 160, 191, 128, 169, 174, 255, 148,
+	169, 157, 158, 189, 190, 192, 255, 144,
+	255, 139, 140, 178, 255, 186, 128, 181,
+	160, 161, 162, 163, 164, 165, 166, 167,
+	168, 169, 170, 171, 172, 173, 174, 175,
+	176, 177, 178, 179, 180, 181, 182, 183,
+	184, 185, 186, 187, 188, 189, 190, 191,
+	128, 173, 128, 155, 160, 180, 182, 189,
+	148, 161, 163, 255, 176, 164, 165, 132,
+	169, 177, 141, 142, 145, 146, 179, 181,
+	186, 187, 158, 133, 134, 137, 138, 143,
+	150, 152, 155, 164, 165, 178, 255, 188,
+	129, 131, 133, 138, 143, 144, 147, 168,
+	170, 176, 178, 179, 181, 182, 184, 185,
+	190, 255, 157, 131, 134, 137, 138, 142,
+	144, 146, 152, 159, 165, 182, 255, 129,
+	131, 133, 141, 143, 145, 147, 168, 170,
+	176, 178, 179, 181, 185, 188, 255, 134,
+	138, 142, 143, 145, 159, 164, 165, 176,
+	184, 186, 255, 129, 131, 133, 140, 143,
+	144, 147, 168, 170, 176, 178, 179, 181,
+	185, 188, 191, 177, 128, 132, 135, 136,
+	139, 141, 150, 151, 156, 157, 159, 163,
+	166, 175, 156, 130, 131, 133, 138, 142,
+	144, 146, 149, 153, 154, 158, 159, 163,
+	164, 168, 170, 174, 185, 190, 191, 144,
+	151, 128, 130, 134, 136, 138, 141, 166,
+	175, 128, 131, 133, 140, 142, 144, 146,
+	168, 170, 185, 189, 255, 133, 137, 151,
+	142, 148, 155, 159, 164, 165, 176, 255,
+	128, 131, 133, 140, 142, 144, 146, 168,
+	170, 179, 181, 185, 188, 191, 158, 128,
+	132, 134, 136, 138, 141, 149, 150, 160,
+	163, 166, 175, 177, 178, 129, 131, 133,
+	140, 142, 144, 146, 186, 189, 255, 133,
+	137, 143, 147, 152, 158, 164, 165, 176,
+	185, 192, 255, 189, 130, 131, 133, 150,
+	154, 177, 179, 187, 138, 150, 128, 134,
+	143, 148, 152, 159, 166, 175, 178, 179,
+	129, 186, 128, 142, 144, 153, 132, 138,
+	141, 165, 167, 129, 130, 135, 136, 148,
+	151, 153, 159, 161, 163, 170, 171, 173,
+	185, 187, 189, 134, 128, 132, 136, 141,
+	144, 153, 156, 159, 128, 181, 183, 185,
+	152, 153, 160, 169, 190, 191, 128, 135,
+	137, 172, 177, 191, 128, 132, 134, 151,
+	153, 188, 134, 128, 129, 130, 131, 137,
+	138, 139, 140, 141, 142, 143, 144, 153,
+	154, 155, 156, 157, 158, 159, 160, 161,
+	162, 163, 164, 165, 166, 167, 168, 169,
+	170, 173, 175, 176, 177, 178, 179, 181,
+	182, 183, 188, 189, 190, 191, 132, 152,
+	172, 184, 185, 187, 128, 191, 128, 137,
+	144, 255, 158, 159, 134, 187, 136, 140,
+	142, 143, 137, 151, 153, 142, 143, 158,
+	159, 137, 177, 142, 143, 182, 183, 191,
+	255, 128, 130, 133, 136, 150, 152, 255,
+	145, 150, 151, 155, 156, 160, 168, 178,
+	255, 128, 143, 160, 255, 182, 183, 190,
+	255, 129, 255, 173, 174, 192, 255, 129,
+	154, 160, 255, 171, 173, 185, 255, 128,
+	140, 142, 148, 160, 180, 128, 147, 160,
+	172, 174, 176, 178, 179, 148, 150, 152,
+	155, 158, 159, 170, 255, 139, 141, 144,
+	153, 160, 255, 184, 255, 128, 170, 176,
+	255, 182, 255, 128, 158, 160, 171, 176,
+	187, 134, 173, 176, 180, 128, 171, 176,
+	255, 138, 143, 155, 255, 128, 155, 160,
+	255, 159, 189, 190, 192, 255, 167, 128,
+	137, 144, 153, 176, 189, 140, 143, 154,
+	170, 180, 255, 180, 255, 128, 183, 128,
+	137, 141, 189, 128, 136, 144, 146, 148,
+	182, 184, 185, 128, 181, 187, 191, 150,
+	151, 158, 159, 152, 154, 156, 158, 134,
+	135, 142, 143, 190, 255, 190, 128, 180,
+	182, 188, 130, 132, 134, 140, 144, 147,
+	150, 155, 160, 172, 178, 180, 182, 188,
+	128, 129, 130, 131, 132, 133, 134, 176,
+	177, 178, 179, 180, 181, 182, 183, 191,
+	255, 129, 147, 149, 176, 178, 190, 192,
+	255, 144, 156, 161, 144, 156, 165, 176,
+	130, 135, 149, 164, 166, 168, 138, 147,
+	152, 157, 170, 185, 188, 191, 142, 133,
+	137, 160, 255, 137, 255, 128, 174, 176,
+	255, 159, 165, 170, 180, 255, 167, 173,
+	128, 165, 176, 255, 168, 174, 176, 190,
+	192, 255, 128, 150, 160, 166, 168, 174,
+	176, 182, 184, 190, 128, 134, 136, 142,
+	144, 150, 152, 158, 160, 191, 128, 129,
+	130, 131, 132, 133, 134, 135, 144, 145,
+	255, 133, 135, 161, 175, 177, 181, 184,
+	188, 160, 151, 152, 187, 192, 255, 133,
+	173, 177, 255, 143, 159, 187, 255, 176,
+	191, 182, 183, 184, 191, 192, 255, 150,
+	255, 128, 146, 147, 148, 152, 153, 154,
+	155, 156, 158, 159, 160, 161, 162, 163,
+	164, 165, 166, 167, 168, 169, 170, 171,
+	172, 173, 174, 175, 176, 129, 255, 141,
+	255, 144, 189, 141, 143, 172, 255, 191,
+	128, 175, 180, 189, 151, 159, 162, 255,
+	175, 137, 138, 184, 255, 183, 255, 168,
+	255, 128, 179, 188, 134, 143, 154, 159,
+	184, 186, 190, 255, 128, 173, 176, 255,
+	148, 159, 189, 255, 129, 142, 154, 159,
+	191, 255, 128, 182, 128, 141, 144, 153,
+	160, 182, 186, 255, 128, 130, 155, 157,
+	160, 175, 178, 182, 129, 134, 137, 142,
+	145, 150, 160, 166, 168, 174, 176, 255,
+	155, 166, 175, 128, 170, 172, 173, 176,
+	185, 158, 159, 160, 255, 164, 175, 135,
+	138, 188, 255, 164, 169, 171, 172, 173,
+	174, 175, 180, 181, 182, 183, 184, 185,
+	187, 188, 189, 190, 191, 165, 186, 174,
+	175, 154, 255, 190, 128, 134, 147, 151,
+	157, 168, 170, 182, 184, 188, 128, 129,
+	131, 132, 134, 255, 147, 255, 190, 255,
+	144, 145, 136, 175, 188, 255, 128, 143,
+	160, 175, 179, 180, 141, 143, 176, 180,
+	182, 255, 189, 255, 191, 144, 153, 161,
+	186, 129, 154, 166, 255, 191, 255, 130,
+	135, 138, 143, 146, 151, 154, 156, 144,
+	145, 146, 147, 148, 150, 151, 152, 155,
+	157, 158, 160, 170, 171, 172, 175, 161,
+	169, 128, 129, 130, 131, 133, 135, 138,
+	139, 140, 141, 142, 143, 144, 145, 146,
+	147, 148, 149, 152, 156, 157, 160, 161,
+	162, 163, 164, 166, 168, 169, 170, 171,
+	172, 173, 174, 176, 177, 153, 155, 178,
+	179, 128, 139, 141, 166, 168, 186, 188,
+	189, 191, 255, 142, 143, 158, 255, 187,
+	255, 128, 180, 189, 128, 156, 160, 255,
+	145, 159, 161, 255, 128, 159, 176, 255,
+	139, 143, 187, 255, 128, 157, 160, 255,
+	144, 132, 135, 150, 255, 158, 159, 170,
+	175, 148, 151, 188, 255, 128, 167, 176,
+	255, 164, 255, 183, 255, 128, 149, 160,
+	167, 136, 188, 128, 133, 138, 181, 183,
+	184, 191, 255, 150, 159, 183, 255, 128,
+	158, 160, 178, 180, 181, 128, 149, 160,
+	185, 128, 183, 190, 191, 191, 128, 131,
+	133, 134, 140, 147, 149, 151, 153, 179,
+	184, 186, 160, 188, 128, 156, 128, 135,
+	137, 166, 128, 181, 128, 149, 160, 178,
+	128, 145, 128, 178, 129, 130, 131, 132,
+	133, 135, 136, 138, 139, 140, 141, 144,
+	145, 146, 147, 150, 151, 152, 153, 154,
+	155, 156, 162, 163, 171, 176, 177, 178,
+	128, 134, 135, 165, 176, 190, 144, 168,
+	176, 185, 128, 180, 182, 191, 182, 144,
+	179, 155, 133, 137, 141, 143, 157, 255,
+	190, 128, 145, 147, 183, 136, 128, 134,
+	138, 141, 143, 157, 159, 168, 176, 255,
+	171, 175, 186, 255, 128, 131, 133, 140,
+	143, 144, 147, 168, 170, 176, 178, 179,
+	181, 185, 188, 191, 144, 151, 128, 132,
+	135, 136, 139, 141, 157, 163, 166, 172,
+	176, 180, 128, 138, 144, 153, 134, 136,
+	143, 154, 255, 128, 181, 184, 255, 129,
+	151, 158, 255, 129, 131, 133, 143, 154,
+	255, 128, 137, 128, 153, 157, 171, 176,
+	185, 160, 255, 170, 190, 192, 255, 128,
+	184, 128, 136, 138, 182, 184, 191, 128,
+	144, 153, 178, 255, 168, 144, 145, 183,
+	255, 128, 142, 145, 149, 129, 141, 144,
+	146, 147, 148, 175, 255, 132, 255, 128,
+	144, 129, 143, 144, 153, 145, 152, 135,
+	255, 160, 168, 169, 171, 172, 173, 174,
+	188, 189, 190, 191, 161, 167, 185, 255,
+	128, 158, 160, 169, 144, 173, 176, 180,
+	128, 131, 144, 153, 163, 183, 189, 255,
+	144, 255, 133, 143, 191, 255, 143, 159,
+	160, 128, 129, 255, 159, 160, 171, 172,
+	255, 173, 255, 179, 255, 128, 176, 177,
+	178, 128, 129, 171, 175, 189, 255, 128,
+	136, 144, 153, 157, 158, 133, 134, 137,
+	144, 145, 146, 147, 148, 149, 154, 155,
+	156, 157, 158, 159, 168, 169, 170, 150,
+	153, 165, 169, 173, 178, 187, 255, 131,
+	132, 140, 169, 174, 255, 130, 132, 149,
+	157, 173, 186, 188, 160, 161, 163, 164,
+	167, 168, 132, 134, 149, 157, 186, 139,
+	140, 191, 255, 134, 128, 132, 138, 144,
+	146, 255, 166, 167, 129, 155, 187, 149,
+	181, 143, 175, 137, 169, 131, 140, 141,
+	192, 255, 128, 182, 187, 255, 173, 180,
+	182, 255, 132, 155, 159, 161, 175, 128,
+	160, 163, 164, 165, 184, 185, 186, 161,
+	162, 128, 134, 136, 152, 155, 161, 163,
+	164, 166, 170, 133, 143, 151, 255, 139,
+	143, 154, 255, 164, 167, 185, 187, 128,
+	131, 133, 159, 161, 162, 169, 178, 180,
+	183, 130, 135, 137, 139, 148, 151, 153,
+	155, 157, 159, 164, 190, 141, 143, 145,
+	146, 161, 162, 167, 170, 172, 178, 180,
+	183, 185, 188, 128, 137, 139, 155, 161,
+	163, 165, 169, 171, 187, 155, 156, 151,
+	255, 156, 157, 160, 181, 255, 186, 187,
+	255, 162, 255, 160, 168, 161, 167, 158,
+	255, 160, 132, 135, 133, 134, 176, 255,
+	128, 191, 154, 164, 168, 128, 149, 150,
+	191, 128, 152, 153, 191, 181, 128, 159,
+	160, 189, 190, 191, 189, 128, 131, 132,
+	185, 186, 191, 144, 128, 151, 152, 161,
+	162, 176, 177, 255, 169, 177, 129, 132,
+	141, 142, 145, 146, 179, 181, 186, 188,
+	190, 191, 192, 255, 142, 158, 128, 155,
+	156, 161, 162, 175, 176, 177, 178, 191,
+	169, 177, 180, 183, 128, 132, 133, 138,
+	139, 142, 143, 144, 145, 146, 147, 185,
+	186, 191, 157, 128, 152, 153, 158, 159,
+	177, 178, 180, 181, 191, 142, 146, 169,
+	177, 180, 189, 128, 132, 133, 185, 186,
+	191, 144, 185, 128, 159, 160, 161, 162,
+	191, 169, 177, 180, 189, 128, 132, 133,
+	140, 141, 142, 143, 144, 145, 146, 147,
+	185, 186, 191, 158, 177, 128, 155, 156,
+	161, 162, 191, 131, 145, 155, 157, 128,
+	132, 133, 138, 139, 141, 142, 149, 150,
+	152, 153, 159, 160, 162, 163, 164, 165,
+	167, 168, 170, 171, 173, 174, 185, 186,
+	191, 144, 128, 191, 141, 145, 169, 189,
+	128, 132, 133, 185, 186, 191, 128, 151,
+	152, 154, 155, 159, 160, 161, 162, 191,
+	128, 141, 145, 169, 180, 189, 129, 132,
+	133, 185, 186, 191, 158, 128, 159, 160,
+	161, 162, 176, 177, 178, 179, 191, 141,
+	145, 189, 128, 132, 133, 186, 187, 191,
+	142, 128, 147, 148, 150, 151, 158, 159,
+	161, 162, 185, 186, 191, 178, 188, 128,
+	132, 133, 150, 151, 153, 154, 189, 190,
+	191, 128, 134, 135, 191, 128, 177, 129,
+	179, 180, 191, 128, 131, 137, 141, 152,
+	160, 164, 166, 172, 177, 189, 129, 132,
+	133, 134, 135, 138, 139, 147, 148, 167,
+	168, 169, 170, 179, 180, 191, 133, 128,
+	134, 135, 155, 156, 159, 160, 191, 128,
+	129, 191, 136, 128, 172, 173, 191, 128,
+	135, 136, 140, 141, 191, 191, 128, 170,
+	171, 190, 161, 128, 143, 144, 149, 150,
+	153, 154, 157, 158, 164, 165, 166, 167,
+	173, 174, 176, 177, 180, 181, 255, 130,
+	141, 143, 159, 134, 187, 136, 140, 142,
+	143, 137, 151, 153, 142, 143, 158, 159,
+	137, 177, 191, 142, 143, 182, 183, 192,
+	255, 129, 151, 128, 133, 134, 135, 136,
+	255, 145, 150, 151, 155, 191, 192, 255,
+	128, 143, 144, 159, 160, 255, 182, 183,
+	190, 191, 192, 255, 128, 129, 255, 173,
+	174, 192, 255, 128, 129, 154, 155, 159,
+	160, 255, 171, 173, 185, 191, 192, 255,
+	141, 128, 145, 146, 159, 160, 177, 178,
+	191, 173, 128, 145, 146, 159, 160, 176,
+	177, 191, 128, 179, 180, 191, 151, 156,
+	128, 191, 128, 159, 160, 255, 184, 191,
+	192, 255, 169, 128, 170, 171, 175, 176,
+	255, 182, 191, 192, 255, 128, 158, 159,
+	191, 128, 143, 144, 173, 174, 175, 176,
+	180, 181, 191, 128, 171, 172, 175, 176,
+	255, 138, 191, 192, 255, 128, 150, 151,
+	159, 160, 255, 149, 191, 192, 255, 167,
+	128, 191, 128, 132, 133, 179, 180, 191,
+	128, 132, 133, 139, 140, 191, 128, 130,
+	131, 160, 161, 173, 174, 175, 176, 185,
+	186, 255, 166, 191, 192, 255, 128, 163,
+	164, 191, 128, 140, 141, 143, 144, 153,
+	154, 189, 190, 191, 128, 136, 137, 191,
+	173, 128, 168, 169, 177, 178, 180, 181,
+	182, 183, 191, 0, 127, 192, 255, 150,
+	151, 158, 159, 152, 154, 156, 158, 134,
+	135, 142, 143, 190, 191, 192, 255, 181,
+	189, 191, 128, 190, 133, 181, 128, 129,
+	130, 140, 141, 143, 144, 147, 148, 149,
+	150, 155, 156, 159, 160, 172, 173, 177,
+	178, 188, 189, 191, 177, 191, 128, 190,
+	128, 143, 144, 156, 157, 191, 130, 135,
+	148, 164, 166, 168, 128, 137, 138, 149,
+	150, 151, 152, 157, 158, 169, 170, 185,
+	186, 187, 188, 191, 142, 128, 132, 133,
+	137, 138, 159, 160, 255, 137, 191, 192,
+	255, 175, 128, 255, 159, 165, 170, 175,
+	177, 180, 191, 192, 255, 166, 173, 128,
+	167, 168, 175, 176, 255, 168, 174, 176,
+	191, 192, 255, 167, 175, 183, 191, 128,
+	150, 151, 159, 160, 190, 135, 143, 151,
+	128, 158, 159, 191, 128, 132, 133, 135,
+	136, 160, 161, 169, 170, 176, 177, 181,
+	182, 183, 184, 188, 189, 191, 160, 151,
+	154, 187, 192, 255, 128, 132, 133, 173,
+	174, 176, 177, 255, 143, 159, 187, 191,
+	192, 255, 128, 175, 176, 191, 150, 191,
+	192, 255, 141, 191, 192, 255, 128, 143,
+	144, 189, 190, 191, 141, 143, 160, 169,
+	172, 191, 192, 255, 191, 128, 174, 175,
+	190, 128, 157, 158, 159, 160, 255, 176,
+	191, 192, 255, 128, 150, 151, 159, 160,
+	161, 162, 255, 175, 137, 138, 184, 191,
+	192, 255, 128, 182, 183, 255, 130, 134,
+	139, 163, 191, 192, 255, 128, 129, 130,
+	179, 180, 191, 187, 189, 128, 177, 178,
+	183, 184, 191, 128, 137, 138, 165, 166,
+	175, 176, 255, 135, 159, 189, 191, 192,
+	255, 128, 131, 132, 178, 179, 191, 143,
+	165, 191, 128, 159, 160, 175, 176, 185,
+	186, 190, 128, 168, 169, 191, 131, 186,
+	128, 139, 140, 159, 160, 182, 183, 189,
+	190, 255, 176, 178, 180, 183, 184, 190,
+	191, 192, 255, 129, 128, 130, 131, 154,
+	155, 157, 158, 159, 160, 170, 171, 177,
+	178, 180, 181, 191, 128, 167, 175, 129,
+	134, 135, 136, 137, 142, 143, 144, 145,
+	150, 151, 159, 160, 255, 155, 166, 175,
+	128, 162, 163, 191, 164, 175, 135, 138,
+	188, 191, 192, 255, 174, 175, 154, 191,
+	192, 255, 157, 169, 183, 189, 191, 128,
+	134, 135, 146, 147, 151, 152, 158, 159,
+	190, 130, 133, 128, 255, 178, 191, 192,
+	255, 128, 146, 147, 255, 190, 191, 192,
+	255, 128, 143, 144, 255, 144, 145, 136,
+	175, 188, 191, 192, 255, 181, 128, 175,
+	176, 255, 189, 191, 192, 255, 128, 160,
+	161, 186, 187, 191, 128, 129, 154, 155,
+	165, 166, 255, 191, 192, 255, 128, 129,
+	130, 135, 136, 137, 138, 143, 144, 145,
+	146, 151, 152, 153, 154, 156, 157, 191,
+	128, 191, 128, 129, 130, 131, 133, 138,
+	139, 140, 141, 142, 143, 144, 145, 146,
+	147, 148, 149, 152, 156, 157, 160, 161,
+	162, 163, 164, 166, 168, 169, 170, 171,
+	172, 173, 174, 176, 177, 132, 151, 153,
+	155, 158, 175, 178, 179, 180, 191, 140,
+	167, 187, 190, 128, 255, 142, 143, 158,
+	191, 192, 255, 187, 191, 192, 255, 128,
+	180, 181, 191, 128, 156, 157, 159, 160,
+	255, 145, 191, 192, 255, 128, 159, 160,
+	175, 176, 255, 139, 143, 182, 191, 192,
+	255, 144, 132, 135, 150, 191, 192, 255,
+	158, 175, 148, 151, 188, 191, 192, 255,
+	128, 167, 168, 175, 176, 255, 164, 191,
+	192, 255, 183, 191, 192, 255, 128, 149,
+	150, 159, 160, 167, 168, 191, 136, 182,
+	188, 128, 133, 134, 137, 138, 184, 185,
+	190, 191, 255, 150, 159, 183, 191, 192,
+	255, 179, 128, 159, 160, 181, 182, 191,
+	128, 149, 150, 159, 160, 185, 186, 191,
+	128, 183, 184, 189, 190, 191, 128, 148,
+	152, 129, 143, 144, 179, 180, 191, 128,
+	159, 160, 188, 189, 191, 128, 156, 157,
+	191, 136, 128, 164, 165, 191, 128, 181,
+	182, 191, 128, 149, 150, 159, 160, 178,
+	179, 191, 128, 145, 146, 191, 128, 178,
+	179, 191, 128, 130, 131, 132, 133, 134,
+	135, 136, 138, 139, 140, 141, 144, 145,
+	146, 147, 150, 151, 152, 153, 154, 156,
+	162, 163, 171, 176, 177, 178, 129, 191,
+	128, 130, 131, 183, 184, 191, 128, 130,
+	131, 175, 176, 191, 128, 143, 144, 168,
+	169, 191, 128, 130, 131, 166, 167, 191,
+	182, 128, 143, 144, 178, 179, 191, 128,
+	130, 131, 178, 179, 191, 128, 154, 156,
+	129, 132, 133, 191, 146, 128, 171, 172,
+	191, 135, 137, 142, 158, 128, 168, 169,
+	175, 176, 255, 159, 191, 192, 255, 144,
+	128, 156, 157, 161, 162, 191, 128, 134,
+	135, 138, 139, 191, 128, 175, 176, 191,
+	134, 128, 131, 132, 135, 136, 191, 128,
+	174, 175, 191, 128, 151, 152, 155, 156,
+	191, 132, 128, 191, 128, 170, 171, 191,
+	128, 153, 154, 191, 160, 190, 192, 255,
+	128, 184, 185, 191, 137, 128, 174, 175,
+	191, 128, 129, 177, 178, 255, 144, 191,
+	192, 255, 128, 142, 143, 144, 145, 146,
+	149, 129, 148, 150, 191, 175, 191, 192,
+	255, 132, 191, 192, 255, 128, 144, 129,
+	143, 145, 191, 144, 153, 128, 143, 145,
+	152, 154, 191, 135, 191, 192, 255, 160,
+	168, 169, 171, 172, 173, 174, 188, 189,
+	190, 191, 128, 159, 161, 167, 170, 187,
+	185, 191, 192, 255, 128, 143, 144, 173,
+	174, 191, 128, 131, 132, 162, 163, 183,
+	184, 188, 189, 255, 133, 143, 145, 191,
+	192, 255, 128, 146, 147, 159, 160, 191,
+	160, 128, 191, 128, 129, 191, 192, 255,
+	159, 160, 171, 128, 170, 172, 191, 192,
+	255, 173, 191, 192, 255, 179, 191, 192,
+	255, 128, 176, 177, 178, 129, 191, 128,
+	129, 130, 191, 171, 175, 189, 191, 192,
+	255, 128, 136, 137, 143, 144, 153, 154,
+	191, 144, 145, 146, 147, 148, 149, 154,
+	155, 156, 157, 158, 159, 128, 143, 150,
+	153, 160, 191, 149, 157, 173, 186, 188,
+	160, 161, 163, 164, 167, 168, 132, 134,
+	149, 157, 186, 191, 139, 140, 192, 255,
+	133, 145, 128, 134, 135, 137, 138, 255,
+	166, 167, 129, 155, 187, 149, 181, 143,
+	175, 137, 169, 131, 140, 191, 192, 255,
+	160, 163, 164, 165, 184, 185, 186, 128,
+	159, 161, 162, 166, 191, 133, 191, 192,
+	255, 132, 160, 163, 167, 179, 184, 186,
+	128, 164, 165, 168, 169, 187, 188, 191,
+	130, 135, 137, 139, 144, 147, 151, 153,
+	155, 157, 159, 163, 171, 179, 184, 189,
+	191, 128, 140, 141, 148, 149, 160, 161,
+	164, 165, 166, 167, 190, 138, 164, 170,
+	128, 155, 156, 160, 161, 187, 188, 191,
+	128, 191, 155, 156, 128, 191, 151, 191,
+	192, 255, 156, 157, 160, 128, 191, 181,
+	191, 192, 255, 158, 159, 186, 128, 185,
+	187, 191, 192, 255, 162, 191, 192, 255,
+	160, 168, 128, 159, 161, 167, 169, 191,
+	158, 191, 192, 255, 9, 10, 13, 32,
+	33, 34, 35, 38, 46, 47, 60, 61,
+	62, 64, 92, 95, 123, 124, 125, 126,
+	127, 194, 195, 198, 199, 203, 204, 205,
+	206, 207, 210, 212, 213, 214, 215, 216,
+	217, 219, 220, 221, 222, 223, 224, 225,
+	226, 227, 228, 233, 234, 237, 238, 239,
+	240, 0, 36, 37, 45, 48, 57, 58,
+	63, 65, 90, 91, 96, 97, 122, 192,
+	193, 196, 218, 229, 236, 241, 247, 9,
+	32, 10, 61, 10, 38, 46, 42, 47,
+	46, 69, 101, 48, 57, 60, 61, 61,
+	62, 61, 45, 95, 194, 195, 198, 199,
+	203, 204, 205, 206, 207, 210, 212, 213,
+	214, 215, 216, 217, 219, 220, 221, 222,
+	223, 224, 225, 226, 227, 228, 233, 234,
+	237, 239, 240, 243, 48, 57, 65, 90,
+	97, 122, 196, 218, 229, 236, 124, 125,
+	128, 191, 170, 181, 186, 128, 191, 151,
+	183, 128, 255, 192, 255, 0, 127, 173,
+	130, 133, 146, 159, 165, 171, 175, 191,
+	192, 255, 181, 190, 128, 175, 176, 183,
+	184, 185, 186, 191, 134, 139, 141, 162,
+	128, 135, 136, 255, 182, 130, 137, 176,
+	151, 152, 154, 160, 136, 191, 192, 255,
+	128, 143, 144, 170, 171, 175, 176, 178,
+	179, 191, 128, 159, 160, 191, 176, 128,
+	138, 139, 173, 174, 255, 148, 150, 164,
+	167, 173, 176, 185, 189, 190, 192, 255,
+	144, 128, 145, 146, 175, 176, 191, 128,
+	140, 141, 255, 166, 176, 178, 191, 192,
+	255, 186, 128, 137, 138, 170, 171, 179,
+	180, 181, 182, 191, 160, 161, 162, 164,
+	165, 166, 167, 168, 169, 170, 171, 172,
+	173, 174, 175, 176, 177, 178, 179, 180,
+	181, 182, 183, 184, 185, 186, 187, 188,
+	189, 190, 128, 191, 128, 129, 130, 131,
+	137, 138, 139, 140, 141, 142, 143, 144,
+	153, 154, 155, 156, 157, 158, 159, 160,
+	161, 162, 163, 164, 165, 166, 167, 168,
+	169, 170, 171, 172, 173, 174, 175, 176,
+	177, 178, 179, 180, 182, 183, 184, 188,
+	189, 190, 191, 132, 187, 129, 130, 132,
+	133, 134, 176, 177, 178, 179, 180, 181,
+	182, 183, 128, 191, 128, 129, 130, 131,
+	132, 133, 134, 135, 144, 136, 143, 145,
+	191, 192, 255, 182, 183, 184, 128, 191,
+	128, 191, 191, 128, 190, 192, 255, 128,
+	146, 147, 148, 152, 153, 154, 155, 156,
+	158, 159, 160, 161, 162, 163, 164, 165,
+	166, 167, 168, 169, 170, 171, 172, 173,
+	174, 175, 176, 129, 191, 192, 255, 158,
+	159, 128, 157, 160, 191, 192, 255, 128,
+	191, 164, 169, 171, 172, 173, 174, 175,
+	180, 181, 182, 183, 184, 185, 187, 188,
+	189, 190, 191, 128, 163, 165, 186, 144,
+	145, 146, 147, 148, 150, 151, 152, 155,
+	157, 158, 160, 170, 171, 172, 175, 128,
+	159, 161, 169, 173, 191, 128, 191, 10,
+	13, 34, 36, 37, 92, 128, 191, 192,
+	223, 224, 239, 240, 247, 248, 255, 10,
+	13, 34, 92, 36, 37, 128, 191, 192,
+	223, 224, 239, 240, 247, 248, 255, 10,
+	13, 36, 123, 123, 126, 126, 37, 123,
+	126, 10, 13, 128, 191, 192, 223, 224,
+	239, 240, 247, 248, 255, 128, 191, 128,
+	191, 128, 191, 10, 13, 36, 37, 128,
+	191, 192, 223, 224, 239, 240, 247, 248,
+	255, 10, 13, 36, 37, 128, 191, 192,
+	223, 224, 239, 240, 247, 248, 255, 10,
+	13, 10, 13, 123, 10, 13, 126, 10,
+	13, 126, 126, 128, 191, 128, 191, 128,
+	191, 10, 13, 36, 37, 128, 191, 192,
+	223, 224, 239, 240, 247, 248, 255, 10,
+	13, 36, 37, 128, 191, 192, 223, 224,
+	239, 240, 247, 248, 255, 10, 13, 10,
+	13, 123, 10, 13, 126, 10, 13, 126,
+	126, 128, 191, 128, 191, 128, 191, 95,
+	194, 195, 198, 199, 203, 204, 205, 206,
+	207, 210, 212, 213, 214, 215, 216, 217,
+	219, 220, 221, 222, 223, 224, 225, 226,
+	227, 228, 233, 234, 237, 238, 239, 240,
+	65, 90, 97, 122, 128, 191, 192, 193,
+	196, 218, 229, 236, 241, 247, 248, 255,
+	45, 95, 194, 195, 198, 199, 203, 204,
+	205, 206, 207, 210, 212, 213, 214, 215,
+	216, 217, 219, 220, 221, 222, 223, 224,
+	225, 226, 227, 228, 233, 234, 237, 239,
+	240, 243, 48, 57, 65, 90, 97, 122,
+	196, 218, 229, 236, 128, 191, 170, 181,
+	186, 128, 191, 151, 183, 128, 255, 192,
+	255, 0, 127, 173, 130, 133, 146, 159,
+	165, 171, 175, 191, 192, 255, 181, 190,
+	128, 175, 176, 183, 184, 185, 186, 191,
+	134, 139, 141, 162, 128, 135, 136, 255,
+	182, 130, 137, 176, 151, 152, 154, 160,
+	136, 191, 192, 255, 128, 143, 144, 170,
+	171, 175, 176, 178, 179, 191, 128, 159,
+	160, 191, 176, 128, 138, 139, 173, 174,
+	255, 148, 150, 164, 167, 173, 176, 185,
+	189, 190, 192, 255, 144, 128, 145, 146,
+	175, 176, 191, 128, 140, 141, 255, 166,
+	176, 178, 191, 192, 255, 186, 128, 137,
+	138, 170, 171, 179, 180, 181, 182, 191,
+	160, 161, 162, 164, 165, 166, 167, 168,
+	169, 170, 171, 172, 173, 174, 175, 176,
+	177, 178, 179, 180, 181, 182, 183, 184,
+	185, 186, 187, 188, 189, 190, 128, 191,
+	128, 129, 130, 131, 137, 138, 139, 140,
+	141, 142, 143, 144, 153, 154, 155, 156,
+	157, 158, 159, 160, 161, 162, 163, 164,
+	165, 166, 167, 168, 169, 170, 171, 172,
+	173, 174, 175, 176, 177, 178, 179, 180,
+	182, 183, 184, 188, 189, 190, 191, 132,
+	187, 129, 130, 132, 133, 134, 176, 177,
+	178, 179, 180, 181, 182, 183, 128, 191,
+	128, 129, 130, 131, 132, 133, 134, 135,
+	144, 136, 143, 145, 191, 192, 255, 182,
+	183, 184, 128, 191, 128, 191, 191, 128,
+	190, 192, 255, 128, 146, 147, 148, 152,
+	153, 154, 155, 156, 158, 159, 160, 161,
+	162, 163, 164, 165, 166, 167, 168, 169,
+	170, 171, 172, 173, 174, 175, 176, 129,
+	191, 192, 255, 158, 159, 128, 157, 160,
+	191, 192, 255, 128, 191, 164, 169, 171,
+	172, 173, 174, 175, 180, 181, 182, 183,
+	184, 185, 187, 188, 189, 190, 191, 128,
+	163, 165, 186, 144, 145, 146, 147, 148,
+	150, 151, 152, 155, 157, 158, 160, 170,
+	171, 172, 175, 128, 159, 161, 169, 173,
+	191, 128, 191,
+}
+
+var _hcltok_single_lengths []byte = []byte{
+	0, 1, 1, 2, 3, 2, 0, 32,
+	31, 36, 1, 4, 0, 0, 0, 0,
+	1, 2, 1, 1, 1, 1, 0, 1,
+	1, 0, 0, 2, 0, 0, 0, 1,
+	32, 0, 0, 0, 0, 1, 3, 1,
+	1, 1, 0, 2, 0, 1, 1, 2,
+	0, 3, 0, 1, 0, 2, 1, 2,
+	0, 0, 5, 1, 4, 0, 0, 1,
+	43, 0, 0, 0, 2, 3, 2, 1,
+	1, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1, 1, 0, 0,
+	0, 0, 0, 0, 0, 0, 4, 1,
+	0, 15, 0, 0, 0, 1, 6, 1,
+	0, 0, 1, 0, 2, 0, 0, 0,
+	9, 0, 1, 1, 0, 0, 0, 3,
+	0, 1, 0, 28, 0, 0, 0, 1,
+	0, 1, 0, 0, 0, 1, 0, 0,
+	0, 0, 0, 0, 0, 1, 0, 2,
+	0, 0, 18, 0, 0, 1, 0, 0,
+	0, 0, 0, 0, 0, 0, 1, 0,
+	0, 0, 16, 36, 0, 0, 0, 0,
+	1, 0, 0, 0, 0, 0, 1, 0,
+	0, 0, 0, 0, 0, 2, 0, 0,
+	0, 0, 0, 1, 0, 0, 0, 0,
+	0, 0, 0, 28, 0, 0, 0, 1,
+	1, 1, 1, 0, 0, 2, 0, 1,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1, 1, 4, 0, 0, 2, 2,
+	0, 11, 0, 0, 0, 0, 0, 0,
+	0, 1, 1, 3, 0, 0, 4, 0,
+	0, 0, 18, 0, 0, 0, 1, 4,
+	1, 4, 1, 0, 3, 2, 2, 2,
+	1, 0, 0, 1, 8, 0, 0, 0,
+	4, 12, 0, 2, 0, 3, 0, 1,
+	0, 2, 0, 1, 2, 0, 3, 1,
+	2, 0, 0, 0, 0, 0, 1, 1,
+	0, 0, 1, 28, 3, 0, 1, 1,
+	2, 1, 0, 1, 1, 2, 1, 1,
+	2, 1, 1, 0, 2, 1, 1, 1,
+	1, 0, 0, 6, 1, 1, 0, 0,
+	46, 1, 1, 0, 0, 0, 0, 2,
+	1, 0, 0, 0, 1, 0, 0, 0,
+	0, 0, 0, 0, 13, 2, 0, 0,
+	0, 9, 0, 1, 28, 0, 1, 3,
+	0, 2, 0, 0, 0, 1, 0, 1,
+	1, 2, 0, 18, 2, 0, 0, 16,
+	35, 0, 0, 0, 1, 0, 28, 0,
+	0, 0, 0, 1, 0, 2, 0, 0,
+	1, 0, 0, 1, 0, 0, 1, 0,
+	0, 0, 0, 1, 11, 0, 0, 0,
+	0, 4, 0, 12, 1, 7, 0, 4,
+	0, 0, 0, 0, 1, 2, 1, 1,
+	1, 1, 0, 1, 1, 0, 0, 2,
+	0, 0, 0, 1, 32, 0, 0, 0,
+	0, 1, 3, 1, 1, 1, 0, 2,
+	0, 1, 1, 2, 0, 3, 0, 1,
+	0, 2, 1, 2, 0, 0, 5, 1,
+	4, 0, 0, 1, 43, 0, 0, 0,
+	2, 3, 2, 1, 1, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	1, 1, 0, 0, 0, 0, 0, 0,
+	0, 0, 4, 1, 0, 15, 0, 0,
+	0, 1, 6, 1, 0, 0, 1, 0,
+	2, 0, 0, 0, 9, 0, 1, 1,
+	0, 0, 0, 3, 0, 1, 0, 28,
+	0, 0, 0, 1, 0, 1, 0, 0,
+	0, 1, 0, 0, 0, 0, 0, 0,
+	0, 1, 0, 2, 0, 0, 18, 0,
+	0, 1, 0, 0, 0, 0, 0, 0,
+	0, 0, 1, 0, 0, 0, 16, 36,
+	0, 0, 0, 0, 1, 0, 0, 0,
+	0, 0, 1, 0, 0, 0, 0, 0,
+	0, 2, 0, 0, 0, 0, 0, 1,
+	0, 0, 0, 0, 0, 0, 0, 28,
+	0, 0, 0, 1, 1, 1, 1, 0,
+	0, 2, 0, 1, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1, 1, 4,
+	0, 0, 2, 2, 0, 11, 0, 0,
+	0, 0, 0, 0, 0, 1, 1, 3,
+	0, 0, 4, 0, 0, 0, 18, 0,
+	0, 0, 1, 4, 1, 4, 1, 0,
+	3, 2, 2, 2, 1, 0, 0, 1,
+	8, 0, 0, 0, 4, 12, 0, 2,
+	0, 3, 0, 1, 0, 2, 0, 1,
+	2, 0, 0, 3, 0, 1, 1, 1,
+	2, 2, 4, 1, 6, 2, 4, 2,
+	4, 1, 4, 0, 6, 1, 3, 1,
+	2, 0, 2, 11, 1, 1, 1, 0,
+	1, 1, 0, 2, 0, 3, 3, 2,
+	1, 0, 0, 0, 1, 0, 1, 0,
+	1, 1, 0, 2, 0, 0, 1, 0,
+	0, 0, 0, 0, 0, 0, 1, 0,
+	0, 0, 0, 0, 0, 0, 1, 0,
+	0, 0, 4, 3, 2, 2, 0, 6,
+	1, 0, 1, 1, 0, 2, 0, 4,
+	3, 0, 1, 1, 0, 0, 0, 0,
+	0, 0, 0, 1, 0, 0, 0, 1,
+	0, 3, 0, 2, 0, 0, 0, 3,
+	0, 2, 1, 1, 3, 1, 0, 0,
+	0, 0, 0, 5, 2, 0, 0, 0,
+	0, 0, 0, 1, 0, 0, 1, 1,
+	0, 0, 35, 4, 0, 0, 0, 0,
+	0, 0, 0, 1, 0, 0, 0, 0,
+	0, 0, 3, 0, 1, 0, 0, 3,
+	0, 0, 1, 0, 0, 0, 0, 28,
+	0, 0, 0, 0, 1, 0, 3, 1,
+	4, 0, 1, 0, 0, 1, 0, 0,
+	1, 0, 0, 0, 0, 1, 1, 0,
+	7, 0, 0, 2, 2, 0, 11, 0,
+	0, 0, 0, 0, 1, 1, 3, 0,
+	0, 4, 0, 0, 0, 12, 1, 4,
+	1, 5, 2, 0, 3, 2, 2, 2,
+	1, 7, 0, 7, 17, 3, 0, 2,
+	0, 3, 0, 0, 1, 0, 2, 0,
+	2, 0, 0, 0, 0, 0, 1, 0,
+	0, 0, 2, 2, 1, 0, 0, 0,
+	2, 2, 4, 0, 0, 0, 0, 1,
+	2, 1, 1, 1, 1, 0, 1, 1,
+	0, 0, 2, 0, 0, 0, 1, 32,
+	0, 0, 0, 0, 1, 3, 1, 1,
+	1, 0, 2, 0, 1, 1, 2, 0,
+	3, 0, 1, 0, 2, 1, 2, 0,
+	0, 5, 1, 4, 0, 0, 1, 43,
+	0, 0, 0, 2, 3, 2, 1, 1,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1, 1, 0, 0, 0,
+	0, 0, 0, 0, 0, 4, 1, 0,
+	15, 0, 0, 0, 1, 6, 1, 0,
+	0, 1, 0, 2, 0, 0, 0, 9,
+	0, 1, 1, 0, 0, 0, 3, 0,
+	1, 0, 28, 0, 0, 0, 1, 0,
+	1, 0, 0, 0, 1, 0, 0, 0,
+	0, 0, 0, 0, 1, 0, 2, 0,
+	0, 18, 0, 0, 1, 0, 0, 0,
+	0, 0, 0, 0, 0, 1, 0, 0,
+	0, 16, 36, 0, 0, 0, 0, 1,
+	0, 0, 0, 0, 0, 1, 0, 0,
+	0, 0, 0, 0, 2, 0, 0, 0,
+	0, 0, 1, 0, 0, 0, 0, 0,
+	0, 0, 28, 0, 0, 0, 1, 1,
+	1, 1, 0, 0, 2, 0, 1, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	1, 1, 4, 0, 0, 2, 2, 0,
+	11, 0, 0, 0, 0, 0, 0, 0,
+	1, 1, 3, 0, 0, 4, 0, 0,
+	0, 18, 0, 0, 0, 1, 4, 1,
+	4, 1, 0, 3, 2, 2, 2, 1,
+	0, 0, 1, 8, 0, 0, 0, 4,
+	12, 0, 2, 0, 3, 0, 1, 0,
+	2, 0, 1, 2, 0, 0, 3, 0,
+	1, 1, 1, 2, 2, 4, 1, 6,
+	2, 4, 2, 4, 1, 4, 0, 6,
+	1, 3, 1, 2, 0, 2, 11, 1,
+	1, 1, 0, 1, 1, 0, 2, 0,
+	3, 3, 2, 1, 0, 0, 0, 1,
+	0, 1, 0, 1, 1, 0, 2, 0,
+	0, 1, 0, 0, 0, 0, 0, 0,
+	0, 1, 0, 0, 0, 0, 0, 0,
+	0, 1, 0, 0, 0, 4, 3, 2,
+	2, 0, 6, 1, 0, 1, 1, 0,
+	2, 0, 4, 3, 0, 1, 1, 0,
+	0, 0, 0, 0, 0, 0, 1, 0,
+	0, 0, 1, 0, 3, 0, 2, 0,
+	0, 0, 3, 0, 2, 1, 1, 3,
+	1, 0, 0, 0, 0, 0, 5, 2,
+	0, 0, 0, 0, 0, 0, 1, 0,
+	0, 1, 1, 0, 0, 35, 4, 0,
+	0, 0, 0, 0, 0, 0, 1, 0,
+	0, 0, 0, 0, 0, 3, 0, 1,
+	0, 0, 3, 0, 0, 1, 0, 0,
+	0, 0, 28, 0, 0, 0, 0, 1,
+	0, 3, 1, 4, 0, 1, 0, 0,
+	1, 0, 0, 1, 0, 0, 0, 0,
+	1, 1, 0, 7, 0, 0, 2, 2,
+	0, 11, 0, 0, 0, 0, 0, 1,
+	1, 3, 0, 0, 4, 0, 0, 0,
+	12, 1, 4, 1, 5, 2, 0, 3,
+	2, 2, 2, 1, 7, 0, 7, 17,
+	3, 0, 2, 0, 3, 0, 0, 1,
+	0, 2, 0, 53, 2, 1, 1, 1,
+	1, 1, 2, 3, 2, 2, 1, 34,
+	1, 1, 0, 3, 2, 0, 0, 0,
+	1, 2, 4, 1, 0, 1, 0, 0,
+	0, 0, 1, 1, 1, 0, 0, 1,
+	30, 47, 13, 9, 3, 0, 1, 28,
+	2, 0, 18, 16, 0, 6, 4, 2,
+	2, 0, 1, 1, 1, 2, 1, 2,
+	0, 0, 0, 4, 2, 2, 3, 3,
+	2, 1, 1, 0, 0, 0, 4, 2,
+	2, 3, 3, 2, 1, 1, 0, 0,
+	0, 33, 34, 0, 3, 2, 0, 0,
+	0, 1, 2, 4, 1, 0, 1, 0,
+	0, 0, 0, 1, 1, 1, 0, 0,
+	1, 30, 47, 13, 9, 3, 0, 1,
+	28, 2, 0, 18, 16, 0,
+}
+
+var _hcltok_range_lengths []byte = []byte{
+	0, 0, 0, 0, 1, 1, 1, 5,
+	5, 5, 0, 0, 3, 0, 1, 1,
+	4, 2, 3, 0, 1, 0, 2, 2,
+	4, 2, 2, 3, 1, 1, 1, 1,
+	0, 1, 1, 2, 2, 1, 4, 6,
+	9, 6, 8, 5, 8, 7, 10, 4,
+	6, 4, 7, 7, 5, 5, 4, 5,
+	1, 2, 8, 4, 3, 3, 3, 0,
+	3, 1, 2, 1, 2, 2, 3, 3,
+	1, 3, 2, 2, 1, 2, 2, 2,
+	3, 4, 4, 3, 1, 2, 1, 3,
+	2, 2, 2, 2, 2, 3, 3, 1,
+	1, 2, 1, 3, 2, 2, 3, 2,
+	7, 0, 1, 4, 1, 2, 4, 2,
+	1, 2, 0, 2, 2, 3, 5, 5,
+	1, 4, 1, 1, 2, 2, 1, 0,
+	0, 1, 1, 1, 1, 1, 2, 2,
+	2, 2, 1, 1, 1, 4, 2, 2,
+	3, 1, 4, 4, 6, 1, 3, 1,
+	1, 2, 1, 1, 1, 5, 3, 1,
+	1, 1, 2, 3, 3, 1, 2, 2,
+	1, 4, 1, 2, 5, 2, 1, 1,
+	0, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 1, 1, 2, 4, 2, 1,
+	2, 2, 2, 6, 1, 1, 2, 1,
+	2, 1, 1, 1, 2, 2, 2, 1,
+	3, 2, 5, 2, 8, 6, 2, 2,
+	2, 2, 3, 1, 3, 1, 2, 1,
+	3, 2, 2, 3, 1, 1, 1, 1,
+	1, 1, 1, 2, 2, 4, 1, 2,
+	1, 0, 1, 1, 1, 1, 0, 1,
+	2, 3, 1, 3, 3, 1, 0, 3,
+	0, 2, 3, 1, 0, 0, 0, 0,
+	2, 2, 2, 2, 1, 5, 2, 2,
+	5, 7, 5, 0, 1, 0, 1, 1,
+	1, 1, 1, 0, 1, 1, 0, 3,
+	3, 1, 1, 2, 1, 3, 5, 1,
+	1, 2, 2, 1, 1, 1, 1, 2,
+	6, 3, 7, 2, 6, 1, 6, 2,
+	8, 0, 4, 2, 5, 2, 3, 3,
+	3, 1, 2, 8, 2, 0, 2, 1,
+	2, 1, 5, 2, 1, 3, 3, 0,
+	2, 1, 2, 1, 0, 1, 1, 3,
+	1, 1, 2, 3, 0, 0, 3, 2,
+	4, 1, 4, 1, 1, 3, 1, 1,
+	1, 1, 2, 2, 1, 3, 1, 4,
+	3, 3, 1, 1, 5, 2, 1, 1,
+	2, 1, 2, 1, 3, 2, 0, 1,
+	1, 1, 1, 1, 1, 1, 2, 1,
+	1, 1, 1, 1, 1, 1, 0, 1,
+	1, 2, 2, 1, 1, 1, 3, 2,
+	1, 0, 2, 1, 1, 1, 1, 0,
+	3, 0, 1, 1, 4, 2, 3, 0,
+	1, 0, 2, 2, 4, 2, 2, 3,
+	1, 1, 1, 1, 0, 1, 1, 2,
+	2, 1, 4, 6, 9, 6, 8, 5,
+	8, 7, 10, 4, 6, 4, 7, 7,
+	5, 5, 4, 5, 1, 2, 8, 4,
+	3, 3, 3, 0, 3, 1, 2, 1,
+	2, 2, 3, 3, 1, 3, 2, 2,
+	1, 2, 2, 2, 3, 4, 4, 3,
+	1, 2, 1, 3, 2, 2, 2, 2,
+	2, 3, 3, 1, 1, 2, 1, 3,
+	2, 2, 3, 2, 7, 0, 1, 4,
+	1, 2, 4, 2, 1, 2, 0, 2,
+	2, 3, 5, 5, 1, 4, 1, 1,
+	2, 2, 1, 0, 0, 1, 1, 1,
+	1, 1, 2, 2, 2, 2, 1, 1,
+	1, 4, 2, 2, 3, 1, 4, 4,
+	6, 1, 3, 1, 1, 2, 1, 1,
+	1, 5, 3, 1, 1, 1, 2, 3,
+	3, 1, 2, 2, 1, 4, 1, 2,
+	5, 2, 1, 1, 0, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 1, 1,
+	2, 4, 2, 1, 2, 2, 2, 6,
+	1, 1, 2, 1, 2, 1, 1, 1,
+	2, 2, 2, 1, 3, 2, 5, 2,
+	8, 6, 2, 2, 2, 2, 3, 1,
+	3, 1, 2, 1, 3, 2, 2, 3,
+	1, 1, 1, 1, 1, 1, 1, 2,
+	2, 4, 1, 2, 1, 0, 1, 1,
+	1, 1, 0, 1, 2, 3, 1, 3,
+	3, 1, 0, 3, 0, 2, 3, 1,
+	0, 0, 0, 0, 2, 2, 2, 2,
+	1, 5, 2, 2, 5, 7, 5, 0,
+	1, 0, 1, 1, 1, 1, 1, 0,
+	1, 1, 1, 2, 2, 3, 3, 4,
+	7, 5, 7, 5, 3, 3, 7, 3,
+	13, 1, 3, 5, 3, 5, 3, 6,
+	5, 2, 2, 8, 4, 1, 2, 3,
+	2, 10, 2, 2, 0, 2, 3, 3,
+	1, 2, 3, 3, 1, 2, 3, 3,
+	4, 4, 2, 1, 2, 2, 3, 2,
+	2, 5, 3, 2, 3, 2, 1, 3,
+	3, 6, 2, 2, 5, 2, 5, 1,
+	1, 2, 4, 1, 11, 1, 3, 8,
+	4, 2, 1, 0, 4, 3, 3, 3,
+	2, 9, 1, 1, 4, 3, 2, 2,
+	2, 3, 4, 2, 3, 2, 4, 3,
+	2, 2, 3, 3, 4, 3, 3, 4,
+	2, 5, 4, 8, 7, 1, 2, 1,
+	3, 1, 2, 5, 1, 2, 2, 2,
+	2, 1, 3, 2, 2, 3, 3, 1,
+	9, 1, 5, 1, 3, 2, 2, 3,
+	2, 3, 3, 3, 1, 3, 3, 2,
+	2, 4, 5, 3, 3, 4, 3, 3,
+	3, 2, 2, 2, 4, 2, 2, 1,
+	3, 3, 3, 3, 3, 3, 2, 2,
+	3, 2, 3, 3, 2, 3, 2, 3,
+	1, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 3, 2, 3, 2,
+	3, 5, 3, 3, 1, 2, 3, 2,
+	2, 1, 2, 3, 4, 3, 0, 3,
+	0, 2, 3, 1, 0, 0, 0, 0,
+	2, 3, 2, 4, 6, 4, 1, 1,
+	2, 1, 2, 1, 3, 2, 3, 2,
+	5, 1, 1, 1, 1, 1, 0, 1,
+	1, 1, 0, 0, 0, 1, 1, 1,
+	0, 0, 0, 3, 0, 1, 1, 4,
+	2, 3, 0, 1, 0, 2, 2, 4,
+	2, 2, 3, 1, 1, 1, 1, 0,
+	1, 1, 2, 2, 1, 4, 6, 9,
+	6, 8, 5, 8, 7, 10, 4, 6,
+	4, 7, 7, 5, 5, 4, 5, 1,
+	2, 8, 4, 3, 3, 3, 0, 3,
+	1, 2, 1, 2, 2, 3, 3, 1,
+	3, 2, 2, 1, 2, 2, 2, 3,
+	4, 4, 3, 1, 2, 1, 3, 2,
+	2, 2, 2, 2, 3, 3, 1, 1,
+	2, 1, 3, 2, 2, 3, 2, 7,
+	0, 1, 4, 1, 2, 4, 2, 1,
+	2, 0, 2, 2, 3, 5, 5, 1,
+	4, 1, 1, 2, 2, 1, 0, 0,
+	1, 1, 1, 1, 1, 2, 2, 2,
+	2, 1, 1, 1, 4, 2, 2, 3,
+	1, 4, 4, 6, 1, 3, 1, 1,
+	2, 1, 1, 1, 5, 3, 1, 1,
+	1, 2, 3, 3, 1, 2, 2, 1,
+	4, 1, 2, 5, 2, 1, 1, 0,
+	2, 2, 2, 2, 2, 2, 2, 2,
+	2, 1, 1, 2, 4, 2, 1, 2,
+	2, 2, 6, 1, 1, 2, 1, 2,
+	1, 1, 1, 2, 2, 2, 1, 3,
+	2, 5, 2, 8, 6, 2, 2, 2,
+	2, 3, 1, 3, 1, 2, 1, 3,
+	2, 2, 3, 1, 1, 1, 1, 1,
+	1, 1, 2, 2, 4, 1, 2, 1,
+	0, 1, 1, 1, 1, 0, 1, 2,
+	3, 1, 3, 3, 1, 0, 3, 0,
+	2, 3, 1, 0, 0, 0, 0, 2,
+	2, 2, 2, 1, 5, 2, 2, 5,
+	7, 5, 0, 1, 0, 1, 1, 1,
+	1, 1, 0, 1, 1, 1, 2, 2,
+	3, 3, 4, 7, 5, 7, 5, 3,
+	3, 7, 3, 13, 1, 3, 5, 3,
+	5, 3, 6, 5, 2, 2, 8, 4,
+	1, 2, 3, 2, 10, 2, 2, 0,
+	2, 3, 3, 1, 2, 3, 3, 1,
+	2, 3, 3, 4, 4, 2, 1, 2,
+	2, 3, 2, 2, 5, 3, 2, 3,
+	2, 1, 3, 3, 6, 2, 2, 5,
+	2, 5, 1, 1, 2, 4, 1, 11,
+	1, 3, 8, 4, 2, 1, 0, 4,
+	3, 3, 3, 2, 9, 1, 1, 4,
+	3, 2, 2, 2, 3, 4, 2, 3,
+	2, 4, 3, 2, 2, 3, 3, 4,
+	3, 3, 4, 2, 5, 4, 8, 7,
+	1, 2, 1, 3, 1, 2, 5, 1,
+	2, 2, 2, 2, 1, 3, 2, 2,
+	3, 3, 1, 9, 1, 5, 1, 3,
+	2, 2, 3, 2, 3, 3, 3, 1,
+	3, 3, 2, 2, 4, 5, 3, 3,
+	4, 3, 3, 3, 2, 2, 2, 4,
+	2, 2, 1, 3, 3, 3, 3, 3,
+	3, 2, 2, 3, 2, 3, 3, 2,
+	3, 2, 3, 1, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 3,
+	2, 3, 2, 3, 5, 3, 3, 1,
+	2, 3, 2, 2, 1, 2, 3, 4,
+	3, 0, 3, 0, 2, 3, 1, 0,
+	0, 0, 0, 2, 3, 2, 4, 6,
+	4, 1, 1, 2, 1, 2, 1, 3,
+	2, 3, 2, 11, 0, 0, 0, 0,
+	0, 0, 0, 1, 0, 0, 0, 5,
+	0, 0, 1, 1, 1, 0, 1, 1,
+	5, 4, 2, 0, 1, 0, 2, 2,
+	5, 2, 3, 5, 3, 2, 3, 5,
+	1, 1, 1, 3, 1, 1, 2, 2,
+	3, 1, 2, 3, 1, 5, 6, 0,
+	0, 0, 0, 0, 0, 0, 0, 5,
+	1, 1, 1, 5, 6, 0, 0, 0,
+	0, 0, 0, 1, 1, 1, 5, 6,
+	0, 0, 0, 0, 0, 0, 1, 1,
+	1, 8, 5, 1, 1, 1, 0, 1,
+	1, 5, 4, 2, 0, 1, 0, 2,
+	2, 5, 2, 3, 5, 3, 2, 3,
+	5, 1, 1, 1, 3, 1, 1, 2,
+	2, 3, 1, 2, 3, 1,
+}
+
+var _hcltok_index_offsets []int16 = []int16{
+	0, 0, 2, 4, 7, 12, 16, 18,
+	56, 93, 135, 137, 142, 146, 147, 149,
+	151, 157, 162, 167, 169, 172, 174, 177,
+	181, 187, 190, 193, 199, 201, 203, 205,
+	208, 241, 243, 245, 248, 251, 254, 262,
+	270, 281, 289, 298, 306, 315, 324, 336,
+	343, 350, 358, 366, 375, 381, 389, 395,
+	403, 405, 408, 422, 428, 436, 440, 444,
+	446, 493, 495, 498, 500, 505, 511, 517,
+	522, 525, 529, 532, 535, 537, 540, 543,
+	546, 550, 555, 560, 564, 566, 569, 571,
+	575, 578, 581, 584, 587, 591, 596, 600,
+	602, 604, 607, 609, 613, 616, 619, 627,
+	631, 639, 655, 657, 662, 664, 668, 679,
+	683, 685, 688, 690, 693, 698, 702, 708,
+	714, 725, 730, 733, 736, 739, 742, 744,
+	748, 749, 752, 754, 784, 786, 788, 791,
+	795, 798, 802, 804, 806, 808, 814, 817,
+	820, 824, 826, 831, 836, 843, 846, 850,
+	854, 856, 859, 879, 881, 883, 890, 894,
+	896, 898, 900, 903, 907, 911, 913, 917,
+	920, 922, 927, 945, 984, 990, 993, 995,
+	997, 999, 1002, 1005, 1008, 1011, 1014, 1018,
+	1021, 1024, 1027, 1029, 1031, 1034, 1041, 1044,
+	1046, 1049, 1052, 1055, 1063, 1065, 1067, 1070,
+	1072, 1075, 1077, 1079, 1109, 1112, 1115, 1118,
+	1121, 1126, 1130, 1137, 1140, 1149, 1158, 1161,
+	1165, 1168, 1171, 1175, 1177, 1181, 1183, 1186,
+	1188, 1192, 1196, 1200, 1208, 1210, 1212, 1216,
+	1220, 1222, 1235, 1237, 1240, 1243, 1248, 1250,
+	1253, 1255, 1257, 1260, 1265, 1267, 1269, 1274,
+	1276, 1279, 1283, 1303, 1307, 1311, 1313, 1315,
+	1323, 1325, 1332, 1337, 1339, 1343, 1346, 1349,
+	1352, 1356, 1359, 1362, 1366, 1376, 1382, 1385,
+	1388, 1398, 1418, 1424, 1427, 1429, 1433, 1435,
+	1438, 1440, 1444, 1446, 1448, 1452, 1454, 1458,
+	1463, 1469, 1471, 1473, 1476, 1478, 1482, 1489,
+	1492, 1494, 1497, 1501, 1531, 1536, 1538, 1541,
+	1545, 1554, 1559, 1567, 1571, 1579, 1583, 1591,
+	1595, 1606, 1608, 1614, 1617, 1625, 1629, 1634,
+	1639, 1644, 1646, 1649, 1664, 1668, 1670, 1673,
+	1675, 1724, 1727, 1734, 1737, 1739, 1743, 1747,
+	1750, 1754, 1756, 1759, 1761, 1763, 1765, 1767,
+	1771, 1773, 1775, 1778, 1782, 1796, 1799, 1803,
+	1806, 1811, 1822, 1827, 1830, 1860, 1864, 1867,
+	1872, 1874, 1878, 1881, 1884, 1886, 1891, 1893,
+	1899, 1904, 1910, 1912, 1932, 1940, 1943, 1945,
+	1963, 2001, 2003, 2006, 2008, 2013, 2016, 2045,
+	2047, 2049, 2051, 2053, 2056, 2058, 2062, 2065,
+	2067, 2070, 2072, 2074, 2077, 2079, 2081, 2083,
+	2085, 2087, 2090, 2093, 2096, 2109, 2111, 2115,
+	2118, 2120, 2125, 2128, 2142, 2145, 2154, 2156,
+	2161, 2165, 2166, 2168, 2170, 2176, 2181, 2186,
+	2188, 2191, 2193, 2196, 2200, 2206, 2209, 2212,
+	2218, 2220, 2222, 2224, 2227, 2260, 2262, 2264,
+	2267, 2270, 2273, 2281, 2289, 2300, 2308, 2317,
+	2325, 2334, 2343, 2355, 2362, 2369, 2377, 2385,
+	2394, 2400, 2408, 2414, 2422, 2424, 2427, 2441,
+	2447, 2455, 2459, 2463, 2465, 2512, 2514, 2517,
+	2519, 2524, 2530, 2536, 2541, 2544, 2548, 2551,
+	2554, 2556, 2559, 2562, 2565, 2569, 2574, 2579,
+	2583, 2585, 2588, 2590, 2594, 2597, 2600, 2603,
+	2606, 2610, 2615, 2619, 2621, 2623, 2626, 2628,
+	2632, 2635, 2638, 2646, 2650, 2658, 2674, 2676,
+	2681, 2683, 2687, 2698, 2702, 2704, 2707, 2709,
+	2712, 2717, 2721, 2727, 2733, 2744, 2749, 2752,
+	2755, 2758, 2761, 2763, 2767, 2768, 2771, 2773,
+	2803, 2805, 2807, 2810, 2814, 2817, 2821, 2823,
+	2825, 2827, 2833, 2836, 2839, 2843, 2845, 2850,
+	2855, 2862, 2865, 2869, 2873, 2875, 2878, 2898,
+	2900, 2902, 2909, 2913, 2915, 2917, 2919, 2922,
+	2926, 2930, 2932, 2936, 2939, 2941, 2946, 2964,
+	3003, 3009, 3012, 3014, 3016, 3018, 3021, 3024,
+	3027, 3030, 3033, 3037, 3040, 3043, 3046, 3048,
+	3050, 3053, 3060, 3063, 3065, 3068, 3071, 3074,
+	3082, 3084, 3086, 3089, 3091, 3094, 3096, 3098,
+	3128, 3131, 3134, 3137, 3140, 3145, 3149, 3156,
+	3159, 3168, 3177, 3180, 3184, 3187, 3190, 3194,
+	3196, 3200, 3202, 3205, 3207, 3211, 3215, 3219,
+	3227, 3229, 3231, 3235, 3239, 3241, 3254, 3256,
+	3259, 3262, 3267, 3269, 3272, 3274, 3276, 3279,
+	3284, 3286, 3288, 3293, 3295, 3298, 3302, 3322,
+	3326, 3330, 3332, 3334, 3342, 3344, 3351, 3356,
+	3358, 3362, 3365, 3368, 3371, 3375, 3378, 3381,
+	3385, 3395, 3401, 3404, 3407, 3417, 3437, 3443,
+	3446, 3448, 3452, 3454, 3457, 3459, 3463, 3465,
+	3467, 3471, 3473, 3475, 3481, 3484, 3489, 3494,
+	3500, 3510, 3518, 3530, 3537, 3547, 3553, 3565,
+	3571, 3589, 3592, 3600, 3606, 3616, 3623, 3630,
+	3638, 3646, 3649, 3654, 3674, 3680, 3683, 3687,
+	3691, 3695, 3707, 3710, 3715, 3716, 3722, 3729,
+	3735, 3738, 3741, 3745, 3749, 3752, 3755, 3760,
+	3764, 3770, 3776, 3779, 3783, 3786, 3789, 3794,
+	3797, 3800, 3806, 3810, 3813, 3817, 3820, 3823,
+	3827, 3831, 3838, 3841, 3844, 3850, 3853, 3860,
+	3862, 3864, 3867, 3876, 3881, 3895, 3899, 3903,
+	3918, 3924, 3927, 3930, 3932, 3937, 3943, 3947,
+	3955, 3961, 3971, 3974, 3977, 3982, 3986, 3989,
+	3992, 3995, 3999, 4004, 4008, 4012, 4015, 4020,
+	4025, 4028, 4034, 4038, 4044, 4049, 4053, 4057,
+	4065, 4068, 4076, 4082, 4092, 4103, 4106, 4109,
+	4111, 4115, 4117, 4120, 4131, 4135, 4138, 4141,
+	4144, 4147, 4149, 4153, 4157, 4160, 4164, 4169,
+	4172, 4182, 4184, 4225, 4231, 4235, 4238, 4241,
+	4245, 4248, 4252, 4256, 4261, 4263, 4267, 4271,
+	4274, 4277, 4282, 4291, 4295, 4300, 4305, 4309,
+	4316, 4320, 4323, 4327, 4330, 4335, 4338, 4341,
+	4371, 4375, 4379, 4383, 4387, 4392, 4396, 4402,
+	4406, 4414, 4417, 4422, 4426, 4429, 4434, 4437,
+	4441, 4444, 4447, 4450, 4453, 4456, 4460, 4464,
+	4467, 4477, 4480, 4483, 4488, 4494, 4497, 4512,
+	4515, 4519, 4525, 4529, 4533, 4536, 4540, 4547,
+	4550, 4553, 4559, 4562, 4566, 4571, 4587, 4589,
+	4597, 4599, 4607, 4613, 4615, 4619, 4622, 4625,
+	4628, 4632, 4643, 4646, 4658, 4682, 4690, 4692,
+	4696, 4699, 4704, 4707, 4709, 4714, 4717, 4723,
+	4726, 4734, 4736, 4738, 4740, 4742, 4744, 4746,
+	4748, 4750, 4752, 4755, 4758, 4760, 4762, 4764,
+	4766, 4769, 4772, 4777, 4781, 4782, 4784, 4786,
+	4792, 4797, 4802, 4804, 4807, 4809, 4812, 4816,
+	4822, 4825, 4828, 4834, 4836, 4838, 4840, 4843,
+	4876, 4878, 4880, 4883, 4886, 4889, 4897, 4905,
+	4916, 4924, 4933, 4941, 4950, 4959, 4971, 4978,
+	4985, 4993, 5001, 5010, 5016, 5024, 5030, 5038,
+	5040, 5043, 5057, 5063, 5071, 5075, 5079, 5081,
+	5128, 5130, 5133, 5135, 5140, 5146, 5152, 5157,
+	5160, 5164, 5167, 5170, 5172, 5175, 5178, 5181,
+	5185, 5190, 5195, 5199, 5201, 5204, 5206, 5210,
+	5213, 5216, 5219, 5222, 5226, 5231, 5235, 5237,
+	5239, 5242, 5244, 5248, 5251, 5254, 5262, 5266,
+	5274, 5290, 5292, 5297, 5299, 5303, 5314, 5318,
+	5320, 5323, 5325, 5328, 5333, 5337, 5343, 5349,
+	5360, 5365, 5368, 5371, 5374, 5377, 5379, 5383,
+	5384, 5387, 5389, 5419, 5421, 5423, 5426, 5430,
+	5433, 5437, 5439, 5441, 5443, 5449, 5452, 5455,
+	5459, 5461, 5466, 5471, 5478, 5481, 5485, 5489,
+	5491, 5494, 5514, 5516, 5518, 5525, 5529, 5531,
+	5533, 5535, 5538, 5542, 5546, 5548, 5552, 5555,
+	5557, 5562, 5580, 5619, 5625, 5628, 5630, 5632,
+	5634, 5637, 5640, 5643, 5646, 5649, 5653, 5656,
+	5659, 5662, 5664, 5666, 5669, 5676, 5679, 5681,
+	5684, 5687, 5690, 5698, 5700, 5702, 5705, 5707,
+	5710, 5712, 5714, 5744, 5747, 5750, 5753, 5756,
+	5761, 5765, 5772, 5775, 5784, 5793, 5796, 5800,
+	5803, 5806, 5810, 5812, 5816, 5818, 5821, 5823,
+	5827, 5831, 5835, 5843, 5845, 5847, 5851, 5855,
+	5857, 5870, 5872, 5875, 5878, 5883, 5885, 5888,
+	5890, 5892, 5895, 5900, 5902, 5904, 5909, 5911,
+	5914, 5918, 5938, 5942, 5946, 5948, 5950, 5958,
+	5960, 5967, 5972, 5974, 5978, 5981, 5984, 5987,
+	5991, 5994, 5997, 6001, 6011, 6017, 6020, 6023,
+	6033, 6053, 6059, 6062, 6064, 6068, 6070, 6073,
+	6075, 6079, 6081, 6083, 6087, 6089, 6091, 6097,
+	6100, 6105, 6110, 6116, 6126, 6134, 6146, 6153,
+	6163, 6169, 6181, 6187, 6205, 6208, 6216, 6222,
+	6232, 6239, 6246, 6254, 6262, 6265, 6270, 6290,
+	6296, 6299, 6303, 6307, 6311, 6323, 6326, 6331,
+	6332, 6338, 6345, 6351, 6354, 6357, 6361, 6365,
+	6368, 6371, 6376, 6380, 6386, 6392, 6395, 6399,
+	6402, 6405, 6410, 6413, 6416, 6422, 6426, 6429,
+	6433, 6436, 6439, 6443, 6447, 6454, 6457, 6460,
+	6466, 6469, 6476, 6478, 6480, 6483, 6492, 6497,
+	6511, 6515, 6519, 6534, 6540, 6543, 6546, 6548,
+	6553, 6559, 6563, 6571, 6577, 6587, 6590, 6593,
+	6598, 6602, 6605, 6608, 6611, 6615, 6620, 6624,
+	6628, 6631, 6636, 6641, 6644, 6650, 6654, 6660,
+	6665, 6669, 6673, 6681, 6684, 6692, 6698, 6708,
+	6719, 6722, 6725, 6727, 6731, 6733, 6736, 6747,
+	6751, 6754, 6757, 6760, 6763, 6765, 6769, 6773,
+	6776, 6780, 6785, 6788, 6798, 6800, 6841, 6847,
+	6851, 6854, 6857, 6861, 6864, 6868, 6872, 6877,
+	6879, 6883, 6887, 6890, 6893, 6898, 6907, 6911,
+	6916, 6921, 6925, 6932, 6936, 6939, 6943, 6946,
+	6951, 6954, 6957, 6987, 6991, 6995, 6999, 7003,
+	7008, 7012, 7018, 7022, 7030, 7033, 7038, 7042,
+	7045, 7050, 7053, 7057, 7060, 7063, 7066, 7069,
+	7072, 7076, 7080, 7083, 7093, 7096, 7099, 7104,
+	7110, 7113, 7128, 7131, 7135, 7141, 7145, 7149,
+	7152, 7156, 7163, 7166, 7169, 7175, 7178, 7182,
+	7187, 7203, 7205, 7213, 7215, 7223, 7229, 7231,
+	7235, 7238, 7241, 7244, 7248, 7259, 7262, 7274,
+	7298, 7306, 7308, 7312, 7315, 7320, 7323, 7325,
+	7330, 7333, 7339, 7342, 7407, 7410, 7412, 7414,
+	7416, 7418, 7420, 7423, 7428, 7431, 7434, 7436,
+	7476, 7478, 7480, 7482, 7487, 7491, 7492, 7494,
+	7496, 7503, 7510, 7517, 7519, 7521, 7523, 7526,
+	7529, 7535, 7538, 7543, 7550, 7555, 7558, 7562,
+	7569, 7601, 7650, 7665, 7678, 7683, 7685, 7689,
+	7720, 7726, 7728, 7749, 7769, 7771, 7783, 7794,
+	7797, 7800, 7801, 7803, 7805, 7807, 7810, 7812,
+	7820, 7822, 7824, 7826, 7836, 7845, 7848, 7852,
+	7856, 7859, 7861, 7863, 7865, 7867, 7869, 7879,
+	7888, 7891, 7895, 7899, 7902, 7904, 7906, 7908,
+	7910, 7912, 7954, 7994, 7996, 8001, 8005, 8006,
+	8008, 8010, 8017, 8024, 8031, 8033, 8035, 8037,
+	8040, 8043, 8049, 8052, 8057, 8064, 8069, 8072,
+	8076, 8083, 8115, 8164, 8179, 8192, 8197, 8199,
+	8203, 8234, 8240, 8242, 8263, 8283,
+}
+
+var _hcltok_indicies []int16 = []int16{
+	1, 0, 3, 2, 3, 4, 2, 6,
+	8, 8, 7, 5, 9, 9, 7, 5,
+	7, 5, 10, 11, 12, 13, 15, 16,
+	17, 18, 19, 20, 21, 22, 23, 24,
+	25, 26, 27, 28, 29, 30, 31, 32,
+	33, 34, 35, 36, 37, 39, 40, 41,
+	42, 43, 11, 11, 14, 14, 38, 0,
+	11, 12, 13, 15, 16, 17, 18, 19,
+	20, 21, 22, 23, 24, 25, 26, 27,
+	28, 29, 30, 31, 32, 33, 34, 35,
+	36, 37, 39, 40, 41, 42, 43, 11,
+	11, 14, 14, 38, 0, 44, 45, 11,
+	11, 46, 13, 15, 16, 17, 16, 47,
+	48, 20, 49, 22, 23, 50, 51, 52,
+	53, 54, 55, 56, 57, 58, 59, 60,
+	61, 62, 37, 39, 63, 41, 64, 65,
+	66, 11, 11, 11, 14, 38, 0, 44,
+	0, 11, 11, 11, 11, 0, 11, 11,
+	11, 0, 11, 0, 11, 11, 0, 0,
+	0, 0, 0, 0, 11, 0, 0, 0,
+	0, 11, 11, 11, 11, 11, 0, 0,
+	11, 0, 0, 11, 0, 11, 0, 0,
+	11, 0, 0, 0, 11, 11, 11, 11,
+	11, 11, 0, 11, 11, 0, 11, 11,
+	0, 0, 0, 0, 0, 0, 11, 11,
+	0, 0, 11, 0, 11, 11, 11, 0,
+	67, 68, 69, 70, 14, 71, 72, 73,
+	74, 75, 76, 77, 78, 79, 80, 81,
+	82, 83, 84, 85, 86, 87, 88, 89,
+	90, 91, 92, 93, 94, 95, 96, 97,
+	0, 11, 0, 11, 0, 11, 11, 0,
+	11, 11, 0, 0, 0, 11, 0, 0,
+	0, 0, 0, 0, 0, 11, 0, 0,
+	0, 0, 0, 0, 0, 11, 11, 11,
+	11, 11, 11, 11, 11, 11, 11, 11,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	11, 11, 11, 11, 11, 11, 11, 11,
+	11, 0, 0, 0, 0, 0, 0, 0,
+	0, 11, 11, 11, 11, 11, 11, 11,
+	11, 11, 0, 11, 11, 11, 11, 11,
+	11, 11, 11, 0, 11, 11, 11, 11,
+	11, 11, 11, 11, 11, 11, 11, 0,
+	11, 11, 11, 11, 11, 11, 0, 11,
+	11, 11, 11, 11, 11, 0, 0, 0,
+	0, 0, 0, 0, 0, 11, 11, 11,
+	11, 11, 11, 11, 11, 0, 11, 11,
+	11, 11, 11, 11, 11, 11, 0, 11,
+	11, 11, 11, 11, 0, 0, 0, 0,
+	0, 0, 0, 0, 11, 11, 11, 11,
+	11, 11, 0, 11, 11, 11, 11, 11,
+	11, 11, 0, 11, 0, 11, 11, 0,
+	11, 11, 11, 11, 11, 11, 11, 11,
+	11, 11, 11, 11, 11, 0, 11, 11,
+	11, 11, 11, 0, 11, 11, 11, 11,
+	11, 11, 11, 0, 11, 11, 11, 0,
+	11, 11, 11, 0, 11, 0, 98, 99,
+	100, 101, 102, 103, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 113, 114, 16,
+	115, 116, 117, 118, 119, 120, 121, 122,
+	123, 124, 125, 126, 127, 128, 129, 130,
+	131, 132, 14, 15, 133, 134, 135, 136,
+	137, 14, 16, 14, 0, 11, 0, 11,
+	11, 0, 0, 11, 0, 0, 0, 0,
+	11, 0, 0, 0, 0, 0, 11, 0,
+	0, 0, 0, 0, 11, 11, 11, 11,
+	11, 0, 0, 0, 11, 0, 0, 0,
+	11, 11, 11, 0, 0, 0, 11, 11,
+	0, 0, 0, 11, 11, 11, 0, 0,
+	0, 11, 11, 11, 11, 0, 11, 11,
+	11, 11, 0, 0, 0, 0, 0, 11,
+	11, 11, 11, 0, 0, 11, 11, 11,
+	0, 0, 11, 11, 11, 11, 0, 11,
+	11, 0, 11, 11, 0, 0, 0, 11,
+	11, 11, 0, 0, 0, 0, 11, 11,
+	11, 11, 11, 0, 0, 0, 0, 11,
+	0, 11, 11, 0, 11, 11, 0, 11,
+	0, 11, 11, 11, 0, 11, 11, 0,
+	0, 0, 11, 0, 0, 0, 0, 0,
+	0, 0, 11, 11, 11, 11, 0, 11,
+	11, 11, 11, 11, 11, 11, 0, 138,
+	139, 140, 141, 142, 143, 144, 145, 146,
+	14, 147, 148, 149, 150, 151, 0, 11,
+	0, 0, 0, 0, 0, 11, 11, 0,
+	11, 11, 11, 0, 11, 11, 11, 11,
+	11, 11, 11, 11, 11, 11, 0, 11,
+	11, 11, 0, 0, 11, 11, 11, 0,
+	0, 11, 0, 0, 11, 11, 11, 11,
+	11, 0, 0, 0, 0, 11, 11, 11,
+	11, 11, 11, 0, 11, 11, 11, 11,
+	11, 0, 152, 109, 153, 154, 155, 14,
+	156, 157, 16, 14, 0, 11, 11, 11,
+	11, 0, 0, 0, 11, 0, 0, 11,
+	11, 11, 0, 0, 0, 11, 11, 0,
+	119, 0, 16, 14, 14, 158, 0, 14,
+	0, 11, 16, 159, 160, 16, 161, 162,
+	16, 57, 163, 164, 165, 166, 167, 16,
+	168, 169, 170, 16, 171, 172, 173, 15,
+	174, 175, 176, 15, 177, 16, 14, 0,
+	0, 11, 11, 0, 0, 0, 11, 11,
+	11, 11, 0, 11, 11, 0, 0, 0,
+	0, 11, 11, 0, 0, 11, 11, 0,
+	0, 0, 0, 0, 0, 11, 11, 11,
+	0, 0, 0, 11, 0, 0, 0, 11,
+	11, 0, 11, 11, 11, 11, 0, 11,
+	11, 11, 11, 0, 11, 11, 11, 11,
+	11, 11, 0, 0, 0, 11, 11, 11,
+	11, 0, 178, 179, 0, 14, 0, 11,
+	0, 0, 11, 16, 180, 181, 182, 183,
+	57, 184, 185, 55, 186, 187, 188, 189,
+	190, 191, 192, 193, 194, 14, 0, 0,
+	11, 0, 11, 11, 11, 11, 11, 11,
+	11, 0, 11, 11, 11, 0, 11, 0,
+	0, 11, 0, 11, 0, 0, 11, 11,
+	11, 11, 0, 11, 11, 11, 0, 0,
+	11, 11, 11, 11, 0, 11, 11, 0,
+	0, 11, 11, 11, 11, 11, 0, 195,
+	196, 197, 198, 199, 200, 201, 202, 203,
+	204, 205, 201, 206, 207, 208, 209, 38,
+	0, 210, 211, 16, 212, 213, 214, 215,
+	216, 217, 218, 219, 220, 16, 14, 221,
+	222, 223, 224, 16, 225, 226, 227, 228,
+	229, 230, 231, 232, 233, 234, 235, 236,
+	237, 238, 239, 16, 144, 14, 240, 0,
+	11, 11, 11, 11, 11, 0, 0, 0,
+	11, 0, 11, 11, 0, 11, 0, 11,
+	11, 0, 0, 0, 11, 11, 11, 0,
+	0, 0, 11, 11, 11, 0, 0, 0,
+	0, 11, 0, 0, 11, 0, 0, 11,
+	11, 11, 0, 0, 11, 0, 11, 11,
+	11, 0, 11, 11, 11, 11, 11, 11,
+	0, 0, 0, 11, 11, 0, 11, 11,
+	0, 11, 11, 0, 11, 11, 0, 11,
+	11, 11, 11, 11, 11, 11, 0, 11,
+	0, 11, 0, 11, 11, 0, 11, 0,
+	11, 11, 0, 11, 0, 11, 0, 241,
+	212, 242, 243, 244, 245, 246, 247, 248,
+	249, 250, 98, 251, 16, 252, 253, 254,
+	16, 255, 129, 256, 257, 258, 259, 260,
+	261, 262, 263, 16, 0, 0, 0, 11,
+	11, 11, 0, 11, 11, 0, 11, 11,
+	0, 0, 0, 0, 0, 11, 11, 11,
+	11, 0, 11, 11, 11, 11, 11, 11,
+	0, 0, 0, 11, 11, 11, 11, 11,
+	11, 11, 11, 11, 0, 11, 11, 11,
+	11, 11, 11, 11, 11, 0, 11, 11,
+	0, 0, 0, 0, 11, 11, 11, 0,
+	0, 0, 11, 0, 0, 0, 11, 11,
+	0, 11, 11, 11, 0, 11, 0, 0,
+	0, 11, 11, 0, 11, 11, 11, 0,
+	11, 11, 11, 0, 0, 0, 0, 11,
+	16, 181, 264, 265, 14, 16, 14, 0,
+	0, 11, 0, 11, 16, 264, 14, 0,
+	16, 266, 14, 0, 0, 11, 16, 267,
+	268, 269, 172, 270, 271, 16, 272, 273,
+	274, 14, 0, 0, 11, 11, 11, 0,
+	11, 11, 0, 11, 11, 11, 11, 0,
+	0, 11, 0, 0, 11, 11, 0, 11,
+	0, 16, 14, 0, 275, 16, 276, 0,
+	14, 0, 11, 0, 11, 277, 16, 278,
+	279, 0, 11, 0, 0, 0, 11, 11,
+	11, 11, 0, 280, 281, 282, 16, 283,
+	284, 285, 286, 287, 288, 289, 290, 291,
+	292, 293, 294, 295, 296, 14, 0, 11,
+	11, 11, 0, 0, 0, 0, 11, 11,
+	0, 0, 11, 0, 0, 0, 0, 0,
+	0, 0, 11, 0, 11, 0, 0, 0,
+	0, 0, 0, 11, 11, 11, 11, 11,
+	0, 0, 11, 0, 0, 0, 11, 0,
+	0, 11, 0, 0, 11, 0, 0, 11,
+	0, 0, 0, 11, 11, 11, 0, 0,
+	0, 11, 11, 11, 11, 0, 297, 16,
+	298, 16, 299, 300, 301, 302, 14, 0,
+	11, 11, 11, 11, 11, 0, 0, 0,
+	11, 0, 0, 11, 11, 11, 11, 11,
+	11, 11, 11, 11, 11, 0, 11, 11,
+	11, 11, 11, 11, 11, 11, 11, 11,
+	11, 11, 11, 11, 11, 11, 11, 11,
+	11, 0, 11, 11, 11, 11, 11, 0,
+	303, 16, 14, 0, 11, 304, 16, 100,
+	14, 0, 11, 305, 0, 14, 0, 11,
+	16, 306, 14, 0, 0, 11, 307, 0,
+	16, 308, 14, 0, 0, 11, 11, 11,
+	11, 0, 11, 11, 11, 11, 0, 11,
+	11, 11, 11, 11, 0, 0, 11, 0,
+	11, 11, 11, 0, 11, 0, 11, 11,
+	11, 0, 0, 0, 0, 0, 0, 0,
+	11, 11, 11, 0, 11, 0, 0, 0,
+	11, 11, 11, 11, 0, 309, 310, 69,
+	311, 312, 313, 314, 315, 316, 317, 318,
+	319, 320, 321, 322, 323, 324, 325, 326,
+	327, 328, 329, 331, 332, 333, 334, 335,
+	336, 330, 0, 11, 11, 11, 11, 0,
+	11, 0, 11, 11, 0, 11, 11, 11,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 11, 11, 11, 11, 11, 0, 11,
+	11, 11, 11, 11, 11, 11, 0, 11,
+	11, 11, 0, 11, 11, 11, 11, 11,
+	11, 11, 0, 11, 11, 11, 0, 11,
+	11, 11, 11, 11, 11, 11, 0, 11,
+	11, 11, 0, 11, 11, 11, 11, 11,
+	11, 11, 11, 11, 11, 0, 11, 0,
+	11, 11, 11, 11, 11, 0, 11, 11,
+	0, 11, 11, 11, 11, 11, 11, 11,
+	0, 11, 11, 11, 0, 11, 11, 11,
+	11, 0, 11, 11, 11, 11, 0, 11,
+	11, 11, 11, 0, 11, 0, 11, 11,
+	0, 11, 11, 11, 11, 11, 11, 11,
+	11, 11, 11, 11, 11, 11, 11, 0,
+	11, 11, 11, 0, 11, 0, 11, 11,
+	0, 11, 0, 337, 338, 339, 101, 102,
+	103, 104, 105, 340, 107, 108, 109, 110,
+	111, 112, 341, 342, 167, 343, 258, 117,
+	344, 119, 229, 269, 122, 345, 346, 347,
+	348, 349, 350, 351, 352, 353, 354, 131,
+	355, 16, 14, 15, 16, 134, 135, 136,
+	137, 14, 14, 0, 11, 11, 0, 11,
+	11, 11, 11, 11, 11, 0, 0, 0,
+	11, 0, 11, 11, 11, 11, 0, 11,
+	11, 11, 0, 11, 11, 0, 11, 11,
+	11, 0, 0, 11, 11, 11, 0, 0,
+	11, 11, 0, 11, 0, 11, 0, 11,
+	11, 11, 0, 0, 11, 11, 0, 11,
+	11, 0, 11, 11, 11, 0, 356, 140,
+	142, 143, 144, 145, 146, 14, 357, 148,
+	358, 150, 359, 0, 11, 11, 0, 0,
+	0, 0, 11, 0, 0, 11, 11, 11,
+	11, 11, 0, 360, 109, 361, 154, 155,
+	14, 156, 157, 16, 14, 0, 11, 11,
+	11, 11, 0, 0, 0, 11, 16, 159,
+	160, 16, 362, 363, 219, 308, 163, 164,
+	165, 364, 167, 365, 366, 367, 368, 369,
+	370, 371, 372, 373, 374, 175, 176, 15,
+	375, 16, 14, 0, 0, 0, 0, 11,
+	11, 11, 0, 0, 0, 0, 0, 11,
+	11, 0, 11, 11, 11, 0, 11, 11,
+	0, 0, 0, 11, 11, 0, 11, 11,
+	11, 11, 0, 11, 0, 11, 11, 11,
+	11, 11, 0, 0, 0, 0, 0, 11,
+	11, 11, 11, 11, 11, 0, 11, 0,
+	16, 180, 181, 376, 183, 57, 184, 185,
+	55, 186, 187, 377, 14, 190, 378, 192,
+	193, 194, 14, 0, 11, 11, 11, 11,
+	11, 11, 11, 0, 11, 11, 0, 11,
+	0, 379, 380, 197, 198, 199, 381, 201,
+	202, 382, 383, 384, 201, 206, 207, 208,
+	209, 38, 0, 210, 211, 16, 212, 213,
+	215, 385, 217, 386, 219, 220, 16, 14,
+	387, 222, 223, 224, 16, 225, 226, 227,
+	228, 229, 230, 231, 232, 388, 234, 235,
+	389, 237, 238, 239, 16, 144, 14, 240,
+	0, 0, 11, 0, 0, 11, 0, 11,
+	11, 11, 11, 11, 0, 11, 11, 0,
+	390, 391, 392, 393, 394, 395, 396, 397,
+	247, 398, 319, 399, 213, 400, 401, 402,
+	403, 404, 401, 405, 406, 407, 258, 408,
+	260, 409, 410, 271, 0, 11, 0, 11,
+	0, 11, 0, 11, 0, 11, 11, 0,
+	11, 0, 11, 11, 11, 0, 11, 11,
+	0, 0, 11, 11, 11, 0, 11, 0,
+	11, 0, 11, 11, 0, 11, 0, 11,
+	0, 11, 0, 11, 0, 11, 0, 0,
+	0, 11, 11, 11, 0, 11, 11, 0,
+	16, 267, 229, 411, 401, 412, 271, 16,
+	413, 414, 274, 14, 0, 11, 0, 11,
+	11, 11, 0, 0, 0, 11, 11, 0,
+	277, 16, 278, 415, 0, 11, 11, 0,
+	16, 283, 284, 285, 286, 287, 288, 289,
+	290, 291, 292, 416, 14, 0, 0, 0,
+	11, 16, 417, 16, 265, 300, 301, 302,
+	14, 0, 0, 11, 419, 419, 419, 419,
+	418, 419, 419, 419, 418, 419, 418, 419,
+	419, 418, 418, 418, 418, 418, 418, 419,
+	418, 418, 418, 418, 419, 419, 419, 419,
+	419, 418, 418, 419, 418, 418, 419, 418,
+	419, 418, 418, 419, 418, 418, 418, 419,
+	419, 419, 419, 419, 419, 418, 419, 419,
+	418, 419, 419, 418, 418, 418, 418, 418,
+	418, 419, 419, 418, 418, 419, 418, 419,
+	419, 419, 418, 421, 422, 423, 424, 425,
+	426, 427, 428, 429, 430, 431, 432, 433,
+	434, 435, 436, 437, 438, 439, 440, 441,
+	442, 443, 444, 445, 446, 447, 448, 449,
+	450, 451, 452, 418, 419, 418, 419, 418,
+	419, 419, 418, 419, 419, 418, 418, 418,
+	419, 418, 418, 418, 418, 418, 418, 418,
+	419, 418, 418, 418, 418, 418, 418, 418,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	419, 419, 419, 418, 418, 418, 418, 418,
+	418, 418, 418, 419, 419, 419, 419, 419,
+	419, 419, 419, 419, 418, 418, 418, 418,
+	418, 418, 418, 418, 419, 419, 419, 419,
+	419, 419, 419, 419, 419, 418, 419, 419,
+	419, 419, 419, 419, 419, 419, 418, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	419, 419, 418, 419, 419, 419, 419, 419,
+	419, 418, 419, 419, 419, 419, 419, 419,
+	418, 418, 418, 418, 418, 418, 418, 418,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	418, 419, 419, 419, 419, 419, 419, 419,
+	419, 418, 419, 419, 419, 419, 419, 418,
+	418, 418, 418, 418, 418, 418, 418, 419,
+	419, 419, 419, 419, 419, 418, 419, 419,
+	419, 419, 419, 419, 419, 418, 419, 418,
+	419, 419, 418, 419, 419, 419, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	418, 419, 419, 419, 419, 419, 418, 419,
+	419, 419, 419, 419, 419, 419, 418, 419,
+	419, 419, 418, 419, 419, 419, 418, 419,
+	418, 453, 454, 455, 456, 457, 458, 459,
+	460, 461, 462, 463, 464, 465, 466, 467,
+	468, 469, 470, 471, 472, 473, 474, 475,
+	476, 477, 478, 479, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 425, 489, 490,
+	491, 492, 493, 494, 425, 470, 425, 418,
+	419, 418, 419, 419, 418, 418, 419, 418,
+	418, 418, 418, 419, 418, 418, 418, 418,
+	418, 419, 418, 418, 418, 418, 418, 419,
+	419, 419, 419, 419, 418, 418, 418, 419,
+	418, 418, 418, 419, 419, 419, 418, 418,
+	418, 419, 419, 418, 418, 418, 419, 419,
+	419, 418, 418, 418, 419, 419, 419, 419,
+	418, 419, 419, 419, 419, 418, 418, 418,
+	418, 418, 419, 419, 419, 419, 418, 418,
+	419, 419, 419, 418, 418, 419, 419, 419,
+	419, 418, 419, 419, 418, 419, 419, 418,
+	418, 418, 419, 419, 419, 418, 418, 418,
+	418, 419, 419, 419, 419, 419, 418, 418,
+	418, 418, 419, 418, 419, 419, 418, 419,
+	419, 418, 419, 418, 419, 419, 419, 418,
+	419, 419, 418, 418, 418, 419, 418, 418,
+	418, 418, 418, 418, 418, 419, 419, 419,
+	419, 418, 419, 419, 419, 419, 419, 419,
+	419, 418, 495, 496, 497, 498, 499, 500,
+	501, 502, 503, 425, 504, 505, 506, 507,
+	508, 418, 419, 418, 418, 418, 418, 418,
+	419, 419, 418, 419, 419, 419, 418, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	419, 418, 419, 419, 419, 418, 418, 419,
+	419, 419, 418, 418, 419, 418, 418, 419,
+	419, 419, 419, 419, 418, 418, 418, 418,
+	419, 419, 419, 419, 419, 419, 418, 419,
+	419, 419, 419, 419, 418, 509, 464, 510,
+	511, 512, 425, 513, 514, 470, 425, 418,
+	419, 419, 419, 419, 418, 418, 418, 419,
+	418, 418, 419, 419, 419, 418, 418, 418,
+	419, 419, 418, 475, 418, 470, 425, 425,
+	515, 418, 425, 418, 419, 470, 516, 517,
+	470, 518, 519, 470, 520, 521, 522, 523,
+	524, 525, 470, 526, 527, 528, 470, 529,
+	530, 531, 489, 532, 533, 534, 489, 535,
+	470, 425, 418, 418, 419, 419, 418, 418,
+	418, 419, 419, 419, 419, 418, 419, 419,
+	418, 418, 418, 418, 419, 419, 418, 418,
+	419, 419, 418, 418, 418, 418, 418, 418,
+	419, 419, 419, 418, 418, 418, 419, 418,
+	418, 418, 419, 419, 418, 419, 419, 419,
+	419, 418, 419, 419, 419, 419, 418, 419,
+	419, 419, 419, 419, 419, 418, 418, 418,
+	419, 419, 419, 419, 418, 536, 537, 418,
+	425, 418, 419, 418, 418, 419, 470, 538,
+	539, 540, 541, 520, 542, 543, 544, 545,
+	546, 547, 548, 549, 550, 551, 552, 553,
+	425, 418, 418, 419, 418, 419, 419, 419,
+	419, 419, 419, 419, 418, 419, 419, 419,
+	418, 419, 418, 418, 419, 418, 419, 418,
+	418, 419, 419, 419, 419, 418, 419, 419,
+	419, 418, 418, 419, 419, 419, 419, 418,
+	419, 419, 418, 418, 419, 419, 419, 419,
+	419, 418, 554, 555, 556, 557, 558, 559,
+	560, 561, 562, 563, 564, 560, 566, 567,
+	568, 569, 565, 418, 570, 571, 470, 572,
+	573, 574, 575, 576, 577, 578, 579, 580,
+	470, 425, 581, 582, 583, 584, 470, 585,
+	586, 587, 588, 589, 590, 591, 592, 593,
+	594, 595, 596, 597, 598, 599, 470, 501,
+	425, 600, 418, 419, 419, 419, 419, 419,
+	418, 418, 418, 419, 418, 419, 419, 418,
+	419, 418, 419, 419, 418, 418, 418, 419,
+	419, 419, 418, 418, 418, 419, 419, 419,
+	418, 418, 418, 418, 419, 418, 418, 419,
+	418, 418, 419, 419, 419, 418, 418, 419,
+	418, 419, 419, 419, 418, 419, 419, 419,
+	419, 419, 419, 418, 418, 418, 419, 419,
+	418, 419, 419, 418, 419, 419, 418, 419,
+	419, 418, 419, 419, 419, 419, 419, 419,
+	419, 418, 419, 418, 419, 418, 419, 419,
+	418, 419, 418, 419, 419, 418, 419, 418,
+	419, 418, 601, 572, 602, 603, 604, 605,
+	606, 607, 608, 609, 610, 453, 611, 470,
+	612, 613, 614, 470, 615, 485, 616, 617,
+	618, 619, 620, 621, 622, 623, 470, 418,
+	418, 418, 419, 419, 419, 418, 419, 419,
+	418, 419, 419, 418, 418, 418, 418, 418,
+	419, 419, 419, 419, 418, 419, 419, 419,
+	419, 419, 419, 418, 418, 418, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 418,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	418, 419, 419, 418, 418, 418, 418, 419,
+	419, 419, 418, 418, 418, 419, 418, 418,
+	418, 419, 419, 418, 419, 419, 419, 418,
+	419, 418, 418, 418, 419, 419, 418, 419,
+	419, 419, 418, 419, 419, 419, 418, 418,
+	418, 418, 419, 470, 539, 624, 625, 425,
+	470, 425, 418, 418, 419, 418, 419, 470,
+	624, 425, 418, 470, 626, 425, 418, 418,
+	419, 470, 627, 628, 629, 530, 630, 631,
+	470, 632, 633, 634, 425, 418, 418, 419,
+	419, 419, 418, 419, 419, 418, 419, 419,
+	419, 419, 418, 418, 419, 418, 418, 419,
+	419, 418, 419, 418, 470, 425, 418, 635,
+	470, 636, 418, 425, 418, 419, 418, 419,
+	637, 470, 638, 639, 418, 419, 418, 418,
+	418, 419, 419, 419, 419, 418, 640, 641,
+	642, 470, 643, 644, 645, 646, 647, 648,
+	649, 650, 651, 652, 653, 654, 655, 656,
+	425, 418, 419, 419, 419, 418, 418, 418,
+	418, 419, 419, 418, 418, 419, 418, 418,
+	418, 418, 418, 418, 418, 419, 418, 419,
+	418, 418, 418, 418, 418, 418, 419, 419,
+	419, 419, 419, 418, 418, 419, 418, 418,
+	418, 419, 418, 418, 419, 418, 418, 419,
+	418, 418, 419, 418, 418, 418, 419, 419,
+	419, 418, 418, 418, 419, 419, 419, 419,
+	418, 657, 470, 658, 470, 659, 660, 661,
+	662, 425, 418, 419, 419, 419, 419, 419,
+	418, 418, 418, 419, 418, 418, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	418, 419, 419, 419, 419, 419, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	419, 419, 419, 419, 418, 419, 419, 419,
+	419, 419, 418, 663, 470, 425, 418, 419,
+	664, 470, 455, 425, 418, 419, 665, 418,
+	425, 418, 419, 470, 666, 425, 418, 418,
+	419, 667, 418, 470, 668, 425, 418, 418,
+	419, 670, 669, 419, 419, 419, 419, 670,
+	669, 419, 670, 669, 670, 670, 419, 670,
+	669, 419, 670, 419, 670, 669, 419, 670,
+	419, 670, 419, 669, 670, 670, 670, 670,
+	670, 670, 670, 670, 669, 419, 419, 670,
+	670, 419, 670, 419, 670, 669, 670, 670,
+	670, 670, 670, 419, 670, 419, 670, 419,
+	670, 669, 670, 670, 419, 670, 419, 670,
+	669, 670, 670, 670, 670, 670, 419, 670,
+	419, 670, 669, 419, 419, 670, 419, 670,
+	669, 670, 670, 670, 419, 670, 419, 670,
+	419, 670, 419, 670, 669, 670, 419, 670,
+	419, 670, 669, 419, 670, 670, 670, 670,
+	419, 670, 419, 670, 419, 670, 419, 670,
+	419, 670, 419, 670, 669, 419, 670, 669,
+	670, 670, 670, 419, 670, 419, 670, 669,
+	670, 419, 670, 419, 670, 669, 419, 670,
+	670, 670, 670, 419, 670, 419, 670, 669,
+	419, 670, 419, 670, 419, 670, 669, 670,
+	670, 419, 670, 419, 670, 669, 419, 670,
+	419, 670, 419, 670, 419, 669, 670, 670,
+	670, 419, 670, 419, 670, 669, 419, 670,
+	669, 670, 670, 419, 670, 669, 670, 670,
+	670, 419, 670, 670, 670, 670, 670, 670,
+	419, 419, 670, 419, 670, 419, 670, 419,
+	670, 669, 670, 419, 670, 419, 670, 669,
+	419, 670, 669, 670, 419, 670, 669, 670,
+	419, 670, 669, 419, 419, 670, 669, 419,
+	670, 419, 670, 419, 670, 419, 670, 419,
+	670, 419, 669, 670, 670, 419, 670, 670,
+	670, 670, 419, 419, 670, 670, 670, 670,
+	670, 419, 670, 670, 670, 670, 670, 669,
+	419, 670, 670, 419, 670, 419, 669, 670,
+	670, 419, 670, 669, 419, 419, 670, 419,
+	669, 670, 670, 669, 419, 670, 419, 669,
+	670, 669, 419, 670, 419, 670, 419, 669,
+	670, 670, 669, 419, 670, 419, 670, 419,
+	670, 669, 670, 419, 670, 419, 670, 669,
+	419, 670, 669, 419, 419, 670, 669, 670,
+	419, 669, 670, 669, 419, 670, 419, 670,
+	419, 669, 670, 669, 419, 419, 670, 669,
+	670, 419, 670, 419, 670, 669, 419, 670,
+	419, 669, 670, 669, 419, 419, 670, 419,
+	669, 670, 669, 419, 419, 670, 669, 670,
+	419, 670, 669, 670, 419, 670, 669, 670,
+	419, 670, 419, 670, 419, 669, 670, 669,
+	419, 419, 670, 669, 670, 419, 670, 419,
+	670, 669, 419, 670, 669, 670, 670, 419,
+	670, 419, 670, 669, 669, 419, 669, 419,
+	670, 670, 419, 670, 670, 670, 670, 670,
+	670, 670, 669, 419, 670, 670, 670, 419,
+	669, 670, 670, 670, 419, 670, 419, 670,
+	419, 670, 419, 670, 419, 670, 669, 419,
+	419, 670, 669, 670, 419, 670, 669, 419,
+	419, 670, 419, 419, 419, 670, 419, 670,
+	419, 670, 419, 670, 419, 669, 419, 670,
+	419, 670, 419, 669, 670, 669, 419, 670,
+	419, 669, 670, 419, 670, 670, 670, 669,
+	419, 670, 419, 419, 670, 419, 669, 670,
+	670, 669, 419, 670, 670, 670, 670, 419,
+	670, 419, 669, 670, 670, 670, 419, 670,
+	669, 670, 419, 670, 419, 670, 419, 670,
+	419, 670, 669, 670, 670, 419, 670, 669,
+	419, 670, 419, 670, 419, 669, 670, 670,
+	669, 419, 670, 419, 669, 670, 669, 419,
+	670, 669, 419, 670, 419, 670, 669, 670,
+	670, 670, 669, 419, 419, 419, 670, 669,
+	419, 670, 419, 669, 670, 669, 419, 670,
+	419, 670, 419, 669, 670, 670, 670, 669,
+	419, 670, 419, 669, 670, 670, 670, 670,
+	669, 419, 670, 419, 670, 669, 419, 419,
+	670, 419, 670, 669, 670, 419, 670, 419,
+	669, 670, 670, 669, 419, 670, 419, 670,
+	669, 419, 670, 670, 670, 419, 670, 419,
+	669, 419, 670, 669, 670, 419, 419, 670,
+	419, 670, 419, 669, 670, 670, 670, 670,
+	669, 419, 670, 419, 670, 419, 670, 419,
+	670, 419, 670, 669, 670, 670, 670, 419,
+	670, 419, 670, 419, 670, 419, 669, 670,
+	670, 419, 419, 670, 669, 670, 419, 670,
+	670, 669, 419, 670, 419, 670, 669, 419,
+	419, 670, 670, 670, 670, 419, 670, 419,
+	670, 419, 669, 670, 670, 419, 669, 670,
+	669, 419, 670, 419, 669, 670, 669, 419,
+	670, 419, 669, 670, 419, 670, 670, 669,
+	419, 670, 670, 419, 669, 670, 669, 419,
+	670, 419, 670, 669, 670, 419, 670, 419,
+	669, 670, 669, 419, 670, 419, 670, 419,
+	670, 419, 670, 419, 670, 669, 671, 669,
+	672, 673, 674, 675, 676, 677, 678, 679,
+	680, 681, 682, 674, 683, 684, 685, 686,
+	687, 674, 688, 689, 690, 691, 692, 693,
+	694, 695, 696, 697, 698, 699, 700, 701,
+	702, 674, 703, 671, 683, 671, 704, 671,
+	669, 670, 670, 670, 670, 419, 669, 670,
+	670, 669, 419, 670, 669, 419, 419, 670,
+	669, 419, 670, 419, 669, 670, 669, 419,
+	419, 670, 419, 669, 670, 670, 669, 419,
+	670, 670, 670, 669, 419, 670, 419, 670,
+	670, 669, 419, 419, 670, 419, 669, 670,
+	669, 419, 670, 669, 419, 419, 670, 419,
+	670, 669, 419, 670, 419, 419, 670, 419,
+	670, 419, 669, 670, 670, 669, 419, 670,
+	670, 419, 670, 669, 419, 670, 419, 670,
+	669, 419, 670, 419, 669, 419, 670, 670,
+	670, 419, 670, 669, 670, 419, 670, 669,
+	419, 670, 669, 670, 419, 670, 669, 419,
+	670, 669, 419, 670, 419, 670, 669, 419,
+	670, 669, 419, 670, 669, 705, 706, 707,
+	708, 709, 710, 711, 712, 713, 714, 715,
+	716, 676, 717, 718, 719, 720, 721, 718,
+	722, 723, 724, 725, 726, 727, 728, 729,
+	730, 671, 669, 670, 419, 670, 669, 670,
+	419, 670, 669, 670, 419, 670, 669, 670,
+	419, 670, 669, 419, 670, 419, 670, 669,
+	670, 419, 670, 669, 670, 419, 419, 419,
+	670, 669, 670, 419, 670, 669, 670, 670,
+	670, 670, 419, 670, 419, 669, 670, 669,
+	419, 419, 670, 419, 670, 669, 670, 419,
+	670, 669, 419, 670, 669, 670, 670, 419,
+	670, 669, 419, 670, 669, 670, 419, 670,
+	669, 419, 670, 669, 419, 670, 669, 419,
+	670, 669, 670, 669, 419, 419, 670, 669,
+	670, 419, 670, 669, 419, 670, 419, 669,
+	670, 669, 419, 674, 731, 671, 674, 732,
+	674, 733, 683, 671, 669, 670, 669, 419,
+	670, 669, 419, 674, 732, 683, 671, 669,
+	674, 734, 671, 683, 671, 669, 670, 669,
+	419, 674, 735, 692, 736, 718, 737, 730,
+	674, 738, 739, 740, 671, 683, 671, 669,
+	670, 669, 419, 670, 419, 670, 669, 419,
+	670, 419, 670, 419, 669, 670, 670, 669,
+	419, 670, 419, 670, 669, 419, 670, 669,
+	674, 683, 425, 669, 741, 674, 742, 683,
+	671, 669, 425, 670, 669, 419, 670, 669,
+	419, 743, 674, 744, 745, 671, 669, 419,
+	670, 669, 670, 670, 669, 419, 419, 670,
+	419, 670, 669, 674, 746, 747, 748, 749,
+	750, 751, 752, 753, 754, 755, 756, 671,
+	683, 671, 669, 670, 419, 670, 670, 670,
+	670, 670, 670, 670, 419, 670, 419, 670,
+	670, 670, 670, 670, 670, 669, 419, 670,
+	670, 419, 670, 419, 669, 670, 419, 670,
+	670, 670, 419, 670, 670, 419, 670, 670,
+	419, 670, 670, 419, 670, 670, 669, 419,
+	674, 757, 674, 733, 758, 759, 760, 671,
+	683, 671, 669, 670, 669, 419, 670, 670,
+	670, 419, 670, 670, 670, 419, 670, 419,
+	670, 669, 419, 419, 419, 419, 670, 670,
+	419, 419, 419, 419, 419, 670, 670, 670,
+	670, 670, 670, 670, 419, 670, 419, 670,
+	419, 669, 670, 670, 670, 419, 670, 419,
+	670, 669, 683, 425, 761, 674, 683, 425,
+	670, 669, 419, 762, 674, 763, 683, 425,
+	670, 669, 419, 670, 419, 764, 683, 671,
+	669, 425, 670, 669, 419, 674, 765, 671,
+	683, 671, 669, 670, 669, 419, 766, 766,
+	766, 768, 769, 770, 766, 767, 767, 771,
+	768, 771, 769, 771, 767, 772, 773, 772,
+	775, 774, 776, 774, 777, 774, 779, 778,
+	781, 782, 780, 781, 783, 780, 785, 784,
+	786, 784, 787, 784, 789, 788, 791, 792,
+	790, 791, 793, 790, 795, 795, 795, 795,
+	794, 795, 795, 795, 794, 795, 794, 795,
+	795, 794, 794, 794, 794, 794, 794, 795,
+	794, 794, 794, 794, 795, 795, 795, 795,
+	795, 794, 794, 795, 794, 794, 795, 794,
+	795, 794, 794, 795, 794, 794, 794, 795,
+	795, 795, 795, 795, 795, 794, 795, 795,
+	794, 795, 795, 794, 794, 794, 794, 794,
+	794, 795, 795, 794, 794, 795, 794, 795,
+	795, 795, 794, 797, 798, 799, 800, 801,
+	802, 803, 804, 805, 806, 807, 808, 809,
+	810, 811, 812, 813, 814, 815, 816, 817,
+	818, 819, 820, 821, 822, 823, 824, 825,
+	826, 827, 828, 794, 795, 794, 795, 794,
+	795, 795, 794, 795, 795, 794, 794, 794,
+	795, 794, 794, 794, 794, 794, 794, 794,
+	795, 794, 794, 794, 794, 794, 794, 794,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	795, 795, 795, 794, 794, 794, 794, 794,
+	794, 794, 794, 795, 795, 795, 795, 795,
+	795, 795, 795, 795, 794, 794, 794, 794,
+	794, 794, 794, 794, 795, 795, 795, 795,
+	795, 795, 795, 795, 795, 794, 795, 795,
+	795, 795, 795, 795, 795, 795, 794, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	795, 795, 794, 795, 795, 795, 795, 795,
+	795, 794, 795, 795, 795, 795, 795, 795,
+	794, 794, 794, 794, 794, 794, 794, 794,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	794, 795, 795, 795, 795, 795, 795, 795,
+	795, 794, 795, 795, 795, 795, 795, 794,
+	794, 794, 794, 794, 794, 794, 794, 795,
+	795, 795, 795, 795, 795, 794, 795, 795,
+	795, 795, 795, 795, 795, 794, 795, 794,
+	795, 795, 794, 795, 795, 795, 795, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	794, 795, 795, 795, 795, 795, 794, 795,
+	795, 795, 795, 795, 795, 795, 794, 795,
+	795, 795, 794, 795, 795, 795, 794, 795,
+	794, 829, 830, 831, 832, 833, 834, 835,
+	836, 837, 838, 839, 840, 841, 842, 843,
+	844, 845, 846, 847, 848, 849, 850, 851,
+	852, 853, 854, 855, 856, 857, 858, 859,
+	860, 861, 862, 863, 864, 801, 865, 866,
+	867, 868, 869, 870, 801, 846, 801, 794,
+	795, 794, 795, 795, 794, 794, 795, 794,
+	794, 794, 794, 795, 794, 794, 794, 794,
+	794, 795, 794, 794, 794, 794, 794, 795,
+	795, 795, 795, 795, 794, 794, 794, 795,
+	794, 794, 794, 795, 795, 795, 794, 794,
+	794, 795, 795, 794, 794, 794, 795, 795,
+	795, 794, 794, 794, 795, 795, 795, 795,
+	794, 795, 795, 795, 795, 794, 794, 794,
+	794, 794, 795, 795, 795, 795, 794, 794,
+	795, 795, 795, 794, 794, 795, 795, 795,
+	795, 794, 795, 795, 794, 795, 795, 794,
+	794, 794, 795, 795, 795, 794, 794, 794,
+	794, 795, 795, 795, 795, 795, 794, 794,
+	794, 794, 795, 794, 795, 795, 794, 795,
+	795, 794, 795, 794, 795, 795, 795, 794,
+	795, 795, 794, 794, 794, 795, 794, 794,
+	794, 794, 794, 794, 794, 795, 795, 795,
+	795, 794, 795, 795, 795, 795, 795, 795,
+	795, 794, 871, 872, 873, 874, 875, 876,
+	877, 878, 879, 801, 880, 881, 882, 883,
+	884, 794, 795, 794, 794, 794, 794, 794,
+	795, 795, 794, 795, 795, 795, 794, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	795, 794, 795, 795, 795, 794, 794, 795,
+	795, 795, 794, 794, 795, 794, 794, 795,
+	795, 795, 795, 795, 794, 794, 794, 794,
+	795, 795, 795, 795, 795, 795, 794, 795,
+	795, 795, 795, 795, 794, 885, 840, 886,
+	887, 888, 801, 889, 890, 846, 801, 794,
+	795, 795, 795, 795, 794, 794, 794, 795,
+	794, 794, 795, 795, 795, 794, 794, 794,
+	795, 795, 794, 851, 794, 846, 801, 801,
+	891, 794, 801, 794, 795, 846, 892, 893,
+	846, 894, 895, 846, 896, 897, 898, 899,
+	900, 901, 846, 902, 903, 904, 846, 905,
+	906, 907, 865, 908, 909, 910, 865, 911,
+	846, 801, 794, 794, 795, 795, 794, 794,
+	794, 795, 795, 795, 795, 794, 795, 795,
+	794, 794, 794, 794, 795, 795, 794, 794,
+	795, 795, 794, 794, 794, 794, 794, 794,
+	795, 795, 795, 794, 794, 794, 795, 794,
+	794, 794, 795, 795, 794, 795, 795, 795,
+	795, 794, 795, 795, 795, 795, 794, 795,
+	795, 795, 795, 795, 795, 794, 794, 794,
+	795, 795, 795, 795, 794, 912, 913, 794,
+	801, 794, 795, 794, 794, 795, 846, 914,
+	915, 916, 917, 896, 918, 919, 920, 921,
+	922, 923, 924, 925, 926, 927, 928, 929,
+	801, 794, 794, 795, 794, 795, 795, 795,
+	795, 795, 795, 795, 794, 795, 795, 795,
+	794, 795, 794, 794, 795, 794, 795, 794,
+	794, 795, 795, 795, 795, 794, 795, 795,
+	795, 794, 794, 795, 795, 795, 795, 794,
+	795, 795, 794, 794, 795, 795, 795, 795,
+	795, 794, 930, 931, 932, 933, 934, 935,
+	936, 937, 938, 939, 940, 936, 942, 943,
+	944, 945, 941, 794, 946, 947, 846, 948,
+	949, 950, 951, 952, 953, 954, 955, 956,
+	846, 801, 957, 958, 959, 960, 846, 961,
+	962, 963, 964, 965, 966, 967, 968, 969,
+	970, 971, 972, 973, 974, 975, 846, 877,
+	801, 976, 794, 795, 795, 795, 795, 795,
+	794, 794, 794, 795, 794, 795, 795, 794,
+	795, 794, 795, 795, 794, 794, 794, 795,
+	795, 795, 794, 794, 794, 795, 795, 795,
+	794, 794, 794, 794, 795, 794, 794, 795,
+	794, 794, 795, 795, 795, 794, 794, 795,
+	794, 795, 795, 795, 794, 795, 795, 795,
+	795, 795, 795, 794, 794, 794, 795, 795,
+	794, 795, 795, 794, 795, 795, 794, 795,
+	795, 794, 795, 795, 795, 795, 795, 795,
+	795, 794, 795, 794, 795, 794, 795, 795,
+	794, 795, 794, 795, 795, 794, 795, 794,
+	795, 794, 977, 948, 978, 979, 980, 981,
+	982, 983, 984, 985, 986, 829, 987, 846,
+	988, 989, 990, 846, 991, 861, 992, 993,
+	994, 995, 996, 997, 998, 999, 846, 794,
+	794, 794, 795, 795, 795, 794, 795, 795,
+	794, 795, 795, 794, 794, 794, 794, 794,
+	795, 795, 795, 795, 794, 795, 795, 795,
+	795, 795, 795, 794, 794, 794, 795, 795,
+	795, 795, 795, 795, 795, 795, 795, 794,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	794, 795, 795, 794, 794, 794, 794, 795,
+	795, 795, 794, 794, 794, 795, 794, 794,
+	794, 795, 795, 794, 795, 795, 795, 794,
+	795, 794, 794, 794, 795, 795, 794, 795,
+	795, 795, 794, 795, 795, 795, 794, 794,
+	794, 794, 795, 846, 915, 1000, 1001, 801,
+	846, 801, 794, 794, 795, 794, 795, 846,
+	1000, 801, 794, 846, 1002, 801, 794, 794,
+	795, 846, 1003, 1004, 1005, 906, 1006, 1007,
+	846, 1008, 1009, 1010, 801, 794, 794, 795,
+	795, 795, 794, 795, 795, 794, 795, 795,
+	795, 795, 794, 794, 795, 794, 794, 795,
+	795, 794, 795, 794, 846, 801, 794, 1011,
+	846, 1012, 794, 801, 794, 795, 794, 795,
+	1013, 846, 1014, 1015, 794, 795, 794, 794,
+	794, 795, 795, 795, 795, 794, 1016, 1017,
+	1018, 846, 1019, 1020, 1021, 1022, 1023, 1024,
+	1025, 1026, 1027, 1028, 1029, 1030, 1031, 1032,
+	801, 794, 795, 795, 795, 794, 794, 794,
+	794, 795, 795, 794, 794, 795, 794, 794,
+	794, 794, 794, 794, 794, 795, 794, 795,
+	794, 794, 794, 794, 794, 794, 795, 795,
+	795, 795, 795, 794, 794, 795, 794, 794,
+	794, 795, 794, 794, 795, 794, 794, 795,
+	794, 794, 795, 794, 794, 794, 795, 795,
+	795, 794, 794, 794, 795, 795, 795, 795,
+	794, 1033, 846, 1034, 846, 1035, 1036, 1037,
+	1038, 801, 794, 795, 795, 795, 795, 795,
+	794, 794, 794, 795, 794, 794, 795, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	794, 795, 795, 795, 795, 795, 795, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	795, 795, 795, 795, 794, 795, 795, 795,
+	795, 795, 794, 1039, 846, 801, 794, 795,
+	1040, 846, 831, 801, 794, 795, 1041, 794,
+	801, 794, 795, 846, 1042, 801, 794, 794,
+	795, 1043, 794, 846, 1044, 801, 794, 794,
+	795, 1046, 1045, 795, 795, 795, 795, 1046,
+	1045, 795, 1046, 1045, 1046, 1046, 795, 1046,
+	1045, 795, 1046, 795, 1046, 1045, 795, 1046,
+	795, 1046, 795, 1045, 1046, 1046, 1046, 1046,
+	1046, 1046, 1046, 1046, 1045, 795, 795, 1046,
+	1046, 795, 1046, 795, 1046, 1045, 1046, 1046,
+	1046, 1046, 1046, 795, 1046, 795, 1046, 795,
+	1046, 1045, 1046, 1046, 795, 1046, 795, 1046,
+	1045, 1046, 1046, 1046, 1046, 1046, 795, 1046,
+	795, 1046, 1045, 795, 795, 1046, 795, 1046,
+	1045, 1046, 1046, 1046, 795, 1046, 795, 1046,
+	795, 1046, 795, 1046, 1045, 1046, 795, 1046,
+	795, 1046, 1045, 795, 1046, 1046, 1046, 1046,
+	795, 1046, 795, 1046, 795, 1046, 795, 1046,
+	795, 1046, 795, 1046, 1045, 795, 1046, 1045,
+	1046, 1046, 1046, 795, 1046, 795, 1046, 1045,
+	1046, 795, 1046, 795, 1046, 1045, 795, 1046,
+	1046, 1046, 1046, 795, 1046, 795, 1046, 1045,
+	795, 1046, 795, 1046, 795, 1046, 1045, 1046,
+	1046, 795, 1046, 795, 1046, 1045, 795, 1046,
+	795, 1046, 795, 1046, 795, 1045, 1046, 1046,
+	1046, 795, 1046, 795, 1046, 1045, 795, 1046,
+	1045, 1046, 1046, 795, 1046, 1045, 1046, 1046,
+	1046, 795, 1046, 1046, 1046, 1046, 1046, 1046,
+	795, 795, 1046, 795, 1046, 795, 1046, 795,
+	1046, 1045, 1046, 795, 1046, 795, 1046, 1045,
+	795, 1046, 1045, 1046, 795, 1046, 1045, 1046,
+	795, 1046, 1045, 795, 795, 1046, 1045, 795,
+	1046, 795, 1046, 795, 1046, 795, 1046, 795,
+	1046, 795, 1045, 1046, 1046, 795, 1046, 1046,
+	1046, 1046, 795, 795, 1046, 1046, 1046, 1046,
+	1046, 795, 1046, 1046, 1046, 1046, 1046, 1045,
+	795, 1046, 1046, 795, 1046, 795, 1045, 1046,
+	1046, 795, 1046, 1045, 795, 795, 1046, 795,
+	1045, 1046, 1046, 1045, 795, 1046, 795, 1045,
+	1046, 1045, 795, 1046, 795, 1046, 795, 1045,
+	1046, 1046, 1045, 795, 1046, 795, 1046, 795,
+	1046, 1045, 1046, 795, 1046, 795, 1046, 1045,
+	795, 1046, 1045, 795, 795, 1046, 1045, 1046,
+	795, 1045, 1046, 1045, 795, 1046, 795, 1046,
+	795, 1045, 1046, 1045, 795, 795, 1046, 1045,
+	1046, 795, 1046, 795, 1046, 1045, 795, 1046,
+	795, 1045, 1046, 1045, 795, 795, 1046, 795,
+	1045, 1046, 1045, 795, 795, 1046, 1045, 1046,
+	795, 1046, 1045, 1046, 795, 1046, 1045, 1046,
+	795, 1046, 795, 1046, 795, 1045, 1046, 1045,
+	795, 795, 1046, 1045, 1046, 795, 1046, 795,
+	1046, 1045, 795, 1046, 1045, 1046, 1046, 795,
+	1046, 795, 1046, 1045, 1045, 795, 1045, 795,
+	1046, 1046, 795, 1046, 1046, 1046, 1046, 1046,
+	1046, 1046, 1045, 795, 1046, 1046, 1046, 795,
+	1045, 1046, 1046, 1046, 795, 1046, 795, 1046,
+	795, 1046, 795, 1046, 795, 1046, 1045, 795,
+	795, 1046, 1045, 1046, 795, 1046, 1045, 795,
+	795, 1046, 795, 795, 795, 1046, 795, 1046,
+	795, 1046, 795, 1046, 795, 1045, 795, 1046,
+	795, 1046, 795, 1045, 1046, 1045, 795, 1046,
+	795, 1045, 1046, 795, 1046, 1046, 1046, 1045,
+	795, 1046, 795, 795, 1046, 795, 1045, 1046,
+	1046, 1045, 795, 1046, 1046, 1046, 1046, 795,
+	1046, 795, 1045, 1046, 1046, 1046, 795, 1046,
+	1045, 1046, 795, 1046, 795, 1046, 795, 1046,
+	795, 1046, 1045, 1046, 1046, 795, 1046, 1045,
+	795, 1046, 795, 1046, 795, 1045, 1046, 1046,
+	1045, 795, 1046, 795, 1045, 1046, 1045, 795,
+	1046, 1045, 795, 1046, 795, 1046, 1045, 1046,
+	1046, 1046, 1045, 795, 795, 795, 1046, 1045,
+	795, 1046, 795, 1045, 1046, 1045, 795, 1046,
+	795, 1046, 795, 1045, 1046, 1046, 1046, 1045,
+	795, 1046, 795, 1045, 1046, 1046, 1046, 1046,
+	1045, 795, 1046, 795, 1046, 1045, 795, 795,
+	1046, 795, 1046, 1045, 1046, 795, 1046, 795,
+	1045, 1046, 1046, 1045, 795, 1046, 795, 1046,
+	1045, 795, 1046, 1046, 1046, 795, 1046, 795,
+	1045, 795, 1046, 1045, 1046, 795, 795, 1046,
+	795, 1046, 795, 1045, 1046, 1046, 1046, 1046,
+	1045, 795, 1046, 795, 1046, 795, 1046, 795,
+	1046, 795, 1046, 1045, 1046, 1046, 1046, 795,
+	1046, 795, 1046, 795, 1046, 795, 1045, 1046,
+	1046, 795, 795, 1046, 1045, 1046, 795, 1046,
+	1046, 1045, 795, 1046, 795, 1046, 1045, 795,
+	795, 1046, 1046, 1046, 1046, 795, 1046, 795,
+	1046, 795, 1045, 1046, 1046, 795, 1045, 1046,
+	1045, 795, 1046, 795, 1045, 1046, 1045, 795,
+	1046, 795, 1045, 1046, 795, 1046, 1046, 1045,
+	795, 1046, 1046, 795, 1045, 1046, 1045, 795,
+	1046, 795, 1046, 1045, 1046, 795, 1046, 795,
+	1045, 1046, 1045, 795, 1046, 795, 1046, 795,
+	1046, 795, 1046, 795, 1046, 1045, 1047, 1045,
+	1048, 1049, 1050, 1051, 1052, 1053, 1054, 1055,
+	1056, 1057, 1058, 1050, 1059, 1060, 1061, 1062,
+	1063, 1050, 1064, 1065, 1066, 1067, 1068, 1069,
+	1070, 1071, 1072, 1073, 1074, 1075, 1076, 1077,
+	1078, 1050, 1079, 1047, 1059, 1047, 1080, 1047,
+	1045, 1046, 1046, 1046, 1046, 795, 1045, 1046,
+	1046, 1045, 795, 1046, 1045, 795, 795, 1046,
+	1045, 795, 1046, 795, 1045, 1046, 1045, 795,
+	795, 1046, 795, 1045, 1046, 1046, 1045, 795,
+	1046, 1046, 1046, 1045, 795, 1046, 795, 1046,
+	1046, 1045, 795, 795, 1046, 795, 1045, 1046,
+	1045, 795, 1046, 1045, 795, 795, 1046, 795,
+	1046, 1045, 795, 1046, 795, 795, 1046, 795,
+	1046, 795, 1045, 1046, 1046, 1045, 795, 1046,
+	1046, 795, 1046, 1045, 795, 1046, 795, 1046,
+	1045, 795, 1046, 795, 1045, 795, 1046, 1046,
+	1046, 795, 1046, 1045, 1046, 795, 1046, 1045,
+	795, 1046, 1045, 1046, 795, 1046, 1045, 795,
+	1046, 1045, 795, 1046, 795, 1046, 1045, 795,
+	1046, 1045, 795, 1046, 1045, 1081, 1082, 1083,
+	1084, 1085, 1086, 1087, 1088, 1089, 1090, 1091,
+	1092, 1052, 1093, 1094, 1095, 1096, 1097, 1094,
+	1098, 1099, 1100, 1101, 1102, 1103, 1104, 1105,
+	1106, 1047, 1045, 1046, 795, 1046, 1045, 1046,
+	795, 1046, 1045, 1046, 795, 1046, 1045, 1046,
+	795, 1046, 1045, 795, 1046, 795, 1046, 1045,
+	1046, 795, 1046, 1045, 1046, 795, 795, 795,
+	1046, 1045, 1046, 795, 1046, 1045, 1046, 1046,
+	1046, 1046, 795, 1046, 795, 1045, 1046, 1045,
+	795, 795, 1046, 795, 1046, 1045, 1046, 795,
+	1046, 1045, 795, 1046, 1045, 1046, 1046, 795,
+	1046, 1045, 795, 1046, 1045, 1046, 795, 1046,
+	1045, 795, 1046, 1045, 795, 1046, 1045, 795,
+	1046, 1045, 1046, 1045, 795, 795, 1046, 1045,
+	1046, 795, 1046, 1045, 795, 1046, 795, 1045,
+	1046, 1045, 795, 1050, 1107, 1047, 1050, 1108,
+	1050, 1109, 1059, 1047, 1045, 1046, 1045, 795,
+	1046, 1045, 795, 1050, 1108, 1059, 1047, 1045,
+	1050, 1110, 1047, 1059, 1047, 1045, 1046, 1045,
+	795, 1050, 1111, 1068, 1112, 1094, 1113, 1106,
+	1050, 1114, 1115, 1116, 1047, 1059, 1047, 1045,
+	1046, 1045, 795, 1046, 795, 1046, 1045, 795,
+	1046, 795, 1046, 795, 1045, 1046, 1046, 1045,
+	795, 1046, 795, 1046, 1045, 795, 1046, 1045,
+	1050, 1059, 801, 1045, 1117, 1050, 1118, 1059,
+	1047, 1045, 801, 1046, 1045, 795, 1046, 1045,
+	795, 1119, 1050, 1120, 1121, 1047, 1045, 795,
+	1046, 1045, 1046, 1046, 1045, 795, 795, 1046,
+	795, 1046, 1045, 1050, 1122, 1123, 1124, 1125,
+	1126, 1127, 1128, 1129, 1130, 1131, 1132, 1047,
+	1059, 1047, 1045, 1046, 795, 1046, 1046, 1046,
+	1046, 1046, 1046, 1046, 795, 1046, 795, 1046,
+	1046, 1046, 1046, 1046, 1046, 1045, 795, 1046,
+	1046, 795, 1046, 795, 1045, 1046, 795, 1046,
+	1046, 1046, 795, 1046, 1046, 795, 1046, 1046,
+	795, 1046, 1046, 795, 1046, 1046, 1045, 795,
+	1050, 1133, 1050, 1109, 1134, 1135, 1136, 1047,
+	1059, 1047, 1045, 1046, 1045, 795, 1046, 1046,
+	1046, 795, 1046, 1046, 1046, 795, 1046, 795,
+	1046, 1045, 795, 795, 795, 795, 1046, 1046,
+	795, 795, 795, 795, 795, 1046, 1046, 1046,
+	1046, 1046, 1046, 1046, 795, 1046, 795, 1046,
+	795, 1045, 1046, 1046, 1046, 795, 1046, 795,
+	1046, 1045, 1059, 801, 1137, 1050, 1059, 801,
+	1046, 1045, 795, 1138, 1050, 1139, 1059, 801,
+	1046, 1045, 795, 1046, 795, 1140, 1059, 1047,
+	1045, 801, 1046, 1045, 795, 1050, 1141, 1047,
+	1059, 1047, 1045, 1046, 1045, 795, 1142, 1143,
+	1144, 1142, 1145, 1146, 1147, 1149, 1150, 1151,
+	1152, 1153, 1154, 670, 670, 419, 1155, 1156,
+	1157, 1158, 670, 1161, 1162, 1164, 1165, 1166,
+	1160, 1167, 1168, 1169, 1170, 1171, 1172, 1173,
+	1174, 1175, 1176, 1177, 1178, 1179, 1180, 1181,
+	1182, 1183, 1184, 1185, 1186, 1188, 1189, 1190,
+	1191, 1192, 1193, 670, 1148, 7, 1148, 419,
+	1148, 419, 1160, 1163, 1187, 1194, 1159, 1142,
+	1142, 1195, 1143, 1196, 1198, 1197, 4, 1147,
+	1200, 1197, 1201, 1197, 2, 1147, 1197, 6,
+	8, 8, 7, 1202, 1203, 1204, 1197, 1205,
+	1206, 1197, 1207, 1197, 419, 419, 1209, 1210,
+	489, 470, 1211, 470, 1212, 1213, 1214, 1215,
+	1216, 1217, 1218, 1219, 1220, 1221, 1222, 544,
+	1223, 520, 1224, 1225, 1226, 1227, 1228, 1229,
+	1230, 1231, 1232, 1233, 1234, 1235, 419, 419,
+	419, 425, 565, 1208, 1236, 1197, 1237, 1197,
+	670, 1238, 419, 419, 419, 670, 1238, 670,
+	670, 419, 1238, 419, 1238, 419, 1238, 419,
+	670, 670, 670, 670, 670, 1238, 419, 670,
+	670, 670, 419, 670, 419, 1238, 419, 670,
+	670, 670, 670, 419, 1238, 670, 419, 670,
+	419, 670, 419, 670, 670, 419, 670, 1238,
+	419, 670, 419, 670, 419, 670, 1238, 670,
+	419, 1238, 670, 419, 670, 419, 1238, 670,
+	670, 670, 670, 670, 1238, 419, 419, 670,
+	419, 670, 1238, 670, 419, 1238, 670, 670,
+	1238, 419, 419, 670, 419, 670, 419, 670,
+	1238, 1239, 1240, 1241, 1242, 1243, 1244, 1245,
+	1246, 1247, 1248, 1249, 715, 1250, 1251, 1252,
+	1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260,
+	1261, 1260, 1262, 1263, 1264, 1265, 1266, 671,
+	1238, 1267, 1268, 1269, 1270, 1271, 1272, 1273,
+	1274, 1275, 1276, 1277, 1278, 1279, 1280, 1281,
+	1282, 1283, 1284, 1285, 725, 1286, 1287, 1288,
+	692, 1289, 1290, 1291, 1292, 1293, 1294, 671,
+	1295, 1296, 1297, 1298, 1299, 1300, 1301, 1302,
+	674, 1303, 671, 674, 1304, 1305, 1306, 1307,
+	683, 1238, 1308, 1309, 1310, 1311, 703, 1312,
+	1313, 683, 1314, 1315, 1316, 1317, 1318, 671,
+	1238, 1319, 1278, 1320, 1321, 1322, 683, 1323,
+	1324, 674, 671, 683, 425, 1238, 1288, 671,
+	674, 683, 425, 683, 425, 1325, 683, 1238,
+	425, 674, 1326, 1327, 674, 1328, 1329, 681,
+	1330, 1331, 1332, 1333, 1334, 1284, 1335, 1336,
+	1337, 1338, 1339, 1340, 1341, 1342, 1343, 1344,
+	1345, 1346, 1303, 1347, 674, 683, 425, 1238,
+	1348, 1349, 683, 671, 1238, 425, 671, 1238,
+	674, 1350, 731, 1351, 1352, 1353, 1354, 1355,
+	1356, 1357, 1358, 671, 1359, 1360, 1361, 1362,
+	1363, 1364, 671, 683, 1238, 1366, 1367, 1368,
+	1369, 1370, 1371, 1372, 1373, 1374, 1375, 1376,
+	1372, 1378, 1379, 1380, 1381, 1365, 1377, 1365,
+	1238, 1365, 1238, 1382, 1382, 1383, 1384, 1385,
+	1386, 1387, 1388, 1389, 1390, 1387, 767, 1391,
+	1391, 1391, 1392, 1391, 1391, 768, 769, 770,
+	1391, 767, 1382, 1382, 1393, 1396, 1397, 1395,
+	1398, 1399, 1398, 1400, 1391, 1402, 1401, 1396,
+	1403, 1395, 1405, 1404, 1394, 1394, 1394, 768,
+	769, 770, 1394, 767, 767, 1406, 773, 1406,
+	1407, 1406, 775, 1408, 1409, 1410, 1411, 1412,
+	1413, 1414, 1411, 776, 775, 1408, 1415, 1415,
+	777, 779, 1416, 1415, 776, 1418, 1419, 1417,
+	1418, 1419, 1420, 1417, 775, 1408, 1421, 1415,
+	775, 1408, 1415, 1423, 1422, 1425, 1424, 776,
+	1426, 777, 1426, 779, 1426, 785, 1427, 1428,
+	1429, 1430, 1431, 1432, 1433, 1430, 786, 785,
+	1427, 1434, 1434, 787, 789, 1435, 1434, 786,
+	1437, 1438, 1436, 1437, 1438, 1439, 1436, 785,
+	1427, 1440, 1434, 785, 1427, 1434, 1442, 1441,
+	1444, 1443, 786, 1445, 787, 1445, 789, 1445,
+	795, 1448, 1449, 1451, 1452, 1453, 1447, 1454,
+	1455, 1456, 1457, 1458, 1459, 1460, 1461, 1462,
+	1463, 1464, 1465, 1466, 1467, 1468, 1469, 1470,
+	1471, 1472, 1473, 1475, 1476, 1477, 1478, 1479,
+	1480, 795, 795, 1446, 1447, 1450, 1474, 1481,
+	1446, 1046, 795, 795, 1483, 1484, 865, 846,
+	1485, 846, 1486, 1487, 1488, 1489, 1490, 1491,
+	1492, 1493, 1494, 1495, 1496, 920, 1497, 896,
+	1498, 1499, 1500, 1501, 1502, 1503, 1504, 1505,
+	1506, 1507, 1508, 1509, 795, 795, 795, 801,
+	941, 1482, 1046, 1510, 795, 795, 795, 1046,
+	1510, 1046, 1046, 795, 1510, 795, 1510, 795,
+	1510, 795, 1046, 1046, 1046, 1046, 1046, 1510,
+	795, 1046, 1046, 1046, 795, 1046, 795, 1510,
+	795, 1046, 1046, 1046, 1046, 795, 1510, 1046,
+	795, 1046, 795, 1046, 795, 1046, 1046, 795,
+	1046, 1510, 795, 1046, 795, 1046, 795, 1046,
+	1510, 1046, 795, 1510, 1046, 795, 1046, 795,
+	1510, 1046, 1046, 1046, 1046, 1046, 1510, 795,
+	795, 1046, 795, 1046, 1510, 1046, 795, 1510,
+	1046, 1046, 1510, 795, 795, 1046, 795, 1046,
+	795, 1046, 1510, 1511, 1512, 1513, 1514, 1515,
+	1516, 1517, 1518, 1519, 1520, 1521, 1091, 1522,
+	1523, 1524, 1525, 1526, 1527, 1528, 1529, 1530,
+	1531, 1532, 1533, 1532, 1534, 1535, 1536, 1537,
+	1538, 1047, 1510, 1539, 1540, 1541, 1542, 1543,
+	1544, 1545, 1546, 1547, 1548, 1549, 1550, 1551,
+	1552, 1553, 1554, 1555, 1556, 1557, 1101, 1558,
+	1559, 1560, 1068, 1561, 1562, 1563, 1564, 1565,
+	1566, 1047, 1567, 1568, 1569, 1570, 1571, 1572,
+	1573, 1574, 1050, 1575, 1047, 1050, 1576, 1577,
+	1578, 1579, 1059, 1510, 1580, 1581, 1582, 1583,
+	1079, 1584, 1585, 1059, 1586, 1587, 1588, 1589,
+	1590, 1047, 1510, 1591, 1550, 1592, 1593, 1594,
+	1059, 1595, 1596, 1050, 1047, 1059, 801, 1510,
+	1560, 1047, 1050, 1059, 801, 1059, 801, 1597,
+	1059, 1510, 801, 1050, 1598, 1599, 1050, 1600,
+	1601, 1057, 1602, 1603, 1604, 1605, 1606, 1556,
+	1607, 1608, 1609, 1610, 1611, 1612, 1613, 1614,
+	1615, 1616, 1617, 1618, 1575, 1619, 1050, 1059,
+	801, 1510, 1620, 1621, 1059, 1047, 1510, 801,
+	1047, 1510, 1050, 1622, 1107, 1623, 1624, 1625,
+	1626, 1627, 1628, 1629, 1630, 1047, 1631, 1632,
+	1633, 1634, 1635, 1636, 1047, 1059, 1510, 1638,
+	1639, 1640, 1641, 1642, 1643, 1644, 1645, 1646,
+	1647, 1648, 1644, 1650, 1651, 1652, 1653, 1637,
+	1649, 1637, 1510, 1637, 1510,
+}
+
+var _hcltok_trans_targs []int16 = []int16{
+	1459, 1459, 2, 3, 1459, 1459, 4, 1467,
+	5, 6, 8, 9, 286, 12, 13, 14,
+	15, 16, 287, 288, 19, 289, 21, 22,
+	290, 291, 292, 293, 294, 295, 296, 297,
+	298, 299, 328, 348, 353, 127, 128, 129,
+	356, 151, 371, 375, 1459, 10, 11, 17,
+	18, 20, 23, 24, 25, 26, 27, 28,
+	29, 30, 31, 32, 64, 105, 120, 131,
+	154, 170, 283, 33, 34, 35, 36, 37,
+	38, 39, 40, 41, 42, 43, 44, 45,
+	46, 47, 48, 49, 50, 51, 52, 53,
+	54, 55, 56, 57, 58, 59, 60, 61,
+	62, 63, 65, 66, 67, 68, 69, 70,
+	71, 72, 73, 74, 75, 76, 77, 78,
+	79, 80, 81, 82, 83, 84, 85, 86,
+	87, 88, 89, 90, 91, 92, 93, 94,
+	95, 96, 97, 98, 99, 100, 101, 102,
+	103, 104, 106, 107, 108, 109, 110, 111,
+	112, 113, 114, 115, 116, 117, 118, 119,
+	121, 122, 123, 124, 125, 126, 130, 132,
+	133, 134, 135, 136, 137, 138, 139, 140,
+	141, 142, 143, 144, 145, 146, 147, 148,
+	149, 150, 152, 153, 155, 156, 157, 158,
+	159, 160, 161, 162, 163, 164, 165, 166,
+	167, 168, 169, 171, 203, 227, 230, 231,
+	233, 242, 243, 246, 250, 268, 275, 277,
+	279, 281, 172, 173, 174, 175, 176, 177,
+	178, 179, 180, 181, 182, 183, 184, 185,
+	186, 187, 188, 189, 190, 191, 192, 193,
+	194, 195, 196, 197, 198, 199, 200, 201,
+	202, 204, 205, 206, 207, 208, 209, 210,
+	211, 212, 213, 214, 215, 216, 217, 218,
+	219, 220, 221, 222, 223, 224, 225, 226,
+	228, 229, 232, 234, 235, 236, 237, 238,
+	239, 240, 241, 244, 245, 247, 248, 249,
+	251, 252, 253, 254, 255, 256, 257, 258,
+	259, 260, 261, 262, 263, 264, 265, 266,
+	267, 269, 270, 271, 272, 273, 274, 276,
+	278, 280, 282, 284, 285, 300, 301, 302,
+	303, 304, 305, 306, 307, 308, 309, 310,
+	311, 312, 313, 314, 315, 316, 317, 318,
+	319, 320, 321, 322, 323, 324, 325, 326,
+	327, 329, 330, 331, 332, 333, 334, 335,
+	336, 337, 338, 339, 340, 341, 342, 343,
+	344, 345, 346, 347, 349, 350, 351, 352,
+	354, 355, 357, 358, 359, 360, 361, 362,
+	363, 364, 365, 366, 367, 368, 369, 370,
+	372, 373, 374, 376, 382, 404, 409, 411,
+	413, 377, 378, 379, 380, 381, 383, 384,
+	385, 386, 387, 388, 389, 390, 391, 392,
+	393, 394, 395, 396, 397, 398, 399, 400,
+	401, 402, 403, 405, 406, 407, 408, 410,
+	412, 414, 1459, 1471, 1459, 437, 438, 439,
+	440, 417, 441, 442, 443, 444, 445, 446,
+	447, 448, 449, 450, 451, 452, 453, 454,
+	455, 456, 457, 458, 459, 460, 461, 462,
+	463, 464, 465, 466, 467, 469, 470, 471,
+	472, 473, 474, 475, 476, 477, 478, 479,
+	480, 481, 482, 483, 484, 485, 419, 486,
+	487, 488, 489, 490, 491, 492, 493, 494,
+	495, 496, 497, 498, 499, 500, 501, 502,
+	503, 418, 504, 505, 506, 507, 508, 510,
+	511, 512, 513, 514, 515, 516, 517, 518,
+	519, 520, 521, 522, 523, 525, 526, 527,
+	528, 529, 530, 534, 536, 537, 538, 539,
+	434, 540, 541, 542, 543, 544, 545, 546,
+	547, 548, 549, 550, 551, 552, 553, 554,
+	556, 557, 559, 560, 561, 562, 563, 564,
+	432, 565, 566, 567, 568, 569, 570, 571,
+	572, 573, 575, 607, 631, 634, 635, 637,
+	646, 647, 650, 654, 672, 532, 679, 681,
+	683, 685, 576, 577, 578, 579, 580, 581,
+	582, 583, 584, 585, 586, 587, 588, 589,
+	590, 591, 592, 593, 594, 595, 596, 597,
+	598, 599, 600, 601, 602, 603, 604, 605,
+	606, 608, 609, 610, 611, 612, 613, 614,
+	615, 616, 617, 618, 619, 620, 621, 622,
+	623, 624, 625, 626, 627, 628, 629, 630,
+	632, 633, 636, 638, 639, 640, 641, 642,
+	643, 644, 645, 648, 649, 651, 652, 653,
+	655, 656, 657, 658, 659, 660, 661, 662,
+	663, 664, 665, 666, 667, 668, 669, 670,
+	671, 673, 674, 675, 676, 677, 678, 680,
+	682, 684, 686, 688, 689, 1459, 1459, 690,
+	827, 828, 759, 829, 830, 831, 832, 833,
+	834, 788, 835, 724, 836, 837, 838, 839,
+	840, 841, 842, 843, 744, 844, 845, 846,
+	847, 848, 849, 850, 851, 852, 853, 769,
+	854, 856, 857, 858, 859, 860, 861, 862,
+	863, 864, 865, 702, 866, 867, 868, 869,
+	870, 871, 872, 873, 874, 740, 875, 876,
+	877, 878, 879, 810, 881, 882, 885, 887,
+	888, 889, 890, 891, 892, 895, 896, 898,
+	899, 900, 902, 903, 904, 905, 906, 907,
+	908, 909, 910, 911, 912, 914, 915, 916,
+	917, 920, 922, 923, 925, 927, 1509, 1510,
+	929, 930, 931, 1509, 1509, 932, 1523, 1523,
+	1524, 935, 1523, 936, 1525, 1526, 1529, 1530,
+	1534, 1534, 1535, 941, 1534, 942, 1536, 1537,
+	1540, 1541, 1545, 1546, 1545, 968, 969, 970,
+	971, 948, 972, 973, 974, 975, 976, 977,
+	978, 979, 980, 981, 982, 983, 984, 985,
+	986, 987, 988, 989, 990, 991, 992, 993,
+	994, 995, 996, 997, 998, 1000, 1001, 1002,
+	1003, 1004, 1005, 1006, 1007, 1008, 1009, 1010,
+	1011, 1012, 1013, 1014, 1015, 1016, 950, 1017,
+	1018, 1019, 1020, 1021, 1022, 1023, 1024, 1025,
+	1026, 1027, 1028, 1029, 1030, 1031, 1032, 1033,
+	1034, 949, 1035, 1036, 1037, 1038, 1039, 1041,
+	1042, 1043, 1044, 1045, 1046, 1047, 1048, 1049,
+	1050, 1051, 1052, 1053, 1054, 1056, 1057, 1058,
+	1059, 1060, 1061, 1065, 1067, 1068, 1069, 1070,
+	965, 1071, 1072, 1073, 1074, 1075, 1076, 1077,
+	1078, 1079, 1080, 1081, 1082, 1083, 1084, 1085,
+	1087, 1088, 1090, 1091, 1092, 1093, 1094, 1095,
+	963, 1096, 1097, 1098, 1099, 1100, 1101, 1102,
+	1103, 1104, 1106, 1138, 1162, 1165, 1166, 1168,
+	1177, 1178, 1181, 1185, 1203, 1063, 1210, 1212,
+	1214, 1216, 1107, 1108, 1109, 1110, 1111, 1112,
+	1113, 1114, 1115, 1116, 1117, 1118, 1119, 1120,
+	1121, 1122, 1123, 1124, 1125, 1126, 1127, 1128,
+	1129, 1130, 1131, 1132, 1133, 1134, 1135, 1136,
+	1137, 1139, 1140, 1141, 1142, 1143, 1144, 1145,
+	1146, 1147, 1148, 1149, 1150, 1151, 1152, 1153,
+	1154, 1155, 1156, 1157, 1158, 1159, 1160, 1161,
+	1163, 1164, 1167, 1169, 1170, 1171, 1172, 1173,
+	1174, 1175, 1176, 1179, 1180, 1182, 1183, 1184,
+	1186, 1187, 1188, 1189, 1190, 1191, 1192, 1193,
+	1194, 1195, 1196, 1197, 1198, 1199, 1200, 1201,
+	1202, 1204, 1205, 1206, 1207, 1208, 1209, 1211,
+	1213, 1215, 1217, 1219, 1220, 1545, 1545, 1221,
+	1358, 1359, 1290, 1360, 1361, 1362, 1363, 1364,
+	1365, 1319, 1366, 1255, 1367, 1368, 1369, 1370,
+	1371, 1372, 1373, 1374, 1275, 1375, 1376, 1377,
+	1378, 1379, 1380, 1381, 1382, 1383, 1384, 1300,
+	1385, 1387, 1388, 1389, 1390, 1391, 1392, 1393,
+	1394, 1395, 1396, 1233, 1397, 1398, 1399, 1400,
+	1401, 1402, 1403, 1404, 1405, 1271, 1406, 1407,
+	1408, 1409, 1410, 1341, 1412, 1413, 1416, 1418,
+	1419, 1420, 1421, 1422, 1423, 1426, 1427, 1429,
+	1430, 1431, 1433, 1434, 1435, 1436, 1437, 1438,
+	1439, 1440, 1441, 1442, 1443, 1445, 1446, 1447,
+	1448, 1451, 1453, 1454, 1456, 1458, 1460, 1459,
+	1461, 1462, 1459, 1463, 1459, 1464, 1465, 1466,
+	1468, 1469, 1470, 1459, 1472, 1459, 1473, 1459,
+	1474, 1475, 1476, 1477, 1478, 1479, 1480, 1481,
+	1482, 1483, 1484, 1485, 1486, 1487, 1488, 1489,
+	1490, 1491, 1492, 1493, 1494, 1495, 1496, 1497,
+	1498, 1499, 1500, 1501, 1502, 1503, 1504, 1505,
+	1506, 1507, 1508, 1459, 1459, 1459, 1459, 1459,
+	1459, 1, 1459, 7, 1459, 1459, 1459, 1459,
+	1459, 415, 416, 420, 421, 422, 423, 424,
+	425, 426, 427, 428, 429, 430, 431, 433,
+	435, 436, 468, 509, 524, 531, 533, 535,
+	555, 558, 574, 687, 1459, 1459, 1459, 691,
+	692, 693, 694, 695, 696, 697, 698, 699,
+	700, 701, 703, 704, 705, 706, 707, 708,
+	709, 710, 711, 712, 713, 714, 715, 716,
+	717, 718, 719, 720, 721, 722, 723, 725,
+	726, 727, 728, 729, 730, 731, 732, 733,
+	734, 735, 736, 737, 738, 739, 741, 742,
+	743, 745, 746, 747, 748, 749, 750, 751,
+	752, 753, 754, 755, 756, 757, 758, 760,
+	761, 762, 763, 764, 765, 766, 767, 768,
+	770, 771, 772, 773, 774, 775, 776, 777,
+	778, 779, 780, 781, 782, 783, 784, 785,
+	786, 787, 789, 790, 791, 792, 793, 794,
+	795, 796, 797, 798, 799, 800, 801, 802,
+	803, 804, 805, 806, 807, 808, 809, 811,
+	812, 813, 814, 815, 816, 817, 818, 819,
+	820, 821, 822, 823, 824, 825, 826, 855,
+	880, 883, 884, 886, 893, 894, 897, 901,
+	913, 918, 919, 921, 924, 926, 1511, 1509,
+	1512, 1517, 1519, 1509, 1520, 1521, 1522, 1509,
+	928, 1509, 1509, 1513, 1514, 1516, 1509, 1515,
+	1509, 1509, 1509, 1518, 1509, 1509, 1509, 933,
+	934, 938, 939, 1523, 1531, 1532, 1533, 1523,
+	937, 1523, 1523, 934, 1527, 1528, 1523, 1523,
+	1523, 1523, 1523, 940, 944, 945, 1534, 1542,
+	1543, 1544, 1534, 943, 1534, 1534, 940, 1538,
+	1539, 1534, 1534, 1534, 1534, 1534, 1545, 1547,
+	1548, 1549, 1550, 1551, 1552, 1553, 1554, 1555,
+	1556, 1557, 1558, 1559, 1560, 1561, 1562, 1563,
+	1564, 1565, 1566, 1567, 1568, 1569, 1570, 1571,
+	1572, 1573, 1574, 1575, 1576, 1577, 1578, 1579,
+	1580, 1581, 1545, 946, 947, 951, 952, 953,
+	954, 955, 956, 957, 958, 959, 960, 961,
+	962, 964, 966, 967, 999, 1040, 1055, 1062,
+	1064, 1066, 1086, 1089, 1105, 1218, 1545, 1222,
+	1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230,
+	1231, 1232, 1234, 1235, 1236, 1237, 1238, 1239,
+	1240, 1241, 1242, 1243, 1244, 1245, 1246, 1247,
+	1248, 1249, 1250, 1251, 1252, 1253, 1254, 1256,
+	1257, 1258, 1259, 1260, 1261, 1262, 1263, 1264,
+	1265, 1266, 1267, 1268, 1269, 1270, 1272, 1273,
+	1274, 1276, 1277, 1278, 1279, 1280, 1281, 1282,
+	1283, 1284, 1285, 1286, 1287, 1288, 1289, 1291,
+	1292, 1293, 1294, 1295, 1296, 1297, 1298, 1299,
+	1301, 1302, 1303, 1304, 1305, 1306, 1307, 1308,
+	1309, 1310, 1311, 1312, 1313, 1314, 1315, 1316,
+	1317, 1318, 1320, 1321, 1322, 1323, 1324, 1325,
+	1326, 1327, 1328, 1329, 1330, 1331, 1332, 1333,
+	1334, 1335, 1336, 1337, 1338, 1339, 1340, 1342,
+	1343, 1344, 1345, 1346, 1347, 1348, 1349, 1350,
+	1351, 1352, 1353, 1354, 1355, 1356, 1357, 1386,
+	1411, 1414, 1415, 1417, 1424, 1425, 1428, 1432,
+	1444, 1449, 1450, 1452, 1455, 1457,
+}
+
+var _hcltok_trans_actions []byte = []byte{
+	145, 107, 0, 0, 91, 141, 0, 7,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 121, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 143, 193, 149, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 147, 125, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 31, 169,
+	0, 0, 0, 35, 33, 0, 55, 41,
+	175, 0, 53, 0, 175, 175, 0, 0,
+	75, 61, 181, 0, 73, 0, 181, 181,
+	0, 0, 85, 187, 89, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 87, 79, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 93,
+	0, 0, 119, 0, 111, 0, 7, 7,
+	7, 0, 0, 113, 0, 115, 0, 123,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 7, 7,
+	7, 196, 196, 196, 196, 196, 196, 7,
+	7, 196, 7, 127, 139, 135, 97, 133,
+	103, 0, 129, 0, 101, 95, 109, 99,
+	131, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 105, 117, 137, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 13,
+	0, 0, 172, 17, 0, 7, 7, 23,
+	0, 25, 27, 0, 0, 0, 151, 0,
+	15, 19, 9, 0, 21, 11, 29, 0,
+	0, 0, 0, 43, 0, 178, 178, 49,
+	0, 157, 154, 1, 175, 175, 45, 37,
+	47, 39, 51, 0, 0, 0, 63, 0,
+	184, 184, 69, 0, 163, 160, 1, 181,
+	181, 65, 57, 67, 59, 71, 77, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 7, 7, 7,
+	190, 190, 190, 190, 190, 190, 7, 7,
+	190, 7, 81, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 83, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0,
+}
+
+var _hcltok_to_state_actions []byte = []byte{
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 3, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 3, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 166, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 166, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 3, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0,
+}
+
+var _hcltok_from_state_actions []byte = []byte{
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 5, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 5, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 5, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 5, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 5, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0,
+}
+
+var _hcltok_eof_trans []int16 = []int16{
+	0, 1, 1, 1, 6, 6, 6, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 419,
+	419, 421, 419, 419, 419, 419, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 419,
+	419, 419, 670, 670, 670, 670, 670, 670,
+	670, 670, 670, 670, 670, 670, 670, 670,
+	670, 670, 670, 670, 670, 670, 670, 670,
+	670, 670, 670, 670, 670, 670, 670, 670,
+	670, 670, 670, 670, 670, 670, 670, 670,
+	670, 670, 670, 670, 670, 670, 670, 670,
+	670, 670, 670, 670, 670, 670, 670, 670,
+	670, 670, 670, 670, 670, 670, 670, 670,
+	670, 670, 670, 670, 670, 670, 670, 670,
+	670, 670, 670, 670, 670, 670, 670, 670,
+	670, 670, 670, 670, 670, 670, 670, 670,
+	670, 670, 670, 670, 670, 670, 670, 670,
+	670, 670, 670, 670, 670, 670, 670, 670,
+	670, 670, 670, 670, 670, 670, 670, 670,
+	670, 670, 670, 670, 670, 670, 670, 670,
+	670, 670, 670, 670, 670, 670, 670, 670,
+	670, 670, 670, 670, 670, 670, 670, 670,
+	670, 670, 670, 670, 670, 670, 670, 670,
+	670, 670, 670, 670, 670, 670, 670, 670,
+	670, 670, 670, 670, 670, 670, 670, 670,
+	670, 670, 670, 670, 670, 670, 670, 670,
+	670, 670, 670, 670, 670, 670, 670, 670,
+	670, 670, 670, 670, 670, 670, 670, 670,
+	670, 670, 670, 670, 670, 670, 670, 670,
+	670, 670, 670, 670, 670, 670, 670, 670,
+	670, 670, 670, 670, 670, 670, 670, 670,
+	670, 670, 670, 670, 670, 670, 670, 670,
+	670, 670, 670, 670, 670, 670, 670, 670,
+	670, 670, 670, 670, 670, 670, 670, 670,
+	670, 670, 670, 670, 670, 670, 670, 670,
+	767, 772, 772, 772, 773, 773, 775, 775,
+	775, 779, 0, 0, 785, 785, 785, 789,
+	0, 0, 795, 795, 797, 795, 795, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	795, 795, 795, 795, 795, 795, 795, 795,
+	795, 795, 795, 795, 795, 1046, 1046, 1046,
+	1046, 1046, 1046, 1046, 1046, 1046, 1046, 1046,
+	1046, 1046, 1046, 1046, 1046, 1046, 1046, 1046,
+	1046, 1046, 1046, 1046, 1046, 1046, 1046, 1046,
+	1046, 1046, 1046, 1046, 1046, 1046, 1046, 1046,
+	1046, 1046, 1046, 1046, 1046, 1046, 1046, 1046,
+	1046, 1046, 1046, 1046, 1046, 1046, 1046, 1046,
+	1046, 1046, 1046, 1046, 1046, 1046, 1046, 1046,
+	1046, 1046, 1046, 1046, 1046, 1046, 1046, 1046,
+	1046, 1046, 1046, 1046, 1046, 1046, 1046, 1046,
+	1046, 1046, 1046, 1046, 1046, 1046, 1046, 1046,
+	1046, 1046, 1046, 1046, 1046, 1046, 1046, 1046,
+	1046, 1046, 1046, 1046, 1046, 1046, 1046, 1046,
+	1046, 1046, 1046, 1046, 1046, 1046, 1046, 1046,
+	1046, 1046, 1046, 1046, 1046, 1046, 1046, 1046,
+	1046, 1046, 1046, 1046, 1046, 1046, 1046, 1046,
+	1046, 1046, 1046, 1046, 1046, 1046, 1046, 1046,
+	1046, 1046, 1046, 1046, 1046, 1046, 1046, 1046,
+	1046, 1046, 1046, 1046, 1046, 1046, 1046, 1046,
+	1046, 1046, 1046, 1046, 1046, 1046, 1046, 1046,
+	1046, 1046, 1046, 1046, 1046, 1046, 1046, 1046,
+	1046, 1046, 1046, 1046, 1046, 1046, 1046, 1046,
+	1046, 1046, 1046, 1046, 1046, 1046, 1046, 1046,
+	1046, 1046, 1046, 1046, 1046, 1046, 1046, 1046,
+	1046, 1046, 1046, 1046, 1046, 1046, 1046, 1046,
+	1046, 1046, 1046, 1046, 1046, 1046, 1046, 1046,
+	1046, 1046, 1046, 1046, 1046, 1046, 1046, 1046,
+	1046, 1046, 1046, 1046, 1046, 1046, 1046, 1046,
+	1046, 1046, 1046, 1046, 1046, 1046, 1046, 1046,
+	1046, 1046, 1046, 1046, 1046, 1046, 1046, 1046,
+	1046, 1046, 1046, 0, 1196, 1197, 1198, 1200,
+	1198, 1198, 1198, 1203, 1198, 1198, 1198, 1209,
+	1198, 1198, 1239, 1239, 1239, 1239, 1239, 1239,
+	1239, 1239, 1239, 1239, 1239, 1239, 1239, 1239,
+	1239, 1239, 1239, 1239, 1239, 1239, 1239, 1239,
+	1239, 1239, 1239, 1239, 1239, 1239, 1239, 1239,
+	1239, 1239, 1239, 1239, 1239, 0, 1392, 1394,
+	1395, 1399, 1399, 1392, 1402, 1395, 1405, 1395,
+	1407, 1407, 1407, 0, 1416, 1418, 1418, 1416,
+	1416, 1423, 1425, 1427, 1427, 1427, 0, 1435,
+	1437, 1437, 1435, 1435, 1442, 1444, 1446, 1446,
+	1446, 0, 1483, 1511, 1511, 1511, 1511, 1511,
+	1511, 1511, 1511, 1511, 1511, 1511, 1511, 1511,
+	1511, 1511, 1511, 1511, 1511, 1511, 1511, 1511,
+	1511, 1511, 1511, 1511, 1511, 1511, 1511, 1511,
+	1511, 1511, 1511, 1511, 1511, 1511,
+}
+
+const hcltok_start int = 1459
+const hcltok_first_final int = 1459
+const hcltok_error int = 0
+
+const hcltok_en_stringTemplate int = 1509
+const hcltok_en_heredocTemplate int = 1523
+const hcltok_en_bareTemplate int = 1534
+const hcltok_en_identOnly int = 1545
+const hcltok_en_main int = 1459
+
+//line scan_tokens.rl:16
+
+func scanTokens(data []byte, filename string, start hcl.Pos, mode scanMode) []Token {
+	stripData := stripUTF8BOM(data)
+	start.Byte += len(data) - len(stripData)
+	data = stripData
+
+	f := &tokenAccum{
+		Filename:  filename,
+		Bytes:     data,
+		Pos:       start,
+		StartByte: start.Byte,
+	}
+
+//line scan_tokens.rl:305
+
+	// Ragel state
+	p := 0          // "Pointer" into data
+	pe := len(data) // End-of-data "pointer"
+	ts := 0
+	te := 0
+	act := 0
+	eof := pe
+	var stack []int
+	var top int
+
+	var cs int // current state
+	switch mode {
+	case scanNormal:
+		cs = hcltok_en_main
+	case scanTemplate:
+		cs = hcltok_en_bareTemplate
+	case scanIdentOnly:
+		cs = hcltok_en_identOnly
+	default:
+		panic("invalid scanMode")
+	}
+
+	braces := 0
+	var retBraces []int              // stack of brace levels that cause us to use fret
+	var heredocs []heredocInProgress // stack of heredocs we're currently processing
+
+//line scan_tokens.rl:340
+
+	// Make Go compiler happy
+	_ = ts
+	_ = te
+	_ = act
+	_ = eof
+
+	token := func(ty TokenType) {
+		f.emitToken(ty, ts, te)
+	}
+	selfToken := func() {
+		b := data[ts:te]
+		if len(b) != 1 {
+			// should never happen
+			panic("selfToken only works for single-character tokens")
+		}
+		f.emitToken(TokenType(b[0]), ts, te)
+	}
+
+//line scan_tokens.go:4289
+	{
+		top = 0
+		ts = 0
+		te = 0
+		act = 0
+	}
+
+//line scan_tokens.go:4297
+	{
+		var _klen int
+		var _trans int
+		var _acts int
+		var _nacts uint
+		var _keys int
+		if p == pe {
+			goto _test_eof
+		}
+		if cs == 0 {
+			goto _out
+		}
+	_resume:
+		_acts = int(_hcltok_from_state_actions[cs])
+		_nacts = uint(_hcltok_actions[_acts])
+		_acts++
+		for ; _nacts > 0; _nacts-- {
+			_acts++
+			switch _hcltok_actions[_acts-1] {
+			case 3:
+//line NONE:1
+				ts = p
+
+//line scan_tokens.go:4320
+			}
+		}
+
+		_keys = int(_hcltok_key_offsets[cs])
+		_trans = int(_hcltok_index_offsets[cs])
+
+		_klen = int(_hcltok_single_lengths[cs])
+		if _klen > 0 {
+			_lower := int(_keys)
+			var _mid int
+			_upper := int(_keys + _klen - 1)
+			for {
+				if _upper < _lower {
+					break
+				}
+
+				_mid = _lower + ((_upper - _lower) >> 1)
+				switch {
+				case data[p] < _hcltok_trans_keys[_mid]:
+					_upper = _mid - 1
+				case data[p] > _hcltok_trans_keys[_mid]:
+					_lower = _mid + 1
+				default:
+					_trans += int(_mid - int(_keys))
+					goto _match
+				}
+			}
+			_keys += _klen
+			_trans += _klen
+		}
+
+		_klen = int(_hcltok_range_lengths[cs])
+		if _klen > 0 {
+			_lower := int(_keys)
+			var _mid int
+			_upper := int(_keys + (_klen << 1) - 2)
+			for {
+				if _upper < _lower {
+					break
+				}
+
+				_mid = _lower + (((_upper - _lower) >> 1) & ^1)
+				switch {
+				case data[p] < _hcltok_trans_keys[_mid]:
+					_upper = _mid - 2
+				case data[p] > _hcltok_trans_keys[_mid+1]:
+					_lower = _mid + 2
+				default:
+					_trans += int((_mid - int(_keys)) >> 1)
+					goto _match
+				}
+			}
+			_trans += _klen
+		}
+
+	_match:
+		_trans = int(_hcltok_indicies[_trans])
+	_eof_trans:
+		cs = int(_hcltok_trans_targs[_trans])
+
+		if _hcltok_trans_actions[_trans] == 0 {
+			goto _again
+		}
+
+		_acts = int(_hcltok_trans_actions[_trans])
+		_nacts = uint(_hcltok_actions[_acts])
+		_acts++
+		for ; _nacts > 0; _nacts-- {
+			_acts++
+			switch _hcltok_actions[_acts-1] {
+			case 0:
+//line scan_tokens.rl:224
+				p--
+
+			case 4:
+//line NONE:1
+				te = p + 1
+
+			case 5:
+//line scan_tokens.rl:248
+				act = 4
+			case 6:
+//line scan_tokens.rl:250
+				act = 6
+			case 7:
+//line scan_tokens.rl:160
+				te = p + 1
+				{
+					token(TokenTemplateInterp)
+					braces++
+					retBraces = append(retBraces, braces)
+					if len(heredocs) > 0 {
+						heredocs[len(heredocs)-1].StartOfLine = false
+					}
+					{
+						stack = append(stack, 0)
+						stack[top] = cs
+						top++
+						cs = 1459
+						goto _again
+					}
+				}
+			case 8:
+//line scan_tokens.rl:170
+				te = p + 1
+				{
+					token(TokenTemplateControl)
+					braces++
+					retBraces = append(retBraces, braces)
+					if len(heredocs) > 0 {
+						heredocs[len(heredocs)-1].StartOfLine = false
+					}
+					{
+						stack = append(stack, 0)
+						stack[top] = cs
+						top++
+						cs = 1459
+						goto _again
+					}
+				}
+			case 9:
+//line scan_tokens.rl:84
+				te = p + 1
+				{
+					token(TokenCQuote)
+					top--
+					cs = stack[top]
+					{
+						stack = stack[:len(stack)-1]
+					}
+					goto _again
+
+				}
+			case 10:
+//line scan_tokens.rl:248
+				te = p + 1
+				{
+					token(TokenQuotedLit)
+				}
+			case 11:
+//line scan_tokens.rl:251
+				te = p + 1
+				{
+					token(TokenBadUTF8)
+				}
+			case 12:
+//line scan_tokens.rl:160
+				te = p
+				p--
+				{
+					token(TokenTemplateInterp)
+					braces++
+					retBraces = append(retBraces, braces)
+					if len(heredocs) > 0 {
+						heredocs[len(heredocs)-1].StartOfLine = false
+					}
+					{
+						stack = append(stack, 0)
+						stack[top] = cs
+						top++
+						cs = 1459
+						goto _again
+					}
+				}
+			case 13:
+//line scan_tokens.rl:170
+				te = p
+				p--
+				{
+					token(TokenTemplateControl)
+					braces++
+					retBraces = append(retBraces, braces)
+					if len(heredocs) > 0 {
+						heredocs[len(heredocs)-1].StartOfLine = false
+					}
+					{
+						stack = append(stack, 0)
+						stack[top] = cs
+						top++
+						cs = 1459
+						goto _again
+					}
+				}
+			case 14:
+//line scan_tokens.rl:248
+				te = p
+				p--
+				{
+					token(TokenQuotedLit)
+				}
+			case 15:
+//line scan_tokens.rl:249
+				te = p
+				p--
+				{
+					token(TokenQuotedNewline)
+				}
+			case 16:
+//line scan_tokens.rl:250
+				te = p
+				p--
+				{
+					token(TokenInvalid)
+				}
+			case 17:
+//line scan_tokens.rl:251
+				te = p
+				p--
+				{
+					token(TokenBadUTF8)
+				}
+			case 18:
+//line scan_tokens.rl:248
+				p = (te) - 1
+				{
+					token(TokenQuotedLit)
+				}
+			case 19:
+//line scan_tokens.rl:251
+				p = (te) - 1
+				{
+					token(TokenBadUTF8)
+				}
+			case 20:
+//line NONE:1
+				switch act {
+				case 4:
+					{
+						p = (te) - 1
+						token(TokenQuotedLit)
+					}
+				case 6:
+					{
+						p = (te) - 1
+						token(TokenInvalid)
+					}
+				}
+
+			case 21:
+//line scan_tokens.rl:148
+				act = 11
+			case 22:
+//line scan_tokens.rl:259
+				act = 12
+			case 23:
+//line scan_tokens.rl:160
+				te = p + 1
+				{
+					token(TokenTemplateInterp)
+					braces++
+					retBraces = append(retBraces, braces)
+					if len(heredocs) > 0 {
+						heredocs[len(heredocs)-1].StartOfLine = false
+					}
+					{
+						stack = append(stack, 0)
+						stack[top] = cs
+						top++
+						cs = 1459
+						goto _again
+					}
+				}
+			case 24:
+//line scan_tokens.rl:170
+				te = p + 1
+				{
+					token(TokenTemplateControl)
+					braces++
+					retBraces = append(retBraces, braces)
+					if len(heredocs) > 0 {
+						heredocs[len(heredocs)-1].StartOfLine = false
+					}
+					{
+						stack = append(stack, 0)
+						stack[top] = cs
+						top++
+						cs = 1459
+						goto _again
+					}
+				}
+			case 25:
+//line scan_tokens.rl:111
+				te = p + 1
+				{
+					// This action is called specificially when a heredoc literal
+					// ends with a newline character.
+
+					// This might actually be our end marker.
+					topdoc := &heredocs[len(heredocs)-1]
+					if topdoc.StartOfLine {
+						maybeMarker := bytes.TrimSpace(data[ts:te])
+						if bytes.Equal(maybeMarker, topdoc.Marker) {
+							// We actually emit two tokens here: the end-of-heredoc
+							// marker first, and then separately the newline that
+							// follows it. This then avoids issues with the closing
+							// marker consuming a newline that would normally be used
+							// to mark the end of an attribute definition.
+							// We might have either a \n sequence or an \r\n sequence
+							// here, so we must handle both.
+							nls := te - 1
+							nle := te
+							te--
+							if data[te-1] == '\r' {
+								// back up one more byte
+								nls--
+								te--
+							}
+							token(TokenCHeredoc)
+							ts = nls
+							te = nle
+							token(TokenNewline)
+							heredocs = heredocs[:len(heredocs)-1]
+							top--
+							cs = stack[top]
+							{
+								stack = stack[:len(stack)-1]
+							}
+							goto _again
+
+						}
+					}
+
+					topdoc.StartOfLine = true
+					token(TokenStringLit)
+				}
+			case 26:
+//line scan_tokens.rl:259
+				te = p + 1
+				{
+					token(TokenBadUTF8)
+				}
+			case 27:
+//line scan_tokens.rl:160
+				te = p
+				p--
+				{
+					token(TokenTemplateInterp)
+					braces++
+					retBraces = append(retBraces, braces)
+					if len(heredocs) > 0 {
+						heredocs[len(heredocs)-1].StartOfLine = false
+					}
+					{
+						stack = append(stack, 0)
+						stack[top] = cs
+						top++
+						cs = 1459
+						goto _again
+					}
+				}
+			case 28:
+//line scan_tokens.rl:170
+				te = p
+				p--
+				{
+					token(TokenTemplateControl)
+					braces++
+					retBraces = append(retBraces, braces)
+					if len(heredocs) > 0 {
+						heredocs[len(heredocs)-1].StartOfLine = false
+					}
+					{
+						stack = append(stack, 0)
+						stack[top] = cs
+						top++
+						cs = 1459
+						goto _again
+					}
+				}
+			case 29:
+//line scan_tokens.rl:148
+				te = p
+				p--
+				{
+					// This action is called when a heredoc literal _doesn't_ end
+					// with a newline character, e.g. because we're about to enter
+					// an interpolation sequence.
+					heredocs[len(heredocs)-1].StartOfLine = false
+					token(TokenStringLit)
+				}
+			case 30:
+//line scan_tokens.rl:259
+				te = p
+				p--
+				{
+					token(TokenBadUTF8)
+				}
+			case 31:
+//line scan_tokens.rl:148
+				p = (te) - 1
+				{
+					// This action is called when a heredoc literal _doesn't_ end
+					// with a newline character, e.g. because we're about to enter
+					// an interpolation sequence.
+					heredocs[len(heredocs)-1].StartOfLine = false
+					token(TokenStringLit)
+				}
+			case 32:
+//line NONE:1
+				switch act {
+				case 0:
+					{
+						cs = 0
+						goto _again
+					}
+				case 11:
+					{
+						p = (te) - 1
+
+						// This action is called when a heredoc literal _doesn't_ end
+						// with a newline character, e.g. because we're about to enter
+						// an interpolation sequence.
+						heredocs[len(heredocs)-1].StartOfLine = false
+						token(TokenStringLit)
+					}
+				case 12:
+					{
+						p = (te) - 1
+						token(TokenBadUTF8)
+					}
+				}
+
+			case 33:
+//line scan_tokens.rl:156
+				act = 15
+			case 34:
+//line scan_tokens.rl:266
+				act = 16
+			case 35:
+//line scan_tokens.rl:160
+				te = p + 1
+				{
+					token(TokenTemplateInterp)
+					braces++
+					retBraces = append(retBraces, braces)
+					if len(heredocs) > 0 {
+						heredocs[len(heredocs)-1].StartOfLine = false
+					}
+					{
+						stack = append(stack, 0)
+						stack[top] = cs
+						top++
+						cs = 1459
+						goto _again
+					}
+				}
+			case 36:
+//line scan_tokens.rl:170
+				te = p + 1
+				{
+					token(TokenTemplateControl)
+					braces++
+					retBraces = append(retBraces, braces)
+					if len(heredocs) > 0 {
+						heredocs[len(heredocs)-1].StartOfLine = false
+					}
+					{
+						stack = append(stack, 0)
+						stack[top] = cs
+						top++
+						cs = 1459
+						goto _again
+					}
+				}
+			case 37:
+//line scan_tokens.rl:156
+				te = p + 1
+				{
+					token(TokenStringLit)
+				}
+			case 38:
+//line scan_tokens.rl:266
+				te = p + 1
+				{
+					token(TokenBadUTF8)
+				}
+			case 39:
+//line scan_tokens.rl:160
+				te = p
+				p--
+				{
+					token(TokenTemplateInterp)
+					braces++
+					retBraces = append(retBraces, braces)
+					if len(heredocs) > 0 {
+						heredocs[len(heredocs)-1].StartOfLine = false
+					}
+					{
+						stack = append(stack, 0)
+						stack[top] = cs
+						top++
+						cs = 1459
+						goto _again
+					}
+				}
+			case 40:
+//line scan_tokens.rl:170
+				te = p
+				p--
+				{
+					token(TokenTemplateControl)
+					braces++
+					retBraces = append(retBraces, braces)
+					if len(heredocs) > 0 {
+						heredocs[len(heredocs)-1].StartOfLine = false
+					}
+					{
+						stack = append(stack, 0)
+						stack[top] = cs
+						top++
+						cs = 1459
+						goto _again
+					}
+				}
+			case 41:
+//line scan_tokens.rl:156
+				te = p
+				p--
+				{
+					token(TokenStringLit)
+				}
+			case 42:
+//line scan_tokens.rl:266
+				te = p
+				p--
+				{
+					token(TokenBadUTF8)
+				}
+			case 43:
+//line scan_tokens.rl:156
+				p = (te) - 1
+				{
+					token(TokenStringLit)
+				}
+			case 44:
+//line NONE:1
+				switch act {
+				case 0:
+					{
+						cs = 0
+						goto _again
+					}
+				case 15:
+					{
+						p = (te) - 1
+
+						token(TokenStringLit)
+					}
+				case 16:
+					{
+						p = (te) - 1
+						token(TokenBadUTF8)
+					}
+				}
+
+			case 45:
+//line scan_tokens.rl:270
+				act = 17
+			case 46:
+//line scan_tokens.rl:271
+				act = 18
+			case 47:
+//line scan_tokens.rl:271
+				te = p + 1
+				{
+					token(TokenBadUTF8)
+				}
+			case 48:
+//line scan_tokens.rl:272
+				te = p + 1
+				{
+					token(TokenInvalid)
+				}
+			case 49:
+//line scan_tokens.rl:270
+				te = p
+				p--
+				{
+					token(TokenIdent)
+				}
+			case 50:
+//line scan_tokens.rl:271
+				te = p
+				p--
+				{
+					token(TokenBadUTF8)
+				}
+			case 51:
+//line scan_tokens.rl:270
+				p = (te) - 1
+				{
+					token(TokenIdent)
+				}
+			case 52:
+//line scan_tokens.rl:271
+				p = (te) - 1
+				{
+					token(TokenBadUTF8)
+				}
+			case 53:
+//line NONE:1
+				switch act {
+				case 17:
+					{
+						p = (te) - 1
+						token(TokenIdent)
+					}
+				case 18:
+					{
+						p = (te) - 1
+						token(TokenBadUTF8)
+					}
+				}
+
+			case 54:
+//line scan_tokens.rl:278
+				act = 22
+			case 55:
+//line scan_tokens.rl:301
+				act = 39
+			case 56:
+//line scan_tokens.rl:280
+				te = p + 1
+				{
+					token(TokenComment)
+				}
+			case 57:
+//line scan_tokens.rl:281
+				te = p + 1
+				{
+					token(TokenNewline)
+				}
+			case 58:
+//line scan_tokens.rl:283
+				te = p + 1
+				{
+					token(TokenEqualOp)
+				}
+			case 59:
+//line scan_tokens.rl:284
+				te = p + 1
+				{
+					token(TokenNotEqual)
+				}
+			case 60:
+//line scan_tokens.rl:285
+				te = p + 1
+				{
+					token(TokenGreaterThanEq)
+				}
+			case 61:
+//line scan_tokens.rl:286
+				te = p + 1
+				{
+					token(TokenLessThanEq)
+				}
+			case 62:
+//line scan_tokens.rl:287
+				te = p + 1
+				{
+					token(TokenAnd)
+				}
+			case 63:
+//line scan_tokens.rl:288
+				te = p + 1
+				{
+					token(TokenOr)
+				}
+			case 64:
+//line scan_tokens.rl:289
+				te = p + 1
+				{
+					token(TokenEllipsis)
+				}
+			case 65:
+//line scan_tokens.rl:290
+				te = p + 1
+				{
+					token(TokenFatArrow)
+				}
+			case 66:
+//line scan_tokens.rl:291
+				te = p + 1
+				{
+					selfToken()
+				}
+			case 67:
+//line scan_tokens.rl:180
+				te = p + 1
+				{
+					token(TokenOBrace)
+					braces++
+				}
+			case 68:
+//line scan_tokens.rl:185
+				te = p + 1
+				{
+					if len(retBraces) > 0 && retBraces[len(retBraces)-1] == braces {
+						token(TokenTemplateSeqEnd)
+						braces--
+						retBraces = retBraces[0 : len(retBraces)-1]
+						top--
+						cs = stack[top]
+						{
+							stack = stack[:len(stack)-1]
+						}
+						goto _again
+
+					} else {
+						token(TokenCBrace)
+						braces--
+					}
+				}
+			case 69:
+//line scan_tokens.rl:197
+				te = p + 1
+				{
+					// Only consume from the retBraces stack and return if we are at
+					// a suitable brace nesting level, otherwise things will get
+					// confused. (Not entering this branch indicates a syntax error,
+					// which we will catch in the parser.)
+					if len(retBraces) > 0 && retBraces[len(retBraces)-1] == braces {
+						token(TokenTemplateSeqEnd)
+						braces--
+						retBraces = retBraces[0 : len(retBraces)-1]
+						top--
+						cs = stack[top]
+						{
+							stack = stack[:len(stack)-1]
+						}
+						goto _again
+
+					} else {
+						// We intentionally generate a TokenTemplateSeqEnd here,
+						// even though the user apparently wanted a brace, because
+						// we want to allow the parser to catch the incorrect use
+						// of a ~} to balance a generic opening brace, rather than
+						// a template sequence.
+						token(TokenTemplateSeqEnd)
+						braces--
+					}
+				}
+			case 70:
+//line scan_tokens.rl:79
+				te = p + 1
+				{
+					token(TokenOQuote)
+					{
+						stack = append(stack, 0)
+						stack[top] = cs
+						top++
+						cs = 1509
+						goto _again
+					}
+				}
+			case 71:
+//line scan_tokens.rl:89
+				te = p + 1
+				{
+					token(TokenOHeredoc)
+					// the token is currently the whole heredoc introducer, like
+					// <<EOT or <<-EOT, followed by a newline. We want to extract
+					// just the "EOT" portion that we'll use as the closing marker.
+
+					marker := data[ts+2 : te-1]
+					if marker[0] == '-' {
+						marker = marker[1:]
+					}
+					if marker[len(marker)-1] == '\r' {
+						marker = marker[:len(marker)-1]
+					}
+
+					heredocs = append(heredocs, heredocInProgress{
+						Marker:      marker,
+						StartOfLine: true,
+					})
+
+					{
+						stack = append(stack, 0)
+						stack[top] = cs
+						top++
+						cs = 1523
+						goto _again
+					}
+				}
+			case 72:
+//line scan_tokens.rl:301
+				te = p + 1
+				{
+					token(TokenBadUTF8)
+				}
+			case 73:
+//line scan_tokens.rl:302
+				te = p + 1
+				{
+					token(TokenInvalid)
+				}
+			case 74:
+//line scan_tokens.rl:276
+				te = p
+				p--
+
+			case 75:
+//line scan_tokens.rl:277
+				te = p
+				p--
+				{
+					token(TokenNumberLit)
+				}
+			case 76:
+//line scan_tokens.rl:278
+				te = p
+				p--
+				{
+					token(TokenIdent)
+				}
+			case 77:
+//line scan_tokens.rl:280
+				te = p
+				p--
+				{
+					token(TokenComment)
+				}
+			case 78:
+//line scan_tokens.rl:291
+				te = p
+				p--
+				{
+					selfToken()
+				}
+			case 79:
+//line scan_tokens.rl:301
+				te = p
+				p--
+				{
+					token(TokenBadUTF8)
+				}
+			case 80:
+//line scan_tokens.rl:302
+				te = p
+				p--
+				{
+					token(TokenInvalid)
+				}
+			case 81:
+//line scan_tokens.rl:277
+				p = (te) - 1
+				{
+					token(TokenNumberLit)
+				}
+			case 82:
+//line scan_tokens.rl:278
+				p = (te) - 1
+				{
+					token(TokenIdent)
+				}
+			case 83:
+//line scan_tokens.rl:291
+				p = (te) - 1
+				{
+					selfToken()
+				}
+			case 84:
+//line scan_tokens.rl:301
+				p = (te) - 1
+				{
+					token(TokenBadUTF8)
+				}
+			case 85:
+//line NONE:1
+				switch act {
+				case 22:
+					{
+						p = (te) - 1
+						token(TokenIdent)
+					}
+				case 39:
+					{
+						p = (te) - 1
+						token(TokenBadUTF8)
+					}
+				}
+
+//line scan_tokens.go:5055
+			}
+		}
+
+	_again:
+		_acts = int(_hcltok_to_state_actions[cs])
+		_nacts = uint(_hcltok_actions[_acts])
+		_acts++
+		for ; _nacts > 0; _nacts-- {
+			_acts++
+			switch _hcltok_actions[_acts-1] {
+			case 1:
+//line NONE:1
+				ts = 0
+
+			case 2:
+//line NONE:1
+				act = 0
+
+//line scan_tokens.go:5073
+			}
+		}
+
+		if cs == 0 {
+			goto _out
+		}
+		p++
+		if p != pe {
+			goto _resume
+		}
+	_test_eof:
+		{
+		}
+		if p == eof {
+			if _hcltok_eof_trans[cs] > 0 {
+				_trans = int(_hcltok_eof_trans[cs] - 1)
+				goto _eof_trans
+			}
+		}
+
+	_out:
+		{
+		}
+	}
+
+//line scan_tokens.rl:363
+
+	// If we fall out here without being in a final state then we've
+	// encountered something that the scanner can't match, which we'll
+	// deal with as an invalid.
+	if cs < hcltok_first_final {
+		if mode == scanTemplate && len(stack) == 0 {
+			// If we're scanning a bare template then any straggling
+			// top-level stuff is actually literal string, rather than
+			// invalid. This handles the case where the template ends
+			// with a single "$" or "%", which trips us up because we
+			// want to see another character to decide if it's a sequence
+			// or an escape.
+			f.emitToken(TokenStringLit, ts, len(data))
+		} else {
+			f.emitToken(TokenInvalid, ts, len(data))
+		}
+	}
+
+	// We always emit a synthetic EOF token at the end, since it gives the
+	// parser position information for an "unexpected EOF" diagnostic.
+	f.emitToken(TokenEOF, len(data), len(data))
+
+	return f.Tokens
+}
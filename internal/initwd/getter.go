@@ -54,6 +54,7 @@ var goGetterGetters = map[string]getter.Getter{
 	"s3":    new(getter.S3Getter),
 	"http":  getterHTTPGetter,
 	"https": getterHTTPGetter,
+	"oci":   new(ociGetter),
 }
 
 var getterHTTPClient = cleanhttp.DefaultClient()
@@ -0,0 +1,513 @@
+package initwd
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	getter "github.com/hashicorp/go-getter"
+)
+
+// ociGetter is a go-getter Getter implementation that fetches a module
+// packaged as an OCI artifact from an OCI distribution registry, for
+// source addresses like:
+//
+//	oci://registry.example.com/modules/network:1.2.0
+//
+// The artifact's manifest must contain at least one gzipped tar layer,
+// which is taken to be the module package and is extracted into the
+// installation directory. A digest may be appended to the reference
+// (after "@") to pin the exact manifest, in which case the downloaded
+// manifest is verified against it before any layers are fetched.
+//
+// Registry credentials are resolved from the Docker CLI configuration,
+// including docker credential helpers, so a "docker login" against the
+// registry is sufficient to authenticate module installation too.
+type ociGetter struct {
+	client *getter.Client
+
+	// httpClient is the client used for registry requests. If nil, the
+	// shared getterHTTPClient is used. This is overridden in tests.
+	httpClient *http.Client
+
+	// scheme is the URL scheme used to contact the registry, defaulting
+	// to "https" when empty. This is overridden in tests.
+	scheme string
+}
+
+func (g *ociGetter) SetClient(c *getter.Client) { g.client = c }
+
+func (g *ociGetter) ClientMode(u *url.URL) (getter.ClientMode, error) {
+	return getter.ClientModeDir, nil
+}
+
+func (g *ociGetter) GetFile(dst string, u *url.URL) error {
+	return fmt.Errorf("oci source addresses always refer to a whole module package, not a single file")
+}
+
+func (g *ociGetter) Get(dst string, u *url.URL) error {
+	ref, err := parseOCIRef(u)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] fetching OCI module artifact %s from %s", ref.Repository, ref.Registry)
+
+	creds, err := ociRegistryCredentials(ref.Registry)
+	if err != nil {
+		return fmt.Errorf("failed to resolve credentials for %s: %s", ref.Registry, err)
+	}
+
+	session := &ociRegistrySession{
+		getter:   g,
+		registry: ref.Registry,
+		creds:    creds,
+	}
+
+	manifest, err := session.fetchManifest(ref)
+	if err != nil {
+		return err
+	}
+
+	layer, err := manifest.modulePackageLayer()
+	if err != nil {
+		return fmt.Errorf("unsupported OCI artifact %s: %s", ref, err)
+	}
+
+	blobFile, err := session.fetchBlob(ref, layer)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(blobFile)
+
+	if err := os.MkdirAll(dst, os.ModePerm); err != nil {
+		return fmt.Errorf("failed to create directory %s: %s", dst, err)
+	}
+
+	decomp := new(getter.TarGzipDecompressor)
+	if err := decomp.Decompress(dst, blobFile, true); err != nil {
+		return fmt.Errorf("failed to extract module package from %s: %s", ref, err)
+	}
+	return nil
+}
+
+// ociRef is a parsed reference to an artifact in an OCI registry.
+type ociRef struct {
+	// Registry is the registry host, possibly including a port.
+	Registry string
+
+	// Repository is the name of the repository within the registry.
+	Repository string
+
+	// Tag is the artifact tag, if the reference included one.
+	Tag string
+
+	// Digest is the pinned manifest digest, if the reference included one.
+	// When both Tag and Digest are set the digest is authoritative.
+	Digest string
+}
+
+func (r ociRef) String() string {
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "%s/%s", r.Registry, r.Repository)
+	if r.Tag != "" {
+		fmt.Fprintf(buf, ":%s", r.Tag)
+	}
+	if r.Digest != "" {
+		fmt.Fprintf(buf, "@%s", r.Digest)
+	}
+	return buf.String()
+}
+
+// manifestRef returns the reference to use in the manifest request path,
+// preferring a pinned digest over a tag.
+func (r ociRef) manifestRef() string {
+	if r.Digest != "" {
+		return r.Digest
+	}
+	return r.Tag
+}
+
+func parseOCIRef(u *url.URL) (ociRef, error) {
+	var ref ociRef
+	if u.Host == "" {
+		return ref, fmt.Errorf("invalid oci source address %q: a registry hostname is required", u.String())
+	}
+	ref.Registry = u.Host
+
+	rest := strings.Trim(u.Path, "/")
+	if idx := strings.Index(rest, "@"); idx != -1 {
+		ref.Digest = rest[idx+1:]
+		rest = rest[:idx]
+		if !strings.HasPrefix(ref.Digest, "sha256:") {
+			return ref, fmt.Errorf("invalid oci source address %q: only sha256 digests are supported for pinning", u.String())
+		}
+	}
+	// A colon in the final path segment separates the repository from
+	// the tag. Earlier segments cannot contain colons, since the port
+	// (if any) belongs to the host portion.
+	if idx := strings.LastIndex(rest, ":"); idx > strings.LastIndex(rest, "/") {
+		ref.Tag = rest[idx+1:]
+		rest = rest[:idx]
+	}
+	if rest == "" {
+		return ref, fmt.Errorf("invalid oci source address %q: a repository name is required", u.String())
+	}
+	ref.Repository = rest
+	if ref.Tag == "" && ref.Digest == "" {
+		return ref, fmt.Errorf("invalid oci source address %q: a tag or digest is required, like %s:1.0.0", u.String(), ref.Repository)
+	}
+	return ref, nil
+}
+
+// ociManifest is the subset of the OCI image manifest schema that we need
+// in order to locate the module package layer.
+type ociManifest struct {
+	MediaType string     `json:"mediaType"`
+	Layers    []ociLayer `json:"layers"`
+}
+
+type ociLayer struct {
+	MediaType string `json:"mediaType"`
+	Digest    string `json:"digest"`
+	Size      int64  `json:"size"`
+}
+
+// modulePackageLayer returns the layer that contains the module package,
+// which is the first layer with a gzipped tar media type.
+func (m *ociManifest) modulePackageLayer() (ociLayer, error) {
+	for _, layer := range m.Layers {
+		if strings.Contains(layer.MediaType, "tar+gzip") || strings.Contains(layer.MediaType, "tar.gzip") {
+			return layer, nil
+		}
+	}
+	return ociLayer{}, fmt.Errorf("manifest contains no gzipped tar layer to use as the module package")
+}
+
+// ociRegistrySession tracks the authentication state for a sequence of
+// requests against a single registry.
+type ociRegistrySession struct {
+	getter   *ociGetter
+	registry string
+	creds    *ociCredentials
+
+	// bearerToken is the token obtained from the registry's authorization
+	// service after a 401 challenge, if any.
+	bearerToken string
+}
+
+func (s *ociRegistrySession) httpClient() *http.Client {
+	if s.getter.httpClient != nil {
+		return s.getter.httpClient
+	}
+	return getterHTTPClient
+}
+
+func (s *ociRegistrySession) baseURL() string {
+	scheme := s.getter.scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	return fmt.Sprintf("%s://%s", scheme, s.registry)
+}
+
+// doRequest performs a registry request, transparently handling a bearer
+// token challenge from the registry's authorization service.
+func (s *ociRegistrySession) doRequest(path string, accept string) (*http.Response, error) {
+	for attempt := 0; attempt < 2; attempt++ {
+		req, err := http.NewRequest("GET", s.baseURL()+path, nil)
+		if err != nil {
+			return nil, err
+		}
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		switch {
+		case s.bearerToken != "":
+			req.Header.Set("Authorization", "Bearer "+s.bearerToken)
+		case s.creds != nil:
+			req.SetBasicAuth(s.creds.Username, s.creds.Secret)
+		}
+
+		resp, err := s.httpClient().Do(req)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusUnauthorized || s.bearerToken != "" {
+			return resp, nil
+		}
+
+		challenge := resp.Header.Get("WWW-Authenticate")
+		resp.Body.Close()
+		if err := s.obtainBearerToken(challenge); err != nil {
+			return nil, err
+		}
+	}
+	return nil, fmt.Errorf("authentication against %s failed", s.registry)
+}
+
+// obtainBearerToken implements the "docker token" authorization flow in
+// response to a bearer challenge from the registry.
+func (s *ociRegistrySession) obtainBearerToken(challenge string) error {
+	params := parseBearerChallenge(challenge)
+	realm := params["realm"]
+	if realm == "" {
+		return fmt.Errorf("registry %s requires authentication but did not offer a bearer token challenge", s.registry)
+	}
+
+	tokenURL, err := url.Parse(realm)
+	if err != nil {
+		return fmt.Errorf("registry %s offered an invalid token realm %q: %s", s.registry, realm, err)
+	}
+	query := tokenURL.Query()
+	if service := params["service"]; service != "" {
+		query.Set("service", service)
+	}
+	if scope := params["scope"]; scope != "" {
+		query.Set("scope", scope)
+	}
+	tokenURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequest("GET", tokenURL.String(), nil)
+	if err != nil {
+		return err
+	}
+	if s.creds != nil {
+		req.SetBasicAuth(s.creds.Username, s.creds.Secret)
+	}
+
+	resp, err := s.httpClient().Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to obtain access token for %s: %s", s.registry, resp.Status)
+	}
+
+	var body struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return fmt.Errorf("failed to obtain access token for %s: %s", s.registry, err)
+	}
+	s.bearerToken = body.Token
+	if s.bearerToken == "" {
+		s.bearerToken = body.AccessToken
+	}
+	if s.bearerToken == "" {
+		return fmt.Errorf("authorization service for %s returned no token", s.registry)
+	}
+	return nil
+}
+
+// parseBearerChallenge extracts the key/value parameters from a
+// WWW-Authenticate bearer challenge header value.
+func parseBearerChallenge(challenge string) map[string]string {
+	params := map[string]string{}
+	challenge = strings.TrimPrefix(challenge, "Bearer ")
+	for _, part := range strings.Split(challenge, ",") {
+		kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		params[kv[0]] = strings.Trim(kv[1], `"`)
+	}
+	return params
+}
+
+const (
+	ociManifestMediaType    = "application/vnd.oci.image.manifest.v1+json"
+	dockerManifestMediaType = "application/vnd.docker.distribution.manifest.v2+json"
+)
+
+func (s *ociRegistrySession) fetchManifest(ref ociRef) (*ociManifest, error) {
+	path := fmt.Sprintf("/v2/%s/manifests/%s", ref.Repository, ref.manifestRef())
+	accept := ociManifestMediaType + ", " + dockerManifestMediaType
+
+	resp, err := s.doRequest(path, accept)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch manifest for %s: %s", ref, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch manifest for %s: %s", ref, resp.Status)
+	}
+
+	raw, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch manifest for %s: %s", ref, err)
+	}
+
+	if ref.Digest != "" {
+		gotDigest := fmt.Sprintf("sha256:%x", sha256.Sum256(raw))
+		if gotDigest != ref.Digest {
+			return nil, fmt.Errorf("manifest for %s does not match the pinned digest: got %s", ref, gotDigest)
+		}
+	}
+
+	manifest := &ociManifest{}
+	if err := json.Unmarshal(raw, manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest for %s: %s", ref, err)
+	}
+	return manifest, nil
+}
+
+// fetchBlob downloads the given layer blob into a temporary file, verifying
+// its digest, and returns the path of that file. The caller is responsible
+// for removing the file once it is no longer needed.
+func (s *ociRegistrySession) fetchBlob(ref ociRef, layer ociLayer) (string, error) {
+	path := fmt.Sprintf("/v2/%s/blobs/%s", ref.Repository, layer.Digest)
+
+	resp, err := s.doRequest(path, "")
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch module package from %s: %s", ref, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to fetch module package from %s: %s", ref, resp.Status)
+	}
+
+	f, err := ioutil.TempFile("", "terraform-oci-module")
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(f, hash), resp.Body); err != nil {
+		os.Remove(f.Name())
+		return "", fmt.Errorf("failed to fetch module package from %s: %s", ref, err)
+	}
+
+	gotDigest := fmt.Sprintf("sha256:%x", hash.Sum(nil))
+	if gotDigest != layer.Digest {
+		os.Remove(f.Name())
+		return "", fmt.Errorf("module package from %s does not match its digest in the manifest: got %s, want %s", ref, gotDigest, layer.Digest)
+	}
+	return f.Name(), nil
+}
+
+// ociCredentials is a username/secret pair for registry authentication,
+// as produced by a docker credential helper.
+type ociCredentials struct {
+	Username string
+	Secret   string
+}
+
+// ociRegistryCredentials resolves credentials for the given registry host
+// from the Docker CLI configuration, consulting any configured credential
+// helper first and then falling back to static auth entries. A nil result
+// with no error means anonymous access.
+func ociRegistryCredentials(registry string) (*ociCredentials, error) {
+	config, err := readDockerConfig()
+	if err != nil {
+		return nil, err
+	}
+	if config == nil {
+		return nil, nil
+	}
+
+	helper := config.CredHelpers[registry]
+	if helper == "" {
+		helper = config.CredsStore
+	}
+	if helper != "" {
+		creds, err := credentialsFromHelper(helper, registry)
+		if err != nil {
+			return nil, err
+		}
+		if creds != nil {
+			return creds, nil
+		}
+	}
+
+	for _, key := range []string{registry, "https://" + registry} {
+		if entry, ok := config.Auths[key]; ok && entry.Auth != "" {
+			raw, err := base64.StdEncoding.DecodeString(entry.Auth)
+			if err != nil {
+				return nil, fmt.Errorf("invalid auth entry for %s in docker config: %s", registry, err)
+			}
+			parts := strings.SplitN(string(raw), ":", 2)
+			if len(parts) != 2 {
+				return nil, fmt.Errorf("invalid auth entry for %s in docker config", registry)
+			}
+			return &ociCredentials{Username: parts[0], Secret: parts[1]}, nil
+		}
+	}
+	return nil, nil
+}
+
+// dockerConfig is the subset of the Docker CLI configuration file schema
+// that describes registry credentials.
+type dockerConfig struct {
+	Auths       map[string]dockerAuthEntry `json:"auths"`
+	CredsStore  string                     `json:"credsStore"`
+	CredHelpers map[string]string          `json:"credHelpers"`
+}
+
+type dockerAuthEntry struct {
+	Auth string `json:"auth"`
+}
+
+func readDockerConfig() (*dockerConfig, error) {
+	dir := os.Getenv("DOCKER_CONFIG")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, nil
+		}
+		dir = filepath.Join(home, ".docker")
+	}
+
+	raw, err := ioutil.ReadFile(filepath.Join(dir, "config.json"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read docker CLI config: %s", err)
+	}
+
+	config := &dockerConfig{}
+	if err := json.Unmarshal(raw, config); err != nil {
+		return nil, fmt.Errorf("failed to parse docker CLI config: %s", err)
+	}
+	return config, nil
+}
+
+// credentialsFromHelper invokes a docker credential helper program to
+// obtain credentials for the given registry. A nil result with no error
+// means the helper has no credentials stored for the registry.
+func credentialsFromHelper(helper, registry string) (*ociCredentials, error) {
+	cmd := exec.Command("docker-credential-"+helper, "get")
+	cmd.Stdin = strings.NewReader(registry)
+
+	out, err := cmd.Output()
+	if err != nil {
+		// Helpers report a missing entry as an error, which we treat as
+		// anonymous access rather than failing installation outright.
+		log.Printf("[WARN] docker credential helper %q failed for %s: %s", helper, registry, err)
+		return nil, nil
+	}
+
+	creds := &ociCredentials{}
+	if err := json.Unmarshal(out, creds); err != nil {
+		return nil, fmt.Errorf("docker credential helper %q returned an invalid response: %s", helper, err)
+	}
+	if creds.Username == "" && creds.Secret == "" {
+		return nil, nil
+	}
+	return creds, nil
+}
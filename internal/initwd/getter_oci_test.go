@@ -0,0 +1,285 @@
+package initwd
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseOCIRef(t *testing.T) {
+	tests := []struct {
+		Input   string
+		Want    ociRef
+		WantErr string
+	}{
+		{
+			Input: "oci://registry.example.com/modules/network:1.2.0",
+			Want: ociRef{
+				Registry:   "registry.example.com",
+				Repository: "modules/network",
+				Tag:        "1.2.0",
+			},
+		},
+		{
+			Input: "oci://registry.example.com:5000/network:latest",
+			Want: ociRef{
+				Registry:   "registry.example.com:5000",
+				Repository: "network",
+				Tag:        "latest",
+			},
+		},
+		{
+			Input: "oci://registry.example.com/modules/network@sha256:abcd",
+			Want: ociRef{
+				Registry:   "registry.example.com",
+				Repository: "modules/network",
+				Digest:     "sha256:abcd",
+			},
+		},
+		{
+			Input: "oci://registry.example.com/modules/network:1.2.0@sha256:abcd",
+			Want: ociRef{
+				Registry:   "registry.example.com",
+				Repository: "modules/network",
+				Tag:        "1.2.0",
+				Digest:     "sha256:abcd",
+			},
+		},
+		{
+			Input:   "oci://registry.example.com/modules/network",
+			WantErr: "a tag or digest is required",
+		},
+		{
+			Input:   "oci://registry.example.com/modules/network@md5:abcd",
+			WantErr: "only sha256 digests are supported",
+		},
+		{
+			Input:   "oci://registry.example.com",
+			WantErr: "a repository name is required",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Input, func(t *testing.T) {
+			u, err := url.Parse(test.Input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := parseOCIRef(u)
+			if test.WantErr != "" {
+				if err == nil {
+					t.Fatalf("succeeded; want error containing %q", test.WantErr)
+				}
+				if !strings.Contains(err.Error(), test.WantErr) {
+					t.Fatalf("wrong error %q; want error containing %q", err, test.WantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != test.Want {
+				t.Errorf("wrong result\ngot:  %#v\nwant: %#v", got, test.Want)
+			}
+		})
+	}
+}
+
+func TestOCIGetter(t *testing.T) {
+	pkg := testOCIModulePackage(t)
+	pkgDigest := fmt.Sprintf("sha256:%x", sha256.Sum256(pkg))
+
+	manifest := []byte(fmt.Sprintf(`{
+		"mediaType": "application/vnd.oci.image.manifest.v1+json",
+		"layers": [
+			{
+				"mediaType": "application/vnd.oci.image.layer.v1.tar+gzip",
+				"digest": %q,
+				"size": %d
+			}
+		]
+	}`, pkgDigest, len(pkg)))
+	manifestDigest := fmt.Sprintf("sha256:%x", sha256.Sum256(manifest))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch {
+		// The manifest is served for any reference so that the digest
+		// verification in the getter is what rejects a bad pin.
+		case strings.HasPrefix(req.URL.Path, "/v2/modules/network/manifests/"):
+			w.Header().Set("Content-Type", ociManifestMediaType)
+			w.Write(manifest)
+		case req.URL.Path == "/v2/modules/network/blobs/"+pkgDigest:
+			w.Write(pkg)
+		default:
+			http.NotFound(w, req)
+		}
+	}))
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+	g := &ociGetter{
+		httpClient: server.Client(),
+		scheme:     "http",
+	}
+
+	t.Run("by tag", func(t *testing.T) {
+		dst := testOCIGet(t, g, "oci://"+serverURL.Host+"/modules/network:1.2.0", "")
+		assertOCIModuleInstalled(t, dst)
+	})
+	t.Run("by pinned digest", func(t *testing.T) {
+		dst := testOCIGet(t, g, "oci://"+serverURL.Host+"/modules/network:1.2.0@"+manifestDigest, "")
+		assertOCIModuleInstalled(t, dst)
+	})
+	t.Run("digest mismatch", func(t *testing.T) {
+		wrongDigest := fmt.Sprintf("sha256:%x", sha256.Sum256([]byte("not the manifest")))
+		testOCIGet(t, g, "oci://"+serverURL.Host+"/modules/network:1.2.0@"+wrongDigest, "does not match the pinned digest")
+	})
+	t.Run("missing artifact", func(t *testing.T) {
+		testOCIGet(t, g, "oci://"+serverURL.Host+"/modules/boop:1.0.0", "failed to fetch manifest")
+	})
+}
+
+func TestOCIGetterBearerAuth(t *testing.T) {
+	pkg := testOCIModulePackage(t)
+	pkgDigest := fmt.Sprintf("sha256:%x", sha256.Sum256(pkg))
+
+	manifest := []byte(fmt.Sprintf(`{
+		"mediaType": "application/vnd.oci.image.manifest.v1+json",
+		"layers": [
+			{
+				"mediaType": "application/vnd.oci.image.layer.v1.tar+gzip",
+				"digest": %q,
+				"size": %d
+			}
+		]
+	}`, pkgDigest, len(pkg)))
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/token", func(w http.ResponseWriter, req *http.Request) {
+		if got, want := req.URL.Query().Get("scope"), "repository:modules/network:pull"; got != want {
+			t.Errorf("wrong scope in token request: got %q, want %q", got, want)
+		}
+		w.Write([]byte(`{"token":"opensesame"}`))
+	})
+	mux.HandleFunc("/v2/", func(w http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Authorization") != "Bearer opensesame" {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(
+				`Bearer realm=%q,service="test",scope="repository:modules/network:pull"`,
+				server.URL+"/token",
+			))
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		switch req.URL.Path {
+		case "/v2/modules/network/manifests/1.2.0":
+			w.Header().Set("Content-Type", ociManifestMediaType)
+			w.Write(manifest)
+		case "/v2/modules/network/blobs/" + pkgDigest:
+			w.Write(pkg)
+		default:
+			http.NotFound(w, req)
+		}
+	})
+
+	serverURL, _ := url.Parse(server.URL)
+	g := &ociGetter{
+		httpClient: server.Client(),
+		scheme:     "http",
+	}
+
+	dst := testOCIGet(t, g, "oci://"+serverURL.Host+"/modules/network:1.2.0", "")
+	assertOCIModuleInstalled(t, dst)
+}
+
+// testOCIModulePackage builds a gzipped tar archive containing a minimal
+// module configuration.
+func testOCIModulePackage(t *testing.T) []byte {
+	t.Helper()
+
+	buf := &bytes.Buffer{}
+	gw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gw)
+
+	content := []byte(`output "hello" { value = "hello" }` + "\n")
+	err := tw.WriteHeader(&tar.Header{
+		Name: "main.tf",
+		Mode: 0644,
+		Size: int64(len(content)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tw.Write(content); err != nil {
+		t.Fatal(err)
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// testOCIGet runs the given getter against the given address and returns
+// the installation directory. If wantErr is non-empty then the get is
+// expected to fail with an error containing that string.
+func testOCIGet(t *testing.T, g *ociGetter, addr, wantErr string) string {
+	t.Helper()
+
+	tmpDir, err := ioutil.TempDir("", "terraform-test-oci-getter")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if t.Failed() || wantErr != "" {
+			os.RemoveAll(tmpDir)
+		}
+	}()
+
+	u, err := url.Parse(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dst := filepath.Join(tmpDir, "module")
+	err = g.Get(dst, u)
+	if wantErr != "" {
+		if err == nil {
+			t.Fatalf("get succeeded; want error containing %q", wantErr)
+		}
+		if !strings.Contains(err.Error(), wantErr) {
+			t.Fatalf("wrong error %q; want error containing %q", err, wantErr)
+		}
+		return dst
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dst
+}
+
+func assertOCIModuleInstalled(t *testing.T, dst string) {
+	t.Helper()
+
+	content, err := ioutil.ReadFile(filepath.Join(dst, "main.tf"))
+	if err != nil {
+		t.Fatalf("module was not extracted: %s", err)
+	}
+	if !strings.Contains(string(content), `output "hello"`) {
+		t.Errorf("wrong content in extracted main.tf:\n%s", content)
+	}
+}
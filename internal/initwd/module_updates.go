@@ -0,0 +1,167 @@
+package initwd
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	version "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform/internal/earlyconfig"
+	"github.com/hashicorp/terraform/registry/regsrc"
+	"github.com/hashicorp/terraform/tfdiags"
+)
+
+// ModuleUpdate describes a registry module in a configuration whose
+// installed version is not the newest one available from its registry.
+type ModuleUpdate struct {
+	// Key is the module's unique identifier within the static module tree,
+	// matching the keys used in the module manifest.
+	Key string
+
+	// SourceAddr is the registry source address of the module, as given
+	// in configuration.
+	SourceAddr string
+
+	// InstalledVersion is the exact version that is currently installed.
+	InstalledVersion *version.Version
+
+	// LatestMatching is the newest available version that the version
+	// constraint in configuration allows. It equals InstalledVersion when
+	// the constraint prevents upgrading any further, and is nil in the
+	// unusual case where no available version matches the constraint
+	// at all.
+	LatestMatching *version.Version
+
+	// LatestVersion is the newest version available from the registry
+	// regardless of the configured constraint. If this is newer than
+	// LatestMatching then the constraint would need to be adjusted before
+	// the module could be upgraded all the way.
+	LatestVersion *version.Version
+}
+
+// ModuleUpdates inspects the already-installed modules for the configuration
+// in the given directory and returns a description of each registry module
+// for which a newer version is available, whether or not the configured
+// version constraint allows selecting it.
+//
+// All modules must already be installed, since the version constraints are
+// read from the configuration tree itself; run a normal installation first
+// if necessary. Modules from non-registry sources are not included, because
+// there is no protocol for enumerating their versions.
+func (i *ModuleInstaller) ModuleUpdates(rootDir string) ([]ModuleUpdate, tfdiags.Diagnostics) {
+	cfg, diags := LoadConfig(rootDir, i.modsDir)
+	if cfg == nil || diags.HasErrors() {
+		return nil, diags
+	}
+
+	var updates []ModuleUpdate
+	var walk func(c *earlyconfig.Config)
+	walk = func(c *earlyconfig.Config) {
+		for name, child := range c.Children {
+			update, moreDiags := i.moduleUpdate(c, name, child)
+			diags = diags.Append(moreDiags)
+			if update != nil {
+				updates = append(updates, *update)
+			}
+			walk(child)
+		}
+	}
+	walk(cfg)
+
+	sort.Slice(updates, func(i, j int) bool {
+		return updates[i].Key < updates[j].Key
+	})
+	return updates, diags
+}
+
+// moduleUpdate determines whether a newer version is available for one
+// module in the configuration tree, returning nil if the module is not a
+// registry module or is already at the newest available version.
+func (i *ModuleInstaller) moduleUpdate(parent *earlyconfig.Config, callName string, c *earlyconfig.Config) (*ModuleUpdate, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	if c.Version == nil {
+		// Not installed from a registry, so there are no versions to check.
+		return nil, nil
+	}
+
+	addr, err := regsrc.ParseModuleSource(c.SourceAddr)
+	if err != nil {
+		// Should never happen, since a version is only recorded for modules
+		// that were installed from a registry.
+		return nil, nil
+	}
+
+	key := strings.Join([]string(c.Path), ".")
+	log.Printf("[DEBUG] ModuleUpdates: checking for newer versions of %s for module %s", addr, key)
+
+	var constraints version.Constraints
+	if call, exists := parent.Module.ModuleCalls[callName]; exists && call.Version != "" {
+		constraints, err = version.NewConstraint(call.Version)
+		if err != nil {
+			// Invalid constraints would have prevented installation, so
+			// this should never happen.
+			return nil, nil
+		}
+	}
+
+	resp, exists := i.moduleVersions[addr.String()]
+	if !exists {
+		resp, err = i.reg.ModuleVersions(addr)
+		if err != nil {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Error accessing remote module registry",
+				fmt.Sprintf("Failed to retrieve available versions for module %s (%q): %s.", key, c.SourceAddr, err),
+			))
+			return nil, diags
+		}
+		i.moduleVersions[addr.String()] = resp
+	}
+	if len(resp.Modules) < 1 {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid response from remote module registry",
+			fmt.Sprintf("The registry returned an invalid response when Terraform requested available versions for module %s (%q).", key, c.SourceAddr),
+		))
+		return nil, diags
+	}
+
+	var latestMatch *version.Version
+	var latestVersion *version.Version
+	for _, mv := range resp.Modules[0].Versions {
+		v, err := version.NewVersion(mv.Version)
+		if err != nil {
+			// Ignore invalid versions, as the installer does.
+			continue
+		}
+		if v.Prerelease() != "" && constraints.String() != v.String() {
+			// Pre-release versions are only eligible when requested exactly,
+			// matching the installer's selection behavior.
+			continue
+		}
+
+		if latestVersion == nil || v.GreaterThan(latestVersion) {
+			latestVersion = v
+		}
+		if constraints.Check(v) {
+			if latestMatch == nil || v.GreaterThan(latestMatch) {
+				latestMatch = v
+			}
+		}
+	}
+
+	if latestVersion == nil || !latestVersion.GreaterThan(c.Version) {
+		// Already as new as it can be.
+		return nil, diags
+	}
+
+	return &ModuleUpdate{
+		Key:              key,
+		SourceAddr:       c.SourceAddr,
+		InstalledVersion: c.Version,
+		LatestMatching:   latestMatch,
+		LatestVersion:    latestVersion,
+	}, diags
+}
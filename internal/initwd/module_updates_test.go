@@ -0,0 +1,97 @@
+package initwd
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform/registry"
+	"github.com/hashicorp/terraform/registry/test"
+)
+
+func TestModuleUpdates(t *testing.T) {
+	server := test.Registry()
+	defer server.Close()
+
+	// Construct a configuration directory with a registry module already
+	// "installed" at a version that is not the newest acceptable one.
+	rootDir, err := ioutil.TempDir("", "terraform-initwd-module-updates")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(rootDir)
+
+	rootConfig := `
+module "acctest" {
+  source  = "registry.terraform.io/test-versions/name/provider"
+  version = "~> 1.2.0"
+}
+`
+	if err := ioutil.WriteFile(filepath.Join(rootDir, "main.tf"), []byte(rootConfig), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	modulesDir := filepath.Join(rootDir, ".terraform", "modules")
+	moduleDir := filepath.Join(modulesDir, "acctest")
+	if err := os.MkdirAll(moduleDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(moduleDir, "main.tf"), []byte(""), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	manifest := `{
+		"Modules": [
+			{
+				"Key": "",
+				"Source": "",
+				"Dir": "."
+			},
+			{
+				"Key": "acctest",
+				"Source": "registry.terraform.io/test-versions/name/provider",
+				"Version": "1.2.1",
+				"Dir": ` + quoteJSONPath(moduleDir) + `
+			}
+		]
+	}`
+	if err := ioutil.WriteFile(filepath.Join(modulesDir, "modules.json"), []byte(manifest), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	inst := NewModuleInstaller(modulesDir, registry.NewClient(test.Disco(server), nil))
+	updates, diags := inst.ModuleUpdates(rootDir)
+	if diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+
+	if len(updates) != 1 {
+		t.Fatalf("wrong number of updates %d; want 1", len(updates))
+	}
+	update := updates[0]
+	if got, want := update.Key, "acctest"; got != want {
+		t.Errorf("wrong module key %q; want %q", got, want)
+	}
+	if got, want := update.InstalledVersion.String(), "1.2.1"; got != want {
+		t.Errorf("wrong installed version %q; want %q", got, want)
+	}
+	if got, want := update.LatestMatching.String(), "1.2.2"; got != want {
+		t.Errorf("wrong latest matching version %q; want %q", got, want)
+	}
+	if got, want := update.LatestVersion.String(), "2.2.0"; got != want {
+		t.Errorf("wrong latest version %q; want %q", got, want)
+	}
+}
+
+// quoteJSONPath escapes a filesystem path for direct inclusion in a JSON
+// document, which matters for the backslashes in Windows-style paths.
+func quoteJSONPath(path string) string {
+	b, err := json.Marshal(path)
+	if err != nil {
+		// json.Marshal cannot fail for a string value
+		panic(err)
+	}
+	return string(b)
+}
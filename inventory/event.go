@@ -0,0 +1,45 @@
+// Package inventory implements a resilient delivery pipeline for resource
+// lifecycle events, intended for keeping external inventory or CMDB systems
+// up to date with changes made by Terraform.
+//
+// Events are buffered on local disk before any delivery is attempted, giving
+// at-least-once semantics: an event that has been accepted by the queue will
+// eventually be delivered even if the Terraform process exits before the
+// receiver acknowledges it, because any events still on disk are recovered
+// and re-sent by a later run.
+package inventory
+
+import (
+	"time"
+)
+
+// EventType describes what happened to the remote object an event refers to.
+type EventType string
+
+const (
+	// EventCreated indicates that a new remote object was created.
+	EventCreated EventType = "created"
+
+	// EventUpdated indicates that an existing remote object was updated
+	// in-place.
+	EventUpdated EventType = "updated"
+
+	// EventDeleted indicates that a remote object was destroyed.
+	EventDeleted EventType = "deleted"
+)
+
+// Event is a single resource lifecycle event.
+//
+// Receivers must treat delivery as at-least-once: the same event may be
+// delivered more than once if an acknowledgement is lost, so receivers
+// should deduplicate on the combination of address, type and timestamp.
+type Event struct {
+	// Type describes what happened to the object.
+	Type EventType `json:"type"`
+
+	// Address is the absolute resource instance address the event refers to.
+	Address string `json:"address"`
+
+	// Timestamp is when the event was recorded, in UTC.
+	Timestamp time.Time `json:"timestamp"`
+}
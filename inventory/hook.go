@@ -0,0 +1,92 @@
+package inventory
+
+import (
+	"sync"
+	"time"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/plans"
+	"github.com/hashicorp/terraform/states"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Hook is a terraform.Hook implementation that records a lifecycle event
+// for each resource instance that is successfully applied.
+//
+// The apply walk visits many resource instances concurrently, so all of the
+// hook methods are safe for concurrent use.
+type Hook struct {
+	terraform.NilHook
+
+	queue *Queue
+
+	mu      sync.Mutex
+	actions map[string]plans.Action
+
+	// EnqueueErrs accumulates any errors from persisting events, which the
+	// caller should surface to the user after the operation completes.
+	// It must only be read once the apply walk has finished.
+	EnqueueErrs []error
+}
+
+var _ terraform.Hook = (*Hook)(nil)
+
+// NewHook returns a hook that records events into the given queue.
+func NewHook(queue *Queue) *Hook {
+	return &Hook{
+		queue:   queue,
+		actions: make(map[string]plans.Action),
+	}
+}
+
+func (h *Hook) PreApply(addr addrs.AbsResourceInstance, gen states.Generation, action plans.Action, priorState, plannedNewState cty.Value) (terraform.HookAction, error) {
+	// The action isn't repeated in PostApply, so we remember it here in
+	// order to classify the event once we know the apply succeeded.
+	h.mu.Lock()
+	h.actions[addr.String()] = action
+	h.mu.Unlock()
+	return terraform.HookActionContinue, nil
+}
+
+func (h *Hook) PostApply(addr addrs.AbsResourceInstance, gen states.Generation, newState cty.Value, err error) (terraform.HookAction, error) {
+	h.mu.Lock()
+	action, ok := h.actions[addr.String()]
+	delete(h.actions, addr.String())
+	h.mu.Unlock()
+
+	if err != nil || !ok {
+		// A failed apply doesn't change the inventory, so no event.
+		return terraform.HookActionContinue, nil
+	}
+
+	var eventType EventType
+	switch action {
+	case plans.Create:
+		eventType = EventCreated
+	case plans.Update:
+		eventType = EventUpdated
+	case plans.Delete:
+		eventType = EventDeleted
+	default:
+		// No remote object was changed, so no event.
+		return terraform.HookActionContinue, nil
+	}
+
+	enqueueErr := h.queue.Enqueue(Event{
+		Type:      eventType,
+		Address:   addr.String(),
+		Timestamp: time.Now().UTC(),
+	})
+	if enqueueErr != nil {
+		// Losing an event is a problem for the external inventory but not
+		// for the apply itself, so we record the error for later reporting
+		// rather than aborting the walk.
+		h.mu.Lock()
+		h.EnqueueErrs = append(h.EnqueueErrs, enqueueErr)
+		h.mu.Unlock()
+	}
+
+	return terraform.HookActionContinue, nil
+}
@@ -0,0 +1,68 @@
+package inventory
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/plans"
+	"github.com/hashicorp/terraform/states"
+)
+
+func TestHookLifecycleEvents(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf-inventory")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	sink := &testSink{}
+	q, err := NewQueue(dir, sink)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := NewHook(q)
+
+	created := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_thing",
+		Name: "new",
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+	failed := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_thing",
+		Name: "broken",
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+
+	// A successful create must produce an event.
+	h.PreApply(created, states.CurrentGen, plans.Create, cty.NullVal(cty.EmptyObject), cty.EmptyObjectVal)
+	h.PostApply(created, states.CurrentGen, cty.EmptyObjectVal, nil)
+
+	// A failed apply must not.
+	h.PreApply(failed, states.CurrentGen, plans.Update, cty.EmptyObjectVal, cty.EmptyObjectVal)
+	h.PostApply(failed, states.CurrentGen, cty.EmptyObjectVal, errors.New("boom"))
+
+	if remaining := q.Flush(); remaining != 0 {
+		t.Fatalf("%d events remain after flush; want 0", remaining)
+	}
+	if len(h.EnqueueErrs) != 0 {
+		t.Fatalf("unexpected enqueue errors: %s", h.EnqueueErrs)
+	}
+	if len(sink.delivered) != 1 {
+		t.Fatalf("%d events delivered; want 1", len(sink.delivered))
+	}
+	ev := sink.delivered[0]
+	if got, want := ev.Type, EventCreated; got != want {
+		t.Errorf("wrong event type %q; want %q", got, want)
+	}
+	if got, want := ev.Address, "test_thing.new"; got != want {
+		t.Errorf("wrong event address %q; want %q", got, want)
+	}
+	if ev.Timestamp.IsZero() {
+		t.Errorf("event has no timestamp")
+	}
+}
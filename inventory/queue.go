@@ -0,0 +1,177 @@
+package inventory
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultRetryCount is how many delivery attempts are made for each
+	// event before the queue gives up for the current run and leaves the
+	// event buffered on disk for a later run to recover.
+	defaultRetryCount = 3
+
+	// defaultRetryDelay is how long the delivery worker waits between
+	// attempts for the same event.
+	defaultRetryDelay = 2 * time.Second
+)
+
+// Queue is a disk-buffered delivery queue for events.
+//
+// Each enqueued event is first persisted as its own file in the queue
+// directory, and is removed only after the sink has acknowledged it. Delivery
+// happens concurrently with whatever operation is producing events, so
+// Enqueue never blocks on the network; the Flush barrier at the end of the
+// operation then waits for the queue to drain.
+type Queue struct {
+	dir  string
+	sink Sink
+
+	// RetryCount and RetryDelay control delivery retries for each event.
+	// They should be set before the first call to Enqueue, if at all.
+	RetryCount int
+	RetryDelay time.Duration
+
+	mu     sync.Mutex
+	serial int
+
+	pending chan string
+	done    chan struct{}
+}
+
+// NewQueue opens a queue in the given directory, creating it if necessary,
+// and starts the delivery worker.
+//
+// Any event files already present in the directory are from a previous run
+// that exited before they were acknowledged, and are enqueued again for
+// delivery before any new events.
+func NewQueue(dir string, sink Sink) (*Queue, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create event queue directory: %s", err)
+	}
+
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read event queue directory: %s", err)
+	}
+	var recovered []string
+	for _, info := range entries {
+		if info.IsDir() || !strings.HasSuffix(info.Name(), ".json") {
+			continue
+		}
+		recovered = append(recovered, filepath.Join(dir, info.Name()))
+	}
+	sort.Strings(recovered)
+
+	q := &Queue{
+		dir:        dir,
+		sink:       sink,
+		RetryCount: defaultRetryCount,
+		RetryDelay: defaultRetryDelay,
+
+		// The channel is buffered generously so that Enqueue doesn't block
+		// during a large apply even if the sink is slow.
+		pending: make(chan string, 1024+len(recovered)),
+		done:    make(chan struct{}),
+	}
+	for _, fn := range recovered {
+		q.pending <- fn
+	}
+
+	go q.deliverLoop()
+
+	return q, nil
+}
+
+// Enqueue persists the given event to disk and schedules it for delivery.
+// Once Enqueue returns a nil error the event will eventually be delivered,
+// even if the current process exits first.
+//
+// Enqueue is safe to call concurrently from multiple goroutines.
+func (q *Queue) Enqueue(ev Event) error {
+	raw, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+
+	q.mu.Lock()
+	q.serial++
+	fn := filepath.Join(q.dir, fmt.Sprintf("%d-%06d.json", ev.Timestamp.UnixNano(), q.serial))
+	q.mu.Unlock()
+
+	// Write via a temporary file and rename so that the recovery scan in
+	// NewQueue can never observe a partially-written event.
+	tmp := fn + ".tmp"
+	if err := ioutil.WriteFile(tmp, raw, 0644); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, fn); err != nil {
+		return err
+	}
+
+	q.pending <- fn
+	return nil
+}
+
+// Flush is the operation-end barrier: it stops accepting new events and
+// blocks until every pending event has either been delivered or exhausted
+// its delivery attempts.
+//
+// The returned count is the number of events that could not be delivered
+// and remain buffered on disk for a later run to recover. After Flush
+// returns, the queue must not be used again.
+func (q *Queue) Flush() int {
+	close(q.pending)
+	<-q.done
+
+	entries, err := ioutil.ReadDir(q.dir)
+	if err != nil {
+		return 0
+	}
+	remaining := 0
+	for _, info := range entries {
+		if info.IsDir() || !strings.HasSuffix(info.Name(), ".json") {
+			continue
+		}
+		remaining++
+	}
+	return remaining
+}
+
+func (q *Queue) deliverLoop() {
+	defer close(q.done)
+
+	for fn := range q.pending {
+		raw, err := ioutil.ReadFile(fn)
+		if err != nil {
+			// The file may have been delivered and removed by a concurrent
+			// run; either way there's nothing more we can do with it.
+			continue
+		}
+
+		delivered := false
+		for attempt := 0; attempt < q.RetryCount; attempt++ {
+			if attempt > 0 {
+				time.Sleep(q.RetryDelay)
+			}
+			if err := q.sink.Deliver(raw); err == nil {
+				delivered = true
+				break
+			}
+		}
+
+		if delivered {
+			// Only now is it safe to forget the event. If removal fails the
+			// event will be delivered again by a later run, which is
+			// acceptable under at-least-once semantics.
+			os.Remove(fn)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package inventory
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testSink collects delivered events in memory, optionally failing the
+// first few delivery attempts to exercise the retry behavior.
+type testSink struct {
+	mu        sync.Mutex
+	failTimes int
+	attempts  int
+	delivered []Event
+}
+
+func (s *testSink) Deliver(raw []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.attempts++
+	if s.attempts <= s.failTimes {
+		return errTemporary
+	}
+	var ev Event
+	if err := json.Unmarshal(raw, &ev); err != nil {
+		return err
+	}
+	s.delivered = append(s.delivered, ev)
+	return nil
+}
+
+var errTemporary = &temporaryError{}
+
+type temporaryError struct{}
+
+func (e *temporaryError) Error() string { return "temporary failure" }
+
+func TestQueueDeliver(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf-inventory")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	sink := &testSink{failTimes: 1}
+	q, err := NewQueue(dir, sink)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q.RetryDelay = time.Millisecond
+
+	err = q.Enqueue(Event{
+		Type:      EventCreated,
+		Address:   "test_thing.foo",
+		Timestamp: time.Now().UTC(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if remaining := q.Flush(); remaining != 0 {
+		t.Fatalf("%d events remain after flush; want 0", remaining)
+	}
+	if len(sink.delivered) != 1 {
+		t.Fatalf("%d events delivered; want 1", len(sink.delivered))
+	}
+	if got, want := sink.delivered[0].Address, "test_thing.foo"; got != want {
+		t.Errorf("wrong event address %q; want %q", got, want)
+	}
+	if sink.attempts != 2 {
+		t.Errorf("sink saw %d attempts; want 2 (one failure, one success)", sink.attempts)
+	}
+}
+
+func TestQueueUndelivered(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf-inventory")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// A sink that never succeeds: the event must remain buffered on disk.
+	sink := &testSink{failTimes: 1000}
+	q, err := NewQueue(dir, sink)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q.RetryCount = 2
+	q.RetryDelay = time.Millisecond
+
+	err = q.Enqueue(Event{
+		Type:      EventDeleted,
+		Address:   "test_thing.foo",
+		Timestamp: time.Now().UTC(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if remaining := q.Flush(); remaining != 1 {
+		t.Fatalf("%d events remain after flush; want 1", remaining)
+	}
+
+	// A later run must recover the buffered event and deliver it once the
+	// sink is healthy again.
+	sink2 := &testSink{}
+	q2, err := NewQueue(dir, sink2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if remaining := q2.Flush(); remaining != 0 {
+		t.Fatalf("%d events remain after recovery flush; want 0", remaining)
+	}
+	if len(sink2.delivered) != 1 {
+		t.Fatalf("%d events delivered after recovery; want 1", len(sink2.delivered))
+	}
+	if got, want := sink2.delivered[0].Type, EventDeleted; got != want {
+		t.Errorf("wrong recovered event type %q; want %q", got, want)
+	}
+}
@@ -0,0 +1,73 @@
+package inventory
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"os/exec"
+
+	"github.com/hashicorp/terraform/httpclient"
+)
+
+// Sink is an endpoint that events can be delivered to.
+//
+// Deliver must return a nil error only once the event has been durably
+// accepted by the receiver; returning an error causes the queue to retry
+// the same event later.
+type Sink interface {
+	Deliver(raw []byte) error
+}
+
+// WebhookSink delivers events by POSTing them as JSON to an HTTP URL.
+//
+// A response with a 2xx status code is treated as an acknowledgement; any
+// other status code or transport error causes the event to be retried.
+type WebhookSink struct {
+	// URL is the endpoint events are POSTed to.
+	URL string
+
+	// Client is the HTTP client used for delivery. If nil, a default
+	// client is used.
+	Client *http.Client
+}
+
+func (s *WebhookSink) Deliver(raw []byte) error {
+	client := s.Client
+	if client == nil {
+		client = httpclient.New()
+	}
+
+	resp, err := client.Post(s.URL, "application/json", bytes.NewReader(raw))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected response status %s", resp.Status)
+	}
+	return nil
+}
+
+// CommandSink delivers events by running an external command with the JSON
+// event on its stdin.
+//
+// A zero exit status is treated as an acknowledgement; a non-zero exit
+// status or a failure to launch causes the event to be retried.
+type CommandSink struct {
+	// Command is the command line to run, with the program name first.
+	Command []string
+}
+
+func (s *CommandSink) Deliver(raw []byte) error {
+	if len(s.Command) == 0 {
+		return fmt.Errorf("no command configured")
+	}
+
+	cmd := exec.Command(s.Command[0], s.Command[1:]...)
+	cmd.Stdin = bytes.NewReader(raw)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%s (output: %q)", err, out)
+	}
+	return nil
+}
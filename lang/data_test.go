@@ -7,14 +7,15 @@ import (
 )
 
 type dataForTests struct {
-	CountAttrs     map[string]cty.Value
-	ForEachAttrs   map[string]cty.Value
-	Resources      map[string]cty.Value
-	LocalValues    map[string]cty.Value
-	Modules        map[string]cty.Value
-	PathAttrs      map[string]cty.Value
-	TerraformAttrs map[string]cty.Value
-	InputVariables map[string]cty.Value
+	CountAttrs      map[string]cty.Value
+	ForEachAttrs    map[string]cty.Value
+	Resources       map[string]cty.Value
+	LocalValues     map[string]cty.Value
+	Modules         map[string]cty.Value
+	PathAttrs       map[string]cty.Value
+	TerraformAttrs  map[string]cty.Value
+	FederatedStates map[string]cty.Value
+	InputVariables  map[string]cty.Value
 }
 
 var _ Data = &dataForTests{}
@@ -60,3 +61,7 @@ func (d *dataForTests) GetPathAttr(addr addrs.PathAttr, rng tfdiags.SourceRange)
 func (d *dataForTests) GetTerraformAttr(addr addrs.TerraformAttr, rng tfdiags.SourceRange) (cty.Value, tfdiags.Diagnostics) {
 	return d.TerraformAttrs[addr.Name], nil
 }
+
+func (d *dataForTests) GetFederatedState(addr addrs.StateReference, rng tfdiags.SourceRange) (cty.Value, tfdiags.Diagnostics) {
+	return d.FederatedStates[addr.Name], nil
+}
@@ -93,6 +93,7 @@ func (s *Scope) EvalExpr(expr hcl.Expression, wantType cty.Type) (cty.Value, tfd
 	}
 
 	val, evalDiags := expr.Value(ctx)
+	evalDiags = refineTemplateDiags(expr, ctx, evalDiags)
 	diags = diags.Append(evalDiags)
 
 	if wantType != cty.DynamicPseudoType {
@@ -100,12 +101,13 @@ func (s *Scope) EvalExpr(expr hcl.Expression, wantType cty.Type) (cty.Value, tfd
 		val, convErr = convert.Convert(val, wantType)
 		if convErr != nil {
 			val = cty.UnknownVal(wantType)
-			diags = diags.Append(&hcl.Diagnostic{
+			convDiags := hcl.Diagnostics{&hcl.Diagnostic{
 				Severity: hcl.DiagError,
 				Summary:  "Incorrect value type",
 				Detail:   fmt.Sprintf("Invalid expression value: %s.", tfdiags.FormatError(convErr)),
 				Subject:  expr.Range().Ptr(),
-			})
+			}}
+			diags = diags.Append(refineTemplateDiags(expr, ctx, convDiags))
 		}
 	}
 
@@ -202,6 +204,7 @@ func (s *Scope) evalContext(refs []*addrs.Reference, selfAddr addrs.Referenceabl
 	localValues := map[string]cty.Value{}
 	pathAttrs := map[string]cty.Value{}
 	terraformAttrs := map[string]cty.Value{}
+	federatedStates := map[string]cty.Value{}
 	countAttrs := map[string]cty.Value{}
 	forEachAttrs := map[string]cty.Value{}
 	var self cty.Value
@@ -327,6 +330,11 @@ func (s *Scope) evalContext(refs []*addrs.Reference, selfAddr addrs.Referenceabl
 			diags = diags.Append(valDiags)
 			terraformAttrs[subj.Name] = val
 
+		case addrs.StateReference:
+			val, valDiags := normalizeRefValue(s.Data.GetFederatedState(subj, rng))
+			diags = diags.Append(valDiags)
+			federatedStates[subj.Name] = val
+
 		case addrs.CountAttr:
 			val, valDiags := normalizeRefValue(s.Data.GetCountAttr(subj, rng))
 			diags = diags.Append(valDiags)
@@ -352,6 +360,9 @@ func (s *Scope) evalContext(refs []*addrs.Reference, selfAddr addrs.Referenceabl
 	vals["local"] = cty.ObjectVal(localValues)
 	vals["path"] = cty.ObjectVal(pathAttrs)
 	vals["terraform"] = cty.ObjectVal(terraformAttrs)
+	if len(federatedStates) > 0 {
+		vals["state"] = cty.ObjectVal(federatedStates)
+	}
 	vals["count"] = cty.ObjectVal(countAttrs)
 	vals["each"] = cty.ObjectVal(forEachAttrs)
 	if self != cty.NilVal {
@@ -0,0 +1,101 @@
+package lang
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+// refineTemplateDiags narrows error diagnostics that name a whole string
+// template down to the specific "${ ... }" sequence responsible, naming the
+// references involved in that sequence.
+//
+// HCL raises some template problems (and our own type conversion failures)
+// against the full template expression, which for long templates leaves the
+// user searching for the offending interpolation by hand. For each error
+// whose subject is the whole template we re-evaluate the interpolated parts
+// individually, and if exactly one of them either fails or produces a value
+// that is not yet known then we re-point the diagnostic at that part.
+//
+// Diagnostics that already have a narrower subject are left untouched.
+func refineTemplateDiags(expr hcl.Expression, ctx *hcl.EvalContext, diags hcl.Diagnostics) hcl.Diagnostics {
+	temp, ok := expr.(*hclsyntax.TemplateExpr)
+	if !ok || len(temp.Parts) < 2 {
+		return diags
+	}
+
+	wholeRange := expr.Range()
+
+	for _, diag := range diags {
+		if diag.Severity != hcl.DiagError || diag.Subject == nil {
+			continue
+		}
+		if *diag.Subject != wholeRange {
+			continue
+		}
+
+		part := blamedTemplatePart(temp, ctx)
+		if part == nil {
+			continue
+		}
+
+		partRange := part.Range()
+		diag.Subject = partRange.Ptr()
+		diag.Context = wholeRange.Ptr()
+
+		if refDesc := describeExprReferences(part); refDesc != "" {
+			diag.Detail = fmt.Sprintf("%s\n\nThis arises from the template sequence using %s.", diag.Detail, refDesc)
+		}
+	}
+
+	return diags
+}
+
+// blamedTemplatePart finds the single interpolated part of the given template
+// that either fails to evaluate or produces a not-yet-known value, returning
+// nil if there is no such part or if there is more than one, since in the
+// latter case we can't tell which one the original diagnostic was about.
+func blamedTemplatePart(temp *hclsyntax.TemplateExpr, ctx *hcl.EvalContext) hclsyntax.Expression {
+	var blamed hclsyntax.Expression
+	for _, part := range temp.Parts {
+		if _, isLiteral := part.(*hclsyntax.LiteralValueExpr); isLiteral {
+			continue
+		}
+
+		val, partDiags := part.Value(ctx)
+		if !partDiags.HasErrors() && val.IsKnown() {
+			continue
+		}
+
+		if blamed != nil {
+			// More than one candidate, so we can't attribute the problem
+			// to any single part.
+			return nil
+		}
+		blamed = part
+	}
+	return blamed
+}
+
+// describeExprReferences returns a human-oriented description of the
+// references made by the given expression, or an empty string if it makes
+// none that we can describe.
+func describeExprReferences(expr hcl.Expression) string {
+	refs, _ := ReferencesInExpr(expr)
+	if len(refs) == 0 {
+		return ""
+	}
+
+	names := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		names = append(names, fmt.Sprintf("%q", ref.Subject.String()))
+	}
+	switch len(names) {
+	case 1:
+		return names[0]
+	default:
+		return strings.Join(names[:len(names)-1], ", ") + " and " + names[len(names)-1]
+	}
+}
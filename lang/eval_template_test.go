@@ -0,0 +1,93 @@
+package lang
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestRefineTemplateDiags(t *testing.T) {
+	parseTemplate := func(t *testing.T, src string) hcl.Expression {
+		t.Helper()
+		expr, parseDiags := hclsyntax.ParseExpression([]byte(src), "test.tf", hcl.Pos{Line: 1, Column: 1})
+		if parseDiags.HasErrors() {
+			t.Fatal(parseDiags.Error())
+		}
+		return expr
+	}
+
+	wholeTemplateDiag := func(expr hcl.Expression) hcl.Diagnostics {
+		return hcl.Diagnostics{
+			{
+				Severity: hcl.DiagError,
+				Summary:  "Incorrect value type",
+				Detail:   "Invalid expression value: a number is required.",
+				Subject:  expr.Range().Ptr(),
+			},
+		}
+	}
+
+	t.Run("single unknown part", func(t *testing.T) {
+		expr := parseTemplate(t, `"a ${var.foo} b ${var.bar} c"`)
+		ctx := &hcl.EvalContext{
+			Variables: map[string]cty.Value{
+				"var": cty.ObjectVal(map[string]cty.Value{
+					"foo": cty.UnknownVal(cty.String),
+					"bar": cty.StringVal("ok"),
+				}),
+			},
+		}
+
+		got := refineTemplateDiags(expr, ctx, wholeTemplateDiag(expr))
+		if *got[0].Subject == expr.Range() {
+			t.Error("subject was not narrowed to the failing template part")
+		}
+		if got[0].Context == nil || *got[0].Context != expr.Range() {
+			t.Error("context does not cover the whole template")
+		}
+		if !strings.Contains(got[0].Detail, `"var.foo"`) {
+			t.Errorf("detail does not name the reference involved: %q", got[0].Detail)
+		}
+		if strings.Contains(got[0].Detail, `"var.bar"`) {
+			t.Errorf("detail names an uninvolved reference: %q", got[0].Detail)
+		}
+	})
+
+	t.Run("multiple unknown parts", func(t *testing.T) {
+		expr := parseTemplate(t, `"a ${var.foo} b ${var.bar} c"`)
+		ctx := &hcl.EvalContext{
+			Variables: map[string]cty.Value{
+				"var": cty.ObjectVal(map[string]cty.Value{
+					"foo": cty.UnknownVal(cty.String),
+					"bar": cty.UnknownVal(cty.String),
+				}),
+			},
+		}
+
+		// With more than one candidate part we can't attribute the problem,
+		// so the diagnostic must be left alone.
+		got := refineTemplateDiags(expr, ctx, wholeTemplateDiag(expr))
+		if *got[0].Subject != expr.Range() {
+			t.Error("subject was changed despite ambiguous blame")
+		}
+	})
+
+	t.Run("non-template expression", func(t *testing.T) {
+		expr := parseTemplate(t, `var.foo`)
+		ctx := &hcl.EvalContext{
+			Variables: map[string]cty.Value{
+				"var": cty.ObjectVal(map[string]cty.Value{
+					"foo": cty.UnknownVal(cty.String),
+				}),
+			},
+		}
+
+		got := refineTemplateDiags(expr, ctx, wholeTemplateDiag(expr))
+		if *got[0].Subject != expr.Range() {
+			t.Error("subject was changed for a non-template expression")
+		}
+	})
+}
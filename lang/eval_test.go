@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform/addrs"
 	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/hashicorp/terraform/lang/marks"
 
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
@@ -321,6 +322,127 @@ func TestScopeEvalContext(t *testing.T) {
 	}
 }
 
+func TestScopeEvalExprMarked(t *testing.T) {
+	// Interpolating a marked value into a template must not panic (our
+	// vendored HCL predates cty marks) and the marks must carry over onto
+	// the result.
+	data := &dataForTests{
+		InputVariables: map[string]cty.Value{
+			"secret": cty.StringVal("hunter2").Mark(marks.Sensitive),
+			"plain":  cty.StringVal("ok"),
+			"obj": cty.ObjectVal(map[string]cty.Value{
+				"public":  cty.StringVal("ok"),
+				"private": cty.StringVal("hunter2").Mark(marks.Sensitive),
+			}),
+		},
+	}
+	scope := &Scope{
+		Data: data,
+	}
+
+	tests := []struct {
+		Expr          string
+		Want          cty.Value
+		WantSensitive bool
+	}{
+		{
+			`"password: ${var.secret}"`,
+			cty.StringVal("password: hunter2"),
+			true,
+		},
+		{
+			`"greeting: ${var.plain}"`,
+			cty.StringVal("greeting: ok"),
+			false,
+		},
+		{
+			// An attribute that is not itself marked must stay unmarked
+			// even though a sibling attribute of the same object is.
+			`var.obj.public`,
+			cty.StringVal("ok"),
+			false,
+		},
+		{
+			`"secret: ${var.obj.private}"`,
+			cty.StringVal("secret: hunter2"),
+			true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Expr, func(t *testing.T) {
+			expr, parseDiags := hclsyntax.ParseExpression([]byte(test.Expr), "", hcl.Pos{Line: 1, Column: 1})
+			if parseDiags.HasErrors() {
+				t.Fatal(parseDiags.Error())
+			}
+
+			got, diags := scope.EvalExpr(expr, cty.String)
+			if diags.HasErrors() {
+				t.Fatal(diags.Err())
+			}
+
+			if sensitive := marks.Has(got, marks.Sensitive); sensitive != test.WantSensitive {
+				t.Errorf("wrong sensitivity for result\ngot:  %#v\nwant sensitive: %t", got, test.WantSensitive)
+			}
+			unmarked, _ := got.Unmark()
+			if !unmarked.RawEquals(test.Want) {
+				t.Errorf("wrong result\ngot:  %#v\nwant: %#v", unmarked, test.Want)
+			}
+		})
+	}
+}
+
+func TestScopeEvalBlockMarked(t *testing.T) {
+	// Evaluating a block whose attributes interpolate marked values must not
+	// panic, and the marks must appear on just the attributes derived from
+	// the marked values rather than on the whole result.
+	schema := &configschema.Block{
+		Attributes: map[string]*configschema.Attribute{
+			"name":  {Type: cty.String, Optional: true},
+			"other": {Type: cty.String, Optional: true},
+		},
+	}
+	data := &dataForTests{
+		InputVariables: map[string]cty.Value{
+			"secret": cty.StringVal("hunter2").Mark(marks.Sensitive),
+			"plain":  cty.StringVal("ok"),
+		},
+	}
+	scope := &Scope{
+		Data: data,
+	}
+
+	config := `
+	name  = "prefix-${var.secret}"
+	other = "prefix-${var.plain}"
+	`
+	file, parseDiags := hclsyntax.ParseConfig([]byte(config), "", hcl.Pos{Line: 1, Column: 1})
+	if parseDiags.HasErrors() {
+		t.Fatal(parseDiags.Error())
+	}
+
+	got, diags := scope.EvalBlock(file.Body, schema)
+	if diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+
+	name := got.GetAttr("name")
+	if !marks.Has(name, marks.Sensitive) {
+		t.Errorf("name is not marked as sensitive: %#v", name)
+	}
+	if unmarked, _ := name.Unmark(); !unmarked.RawEquals(cty.StringVal("prefix-hunter2")) {
+		t.Errorf("wrong value for name: %#v", unmarked)
+	}
+
+	other := got.GetAttr("other")
+	if marks.Has(other, marks.Sensitive) {
+		t.Errorf("other is marked as sensitive, but was not derived from a sensitive value: %#v", other)
+	}
+	if !other.RawEquals(cty.StringVal("prefix-ok")) {
+		t.Errorf("wrong value for other: %#v", other)
+	}
+}
+
 func TestScopeExpandEvalBlock(t *testing.T) {
 	nestedObjTy := cty.Object(map[string]cty.Type{
 		"boop": cty.String,
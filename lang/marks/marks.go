@@ -0,0 +1,28 @@
+// Package marks defines the "marks" that Terraform uses to annotate cty
+// values as they pass through the language runtime, along with some helpers
+// for working with them.
+package marks
+
+import (
+	"github.com/zclconf/go-cty/cty"
+)
+
+// valueMarks allow creating strictly typed values for use as cty.Value marks,
+// so that the marks defined here can't collide with marks applied by any
+// other subsystem.
+type valueMark string
+
+func (m valueMark) GoString() string {
+	return "marks." + string(m)
+}
+
+// Has returns true if and only if the cty.Value has the given mark.
+func Has(val cty.Value, mark valueMark) bool {
+	return val.HasMark(mark)
+}
+
+// Sensitive indicates that this value is marked as sensitive in the context
+// of Terraform, either because it derives from a variable or output value
+// declared with "sensitive = true" or because a provider declared the
+// corresponding attribute as sensitive.
+const Sensitive = valueMark("sensitive")
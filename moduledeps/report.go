@@ -0,0 +1,84 @@
+package moduledeps
+
+import (
+	"sort"
+)
+
+// ModuleReport is a serialization-friendly description of the provider
+// dependency tree for a module and its descendents, suitable both for
+// direct JSON encoding and for driving human-oriented tree renderings.
+type ModuleReport struct {
+	Name      string           `json:"name,omitempty"`
+	Providers []ProviderReport `json:"providers"`
+	Children  []*ModuleReport  `json:"children,omitempty"`
+
+	// Requirements describes the effective version constraint for each
+	// provider across the whole tree, as produced by EffectiveRequirements.
+	// It is populated only on the root of a report.
+	Requirements []ProviderRequirement `json:"requirements,omitempty"`
+}
+
+// ProviderReport describes one provider dependency of a single module.
+type ProviderReport struct {
+	// Provider is the fully-qualified provider source address.
+	Provider string `json:"provider"`
+
+	// Constraints is the version constraint string declared for this
+	// provider in this module, or empty if no constraint was declared.
+	Constraints string `json:"constraints,omitempty"`
+
+	// Reason explains why this module depends on this provider: "explicit",
+	// "implicit", "inherited" or "state".
+	Reason string `json:"reason"`
+}
+
+// Report produces a ModuleReport describing the receiver and all of its
+// descendents. Providers and children are sorted by name so that the result
+// is deterministic for a given dependency tree.
+func (m *Module) Report() *ModuleReport {
+	ret := m.report()
+	ret.Requirements = m.EffectiveRequirements()
+	return ret
+}
+
+func (m *Module) report() *ModuleReport {
+	ret := &ModuleReport{
+		Name:      m.Name,
+		Providers: make([]ProviderReport, 0, len(m.Providers)),
+	}
+
+	for fqn, dep := range m.Providers {
+		ret.Providers = append(ret.Providers, ProviderReport{
+			Provider:    fqn.String(),
+			Constraints: dep.Constraints.String(),
+			Reason:      dep.Reason.reportString(),
+		})
+	}
+	sort.Slice(ret.Providers, func(i, j int) bool {
+		return ret.Providers[i].Provider < ret.Providers[j].Provider
+	})
+
+	for _, child := range m.Children {
+		ret.Children = append(ret.Children, child.report())
+	}
+	sort.Slice(ret.Children, func(i, j int) bool {
+		return ret.Children[i].Name < ret.Children[j].Name
+	})
+
+	return ret
+}
+
+func (r ProviderDependencyReason) reportString() string {
+	switch r {
+	case ProviderDependencyExplicit:
+		return "explicit"
+	case ProviderDependencyImplicit:
+		return "implicit"
+	case ProviderDependencyInherited:
+		return "inherited"
+	case ProviderDependencyFromState:
+		return "state"
+	default:
+		return "unknown"
+	}
+}
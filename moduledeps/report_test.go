@@ -0,0 +1,80 @@
+package moduledeps
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/plugin/discovery"
+)
+
+func TestModuleReport(t *testing.T) {
+	root := &Module{
+		Name: "root",
+		Providers: Providers{
+			addrs.NewLegacyProvider("foo"): ProviderDependency{
+				Constraints: discovery.ConstraintStr(">=1.0.0").MustParse(),
+				Reason:      ProviderDependencyExplicit,
+			},
+		},
+		Children: []*Module{
+			{
+				Name: "child",
+				Providers: Providers{
+					addrs.NewLegacyProvider("baz"): ProviderDependency{
+						Reason: ProviderDependencyFromState,
+					},
+					addrs.NewLegacyProvider("bar"): ProviderDependency{
+						Reason: ProviderDependencyInherited,
+					},
+				},
+			},
+		},
+	}
+
+	got := root.Report()
+	want := &ModuleReport{
+		Name: "root",
+		Providers: []ProviderReport{
+			{
+				Provider:    addrs.NewLegacyProvider("foo").String(),
+				Constraints: ">=1.0.0",
+				Reason:      "explicit",
+			},
+		},
+		Children: []*ModuleReport{
+			{
+				Name: "child",
+				Providers: []ProviderReport{
+					{
+						Provider: addrs.NewLegacyProvider("bar").String(),
+						Reason:   "inherited",
+					},
+					{
+						Provider: addrs.NewLegacyProvider("baz").String(),
+						Reason:   "state",
+					},
+				},
+			},
+		},
+		Requirements: []ProviderRequirement{
+			{
+				Provider: addrs.NewLegacyProvider("bar").String(),
+			},
+			{
+				Provider: addrs.NewLegacyProvider("baz").String(),
+			},
+			{
+				Provider:    addrs.NewLegacyProvider("foo").String(),
+				Constraints: ">=1.0.0",
+				Contributions: []RequirementContribution{
+					{Module: "root", Constraints: ">=1.0.0"},
+				},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("wrong report\ngot:  %#v\nwant: %#v", got, want)
+	}
+}
@@ -0,0 +1,83 @@
+package moduledeps
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/plugin/discovery"
+)
+
+// ProviderRequirement describes the effective version constraint for a
+// single provider across an entire configuration tree, along with the
+// modules whose declarations contributed to it.
+//
+// The effective constraint is the intersection of every contribution: the
+// root module's constraints cascade over all descendent modules, so a
+// provider version is acceptable only if it satisfies the root's
+// requirement as well as the requirement of every other module that
+// declares one.
+type ProviderRequirement struct {
+	// Provider is the fully-qualified provider source address.
+	Provider string `json:"provider"`
+
+	// Constraints is the effective version constraint string, or empty if
+	// no module declared any constraint for this provider.
+	Constraints string `json:"constraints,omitempty"`
+
+	// Contributions records each module that declared a constraint for
+	// this provider, in tree walk order.
+	Contributions []RequirementContribution `json:"contributions,omitempty"`
+}
+
+// RequirementContribution records a single module's contribution to a
+// provider's effective version constraint.
+type RequirementContribution struct {
+	// Module is the dotted path of the contributing module, starting
+	// with "root".
+	Module string `json:"module"`
+
+	// Constraints is the constraint string this module declared.
+	Constraints string `json:"constraints"`
+}
+
+// EffectiveRequirements produces the effective requirement for every
+// provider used anywhere in the tree of modules rooted at the receiver,
+// sorted by provider address.
+//
+// The result reflects the same intersection semantics that
+// AllPluginRequirements applies during plugin resolution, but additionally
+// records which modules contributed each constraint so that the outcome
+// can be explained to the user.
+func (m *Module) EffectiveRequirements() []ProviderRequirement {
+	effective := make(map[addrs.Provider]discovery.Constraints)
+	contributions := make(map[addrs.Provider][]RequirementContribution)
+
+	m.WalkTree(func(path []string, parent *Module, current *Module) error {
+		moduleName := strings.Join(path, ".")
+		for fqn, dep := range current.Providers {
+			effective[fqn] = effective[fqn].Append(dep.Constraints)
+			if str := dep.Constraints.String(); str != "" {
+				contributions[fqn] = append(contributions[fqn], RequirementContribution{
+					Module:      moduleName,
+					Constraints: str,
+				})
+			}
+		}
+		return nil
+	})
+
+	ret := make([]ProviderRequirement, 0, len(effective))
+	for fqn, cons := range effective {
+		ret = append(ret, ProviderRequirement{
+			Provider:      fqn.String(),
+			Constraints:   cons.String(),
+			Contributions: contributions[fqn],
+		})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Provider < ret[j].Provider
+	})
+
+	return ret
+}
@@ -0,0 +1,57 @@
+package moduledeps
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/plugin/discovery"
+)
+
+func TestModuleEffectiveRequirements(t *testing.T) {
+	root := &Module{
+		Name: "root",
+		Providers: Providers{
+			addrs.NewLegacyProvider("foo"): ProviderDependency{
+				Constraints: discovery.ConstraintStr("~> 1.0").MustParse(),
+				Reason:      ProviderDependencyExplicit,
+			},
+		},
+		Children: []*Module{
+			{
+				Name: "child",
+				Providers: Providers{
+					addrs.NewLegacyProvider("foo"): ProviderDependency{
+						Constraints: discovery.ConstraintStr(">= 1.2.0").MustParse(),
+						Reason:      ProviderDependencyExplicit,
+					},
+					addrs.NewLegacyProvider("bar"): ProviderDependency{
+						Reason: ProviderDependencyImplicit,
+					},
+				},
+			},
+		},
+	}
+
+	got := root.EffectiveRequirements()
+	want := []ProviderRequirement{
+		{
+			// Used only implicitly, so any version is acceptable.
+			Provider: addrs.NewLegacyProvider("bar").String(),
+		},
+		{
+			// The root module's constraint cascades over the child's, so
+			// the effective constraint is the intersection of both.
+			Provider:    addrs.NewLegacyProvider("foo").String(),
+			Constraints: ">= 1.2.0,~> 1.0",
+			Contributions: []RequirementContribution{
+				{Module: "root", Constraints: "~> 1.0"},
+				{Module: "root.child", Constraints: ">= 1.2.0"},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("wrong effective requirements\ngot:  %#v\nwant: %#v", got, want)
+	}
+}
@@ -10,6 +10,11 @@ const (
 	DeleteThenCreate Action = '∓'
 	CreateThenDelete Action = '±'
 	Delete           Action = '-'
+
+	// Forget is the action of removing an object from the state without
+	// destroying the remote object it tracks, as requested by a "removed"
+	// block with destroy disabled.
+	Forget Action = '.'
 )
 
 //go:generate go run golang.org/x/tools/cmd/stringer -type Action
@@ -24,6 +24,23 @@ type Changes struct {
 	// can be easily re-calculated during the apply phase. Therefore only root
 	// module outputs will survive a round-trip through a plan file.
 	Outputs []*OutputChangeSrc
+
+	// DeferredProvisioners records provisioners whose configurations
+	// referred to values that were not yet known when the plan was created.
+	// Their execution is deferred to the apply phase, where the
+	// configurations are evaluated again once the values are known.
+	DeferredProvisioners []*DeferredProvisioner
+}
+
+// DeferredProvisioner describes one provisioner on one resource instance
+// whose configuration could not be fully evaluated during planning.
+type DeferredProvisioner struct {
+	// InstanceAddr is the address of the resource instance the provisioner
+	// belongs to.
+	InstanceAddr addrs.AbsResourceInstance
+
+	// Type is the provisioner type, e.g. "remote-exec".
+	Type string
 }
 
 // NewChanges returns a valid Changes object that describes no changes.
@@ -127,6 +144,28 @@ type ResourceInstanceChange struct {
 	// Terraform that relates to this change. Terraform will save this
 	// byte-for-byte and return it to the provider in the apply call.
 	Private []byte
+
+	// InputsHash, if non-empty, is a digest of the inputs that determined
+	// this change: the resolved configuration and the prior state object.
+	// A subsequent plan can compare its own digest of the same inputs
+	// against this one to decide whether the change can be reused without
+	// consulting the provider again. Empty if no digest was computed, in
+	// which case the change must never be reused.
+	InputsHash []byte
+
+	// ActionReason is an optional extra indication of why the change's
+	// particular action was chosen, for UI purposes; it never changes how
+	// the action is applied. Currently the only recorded reason is that a
+	// replace was planned because the prior object was tainted.
+	ActionReason ResourceInstanceChangeActionReason
+
+	// Importing is true if this change was planned from an "import" block
+	// in the configuration, in which case the object described by After
+	// already exists and apply adopts it into state rather than creating
+	// a new object. ImportID is then the provider-specific id the object
+	// was imported by.
+	Importing bool
+	ImportID  string
 }
 
 // Encode produces a variant of the reciever that has its change values
@@ -144,9 +183,27 @@ func (rc *ResourceInstanceChange) Encode(ty cty.Type) (*ResourceInstanceChangeSr
 		ChangeSrc:       *cs,
 		RequiredReplace: rc.RequiredReplace,
 		Private:         rc.Private,
+		InputsHash:      rc.InputsHash,
+		ActionReason:    rc.ActionReason,
+		Importing:       rc.Importing,
+		ImportID:        rc.ImportID,
 	}, err
 }
 
+// ResourceInstanceChangeActionReason is an enumeration of the reasons that
+// can be recorded in a ResourceInstanceChange's ActionReason field.
+type ResourceInstanceChangeActionReason rune
+
+const (
+	// ResourceInstanceChangeNoReason indicates that there is no special
+	// reason recorded for the change's action, which is the common case.
+	ResourceInstanceChangeNoReason ResourceInstanceChangeActionReason = 0
+
+	// ResourceInstanceReplaceBecauseTainted indicates that a replace action
+	// was chosen because the prior object was marked as tainted.
+	ResourceInstanceReplaceBecauseTainted ResourceInstanceChangeActionReason = 'T'
+)
+
 // Simplify will, where possible, produce a change with a simpler action than
 // the receiever given a flag indicating whether the caller is dealing with
 // a normal apply or a destroy. This flag deals with the fact that Terraform
@@ -159,6 +216,7 @@ func (rc *ResourceInstanceChange) Encode(ty cty.Type) (*ResourceInstanceChangeSr
 //     --------+-------------+-----------
 //     Create    true          NoOp
 //     Delete    false         NoOp
+//     Forget    false         NoOp
 //     Replace   true          Delete
 //     Replace   false         Create
 //
@@ -167,7 +225,7 @@ func (rc *ResourceInstanceChange) Encode(ty cty.Type) (*ResourceInstanceChangeSr
 func (rc *ResourceInstanceChange) Simplify(destroying bool) *ResourceInstanceChange {
 	if destroying {
 		switch rc.Action {
-		case Delete:
+		case Delete, Forget:
 			// We'll fall out and just return rc verbatim, then.
 		case CreateThenDelete, DeleteThenCreate:
 			return &ResourceInstanceChange{
@@ -196,7 +254,7 @@ func (rc *ResourceInstanceChange) Simplify(destroying bool) *ResourceInstanceCha
 		}
 	} else {
 		switch rc.Action {
-		case Delete:
+		case Delete, Forget:
 			return &ResourceInstanceChange{
 				Addr:         rc.Addr,
 				DeposedKey:   rc.DeposedKey,
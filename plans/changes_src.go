@@ -46,6 +46,24 @@ type ResourceInstanceChangeSrc struct {
 	// Terraform that relates to this change. Terraform will save this
 	// byte-for-byte and return it to the provider in the apply call.
 	Private []byte
+
+	// InputsHash, if non-empty, is a digest of the inputs that determined
+	// this change, which a subsequent plan can use to decide whether the
+	// change can be reused without consulting the provider again. See
+	// ResourceInstanceChange.InputsHash for details.
+	InputsHash []byte
+
+	// ActionReason is an optional extra indication of why the change's
+	// particular action was chosen, for UI purposes. See
+	// ResourceInstanceChange.ActionReason for details.
+	ActionReason ResourceInstanceChangeActionReason
+
+	// Importing is true if this change was planned from an "import" block
+	// in the configuration, with ImportID giving the provider-specific id
+	// of the object being imported. See ResourceInstanceChange.Importing
+	// for details.
+	Importing bool
+	ImportID  string
 }
 
 // Decode unmarshals the raw representation of the instance object being
@@ -63,6 +81,10 @@ func (rcs *ResourceInstanceChangeSrc) Decode(ty cty.Type) (*ResourceInstanceChan
 		Change:          *change,
 		RequiredReplace: rcs.RequiredReplace,
 		Private:         rcs.Private,
+		InputsHash:      rcs.InputsHash,
+		ActionReason:    rcs.ActionReason,
+		Importing:       rcs.Importing,
+		ImportID:        rcs.ImportID,
 	}, nil
 }
 
@@ -88,6 +110,12 @@ func (rcs *ResourceInstanceChangeSrc) DeepCopy() *ResourceInstanceChangeSrc {
 		ret.Private = private
 	}
 
+	if len(ret.InputsHash) != 0 {
+		inputsHash := make([]byte, len(ret.InputsHash))
+		copy(inputsHash, ret.InputsHash)
+		ret.InputsHash = inputsHash
+	}
+
 	ret.ChangeSrc.Before = ret.ChangeSrc.Before.Copy()
 	ret.ChangeSrc.After = ret.ChangeSrc.After.Copy()
 
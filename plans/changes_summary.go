@@ -0,0 +1,120 @@
+package plans
+
+import (
+	"github.com/hashicorp/terraform/addrs"
+)
+
+// ActionCounts describes how many resource instance changes in a set of
+// changes fall into each of the user-facing action categories.
+//
+// The two replace actions DeleteThenCreate and CreateThenDelete are both
+// counted under Replace, since the distinction between them is an
+// implementation detail of how the replacement is sequenced rather than a
+// difference in outcome.
+type ActionCounts struct {
+	Create  int
+	Update  int
+	Replace int
+	Delete  int
+	Forget  int
+	Read    int
+}
+
+// Total returns the total number of changes counted, across all actions.
+func (c ActionCounts) Total() int {
+	return c.Create + c.Update + c.Replace + c.Delete + c.Forget + c.Read
+}
+
+func (c *ActionCounts) addAction(action Action) {
+	switch {
+	case action == Create:
+		c.Create++
+	case action == Update:
+		c.Update++
+	case action.IsReplace():
+		c.Replace++
+	case action == Delete:
+		c.Delete++
+	case action == Forget:
+		c.Forget++
+	case action == Read:
+		c.Read++
+	}
+}
+
+// ChangesSummary describes the overall shape of a set of changes in terms of
+// counts, without the detail of the individual change values, so that callers
+// such as automation wrappers can make gating decisions without parsing
+// human-oriented rendered output.
+type ChangesSummary struct {
+	// Total counts all of the resource instance changes across the whole
+	// configuration.
+	Total ActionCounts
+
+	// ByModule breaks the resource instance changes down by the module
+	// instance each affected instance belongs to, keyed by the string
+	// representation of the module instance address. The root module is
+	// keyed by the empty string, matching addrs.RootModuleInstance.String.
+	ByModule map[string]ActionCounts
+
+	// ByProvider breaks the resource instance changes down by the provider
+	// that would perform them, keyed by the string representation of the
+	// provider's fully-qualified name.
+	ByProvider map[string]ActionCounts
+
+	// OutputChanges is the number of root module output values that would
+	// change, not counting no-op changes.
+	OutputChanges int
+
+	// OnlyOutputs is true if there is at least one output value change but
+	// no resource instance changes at all, which some callers treat as a
+	// "safe" plan that doesn't need the same review as one that changes
+	// real infrastructure.
+	OnlyOutputs bool
+}
+
+// Summary counts the changes by action, module, and provider, returning a
+// summary that describes the shape of the whole changeset.
+//
+// No-op changes are not counted at all, and deletions of data resource
+// instances are also excluded because they represent bookkeeping cleanup
+// rather than a real action to be taken, matching how the UI layers filter
+// them before rendering.
+func (c *Changes) Summary() ChangesSummary {
+	ret := ChangesSummary{
+		ByModule:   make(map[string]ActionCounts),
+		ByProvider: make(map[string]ActionCounts),
+	}
+
+	for _, rc := range c.Resources {
+		if rc.Action == NoOp {
+			continue
+		}
+		if rc.Action == Delete && rc.Addr.Resource.Resource.Mode == addrs.DataResourceMode {
+			continue
+		}
+
+		ret.Total.addAction(rc.Action)
+
+		moduleKey := rc.Addr.Module.String()
+		moduleCounts := ret.ByModule[moduleKey]
+		moduleCounts.addAction(rc.Action)
+		ret.ByModule[moduleKey] = moduleCounts
+
+		providerKey := rc.ProviderAddr.Provider.String()
+		providerCounts := ret.ByProvider[providerKey]
+		providerCounts.addAction(rc.Action)
+		ret.ByProvider[providerKey] = providerCounts
+	}
+
+	for _, oc := range c.Outputs {
+		if oc.Action == NoOp || !oc.Addr.Module.IsRoot() {
+			continue
+		}
+		ret.OutputChanges++
+	}
+
+	ret.OnlyOutputs = ret.Total.Total() == 0 && ret.OutputChanges > 0
+
+	return ret
+}
@@ -0,0 +1,135 @@
+package plans
+
+import (
+	"testing"
+
+	"github.com/go-test/deep"
+
+	"github.com/hashicorp/terraform/addrs"
+)
+
+func TestChangesSummary(t *testing.T) {
+	testProviderAddr := addrs.AbsProviderConfig{
+		Module:   addrs.RootModuleInstance,
+		Provider: addrs.NewLegacyProvider("test"),
+	}
+	childModule := addrs.RootModuleInstance.Child("child", addrs.NoKey)
+	childProviderAddr := addrs.AbsProviderConfig{
+		Module:   childModule,
+		Provider: addrs.NewLegacyProvider("other"),
+	}
+
+	resourceChange := func(name string, action Action, providerAddr addrs.AbsProviderConfig) *ResourceInstanceChangeSrc {
+		return &ResourceInstanceChangeSrc{
+			Addr: addrs.Resource{
+				Mode: addrs.ManagedResourceMode,
+				Type: "test_thing",
+				Name: name,
+			}.Instance(addrs.NoKey).Absolute(providerAddr.Module),
+			ProviderAddr: providerAddr,
+			ChangeSrc: ChangeSrc{
+				Action: action,
+			},
+		}
+	}
+
+	changes := &Changes{
+		Resources: []*ResourceInstanceChangeSrc{
+			resourceChange("create", Create, testProviderAddr),
+			resourceChange("update", Update, testProviderAddr),
+			resourceChange("replace", DeleteThenCreate, testProviderAddr),
+			resourceChange("noop", NoOp, testProviderAddr),
+			resourceChange("delete", Delete, childProviderAddr),
+			resourceChange("replace_cbd", CreateThenDelete, childProviderAddr),
+			{
+				// Data resource deletions are bookkeeping cleanup only,
+				// so they must not be counted.
+				Addr: addrs.Resource{
+					Mode: addrs.DataResourceMode,
+					Type: "test_thing",
+					Name: "data",
+				}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance),
+				ProviderAddr: testProviderAddr,
+				ChangeSrc: ChangeSrc{
+					Action: Delete,
+				},
+			},
+		},
+		Outputs: []*OutputChangeSrc{
+			{
+				Addr: addrs.OutputValue{Name: "changed"}.Absolute(addrs.RootModuleInstance),
+				ChangeSrc: ChangeSrc{
+					Action: Update,
+				},
+			},
+			{
+				Addr: addrs.OutputValue{Name: "unchanged"}.Absolute(addrs.RootModuleInstance),
+				ChangeSrc: ChangeSrc{
+					Action: NoOp,
+				},
+			},
+		},
+	}
+
+	got := changes.Summary()
+	want := ChangesSummary{
+		Total: ActionCounts{
+			Create:  1,
+			Update:  1,
+			Replace: 2,
+			Delete:  1,
+		},
+		ByModule: map[string]ActionCounts{
+			"": {
+				Create:  1,
+				Update:  1,
+				Replace: 1,
+			},
+			"module.child": {
+				Replace: 1,
+				Delete:  1,
+			},
+		},
+		ByProvider: map[string]ActionCounts{
+			"registry.terraform.io/-/test": {
+				Create:  1,
+				Update:  1,
+				Replace: 1,
+			},
+			"registry.terraform.io/-/other": {
+				Replace: 1,
+				Delete:  1,
+			},
+		},
+		OutputChanges: 1,
+	}
+
+	for _, problem := range deep.Equal(got, want) {
+		t.Error(problem)
+	}
+
+	if total := got.Total.Total(); total != 5 {
+		t.Errorf("wrong total count %d; want 5", total)
+	}
+}
+
+func TestChangesSummary_onlyOutputs(t *testing.T) {
+	changes := &Changes{
+		Outputs: []*OutputChangeSrc{
+			{
+				Addr: addrs.OutputValue{Name: "changed"}.Absolute(addrs.RootModuleInstance),
+				ChangeSrc: ChangeSrc{
+					Action: Update,
+				},
+			},
+		},
+	}
+
+	got := changes.Summary()
+	if !got.OnlyOutputs {
+		t.Errorf("OnlyOutputs is false; want true")
+	}
+	if got.OutputChanges != 1 {
+		t.Errorf("wrong OutputChanges %d; want 1", got.OutputChanges)
+	}
+}
@@ -38,6 +38,19 @@ func (cs *ChangesSync) AppendResourceInstanceChange(changeSrc *ResourceInstanceC
 	cs.changes.Resources = append(cs.changes.Resources, s)
 }
 
+// AppendDeferredProvisioner records that the given provisioner's
+// configuration refers to values not yet known at plan time, so its
+// execution is deferred until apply.
+func (cs *ChangesSync) AppendDeferredProvisioner(dp *DeferredProvisioner) {
+	if cs == nil {
+		panic("AppendDeferredProvisioner on nil ChangesSync")
+	}
+	cs.lock.Lock()
+	defer cs.lock.Unlock()
+
+	cs.changes.DeferredProvisioners = append(cs.changes.DeferredProvisioners, dp)
+}
+
 // GetResourceInstanceChange searches the set of resource instance changes for
 // one matching the given address and generation, returning it if it exists.
 //
@@ -62,6 +75,33 @@ func (cs *ChangesSync) GetResourceInstanceChange(addr addrs.AbsResourceInstance,
 	panic(fmt.Sprintf("unsupported generation value %#v", gen))
 }
 
+// GetChangesForAbsResource searches the set of resource instance changes for
+// any that belong to instances of the given whole resource, returning them
+// all.
+//
+// If no changes exist for any instance of the resource, an empty slice is
+// returned.
+//
+// The returned objects are deep copies of the changes recorded in the plan,
+// so callers may mutate them although it's generally better (less confusing)
+// to treat planned changes as immutable after they've been initially
+// constructed.
+func (cs *ChangesSync) GetChangesForAbsResource(addr addrs.AbsResource) []*ResourceInstanceChangeSrc {
+	if cs == nil {
+		panic("GetChangesForAbsResource on nil ChangesSync")
+	}
+	cs.lock.Lock()
+	defer cs.lock.Unlock()
+
+	var changes []*ResourceInstanceChangeSrc
+	for _, c := range cs.changes.Resources {
+		if c.Addr.ContainingResource().Equal(addr) {
+			changes = append(changes, c.DeepCopy())
+		}
+	}
+	return changes
+}
+
 // RemoveResourceInstanceChange searches the set of resource instance changes
 // for one matching the given address and generation, and removes it from the
 // set if it exists.
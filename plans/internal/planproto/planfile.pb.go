@@ -32,6 +32,7 @@ const (
 	Action_DELETE             Action = 5
 	Action_DELETE_THEN_CREATE Action = 6
 	Action_CREATE_THEN_DELETE Action = 7
+	Action_FORGET             Action = 8
 )
 
 var Action_name = map[int32]string{
@@ -42,6 +43,7 @@ var Action_name = map[int32]string{
 	5: "DELETE",
 	6: "DELETE_THEN_CREATE",
 	7: "CREATE_THEN_DELETE",
+	8: "FORGET",
 }
 
 var Action_value = map[string]int32{
@@ -52,6 +54,7 @@ var Action_value = map[string]int32{
 	"DELETE":             5,
 	"DELETE_THEN_CREATE": 6,
 	"CREATE_THEN_DELETE": 7,
+	"FORGET":             8,
 }
 
 func (x Action) String() string {
@@ -114,6 +117,16 @@ type Plan struct {
 	// target addresses are present, the plan applies to the whole
 	// configuration.
 	TargetAddrs []string `protobuf:"bytes,5,rep,name=target_addrs,json=targetAddrs,proto3" json:"target_addrs,omitempty"`
+	// Destroy is true if this plan was created in destroy mode, in which
+	// case applying it must also use destroy mode.
+	Destroy bool `protobuf:"varint,6,opt,name=destroy,proto3" json:"destroy,omitempty"`
+	// If lifecycle.prevent_destroy protections were overridden while
+	// creating this plan then destroy_protection_override records who or
+	// what requested the override, and protected_destroys gives the
+	// addresses of the resource instances that would otherwise have
+	// blocked the plan.
+	DestroyProtectionOverride string   `protobuf:"bytes,7,opt,name=destroy_protection_override,json=destroyProtectionOverride,proto3" json:"destroy_protection_override,omitempty"`
+	ProtectedDestroys         []string `protobuf:"bytes,8,rep,name=protected_destroys,json=protectedDestroys,proto3" json:"protected_destroys,omitempty"`
 	// The version string for the Terraform binary that created this plan.
 	TerraformVersion string `protobuf:"bytes,14,opt,name=terraform_version,json=terraformVersion,proto3" json:"terraform_version,omitempty"`
 	// SHA256 digests of all of the provider plugin binaries that were used
@@ -187,6 +200,27 @@ func (m *Plan) GetTargetAddrs() []string {
 	return nil
 }
 
+func (m *Plan) GetDestroy() bool {
+	if m != nil {
+		return m.Destroy
+	}
+	return false
+}
+
+func (m *Plan) GetDestroyProtectionOverride() string {
+	if m != nil {
+		return m.DestroyProtectionOverride
+	}
+	return ""
+}
+
+func (m *Plan) GetProtectedDestroys() []string {
+	if m != nil {
+		return m.ProtectedDestroys
+	}
+	return nil
+}
+
 func (m *Plan) GetTerraformVersion() string {
 	if m != nil {
 		return m.TerraformVersion
@@ -366,7 +400,20 @@ type ResourceInstanceChange struct {
 	// An unordered set of paths that prompted the change action to be
 	// "replace" rather than "update". Empty for any action other than
 	// "replace".
-	RequiredReplace      []*Path  `protobuf:"bytes,11,rep,name=required_replace,json=requiredReplace,proto3" json:"required_replace,omitempty"`
+	RequiredReplace []*Path `protobuf:"bytes,11,rep,name=required_replace,json=requiredReplace,proto3" json:"required_replace,omitempty"`
+	// inputs_hash is an opaque digest of the inputs that determined this
+	// change, which a subsequent plan may compare against its own digest of
+	// the same inputs to decide whether the change can be reused without
+	// re-planning. Empty if no digest was computed.
+	InputsHash []byte `protobuf:"bytes,12,opt,name=inputs_hash,json=inputsHash,proto3" json:"inputs_hash,omitempty"`
+	// importing is set if this change was planned from an "import" block in
+	// the configuration, in which case import_id is the provider-specific
+	// id of the existing object being imported.
+	Importing bool   `protobuf:"varint,13,opt,name=importing,proto3" json:"importing,omitempty"`
+	ImportId  string `protobuf:"bytes,14,opt,name=import_id,json=importId,proto3" json:"import_id,omitempty"`
+	// action_reason is an optional extra indication of why the change's
+	// action was chosen, for UI purposes. Zero means no reason is recorded.
+	ActionReason         int32    `protobuf:"varint,15,opt,name=action_reason,json=actionReason,proto3" json:"action_reason,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -497,6 +544,34 @@ func (m *ResourceInstanceChange) GetRequiredReplace() []*Path {
 	return nil
 }
 
+func (m *ResourceInstanceChange) GetInputsHash() []byte {
+	if m != nil {
+		return m.InputsHash
+	}
+	return nil
+}
+
+func (m *ResourceInstanceChange) GetImporting() bool {
+	if m != nil {
+		return m.Importing
+	}
+	return false
+}
+
+func (m *ResourceInstanceChange) GetImportId() string {
+	if m != nil {
+		return m.ImportId
+	}
+	return ""
+}
+
+func (m *ResourceInstanceChange) GetActionReason() int32 {
+	if m != nil {
+		return m.ActionReason
+	}
+	return 0
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ResourceInstanceChange) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -805,61 +880,70 @@ func init() {
 func init() { proto.RegisterFile("planfile.proto", fileDescriptor_02431083a6706c5b) }
 
 var fileDescriptor_02431083a6706c5b = []byte{
-	// 893 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x55, 0xe1, 0x6e, 0xe3, 0x44,
-	0x10, 0xae, 0x63, 0xc7, 0x49, 0x26, 0xa9, 0x9b, 0x5b, 0x50, 0x65, 0x95, 0xd3, 0x11, 0x2c, 0xc1,
-	0x85, 0x3b, 0x94, 0x4a, 0x41, 0x50, 0x0e, 0x7e, 0xa0, 0xf6, 0x1a, 0x29, 0xd5, 0x41, 0x1b, 0x2d,
-	0xa5, 0x3f, 0xf8, 0x81, 0xb5, 0xb1, 0xa7, 0x89, 0x55, 0xc7, 0x36, 0xbb, 0x9b, 0xa0, 0x3c, 0x10,
-	0x0f, 0xc1, 0x4b, 0xf0, 0x4c, 0x68, 0x77, 0x6d, 0x27, 0x95, 0x7a, 0xfd, 0x95, 0x9d, 0x6f, 0x66,
-	0x3e, 0xcf, 0x7e, 0x33, 0xb3, 0x01, 0xaf, 0x48, 0x59, 0x76, 0x9f, 0xa4, 0x38, 0x2a, 0x78, 0x2e,
-	0x73, 0xe2, 0xca, 0x7b, 0x85, 0x04, 0xff, 0x39, 0xe0, 0xcc, 0x52, 0x96, 0x11, 0x1f, 0x5a, 0x1b,
-	0xe4, 0x22, 0xc9, 0x33, 0xdf, 0x1a, 0x58, 0x43, 0x87, 0x56, 0x26, 0x79, 0x07, 0x9d, 0x0d, 0xe3,
-	0x09, 0x9b, 0xa7, 0x28, 0xfc, 0xc6, 0xc0, 0x1e, 0x76, 0xc7, 0x9f, 0x8d, 0x4c, 0xfa, 0x48, 0xa5,
-	0x8e, 0xee, 0x2a, 0xef, 0x24, 0x93, 0x7c, 0x4b, 0x77, 0xd1, 0xe4, 0x0a, 0xfa, 0x1c, 0x45, 0xbe,
-	0xe6, 0x11, 0x86, 0xd1, 0x92, 0x65, 0x0b, 0x14, 0xbe, 0xad, 0x19, 0x5e, 0x55, 0x0c, 0xb4, 0xf4,
-	0x5f, 0x65, 0x42, 0xb2, 0x2c, 0xc2, 0xf7, 0x3a, 0x8c, 0x1e, 0x55, 0x79, 0xc6, 0x16, 0xe4, 0x27,
-	0xf0, 0xf2, 0xb5, 0x2c, 0xd6, 0xb2, 0x26, 0x72, 0x34, 0xd1, 0xa7, 0x15, 0xd1, 0x8d, 0xf6, 0x96,
-	0xe9, 0x87, 0xf9, 0x9e, 0x25, 0xc8, 0x17, 0xd0, 0x93, 0x8c, 0x2f, 0x50, 0x86, 0x2c, 0x8e, 0xb9,
-	0xf0, 0x9b, 0x03, 0x7b, 0xd8, 0xa1, 0x5d, 0x83, 0x9d, 0x2b, 0x88, 0xbc, 0x85, 0x17, 0x12, 0x39,
-	0x67, 0xf7, 0x39, 0x5f, 0x85, 0x95, 0x12, 0xde, 0xc0, 0x1a, 0x76, 0x68, 0xbf, 0x76, 0xdc, 0x95,
-	0x92, 0x5c, 0xc1, 0x51, 0xc1, 0xf3, 0x4d, 0x12, 0x23, 0x0f, 0x97, 0x4c, 0x2c, 0x51, 0xf8, 0x47,
-	0xba, 0x9a, 0xc1, 0x23, 0x61, 0x66, 0x65, 0xcc, 0x54, 0x87, 0x18, 0x75, 0xbc, 0xe2, 0x11, 0x48,
-	0xbe, 0x86, 0xd6, 0x9c, 0x45, 0x0f, 0x98, 0xc5, 0xfe, 0xe1, 0xc0, 0x1a, 0x76, 0xc7, 0x47, 0x15,
-	0xc5, 0x85, 0x81, 0x69, 0xe5, 0x3f, 0xa1, 0xe0, 0x3d, 0x96, 0x9a, 0xf4, 0xc1, 0x7e, 0xc0, 0xad,
-	0x6e, 0x58, 0x87, 0xaa, 0x23, 0x79, 0x03, 0xcd, 0x0d, 0x4b, 0xd7, 0xe8, 0x37, 0x34, 0x59, 0xad,
-	0xce, 0xe5, 0x36, 0x63, 0xab, 0x24, 0xba, 0x53, 0x3e, 0x6a, 0x42, 0x7e, 0x6c, 0xfc, 0x60, 0x9d,
-	0xdc, 0xc0, 0x27, 0x4f, 0x54, 0xf9, 0x04, 0x71, 0xf0, 0x98, 0xb8, 0x57, 0x11, 0xab, 0xac, 0x3d,
-	0xc2, 0x20, 0x81, 0x56, 0x59, 0x38, 0x21, 0xe0, 0xc8, 0x6d, 0x81, 0x25, 0x8b, 0x3e, 0x93, 0x6f,
-	0xc0, 0x8d, 0xf2, 0xec, 0x3e, 0x59, 0x3c, 0x5b, 0x60, 0x19, 0x43, 0x5e, 0x42, 0xe7, 0xef, 0x9c,
-	0x3f, 0x88, 0x82, 0x45, 0xe8, 0xdb, 0x9a, 0x66, 0x07, 0x04, 0x7f, 0x82, 0x6b, 0x1a, 0x4c, 0xbe,
-	0x02, 0x97, 0x45, 0xb2, 0x9a, 0x5d, 0x6f, 0xec, 0x55, 0xac, 0xe7, 0x1a, 0xa5, 0xa5, 0x57, 0x7d,
-	0x5d, 0x57, 0x5a, 0xcd, 0xf1, 0x47, 0xbe, 0x6e, 0x62, 0x82, 0x7f, 0x6d, 0x38, 0x7e, 0x7a, 0x3c,
-	0xc9, 0xe7, 0xd0, 0x5d, 0xe5, 0xf1, 0x3a, 0xc5, 0xb0, 0x60, 0x72, 0x59, 0xde, 0x10, 0x0c, 0x34,
-	0x63, 0x72, 0x49, 0x7e, 0x06, 0x67, 0x95, 0xc7, 0x46, 0x2d, 0x6f, 0xfc, 0xf6, 0xf9, 0x69, 0xaf,
-	0xe1, 0x5f, 0xf3, 0x18, 0xa9, 0x4e, 0xac, 0xc5, 0xb3, 0xf7, 0xc4, 0x23, 0xe0, 0x64, 0x6c, 0x85,
-	0xbe, 0x63, 0x30, 0x75, 0x26, 0x04, 0x6c, 0x21, 0xb9, 0xdf, 0x54, 0xd0, 0xf4, 0x80, 0x2a, 0x43,
-	0x61, 0x49, 0x26, 0x7d, 0x77, 0x60, 0x0d, 0x6d, 0x85, 0x25, 0x99, 0x54, 0x15, 0xc7, 0x58, 0xe4,
-	0x02, 0xe3, 0x50, 0x75, 0xb6, 0x65, 0x2a, 0x2e, 0xa1, 0x0f, 0xb8, 0x25, 0x27, 0xd0, 0xae, 0x46,
-	0xd3, 0x6f, 0x6b, 0x6f, 0x6d, 0x2b, 0x7d, 0xcd, 0xd6, 0xf9, 0x1d, 0xdd, 0xb5, 0x5a, 0xdf, 0x72,
-	0xdd, 0x4a, 0xaf, 0x7a, 0x44, 0x0a, 0x9e, 0x6c, 0x98, 0x44, 0x1f, 0x06, 0xd6, 0xb0, 0x47, 0x2b,
-	0x93, 0x9c, 0xa9, 0x97, 0xe0, 0xaf, 0x75, 0xc2, 0x31, 0x0e, 0x39, 0x16, 0xa9, 0x6a, 0x68, 0x57,
-	0xf7, 0xa0, 0x9e, 0x24, 0xa5, 0x9b, 0xda, 0x7b, 0x13, 0x45, 0x4d, 0x50, 0xf0, 0x25, 0xf4, 0xf6,
-	0xd5, 0x21, 0x5d, 0x68, 0xad, 0x58, 0xc6, 0x16, 0x18, 0xf7, 0x0f, 0x48, 0x1b, 0x9c, 0x98, 0x49,
-	0xd6, 0xb7, 0x2e, 0x3c, 0xe8, 0x25, 0xa5, 0xa6, 0xea, 0x7e, 0xc1, 0x12, 0x7a, 0xfb, 0x0f, 0x42,
-	0x2d, 0x9d, 0xb5, 0x27, 0xdd, 0xee, 0x56, 0x8d, 0x67, 0x6f, 0xf5, 0x12, 0x3a, 0x02, 0x33, 0x91,
-	0xc8, 0x64, 0x63, 0xfa, 0xd1, 0xa6, 0x3b, 0x20, 0x18, 0x42, 0x6f, 0x7f, 0x7a, 0x94, 0x06, 0x2b,
-	0xb1, 0x28, 0x58, 0xf4, 0xa0, 0x3f, 0xd6, 0xa3, 0x95, 0x19, 0xbc, 0x02, 0x47, 0x6d, 0x0b, 0x39,
-	0x06, 0x57, 0x2c, 0xd9, 0xf8, 0xbb, 0xef, 0xcb, 0x80, 0xd2, 0x0a, 0xfe, 0xb1, 0xc0, 0xd1, 0xc3,
-	0xf3, 0x1a, 0x9a, 0x42, 0x62, 0x21, 0x7c, 0x4b, 0x2b, 0xf4, 0x62, 0x5f, 0xa1, 0xd1, 0x6f, 0x12,
-	0x0b, 0x6a, 0xfc, 0x27, 0x12, 0x1c, 0x65, 0x92, 0xd7, 0xe0, 0x31, 0x29, 0x79, 0x32, 0x5f, 0x4b,
-	0x0c, 0x77, 0xf7, 0x9c, 0x1e, 0xd0, 0xc3, 0x1a, 0xbf, 0x56, 0x57, 0x3e, 0x83, 0x2e, 0xa6, 0xb8,
-	0xc2, 0x4c, 0xea, 0x29, 0x78, 0x66, 0x07, 0xa7, 0x07, 0x14, 0xca, 0xd0, 0x0f, 0xb8, 0xbd, 0x00,
-	0x68, 0x0b, 0x4c, 0x31, 0x92, 0x39, 0x7f, 0x53, 0x80, 0x6b, 0xf6, 0x4a, 0xe9, 0x7f, 0x7d, 0x73,
-	0x33, 0xeb, 0x1f, 0x10, 0x00, 0xf7, 0x3d, 0x9d, 0x9c, 0xdf, 0x4e, 0xfa, 0x96, 0x42, 0xe9, 0xe4,
-	0xfc, 0xb2, 0xdf, 0x50, 0xe8, 0xef, 0xb3, 0x4b, 0x85, 0xda, 0xea, 0x7c, 0x39, 0xf9, 0x65, 0x72,
-	0x3b, 0xe9, 0x37, 0xc9, 0x31, 0x10, 0x73, 0x0e, 0x6f, 0xa7, 0x93, 0xeb, 0xb0, 0xcc, 0x74, 0x15,
-	0x6e, 0xce, 0x06, 0x2f, 0xe3, 0x5b, 0x17, 0xef, 0xfe, 0x38, 0x5b, 0x24, 0x72, 0xb9, 0x9e, 0x8f,
-	0xa2, 0x7c, 0x75, 0xaa, 0x5e, 0xdc, 0x24, 0xca, 0x79, 0x71, 0x5a, 0x3f, 0xcc, 0xa7, 0xaa, 0x7e,
-	0x71, 0x9a, 0x64, 0x12, 0x79, 0xc6, 0x52, 0x6d, 0xea, 0x3f, 0xba, 0xb9, 0xab, 0x7f, 0xbe, 0xfd,
-	0x3f, 0x00, 0x00, 0xff, 0xff, 0x30, 0x3e, 0x4e, 0x33, 0x01, 0x07, 0x00, 0x00,
+	// 1025 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x55, 0x6d, 0x6f, 0x23, 0x35,
+	0x10, 0xee, 0x36, 0x9b, 0xb7, 0x49, 0x9a, 0xa6, 0x06, 0x55, 0x4b, 0x7b, 0x3a, 0x42, 0x10, 0x5c,
+	0xb8, 0x83, 0x54, 0x2a, 0x82, 0x72, 0x20, 0x81, 0xda, 0x6b, 0xa0, 0xd5, 0x41, 0x13, 0x99, 0xd2,
+	0x0f, 0x7c, 0x60, 0xe5, 0xee, 0xba, 0x89, 0xd5, 0xac, 0xbd, 0xd8, 0x4e, 0x50, 0x24, 0xfe, 0x0e,
+	0x7f, 0x82, 0x3f, 0xc2, 0xdf, 0x41, 0x7e, 0xd9, 0x4d, 0x2a, 0xf5, 0xfa, 0x29, 0x9e, 0x67, 0x9e,
+	0x19, 0x8f, 0xe7, 0x99, 0xd9, 0x40, 0x27, 0x9f, 0x13, 0x7e, 0xc7, 0xe6, 0x74, 0x98, 0x4b, 0xa1,
+	0x05, 0xaa, 0xe9, 0x3b, 0x83, 0xf4, 0xff, 0xab, 0x42, 0x38, 0x99, 0x13, 0x8e, 0x22, 0xa8, 0x2f,
+	0xa9, 0x54, 0x4c, 0xf0, 0x28, 0xe8, 0x05, 0x83, 0x10, 0x17, 0x26, 0x7a, 0x0d, 0xcd, 0x25, 0x91,
+	0x8c, 0xdc, 0xce, 0xa9, 0x8a, 0xb6, 0x7b, 0x95, 0x41, 0xeb, 0xf8, 0x70, 0xe8, 0xc2, 0x87, 0x26,
+	0x74, 0x78, 0x53, 0x78, 0x47, 0x5c, 0xcb, 0x15, 0x5e, 0xb3, 0xd1, 0x25, 0x74, 0x25, 0x55, 0x62,
+	0x21, 0x13, 0x1a, 0x27, 0x33, 0xc2, 0xa7, 0x54, 0x45, 0x15, 0x9b, 0xe1, 0x79, 0x91, 0x01, 0x7b,
+	0xff, 0x25, 0x57, 0x9a, 0xf0, 0x84, 0xbe, 0xb1, 0x34, 0xbc, 0x5b, 0xc4, 0x39, 0x5b, 0xa1, 0xef,
+	0xa0, 0x23, 0x16, 0x3a, 0x5f, 0xe8, 0x32, 0x51, 0x68, 0x13, 0xbd, 0x5f, 0x24, 0x1a, 0x5b, 0xaf,
+	0x0f, 0xdf, 0x11, 0x1b, 0x96, 0x42, 0x1f, 0x41, 0x5b, 0x13, 0x39, 0xa5, 0x3a, 0x26, 0x69, 0x2a,
+	0x55, 0x54, 0xed, 0x55, 0x06, 0x4d, 0xdc, 0x72, 0xd8, 0xa9, 0x81, 0xcc, 0xfb, 0x53, 0xaa, 0xb4,
+	0x14, 0xab, 0xa8, 0xd6, 0x0b, 0x06, 0x0d, 0x5c, 0x98, 0xe8, 0x7b, 0x38, 0xf4, 0xc7, 0xd8, 0xf4,
+	0x8e, 0x26, 0x9a, 0x09, 0x1e, 0x8b, 0x25, 0x95, 0x92, 0xa5, 0x34, 0xaa, 0xf7, 0x82, 0x41, 0x13,
+	0x7f, 0xe0, 0x29, 0x93, 0x92, 0x31, 0xf6, 0x04, 0xf4, 0x05, 0x20, 0x1f, 0x47, 0xd3, 0xd8, 0xd3,
+	0x54, 0xd4, 0xb0, 0x25, 0xec, 0x95, 0x9e, 0x73, 0xef, 0x40, 0xaf, 0x60, 0x4f, 0x53, 0x29, 0xc9,
+	0x9d, 0x90, 0x59, 0x5c, 0x48, 0xd2, 0xb1, 0x97, 0x74, 0x4b, 0xc7, 0x8d, 0xd7, 0xe6, 0x12, 0x76,
+	0x73, 0x29, 0x96, 0x2c, 0xa5, 0x32, 0x9e, 0x11, 0x35, 0xa3, 0x2a, 0xda, 0xb5, 0x6d, 0xe9, 0x3d,
+	0x50, 0x68, 0xe2, 0x39, 0x17, 0x96, 0xe2, 0x64, 0xea, 0xe4, 0x0f, 0x40, 0xf4, 0x19, 0xd4, 0x6f,
+	0x49, 0x72, 0x4f, 0x79, 0x1a, 0xed, 0xf4, 0x82, 0x41, 0xeb, 0x78, 0xb7, 0x48, 0x71, 0xe6, 0x60,
+	0x5c, 0xf8, 0x0f, 0x30, 0x74, 0x1e, 0x6a, 0x8e, 0xba, 0x50, 0xb9, 0xa7, 0x2b, 0x3b, 0x39, 0x4d,
+	0x6c, 0x8e, 0xe8, 0x25, 0x54, 0x97, 0x64, 0xbe, 0xa0, 0xd1, 0xb6, 0x4d, 0x56, 0xca, 0x74, 0xbe,
+	0xe2, 0x24, 0x63, 0xc9, 0x8d, 0xf1, 0x61, 0x47, 0xf9, 0x76, 0xfb, 0x9b, 0xe0, 0x60, 0x0c, 0xef,
+	0x3d, 0x52, 0xe5, 0x23, 0x89, 0xfb, 0x0f, 0x13, 0xb7, 0x8b, 0xc4, 0x26, 0x6a, 0x23, 0x61, 0x9f,
+	0x41, 0xdd, 0x17, 0x8e, 0x10, 0x84, 0x7a, 0x95, 0x53, 0x9f, 0xc5, 0x9e, 0xd1, 0xe7, 0x50, 0x4b,
+	0x04, 0xbf, 0x63, 0xd3, 0x27, 0x0b, 0xf4, 0x1c, 0xf4, 0x0c, 0x9a, 0x7f, 0x09, 0x79, 0xaf, 0x72,
+	0x92, 0xd0, 0xa8, 0x62, 0xd3, 0xac, 0x81, 0xfe, 0x1f, 0x50, 0x73, 0x93, 0x86, 0x3e, 0x85, 0x1a,
+	0xb1, 0xea, 0xdb, 0xbb, 0x3a, 0xc7, 0x9d, 0x22, 0xeb, 0xa9, 0x45, 0xb1, 0xf7, 0x9a, 0xdb, 0x6d,
+	0xa5, 0xc5, 0x42, 0xbd, 0xe3, 0x76, 0xc7, 0xe9, 0xff, 0x1b, 0xc2, 0xfe, 0xe3, 0x7b, 0x82, 0x3e,
+	0x84, 0x56, 0x26, 0xd2, 0xc5, 0x9c, 0xc6, 0x39, 0xd1, 0x33, 0xff, 0x42, 0x70, 0xd0, 0x84, 0xe8,
+	0x19, 0xfa, 0x01, 0xc2, 0x4c, 0xa4, 0xae, 0x5b, 0x9d, 0xe3, 0x57, 0x4f, 0xaf, 0x5d, 0x09, 0xff,
+	0x22, 0x52, 0x8a, 0x6d, 0x60, 0xd9, 0xbc, 0xca, 0x46, 0xf3, 0x10, 0x84, 0x9c, 0x64, 0x34, 0x0a,
+	0x1d, 0x66, 0xce, 0x08, 0x41, 0x45, 0x69, 0x19, 0x55, 0x0d, 0x74, 0xb1, 0x85, 0x8d, 0x61, 0x30,
+	0xc6, 0xb5, 0x5d, 0xa8, 0x8a, 0xc1, 0x18, 0xd7, 0xa6, 0xe2, 0x94, 0xe6, 0x42, 0xd1, 0x34, 0x36,
+	0xca, 0xba, 0xf5, 0x01, 0x0f, 0xbd, 0xa5, 0x2b, 0x74, 0x00, 0x8d, 0x62, 0x34, 0xa3, 0x86, 0xf5,
+	0x96, 0xb6, 0xe9, 0xaf, 0x5b, 0xff, 0xa8, 0x69, 0x55, 0x2b, 0xfb, 0xeb, 0xf7, 0xde, 0x7b, 0xcd,
+	0x36, 0xe7, 0x92, 0x2d, 0x89, 0xa6, 0x11, 0xf4, 0x82, 0x41, 0x1b, 0x17, 0x26, 0x3a, 0x31, 0x9f,
+	0xa4, 0x3f, 0x17, 0x4c, 0xd2, 0x34, 0x96, 0x34, 0x9f, 0x1b, 0x41, 0x5b, 0x56, 0x83, 0x72, 0x92,
+	0x4c, 0xdf, 0xcc, 0x07, 0xc8, 0xb1, 0xb0, 0x23, 0x99, 0xba, 0x19, 0xcf, 0x17, 0x5a, 0xd9, 0x45,
+	0x8b, 0xda, 0x36, 0x2d, 0x38, 0xc8, 0xcc, 0x9e, 0x99, 0x11, 0x96, 0xe5, 0x42, 0x6a, 0xc6, 0xa7,
+	0x76, 0x85, 0x1a, 0x78, 0x0d, 0xa0, 0xc3, 0xc2, 0x1b, 0xb3, 0xd4, 0xaf, 0x73, 0xc3, 0x01, 0x97,
+	0x29, 0xfa, 0x18, 0x76, 0xdc, 0x60, 0xc4, 0x92, 0x12, 0x25, 0x78, 0xb4, 0xdb, 0x0b, 0x06, 0x55,
+	0xdc, 0x76, 0x20, 0xb6, 0x58, 0xff, 0x13, 0x68, 0x6f, 0xca, 0x83, 0x5a, 0x50, 0xcf, 0x08, 0x27,
+	0x53, 0x9a, 0x76, 0xb7, 0x50, 0x03, 0xc2, 0x94, 0x68, 0xd2, 0x0d, 0xce, 0x3a, 0xd0, 0x66, 0x5e,
+	0x54, 0xd3, 0xe0, 0xfe, 0x0c, 0xda, 0x9b, 0x9f, 0xc6, 0x52, 0xbb, 0x60, 0x43, 0xbb, 0x75, 0x5b,
+	0xb7, 0x9f, 0x6c, 0xeb, 0x33, 0x68, 0x2a, 0xca, 0x15, 0xd3, 0x6c, 0xe9, 0x06, 0xa2, 0x81, 0xd7,
+	0x40, 0x7f, 0x00, 0xed, 0xcd, 0xf1, 0x35, 0x22, 0x64, 0x6a, 0x9a, 0x93, 0xe4, 0xde, 0x5e, 0xd6,
+	0xc6, 0x85, 0xd9, 0x7f, 0x0e, 0xa1, 0x6d, 0xd9, 0x3e, 0xd4, 0xd4, 0x8c, 0x1c, 0x7f, 0xf5, 0xb5,
+	0x27, 0x78, 0xab, 0xff, 0x4f, 0x00, 0xa1, 0x9d, 0xde, 0x17, 0x50, 0x55, 0x9a, 0xe6, 0x2a, 0x0a,
+	0xac, 0x44, 0x7b, 0x9b, 0x12, 0x0d, 0x7f, 0xd5, 0x34, 0xc7, 0xce, 0x7f, 0xa0, 0x21, 0x34, 0x26,
+	0x7a, 0x01, 0x1d, 0xa2, 0xb5, 0x64, 0xb7, 0x0b, 0x4d, 0xe3, 0xf5, 0x3b, 0x2f, 0xb6, 0xf0, 0x4e,
+	0x89, 0x5f, 0x99, 0x27, 0x9f, 0x40, 0x8b, 0xce, 0x69, 0x46, 0xb9, 0xb6, 0x63, 0xf8, 0xc4, 0x47,
+	0xe0, 0x62, 0x0b, 0x83, 0xa7, 0xbe, 0xa5, 0xab, 0x33, 0x80, 0x86, 0xa2, 0x73, 0x9a, 0x68, 0x21,
+	0x5f, 0xfe, 0x0d, 0x35, 0xb7, 0xd8, 0xa6, 0xff, 0x57, 0xe3, 0xf1, 0xa4, 0xbb, 0x85, 0x00, 0x6a,
+	0x6f, 0xf0, 0xe8, 0xf4, 0x7a, 0xd4, 0x0d, 0x0c, 0x8a, 0x47, 0xa7, 0xe7, 0xdd, 0x6d, 0x83, 0xfe,
+	0x36, 0x39, 0x37, 0x68, 0xc5, 0x9c, 0xcf, 0x47, 0x3f, 0x8f, 0xae, 0x47, 0xdd, 0x2a, 0xda, 0x07,
+	0xe4, 0xce, 0xf1, 0xf5, 0xc5, 0xe8, 0x2a, 0xf6, 0x91, 0x35, 0x83, 0xbb, 0xb3, 0xc3, 0x3d, 0xbf,
+	0x6e, 0x62, 0x7f, 0x1c, 0xe3, 0x9f, 0x46, 0xd7, 0xdd, 0xc6, 0xd9, 0xeb, 0xdf, 0x4f, 0xa6, 0x4c,
+	0xcf, 0x16, 0xb7, 0xc3, 0x44, 0x64, 0x47, 0x66, 0x2a, 0x59, 0x22, 0x64, 0x7e, 0x54, 0xfe, 0x4b,
+	0x1c, 0x99, 0xb7, 0xa8, 0x23, 0xc6, 0x35, 0x95, 0x9c, 0xcc, 0xad, 0x69, 0xff, 0xfe, 0x6f, 0x6b,
+	0xf6, 0xe7, 0xcb, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x4a, 0x8e, 0xf0, 0x8a, 0x17, 0x08, 0x00,
+	0x00,
 }
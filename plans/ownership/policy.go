@@ -0,0 +1,193 @@
+// Package ownership implements a simple address ownership policy for
+// multi-team monorepos, mapping module and resource address patterns to
+// owning teams so that a plan can be checked for changes outside the scope
+// that the invoking pipeline declared.
+package ownership
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/plans"
+	"github.com/hashicorp/terraform/tfdiags"
+)
+
+// Enforcement decides how out-of-scope changes are reported.
+type Enforcement string
+
+const (
+	// EnforcementError reports out-of-scope changes as errors, blocking the
+	// operation.
+	EnforcementError Enforcement = "error"
+
+	// EnforcementWarning reports out-of-scope changes as warnings, allowing
+	// the operation to proceed.
+	EnforcementWarning Enforcement = "warning"
+)
+
+// Policy is a set of ownership rules along with the enforcement level to
+// use when a plan strays outside the declared scope.
+type Policy struct {
+	Enforcement Enforcement
+	Rules       []Rule
+}
+
+// Rule assigns everything under a single targetable address to a team.
+type Rule struct {
+	Target *addrs.Target
+	Team   string
+}
+
+// policyFile is the wire representation of a policy file.
+type policyFile struct {
+	Version     int           `json:"version"`
+	Enforcement string        `json:"enforcement"`
+	Owners      []policyOwner `json:"owners"`
+}
+
+type policyOwner struct {
+	Address string `json:"address"`
+	Team    string `json:"team"`
+}
+
+// LoadPolicyFile reads and parses the JSON policy file at the given path.
+func LoadPolicyFile(path string) (*Policy, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	src, err := ioutil.ReadFile(path)
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to read ownership policy",
+			fmt.Sprintf("The ownership policy file %q could not be read: %s.", path, err),
+		))
+		return nil, diags
+	}
+
+	var raw policyFile
+	if err := json.Unmarshal(src, &raw); err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid ownership policy",
+			fmt.Sprintf("The ownership policy file %q is not valid JSON: %s.", path, err),
+		))
+		return nil, diags
+	}
+	if raw.Version != 1 {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid ownership policy",
+			fmt.Sprintf("The ownership policy file %q has unsupported version %d; only version 1 is supported.", path, raw.Version),
+		))
+		return nil, diags
+	}
+
+	ret := &Policy{}
+	switch raw.Enforcement {
+	case "", string(EnforcementError):
+		ret.Enforcement = EnforcementError
+	case string(EnforcementWarning):
+		ret.Enforcement = EnforcementWarning
+	default:
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Invalid ownership policy",
+			fmt.Sprintf("The ownership policy enforcement level %q is not valid; use %q or %q.", raw.Enforcement, EnforcementError, EnforcementWarning),
+		))
+		return nil, diags
+	}
+
+	for _, owner := range raw.Owners {
+		target, targetDiags := addrs.ParseTargetStr(owner.Address)
+		if targetDiags.HasErrors() {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Invalid ownership policy",
+				fmt.Sprintf("The ownership policy address %q is not a valid module or resource address: %s.", owner.Address, targetDiags.Err()),
+			))
+			continue
+		}
+		if owner.Team == "" {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Invalid ownership policy",
+				fmt.Sprintf("The ownership policy entry for %q does not name an owning team.", owner.Address),
+			))
+			continue
+		}
+		ret.Rules = append(ret.Rules, Rule{Target: target, Team: owner.Team})
+	}
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	return ret, diags
+}
+
+// OwnerOf returns the team owning the given resource instance, if any. When
+// several rules match, the most specific (longest) address wins.
+func (p *Policy) OwnerOf(addr addrs.AbsResourceInstance) (string, bool) {
+	bestLen := -1
+	team := ""
+	for _, rule := range p.Rules {
+		if !rule.Target.Subject.TargetContains(addr) {
+			continue
+		}
+		if l := len(rule.Target.Subject.String()); l > bestLen {
+			bestLen = l
+			team = rule.Team
+		}
+	}
+	return team, bestLen >= 0
+}
+
+// CheckChanges compares the given change set against the policy, returning
+// one diagnostic per planned change whose address is owned by a team other
+// than the given one. Addresses no rule claims are not restricted.
+func (p *Policy) CheckChanges(changes *plans.Changes, team string) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+
+	severity := tfdiags.Error
+	if p.Enforcement == EnforcementWarning {
+		severity = tfdiags.Warning
+	}
+
+	for _, rc := range changes.Resources {
+		if rc.Action == plans.NoOp {
+			continue
+		}
+		owner, ok := p.OwnerOf(rc.Addr)
+		if !ok || owner == team {
+			continue
+		}
+		diags = diags.Append(tfdiags.Sourceless(
+			severity,
+			"Planned change outside ownership scope",
+			fmt.Sprintf(
+				"This plan would %s %s, which is owned by team %q, but this operation declared ownership scope %q.",
+				describeAction(rc.Action), rc.Addr, owner, team,
+			),
+		))
+	}
+
+	return diags
+}
+
+func describeAction(action plans.Action) string {
+	switch action {
+	case plans.Create:
+		return "create"
+	case plans.Update:
+		return "update"
+	case plans.Delete:
+		return "destroy"
+	case plans.CreateThenDelete, plans.DeleteThenCreate:
+		return "replace"
+	case plans.Read:
+		return "read"
+	default:
+		return "change"
+	}
+}
@@ -0,0 +1,124 @@
+package ownership
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/plans"
+	"github.com/hashicorp/terraform/tfdiags"
+)
+
+func testPolicy(t *testing.T, src string) *Policy {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "owners.json")
+	if err := ioutil.WriteFile(path, []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+	policy, diags := LoadPolicyFile(path)
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+	return policy
+}
+
+func testChange(addrStr string, action plans.Action) *plans.ResourceInstanceChangeSrc {
+	addr, diags := addrs.ParseAbsResourceInstanceStr(addrStr)
+	if diags.HasErrors() {
+		panic(diags.Err())
+	}
+	return &plans.ResourceInstanceChangeSrc{
+		Addr: addr,
+		ChangeSrc: plans.ChangeSrc{
+			Action: action,
+		},
+	}
+}
+
+func TestPolicyCheckChanges(t *testing.T) {
+	policy := testPolicy(t, `{
+		"version": 1,
+		"owners": [
+			{"address": "module.network", "team": "networking"},
+			{"address": "aws_instance.web", "team": "compute"}
+		]
+	}`)
+
+	changes := plans.NewChanges()
+	changes.Resources = append(changes.Resources,
+		testChange("module.network.aws_vpc.main", plans.Update),
+		testChange("aws_instance.web", plans.Create),
+		testChange("aws_instance.unclaimed", plans.Create),
+		testChange("module.network.aws_subnet.a", plans.NoOp),
+	)
+
+	diags := policy.CheckChanges(changes, "compute")
+	if !diags.HasErrors() {
+		t.Fatal("succeeded; want error about module.network.aws_vpc.main")
+	}
+	if len(diags) != 1 {
+		t.Fatalf("wrong number of diagnostics %d; want 1\n%s", len(diags), diags.Err())
+	}
+	detail := diags[0].Description().Detail
+	if !strings.Contains(detail, "module.network.aws_vpc.main") || !strings.Contains(detail, `"networking"`) {
+		t.Errorf("diagnostic does not describe the out-of-scope change:\n%s", detail)
+	}
+
+	if diags := policy.CheckChanges(changes, "networking"); len(diags) != 1 {
+		t.Errorf("wrong number of diagnostics for networking %d; want 1", len(diags))
+	}
+}
+
+func TestPolicyCheckChanges_warningEnforcement(t *testing.T) {
+	policy := testPolicy(t, `{
+		"version": 1,
+		"enforcement": "warning",
+		"owners": [
+			{"address": "aws_instance.web", "team": "compute"}
+		]
+	}`)
+
+	changes := plans.NewChanges()
+	changes.Resources = append(changes.Resources,
+		testChange("aws_instance.web", plans.Delete),
+	)
+
+	diags := policy.CheckChanges(changes, "networking")
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+	if len(diags) != 1 || diags[0].Severity() != tfdiags.Warning {
+		t.Fatalf("want exactly one warning; got %#v", diags)
+	}
+}
+
+func TestPolicyOwnerOf_mostSpecific(t *testing.T) {
+	policy := testPolicy(t, `{
+		"version": 1,
+		"owners": [
+			{"address": "module.network", "team": "networking"},
+			{"address": "module.network.aws_instance.nat", "team": "compute"}
+		]
+	}`)
+
+	addr, diags := addrs.ParseAbsResourceInstanceStr("module.network.aws_instance.nat")
+	if diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+	owner, ok := policy.OwnerOf(addr)
+	if !ok || owner != "compute" {
+		t.Errorf("wrong owner %q, %v; want \"compute\", true", owner, ok)
+	}
+}
+
+func TestLoadPolicyFile_invalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "owners.json")
+	if err := ioutil.WriteFile(path, []byte(`{"version": 1, "owners": [{"address": "not an address", "team": "x"}]}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, diags := LoadPolicyFile(path); !diags.HasErrors() {
+		t.Fatal("succeeded; want error for invalid address")
+	}
+}
@@ -25,6 +25,42 @@ type Plan struct {
 	TargetAddrs     []addrs.Targetable
 	ProviderSHA256s map[string][]byte
 	Backend         Backend
+
+	// Destroy is true if this plan was created in destroy mode, in which
+	// case applying it must also use destroy mode so that the apply graph
+	// is built with the correct ordering. This is recorded in the plan file
+	// so that "terraform apply <planfile>" can destroy without the user
+	// needing to re-specify the destroy option.
+	Destroy bool
+
+	// DestroyProtectionOverride is non-empty if this plan was created with
+	// lifecycle.prevent_destroy protections overridden, in which case it
+	// records who or what requested the override. ProtectedDestroys then
+	// lists the resource instances that would have blocked this plan had
+	// the override not been in effect. Both are recorded in the plan file
+	// so that an audit trail survives into apply.
+	DestroyProtectionOverride string
+	ProtectedDestroys         []addrs.AbsResourceInstance
+
+	// Moves describes any state address rewrites that were performed while
+	// creating this plan, as requested by "moved" blocks in the
+	// configuration. These have already been applied to the state the plan
+	// was built from, and are recorded here so the UI can report them.
+	Moves []Move
+
+	// Targeting describes the effect that resource targeting had on this
+	// plan, or nil if the plan was created without any -target options.
+	// This is retained only for UI purposes and so it does not currently
+	// survive a round-trip through a saved plan file.
+	Targeting *TargetingReport
+}
+
+// Move records that the object formerly tracked in state at address From
+// is now tracked at address To, as a result of a "moved" block in the
+// configuration.
+type Move struct {
+	From addrs.Targetable
+	To   addrs.Targetable
 }
 
 // Backend represents the backend-related configuration and other data as it
@@ -57,6 +57,7 @@ func readTfplan(r io.Reader) (*plans.Plan, error) {
 		},
 
 		ProviderSHA256s: map[string][]byte{},
+		Destroy:         rawPlan.Destroy,
 	}
 
 	for _, rawOC := range rawPlan.OutputChanges {
@@ -94,6 +95,15 @@ func readTfplan(r io.Reader) (*plans.Plan, error) {
 		plan.TargetAddrs = append(plan.TargetAddrs, target.Subject)
 	}
 
+	plan.DestroyProtectionOverride = rawPlan.DestroyProtectionOverride
+	for _, rawAddr := range rawPlan.ProtectedDestroys {
+		instAddr, diags := addrs.ParseAbsResourceInstanceStr(rawAddr)
+		if diags.HasErrors() {
+			return nil, fmt.Errorf("plan contains invalid protected destroy address %q: %s", rawAddr, diags.Err())
+		}
+		plan.ProtectedDestroys = append(plan.ProtectedDestroys, instAddr)
+	}
+
 	for name, rawHashObj := range rawPlan.ProviderHashes {
 		if len(rawHashObj.Sha256) == 0 {
 			return nil, fmt.Errorf("no SHA256 hash for provider %q plugin", name)
@@ -201,6 +211,14 @@ func resourceChangeFromTfplan(rawChange *planproto.ResourceInstanceChange) (*pla
 		ret.Private = rawChange.Private
 	}
 
+	if len(rawChange.InputsHash) != 0 {
+		ret.InputsHash = rawChange.InputsHash
+	}
+
+	ret.Importing = rawChange.Importing
+	ret.ImportID = rawChange.ImportId
+	ret.ActionReason = plans.ResourceInstanceChangeActionReason(rawChange.ActionReason)
+
 	return ret, nil
 }
 
@@ -238,6 +256,9 @@ func changeFromTfplan(rawChange *planproto.Change) (*plans.ChangeSrc, error) {
 		ret.Action = plans.CreateThenDelete
 		beforeIdx = 0
 		afterIdx = 1
+	case planproto.Action_FORGET:
+		ret.Action = plans.Forget
+		beforeIdx = 0
 	case planproto.Action_DELETE_THEN_CREATE:
 		ret.Action = plans.DeleteThenCreate
 		beforeIdx = 0
@@ -298,6 +319,7 @@ func writeTfplan(plan *plans.Plan, w io.Writer) error {
 		Version:          tfplanFormatVersion,
 		TerraformVersion: version.String(),
 		ProviderHashes:   map[string]*planproto.Hash{},
+		Destroy:          plan.Destroy,
 
 		Variables:       map[string]*planproto.DynamicValue{},
 		OutputChanges:   []*planproto.OutputChange{},
@@ -341,6 +363,11 @@ func writeTfplan(plan *plans.Plan, w io.Writer) error {
 		rawPlan.TargetAddrs = append(rawPlan.TargetAddrs, targetAddr.String())
 	}
 
+	rawPlan.DestroyProtectionOverride = plan.DestroyProtectionOverride
+	for _, instAddr := range plan.ProtectedDestroys {
+		rawPlan.ProtectedDestroys = append(rawPlan.ProtectedDestroys, instAddr.String())
+	}
+
 	for name, hash := range plan.ProviderSHA256s {
 		rawPlan.ProviderHashes[name] = &planproto.Hash{
 			Sha256: hash,
@@ -424,6 +451,14 @@ func resourceChangeToTfplan(change *plans.ResourceInstanceChangeSrc) (*planproto
 		ret.Private = change.Private
 	}
 
+	if len(change.InputsHash) > 0 {
+		ret.InputsHash = change.InputsHash
+	}
+
+	ret.Importing = change.Importing
+	ret.ImportId = change.ImportID
+	ret.ActionReason = int32(change.ActionReason)
+
 	return ret, nil
 }
 
@@ -455,6 +490,9 @@ func changeToTfplan(change *plans.ChangeSrc) (*planproto.Change, error) {
 	case plans.CreateThenDelete:
 		ret.Action = planproto.Action_CREATE_THEN_DELETE
 		ret.Values = []*planproto.DynamicValue{before, after}
+	case plans.Forget:
+		ret.Action = planproto.Action_FORGET
+		ret.Values = []*planproto.DynamicValue{before}
 	default:
 		return nil, fmt.Errorf("invalid change action %s", change.Action)
 	}
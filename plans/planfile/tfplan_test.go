@@ -217,6 +217,15 @@ func TestTFPlanRoundTripDestroy(t *testing.T) {
 				Name: "woot",
 			}.Absolute(addrs.RootModuleInstance),
 		},
+		Destroy:                   true,
+		DestroyProtectionOverride: "aborting deployment during incident 123",
+		ProtectedDestroys: []addrs.AbsResourceInstance{
+			addrs.Resource{
+				Mode: addrs.ManagedResourceMode,
+				Type: "test_thing",
+				Name: "woot",
+			}.Instance(addrs.IntKey(0)).Absolute(addrs.RootModuleInstance),
+		},
 		Backend: plans.Backend{
 			Type: "local",
 			Config: mustNewDynamicValue(
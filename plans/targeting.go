@@ -0,0 +1,22 @@
+package plans
+
+import (
+	"github.com/hashicorp/terraform/addrs"
+)
+
+// TargetingReport describes the effect that resource targeting had on a
+// plan that was created with -target options, so that operators can see
+// the blast radius of a partial apply before running it.
+type TargetingReport struct {
+	// ImplicitlyIncluded are resources that were not named by any of the
+	// target addresses but were kept in the plan anyway because a targeted
+	// resource depends on them, or, in destroy mode, because they depend
+	// on a targeted resource.
+	ImplicitlyIncluded []addrs.AbsResource
+
+	// Excluded are resources that were removed from the plan by targeting.
+	// Their real objects are not updated by applying the plan and so may
+	// become stale with respect to the configuration until a plan without
+	// targeting is applied.
+	Excluded []addrs.AbsResource
+}
@@ -277,38 +277,46 @@ func (i *ProviderInstaller) Get(provider addrs.Provider, req Constraints) (Plugi
 }
 
 func (i *ProviderInstaller) install(provider addrs.Provider, version Version, url string) error {
-	if i.Cache != nil {
-		log.Printf("[DEBUG] looking for provider %s %s in plugin cache", provider.LegacyString(), version)
-		cached := i.Cache.CachedPluginPath("provider", provider.Type, version)
+	return installPlugin("provider", provider.Type, provider.LegacyString(), version, url, i.Dir, i.Cache)
+}
+
+// installPlugin downloads the plugin archive at the given URL and extracts
+// it into the given directory, optionally via a local plugin cache. It is
+// shared by the provider and provisioner installers, which differ only in
+// how they discover the download URL.
+func installPlugin(kind, name, displayName string, version Version, url, dir string, cache PluginCache) error {
+	if cache != nil {
+		log.Printf("[DEBUG] looking for %s %s %s in plugin cache", kind, displayName, version)
+		cached := cache.CachedPluginPath(kind, name, version)
 		if cached == "" {
-			log.Printf("[DEBUG] %s %s not yet in cache, so downloading %s", provider.LegacyString(), version, url)
-			err := getter.Get(i.Cache.InstallDir(), url)
+			log.Printf("[DEBUG] %s %s not yet in cache, so downloading %s", displayName, version, url)
+			err := getter.Get(cache.InstallDir(), url)
 			if err != nil {
 				return err
 			}
 			// should now be in cache
-			cached = i.Cache.CachedPluginPath("provider", provider.Type, version)
+			cached = cache.CachedPluginPath(kind, name, version)
 			if cached == "" {
 				// should never happen if the getter is behaving properly
 				// and the plugins are packaged properly.
-				return fmt.Errorf("failed to find downloaded plugin in cache %s", i.Cache.InstallDir())
+				return fmt.Errorf("failed to find downloaded plugin in cache %s", cache.InstallDir())
 			}
 		}
 
 		// Link or copy the cached binary into our install dir so the
 		// normal resolution machinery can find it.
 		filename := filepath.Base(cached)
-		targetPath := filepath.Join(i.Dir, filename)
+		targetPath := filepath.Join(dir, filename)
 		// check if the target dir exists, and create it if not
 		var err error
-		if _, StatErr := os.Stat(i.Dir); os.IsNotExist(StatErr) {
-			err = os.MkdirAll(i.Dir, 0700)
+		if _, StatErr := os.Stat(dir); os.IsNotExist(StatErr) {
+			err = os.MkdirAll(dir, 0700)
 		}
 		if err != nil {
 			return err
 		}
 
-		log.Printf("[DEBUG] installing %s %s to %s from local cache %s", provider.LegacyString(), version, targetPath, cached)
+		log.Printf("[DEBUG] installing %s %s to %s from local cache %s", displayName, version, targetPath, cached)
 
 		// Delete if we can. If there's nothing there already then no harm done.
 		// This is important because we can't create a link if there's
@@ -364,10 +372,10 @@ func (i *ProviderInstaller) install(provider addrs.Provider, version Version, ur
 		}
 
 		// One way or another, by the time we get here we should have either
-		// a link or a copy of the cached plugin within i.Dir, as expected.
+		// a link or a copy of the cached plugin within dir, as expected.
 	} else {
-		log.Printf("[DEBUG] plugin cache is disabled, so downloading %s %s from %s", provider.LegacyString(), version, url)
-		err := getter.Get(i.Dir, url)
+		log.Printf("[DEBUG] plugin cache is disabled, so downloading %s %s from %s", displayName, version, url)
+		err := getter.Get(dir, url)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,230 @@
+package discovery
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"runtime"
+	"sort"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/tfdiags"
+	"github.com/mitchellh/cli"
+)
+
+// DefaultProvisionerReleasesURL is the base URL of the release repository
+// that ProvisionerInstaller downloads from when no other base URL is
+// configured. The repository layout is the same one used by
+// releases.hashicorp.com.
+const DefaultProvisionerReleasesURL = "https://releases.hashicorp.com"
+
+// ProvisionerInstaller is the provisioner counterpart of ProviderInstaller:
+// it downloads provisioner plugins from a releases repository or mirror
+// into a local directory, verifying each archive against the repository's
+// SHA256SUMS file. The files downloaded follow the naming scheme expected
+// by FindPlugins, so the target directory can be used directly as a plugin
+// discovery source for provisionerFactories.
+//
+// Unlike providers, provisioners are not currently served by the registry
+// protocol, so the available versions are discovered from the repository's
+// own index document instead.
+type ProvisionerInstaller struct {
+	Dir string
+
+	// Cache is used to access and update a local cache of plugins if non-nil.
+	// Can be nil to disable caching.
+	Cache PluginCache
+
+	// BaseURL is the base URL of the releases repository or mirror to
+	// download from. If empty, DefaultProvisionerReleasesURL is used.
+	BaseURL string
+
+	// OS and Arch specify the OS and architecture that should be used when
+	// installing plugins. These use the same labels as the runtime.GOOS and
+	// runtime.GOARCH variables respectively, and indeed the values of these
+	// are used as defaults if either of these is the empty string.
+	OS   string
+	Arch string
+
+	// Skip checksum verification
+	SkipVerify bool
+
+	Ui cli.Ui // Ui for output
+}
+
+// provisionerVersionsDoc is the subset of the releases repository index
+// document that lists the available versions of a plugin.
+type provisionerVersionsDoc struct {
+	Versions map[string]struct{} `json:"versions"`
+}
+
+// Get downloads and installs the newest version of the given provisioner
+// plugin that matches the given constraints, returning the metadata for
+// the plugin that was installed.
+//
+// As with ProviderInstaller.Get, this may return ErrorNoSuchProvider or
+// ErrorNoSuitableVersion to indicate issues that are likely resolvable
+// through user action.
+func (i *ProvisionerInstaller) Get(name string, req Constraints) (PluginMeta, tfdiags.Diagnostics, error) {
+	var diags tfdiags.Diagnostics
+
+	// a little bit of initialization.
+	if i.OS == "" {
+		i.OS = runtime.GOOS
+	}
+	if i.Arch == "" {
+		i.Arch = runtime.GOARCH
+	}
+
+	versions, err := i.listProvisionerVersions(name)
+	if err != nil {
+		log.Printf("[DEBUG] %s", err)
+		return PluginMeta{}, diags, ErrorNoSuchProvider
+	}
+	if len(versions) == 0 {
+		return PluginMeta{}, diags, ErrorNoSuitableVersion
+	}
+
+	// Filter the list of versions to those which meet the version
+	// constraints, and sort them newest to oldest. The newest version wins!
+	var allowed []Version
+	for _, v := range versions {
+		if req.Allows(v) {
+			allowed = append(allowed, v)
+		}
+	}
+	if len(allowed) == 0 {
+		return PluginMeta{}, diags, ErrorNoSuitableVersion
+	}
+	sort.Slice(allowed, func(i, j int) bool {
+		return allowed[i].NewerThan(allowed[j])
+	})
+	v := allowed[0]
+
+	archiveName := fmt.Sprintf("terraform-provisioner-%s_%s_%s_%s.zip", name, v, i.OS, i.Arch)
+	url := fmt.Sprintf("%s/terraform-provisioner-%s/%s/%s", i.baseURL(), name, v, archiveName)
+
+	if !i.SkipVerify {
+		// The SHA256SUMS document published alongside the release is the
+		// authoritative record of the archive contents, so we ask go-getter
+		// to verify the download against it.
+		sumsURL := fmt.Sprintf("%s/terraform-provisioner-%s/%s/terraform-provisioner-%s_%s_SHA256SUMS", i.baseURL(), name, v, name, v)
+		shasums, err := getFile(sumsURL)
+		if err != nil {
+			return PluginMeta{}, diags, fmt.Errorf("failed to fetch checksums for provisioner %q version %s: %s", name, v, err)
+		}
+		checksum := checksumForFile(shasums, archiveName)
+		if checksum == "" {
+			return PluginMeta{}, diags, fmt.Errorf("checksums for provisioner %q version %s do not include %s", name, v, archiveName)
+		}
+		url = url + "?checksum=sha256:" + checksum
+	}
+
+	i.Ui.Info(fmt.Sprintf("- Downloading plugin for provisioner %q %s...", name, v))
+	log.Printf("[DEBUG] getting provisioner %q version %q", name, v)
+	err = installPlugin("provisioner", name, name, v, url, i.Dir, i.Cache)
+	if err != nil {
+		return PluginMeta{}, diags, err
+	}
+
+	// Find what we just installed
+	// (This is weird, because go-getter doesn't directly return
+	//  information about what was extracted, and we just extracted
+	//  the archive directly into a shared dir here.)
+	log.Printf("[DEBUG] looking for the %s %s plugin we just installed", name, v)
+	metas := FindPlugins("provisioner", []string{i.Dir})
+	metas, _ = metas.ValidateVersions()
+	metas = metas.WithName(name).WithVersion(v)
+	if metas.Count() == 0 {
+		// This should never happen. Suggests that the release archive
+		// contains an executable file whose name doesn't match the
+		// expected convention.
+		return PluginMeta{}, diags, fmt.Errorf(
+			"failed to find installed provisioner version %s; this is a bug in Terraform and should be reported",
+			v,
+		)
+	}
+	if metas.Count() > 1 {
+		// This should also never happen, and suggests that a
+		// particular version was re-released with a different
+		// executable filename. We consider releases as immutable, so
+		// this is an error.
+		return PluginMeta{}, diags, fmt.Errorf(
+			"multiple provisioner plugins installed for version %s; this is a bug in Terraform and should be reported",
+			v,
+		)
+	}
+
+	// By now we know we have exactly one meta, and so "Newest" will
+	// return that one.
+	return metas.Newest(), diags, nil
+}
+
+// PurgeUnused removes from the installation directory any provisioner
+// plugins that are not in the given map, using the same semantics as
+// ProviderInstaller.PurgeUnused.
+func (i *ProvisionerInstaller) PurgeUnused(used map[string]PluginMeta) (PluginMetaSet, error) {
+	purge := make(PluginMetaSet)
+
+	present := FindPlugins("provisioner", []string{i.Dir})
+	for meta := range present {
+		chosen, ok := used[meta.Name]
+		if !ok {
+			purge.Add(meta)
+		}
+		if chosen.Path != meta.Path {
+			purge.Add(meta)
+		}
+	}
+
+	removed := make(PluginMetaSet)
+	var errs error
+	for meta := range purge {
+		path := meta.Path
+		err := os.Remove(path)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf(
+				"failed to remove unused provisioner plugin %s: %s",
+				path, err,
+			))
+		} else {
+			removed.Add(meta)
+		}
+	}
+
+	return removed, errs
+}
+
+func (i *ProvisionerInstaller) baseURL() string {
+	if i.BaseURL != "" {
+		return i.BaseURL
+	}
+	return DefaultProvisionerReleasesURL
+}
+
+// listProvisionerVersions retrieves the repository's index document for
+// the given provisioner and returns the versions it offers.
+func (i *ProvisionerInstaller) listProvisionerVersions(name string) ([]Version, error) {
+	url := fmt.Sprintf("%s/terraform-provisioner-%s/index.json", i.baseURL(), name)
+	raw, err := getFile(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list versions for provisioner %q: %s", name, err)
+	}
+
+	doc := &provisionerVersionsDoc{}
+	if err := json.Unmarshal(raw, doc); err != nil {
+		return nil, fmt.Errorf("invalid version index for provisioner %q: %s", name, err)
+	}
+
+	var versions []Version
+	for str := range doc.Versions {
+		v, err := VersionStr(str).Parse()
+		if err != nil {
+			log.Printf("[WARN] ignoring invalid version %q for provisioner %q", str, name)
+			continue
+		}
+		versions = append(versions, v)
+	}
+	return versions, nil
+}
@@ -0,0 +1,159 @@
+package discovery
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mitchellh/cli"
+)
+
+const testProvisionerFile = "test provisioner binary"
+
+// testProvisionerServer serves a releases-style repository offering a
+// "greet" provisioner at a few versions, with valid SHA256SUMS documents.
+func testProvisionerServer(t *testing.T) *httptest.Server {
+	zipFor := func(version string) []byte {
+		buf := &bytes.Buffer{}
+		z := zip.NewWriter(buf)
+		f, err := z.Create(fmt.Sprintf("terraform-provisioner-greet_v%s", version))
+		if err != nil {
+			t.Fatal(err)
+		}
+		io.WriteString(f, testProvisionerFile)
+		z.Close()
+		return buf.Bytes()
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/terraform-provisioner-greet/index.json" {
+			io.WriteString(w, `{"versions":{"0.9.0":{},"1.0.0":{},"1.1.0":{}}}`)
+			return
+		}
+
+		parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+		if len(parts) != 3 || parts[0] != "terraform-provisioner-greet" {
+			http.NotFound(w, r)
+			return
+		}
+		version := parts[1]
+
+		switch {
+		case strings.HasSuffix(parts[2], "_SHA256SUMS"):
+			archiveName := fmt.Sprintf("terraform-provisioner-greet_%s_mockos_mockarch.zip", version)
+			fmt.Fprintf(w, "%x  %s\n", sha256.Sum256(zipFor(version)), archiveName)
+		case strings.HasSuffix(parts[2], ".zip"):
+			w.Write(zipFor(version))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+func TestProvisionerInstallerGet(t *testing.T) {
+	server := testProvisionerServer(t)
+	defer server.Close()
+
+	tmpDir, err := ioutil.TempDir("", "tf-plugin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	i := &ProvisionerInstaller{
+		Dir:     tmpDir,
+		BaseURL: server.URL,
+		OS:      "mockos",
+		Arch:    "mockarch",
+		Ui:      cli.NewMockUi(),
+	}
+
+	{
+		_, _, err := i.Get("nonexist", AllVersions)
+		if err != ErrorNoSuchProvider {
+			t.Fatalf("wrong error %v; want error for no such provisioner", err)
+		}
+	}
+
+	{
+		_, _, err := i.Get("greet", ConstraintStr(">9.0.0").MustParse())
+		if err != ErrorNoSuitableVersion {
+			t.Fatalf("wrong error %v; want error for mismatching constraints", err)
+		}
+	}
+
+	gotMeta, _, err := i.Get("greet", ConstraintStr("~> 1.0").MustParse())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the newest version matching the constraint should win
+	dest := filepath.Join(tmpDir, "terraform-provisioner-greet_v1.1.0")
+	wantMeta := PluginMeta{
+		Name:    "greet",
+		Version: VersionStr("1.1.0"),
+		Path:    dest,
+	}
+	if gotMeta != wantMeta {
+		t.Errorf("wrong result meta\ngot:  %#v\nwant: %#v", gotMeta, wantMeta)
+	}
+
+	f, err := ioutil.ReadFile(dest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(f) != testProvisionerFile {
+		t.Fatalf("test provisioner contains: %q", f)
+	}
+}
+
+func TestProvisionerInstallerPurgeUnused(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "tf-plugin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	unusedPath := filepath.Join(tmpDir, "terraform-provisioner-greet_v0.9.0")
+	usedPath := filepath.Join(tmpDir, "terraform-provisioner-greet_v1.1.0")
+	if err := ioutil.WriteFile(unusedPath, []byte(testProvisionerFile), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(usedPath, []byte(testProvisionerFile), 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	i := &ProvisionerInstaller{
+		Dir: tmpDir,
+		Ui:  cli.NewMockUi(),
+	}
+	removed, err := i.PurgeUnused(map[string]PluginMeta{
+		"greet": {
+			Name:    "greet",
+			Version: VersionStr("1.1.0"),
+			Path:    usedPath,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := removed.Count(), 1; got != want {
+		t.Fatalf("wrong number of removed plugins %d; want %d", got, want)
+	}
+	if _, err := os.Stat(unusedPath); !os.IsNotExist(err) {
+		t.Errorf("unused plugin still exists at %s", unusedPath)
+	}
+	if _, err := os.Stat(usedPath); err != nil {
+		t.Errorf("used plugin was removed from %s", usedPath)
+	}
+}
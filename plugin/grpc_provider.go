@@ -47,6 +47,18 @@ type GRPCProvider struct {
 	// used in an end to end test of a provider.
 	TestServer *grpc.Server
 
+	// ProtocolVersion is the plugin protocol version that was negotiated
+	// with the provider at handshake time. If zero, the baseline version 5
+	// is assumed.
+	ProtocolVersion int
+
+	// SchemaCache, if non-nil, is used to persist the provider's schema
+	// between runs so that GetSchema can avoid a round-trip to the plugin.
+	// SchemaCacheKey identifies the provider release the cache entries
+	// belong to; if it is incomplete the cache is not consulted.
+	SchemaCache    *SchemaCache
+	SchemaCacheKey SchemaCacheKey
+
 	// Proto client use to make the grpc service calls.
 	client proto.ProviderClient
 
@@ -114,6 +126,20 @@ func (p *GRPCProvider) GetSchema() (resp providers.GetSchemaResponse) {
 		return p.schemas
 	}
 
+	protocolVersion := p.ProtocolVersion
+	if protocolVersion == 0 {
+		protocolVersion = 5
+	}
+
+	if cached, ok := p.SchemaCache.Read(p.SchemaCacheKey); ok {
+		log.Printf("[TRACE] GRPCProvider: GetSchema returning cached schema for %s %s", p.SchemaCacheKey.ProviderAddr, p.SchemaCacheKey.Version)
+		// Capabilities depend on the protocol version negotiated for this
+		// particular plugin launch, so they are never cached.
+		cached.Capabilities = providers.CapabilitiesForProtocolVersion(protocolVersion)
+		p.schemas = cached
+		return cached
+	}
+
 	resp.ResourceTypes = make(map[string]providers.Schema)
 	resp.DataSources = make(map[string]providers.Schema)
 
@@ -146,6 +172,14 @@ func (p *GRPCProvider) GetSchema() (resp providers.GetSchemaResponse) {
 		resp.DataSources[name] = convert.ProtoToProviderSchema(data)
 	}
 
+	resp.Capabilities = providers.CapabilitiesForProtocolVersion(protocolVersion)
+
+	if !resp.Diagnostics.HasErrors() {
+		if err := p.SchemaCache.Write(p.SchemaCacheKey, resp); err != nil {
+			log.Printf("[WARN] GRPCProvider: failed to cache schema for %s %s: %s", p.SchemaCacheKey.ProviderAddr, p.SchemaCacheKey.Version, err)
+		}
+	}
+
 	p.schemas = resp
 
 	return resp
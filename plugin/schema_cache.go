@@ -0,0 +1,138 @@
+package plugin
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform/providers"
+)
+
+// schemaCacheFormatVersion is written into each cache file so that the
+// format can evolve; files with a different version are ignored.
+const schemaCacheFormatVersion = 1
+
+// SchemaCache persists provider GetSchema responses to disk so that later
+// runs can skip the schema round-trip to the provider plugin, which can be
+// slow for providers with many resource types.
+//
+// Cache entries are keyed by provider address and version, and record the
+// SHA256 digest of the plugin binary that produced them; an entry whose
+// digest does not match the current binary is treated as missing, so a
+// rebuilt binary of the same version invalidates the cache automatically.
+type SchemaCache struct {
+	// Dir is the base directory for cache files. Entries are stored at
+	// Dir/<provider address>/<version>.json.
+	Dir string
+}
+
+// NewSchemaCache returns a SchemaCache that stores its entries in the given
+// base directory.
+func NewSchemaCache(dir string) *SchemaCache {
+	return &SchemaCache{Dir: dir}
+}
+
+// SchemaCacheKey identifies a particular provider release for schema
+// caching purposes.
+type SchemaCacheKey struct {
+	// ProviderAddr is the fully-qualified provider address, in the string
+	// form produced by addrs.Provider.String.
+	ProviderAddr string
+
+	// Version is the provider version string.
+	Version string
+
+	// PluginHash is the SHA256 digest of the plugin binary.
+	PluginHash []byte
+}
+
+// Valid returns true if the key is complete enough to address a cache entry.
+func (k SchemaCacheKey) Valid() bool {
+	return k.ProviderAddr != "" && k.Version != "" && len(k.PluginHash) != 0
+}
+
+// schemaCacheFile is the JSON file format for a single cache entry.
+type schemaCacheFile struct {
+	FormatVersion int                         `json:"format_version"`
+	PluginHash    string                      `json:"plugin_hash"`
+	Provider      providers.Schema            `json:"provider"`
+	ResourceTypes map[string]providers.Schema `json:"resource_schemas"`
+	DataSources   map[string]providers.Schema `json:"data_source_schemas"`
+}
+
+// Read returns the cached schema response for the given key, if the cache
+// holds an entry that was produced by a plugin binary with a matching hash.
+//
+// The Capabilities and Diagnostics fields of the result are left at their
+// zero values; callers must fill in Capabilities based on the negotiated
+// protocol version as usual.
+func (c *SchemaCache) Read(key SchemaCacheKey) (providers.GetSchemaResponse, bool) {
+	var resp providers.GetSchemaResponse
+	if c == nil || !key.Valid() {
+		return resp, false
+	}
+
+	src, err := ioutil.ReadFile(c.entryPath(key))
+	if err != nil {
+		// Commonly the file just doesn't exist yet, and any other failure
+		// is also just a cache miss.
+		return resp, false
+	}
+
+	var entry schemaCacheFile
+	if err := json.Unmarshal(src, &entry); err != nil {
+		log.Printf("[WARN] SchemaCache: ignoring malformed cache entry for %s %s: %s", key.ProviderAddr, key.Version, err)
+		return resp, false
+	}
+	if entry.FormatVersion != schemaCacheFormatVersion {
+		return resp, false
+	}
+	if entry.PluginHash != hex.EncodeToString(key.PluginHash) {
+		log.Printf("[DEBUG] SchemaCache: plugin hash mismatch for %s %s, so cached schema is stale", key.ProviderAddr, key.Version)
+		return resp, false
+	}
+
+	resp.Provider = entry.Provider
+	resp.ResourceTypes = entry.ResourceTypes
+	if resp.ResourceTypes == nil {
+		resp.ResourceTypes = make(map[string]providers.Schema)
+	}
+	resp.DataSources = entry.DataSources
+	if resp.DataSources == nil {
+		resp.DataSources = make(map[string]providers.Schema)
+	}
+	return resp, true
+}
+
+// Write stores the given schema response as the cache entry for the given
+// key, replacing any existing entry.
+func (c *SchemaCache) Write(key SchemaCacheKey, resp providers.GetSchemaResponse) error {
+	if c == nil || !key.Valid() {
+		return nil
+	}
+
+	entry := schemaCacheFile{
+		FormatVersion: schemaCacheFormatVersion,
+		PluginHash:    hex.EncodeToString(key.PluginHash),
+		Provider:      resp.Provider,
+		ResourceTypes: resp.ResourceTypes,
+		DataSources:   resp.DataSources,
+	}
+	src, err := json.MarshalIndent(&entry, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	path := c.entryPath(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, src, 0644)
+}
+
+func (c *SchemaCache) entryPath(key SchemaCacheKey) string {
+	return filepath.Join(c.Dir, filepath.FromSlash(key.ProviderAddr), key.Version+".json")
+}
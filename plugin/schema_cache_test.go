@@ -0,0 +1,122 @@
+package plugin
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/hashicorp/terraform/providers"
+)
+
+func testSchemaCacheResponse() providers.GetSchemaResponse {
+	return providers.GetSchemaResponse{
+		Provider: providers.Schema{
+			Block: &configschema.Block{
+				Attributes: map[string]*configschema.Attribute{
+					"region": {Type: cty.String, Optional: true},
+				},
+			},
+		},
+		ResourceTypes: map[string]providers.Schema{
+			"test_thing": {
+				Version: 3,
+				Block: &configschema.Block{
+					Attributes: map[string]*configschema.Attribute{
+						"id":  {Type: cty.String, Computed: true},
+						"ami": {Type: cty.String, Optional: true},
+					},
+				},
+			},
+		},
+		DataSources: map[string]providers.Schema{},
+	}
+}
+
+func TestSchemaCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf-schema-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cache := NewSchemaCache(dir)
+	key := SchemaCacheKey{
+		ProviderAddr: "registry.terraform.io/-/test",
+		Version:      "1.2.3",
+		PluginHash:   []byte{0xde, 0xad, 0xbe, 0xef},
+	}
+
+	if _, ok := cache.Read(key); ok {
+		t.Fatal("read succeeded before anything was written")
+	}
+
+	resp := testSchemaCacheResponse()
+	if err := cache.Write(key, resp); err != nil {
+		t.Fatalf("write failed: %s", err)
+	}
+
+	got, ok := cache.Read(key)
+	if !ok {
+		t.Fatal("read failed after write")
+	}
+	if got.Provider.Block == nil || got.Provider.Block.Attributes["region"] == nil {
+		t.Error("provider schema not round-tripped")
+	}
+	rt, ok := got.ResourceTypes["test_thing"]
+	if !ok {
+		t.Fatal("resource type schema missing after round-trip")
+	}
+	if got, want := rt.Version, int64(3); got != want {
+		t.Errorf("wrong schema version %d; want %d", got, want)
+	}
+	if got, want := rt.Block.Attributes["ami"].Type, cty.String; !want.Equals(got) {
+		t.Errorf("wrong attribute type %#v; want %#v", got, want)
+	}
+
+	// A different plugin hash must miss, since the cached schema may be
+	// stale for the new binary.
+	staleKey := key
+	staleKey.PluginHash = []byte{0x01}
+	if _, ok := cache.Read(staleKey); ok {
+		t.Error("read succeeded with a mismatched plugin hash")
+	}
+
+	// A malformed cache file is just a miss.
+	if err := ioutil.WriteFile(filepath.Join(dir, "registry.terraform.io", "-", "test", "1.2.3.json"), []byte("{"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := cache.Read(key); ok {
+		t.Error("read succeeded with a malformed cache file")
+	}
+}
+
+func TestSchemaCache_incompleteKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf-schema-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cache := NewSchemaCache(dir)
+	key := SchemaCacheKey{ProviderAddr: "registry.terraform.io/-/test"}
+
+	if err := cache.Write(key, testSchemaCacheResponse()); err != nil {
+		t.Fatalf("write failed: %s", err)
+	}
+	if _, ok := cache.Read(key); ok {
+		t.Error("read succeeded with an incomplete key")
+	}
+
+	// A nil cache must also be safe to use.
+	var nilCache *SchemaCache
+	if _, ok := nilCache.Read(key); ok {
+		t.Error("read on nil cache succeeded")
+	}
+	if err := nilCache.Write(key, testSchemaCacheResponse()); err != nil {
+		t.Fatalf("write on nil cache failed: %s", err)
+	}
+}
@@ -0,0 +1,29 @@
+package providers
+
+// BatchApplier is an optional extension interface that an Interface
+// implementation may additionally satisfy to accept several resource change
+// applications in a single call.
+//
+// Terraform Core coalesces compatible Create and Update operations for the
+// same resource type into one ApplyResourceChangeBatch call when a provider
+// implements this interface, which can significantly reduce round trips for
+// backends whose API supports bulk writes. Providers that do not implement
+// it receive one ApplyResourceChange call per resource instance, as usual.
+type BatchApplier interface {
+	// ApplyResourceChangeBatch applies each of the changes in the request
+	// and returns one result per change, in the same order.
+	ApplyResourceChangeBatch(ApplyResourceChangeBatchRequest) ApplyResourceChangeBatchResponse
+}
+
+type ApplyResourceChangeBatchRequest struct {
+	// Changes are the individual changes to apply. All of the changes in
+	// a single batch are for the same resource type and were planned by
+	// the same provider configuration.
+	Changes []ApplyResourceChangeRequest
+}
+
+type ApplyResourceChangeBatchResponse struct {
+	// Results are the outcomes of the individual changes, corresponding
+	// positionally with Changes in the request.
+	Results []ApplyResourceChangeResponse
+}
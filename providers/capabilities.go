@@ -0,0 +1,45 @@
+package providers
+
+// Capabilities describes the optional parts of the provider wire protocol
+// that a particular provider supports, based on the plugin protocol version
+// negotiated at handshake time.
+//
+// Core uses these flags to decide between legacy shims and newer request
+// flows on a per-provider basis, so that old and new providers can coexist
+// within the same configuration.
+type Capabilities struct {
+	// ProtocolVersion is the major plugin protocol version that was
+	// negotiated with the provider at handshake time. Zero means the
+	// version is unknown, which callers must treat the same as the
+	// baseline version 5.
+	ProtocolVersion int
+
+	// PlannedDestroy is true if the provider expects to be consulted via
+	// PlanResourceChange when planning to destroy an object, rather than
+	// having core construct the destroy change on its own.
+	PlannedDestroy bool
+
+	// Marks is true if the provider can accept and preserve cty marks,
+	// such as value sensitivity, on values sent over the wire.
+	Marks bool
+
+	// MultiSession is true if the provider's plugin process can host
+	// multiple independent configure sessions at once, allowing several
+	// provider configurations to share a single process.
+	MultiSession bool
+}
+
+// CapabilitiesForProtocolVersion returns the capabilities implied by a
+// negotiated plugin protocol version, for providers that don't advertise
+// their capabilities explicitly.
+func CapabilitiesForProtocolVersion(version int) Capabilities {
+	caps := Capabilities{
+		ProtocolVersion: version,
+	}
+	if version >= 6 {
+		caps.PlannedDestroy = true
+		caps.Marks = true
+		caps.MultiSession = true
+	}
+	return caps
+}
@@ -79,6 +79,10 @@ type GetSchemaResponse struct {
 	// DataSources maps the data source name to that data source's schema.
 	DataSources map[string]Schema
 
+	// Capabilities describes the optional protocol features this provider
+	// supports, based on the protocol version negotiated at handshake time.
+	Capabilities Capabilities
+
 	// Diagnostics contains any warnings or errors from the method call.
 	Diagnostics tfdiags.Diagnostics
 }
@@ -180,18 +184,39 @@ type ReadResourceRequest struct {
 	// Private is an opaque blob that will be stored in state along with the
 	// resource. It is intended only for interpretation by the provider itself.
 	Private []byte
+
+	// PriorVersionToken is the opaque version token the provider returned
+	// for this object on a previous read or apply, if any. A provider that
+	// tracks object versions remotely can compare it against the current
+	// remote version and answer with Unchanged set rather than re-reading
+	// the whole object. An empty token means no version is recorded, in
+	// which case the provider must do a full read.
+	PriorVersionToken string
 }
 
 type ReadResourceResponse struct {
 	// NewState contains the current state of the resource.
 	NewState cty.Value
 
+	// Unchanged, if true, indicates that the remote object still matches
+	// the version identified by the PriorVersionToken in the request, and
+	// so NewState has been left as cty.NilVal. Providers must never set
+	// this when the request had no token.
+	Unchanged bool
+
 	// Diagnostics contains any warnings or errors from the method call.
 	Diagnostics tfdiags.Diagnostics
 
 	// Private is an opaque blob that will be stored in state along with the
 	// resource. It is intended only for interpretation by the provider itself.
 	Private []byte
+
+	// VersionToken is an optional opaque token identifying the version of
+	// the remote object that NewState describes. Terraform stores it in
+	// state and offers it back on the next read so that the provider can
+	// detect an unchanged object cheaply. Providers that don't track
+	// versions leave it empty.
+	VersionToken string
 }
 
 type PlanResourceChangeRequest struct {
@@ -274,6 +299,11 @@ type ApplyResourceChangeResponse struct {
 	// resource. It is intended only for interpretation by the provider itself.
 	Private []byte
 
+	// VersionToken is an optional opaque token identifying the version of
+	// the remote object after the change, with the same meaning as the
+	// field of the same name on ReadResourceResponse.
+	VersionToken string
+
 	// Diagnostics contains any warnings or errors from the method call.
 	Diagnostics tfdiags.Diagnostics
 
@@ -0,0 +1,54 @@
+package states
+
+// CheckStatus describes the most recently-determined outcome of a
+// configuration-defined check.
+type CheckStatus rune
+
+//go:generate go run golang.org/x/tools/cmd/stringer -type CheckStatus
+
+const (
+	// CheckStatusUnknown means that the check's conditions could not be
+	// fully evaluated, for example because they referred to values that
+	// were not yet known during the operation that recorded the result.
+	CheckStatusUnknown CheckStatus = 0
+
+	// CheckStatusPass means that all of the check's assertions succeeded.
+	CheckStatusPass CheckStatus = 'P'
+
+	// CheckStatusFail means that at least one of the check's assertions
+	// returned false.
+	CheckStatusFail CheckStatus = 'F'
+
+	// CheckStatusError means that at least one of the check's assertions
+	// was itself invalid, which is different than CheckStatusFail in that
+	// it suggests a configuration error rather than a problem with the
+	// objects being checked.
+	CheckStatusError CheckStatus = 'E'
+)
+
+// CheckResult records the outcome of a single "check" block from the most
+// recent operation that evaluated it, so that later operations can report
+// any problems that were detected.
+type CheckResult struct {
+	Status CheckStatus
+
+	// FailureMessages are the error messages from any assertions that did
+	// not pass, in the same order the assertions were declared.
+	FailureMessages []string
+}
+
+// DeepCopy returns a new CheckResult containing the same data as the
+// receiver but not aliasing any of the same memory.
+func (r *CheckResult) DeepCopy() *CheckResult {
+	if r == nil {
+		return nil
+	}
+	ret := &CheckResult{
+		Status: r.Status,
+	}
+	if r.FailureMessages != nil {
+		ret.FailureMessages = make([]string, len(r.FailureMessages))
+		copy(ret.FailureMessages, r.FailureMessages)
+	}
+	return ret
+}
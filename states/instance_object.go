@@ -1,6 +1,8 @@
 package states
 
 import (
+	"time"
+
 	"github.com/zclconf/go-cty/cty"
 	ctyjson "github.com/zclconf/go-cty/cty/json"
 
@@ -25,21 +27,54 @@ type ResourceInstanceObject struct {
 	// a provider can use it for retaining any necessary private state.
 	Private []byte
 
+	// VersionToken is an opaque token set by the provider to identify the
+	// version of the remote object this value was read from, if the
+	// provider tracks such versions. Terraform Core offers it back to the
+	// provider on the next refresh so that an unchanged object can be
+	// detected without a full read. An empty string means no version is
+	// recorded.
+	VersionToken string
+
+	// UniqueID is a stable opaque identifier assigned by Terraform Core when
+	// the remote object is first created, and retained unchanged for the
+	// rest of the object's life, including across updates, resource renames,
+	// and deposal. It allows external systems such as CMDBs and audit tools
+	// to track an object independently of its resource address. An empty
+	// string means no identifier has been assigned, which is always true for
+	// objects created before this metadata was introduced.
+	UniqueID string
+
 	// Status represents the "readiness" of the object as of the last time
 	// it was updated.
 	Status ObjectStatus
 
-	// Dependencies is a set of absolute address to other resources this
-	// instance dependeded on when it was applied. This is used to construct
-	// the dependency relationships for an object whose configuration is no
-	// longer available, such as if it has been removed from configuration
-	// altogether, or is now deposed.
-	Dependencies []addrs.AbsResource
+	// Dependencies is a set of addresses of the resources, as given in
+	// configuration, that this instance depended on when it was applied.
+	// This is used to construct the dependency relationships for an object
+	// whose configuration is no longer available, such as if it has been
+	// removed from configuration altogether, or is now deposed.
+	Dependencies []addrs.ConfigResource
 
 	// DependsOn corresponds to the deprecated `depends_on` field in the state.
 	// This field contained the configuration `depends_on` values, and some of
 	// the references from within a single module.
 	DependsOn []addrs.Referenceable
+
+	// HasDestroyProvisioners records whether the configuration that most
+	// recently created or updated this object included any destroy-time
+	// provisioners. We track this so that we can warn the user if the
+	// object is later destroyed without its configuration, since in that
+	// case the destroy-time provisioners cannot run.
+	HasDestroyProvisioners bool
+
+	// CreatedAt and UpdatedAt are timestamps recording when the remote object
+	// was first created and most recently modified by an apply operation,
+	// respectively. These are audit metadata maintained by Terraform Core and
+	// are not exposed for use in configuration expressions. A zero value
+	// means the timestamp is unknown, which is always true for objects
+	// created before this metadata was introduced.
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
 // ObjectStatus represents the status of a RemoteObject.
@@ -102,11 +137,16 @@ func (o *ResourceInstanceObject) Encode(ty cty.Type, schemaVersion uint64) (*Res
 	}
 
 	return &ResourceInstanceObjectSrc{
-		SchemaVersion: schemaVersion,
-		AttrsJSON:     src,
-		Private:       o.Private,
-		Status:        o.Status,
-		Dependencies:  o.Dependencies,
+		SchemaVersion:          schemaVersion,
+		AttrsJSON:              src,
+		Private:                o.Private,
+		VersionToken:           o.VersionToken,
+		UniqueID:               o.UniqueID,
+		Status:                 o.Status,
+		Dependencies:           o.Dependencies,
+		HasDestroyProvisioners: o.HasDestroyProvisioners,
+		CreatedAt:              o.CreatedAt,
+		UpdatedAt:              o.UpdatedAt,
 	}, nil
 }
 
@@ -1,6 +1,8 @@
 package states
 
 import (
+	"time"
+
 	"github.com/zclconf/go-cty/cty"
 	ctyjson "github.com/zclconf/go-cty/cty/json"
 
@@ -51,9 +53,14 @@ type ResourceInstanceObjectSrc struct {
 
 	// These fields all correspond to the fields of the same name on
 	// ResourceInstanceObject.
-	Private      []byte
-	Status       ObjectStatus
-	Dependencies []addrs.AbsResource
+	Private                []byte
+	VersionToken           string
+	UniqueID               string
+	Status                 ObjectStatus
+	Dependencies           []addrs.ConfigResource
+	HasDestroyProvisioners bool
+	CreatedAt              time.Time
+	UpdatedAt              time.Time
 	// deprecated
 	DependsOn []addrs.Referenceable
 }
@@ -85,11 +92,16 @@ func (os *ResourceInstanceObjectSrc) Decode(ty cty.Type) (*ResourceInstanceObjec
 	}
 
 	return &ResourceInstanceObject{
-		Value:        val,
-		Status:       os.Status,
-		Dependencies: os.Dependencies,
-		DependsOn:    os.DependsOn,
-		Private:      os.Private,
+		Value:                  val,
+		Status:                 os.Status,
+		Dependencies:           os.Dependencies,
+		DependsOn:              os.DependsOn,
+		Private:                os.Private,
+		VersionToken:           os.VersionToken,
+		UniqueID:               os.UniqueID,
+		HasDestroyProvisioners: os.HasDestroyProvisioners,
+		CreatedAt:              os.CreatedAt,
+		UpdatedAt:              os.UpdatedAt,
 	}, nil
 }
 
@@ -0,0 +1,197 @@
+package states
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/tfdiags"
+)
+
+// Merge attempts a three-way merge of two states that have diverged from a
+// common base state, as can happen when two operations race against the same
+// backend and each persists its own snapshot.
+//
+// Changes that affect disjoint parts of the state are combined: for each
+// resource instance and output value, a change made on only one side is
+// carried into the result, while parts unchanged on both sides are kept
+// as-is. If both sides changed the same resource instance or output value in
+// different ways then that is a true conflict, reported through the returned
+// diagnostics, and the local version is retained in the result so that the
+// caller can still inspect it.
+//
+// Only the current object for each resource instance participates in the
+// merge; deposed objects are carried over from the local state unchanged.
+// The returned state is always a newly-allocated value that shares no
+// mutable data with any of the arguments, and is meaningful only if the
+// diagnostics contain no errors.
+func Merge(base, local, remote *State) (*State, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+	if base == nil {
+		base = NewState()
+	}
+	if local == nil {
+		local = NewState()
+	}
+	if remote == nil {
+		remote = NewState()
+	}
+
+	merged := local.DeepCopy()
+	ss := merged.SyncWrapper()
+
+	for _, instAddr := range mergeInstanceAddrs(base, local, remote) {
+		baseObj := currentObjectSrc(base, instAddr)
+		localObj := currentObjectSrc(local, instAddr)
+		remoteObj := currentObjectSrc(remote, instAddr)
+
+		switch {
+		case objectSrcEqual(localObj, remoteObj):
+			// Both sides agree, so the local version already in the merged
+			// state is correct regardless of what the base recorded.
+		case objectSrcEqual(remoteObj, baseObj):
+			// Only the local side changed this instance; keep it.
+		case objectSrcEqual(localObj, baseObj):
+			// Only the remote side changed this instance, so we take the
+			// remote version.
+			if remoteObj == nil {
+				ss.ForgetResourceInstanceAll(instAddr)
+				ss.RemoveResourceIfEmpty(instAddr.ContainingResource())
+				continue
+			}
+			providerAddr := remote.Resource(instAddr.ContainingResource()).ProviderConfig
+			ss.SetResourceInstanceCurrent(instAddr, remoteObj.DeepCopy(), providerAddr)
+		default:
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Conflicting state changes",
+				fmt.Sprintf("Resource instance %s was changed by both operations since their common base snapshot, so the changes cannot be merged automatically. This conflict must be resolved manually.", instAddr),
+			))
+		}
+	}
+
+	for _, addr := range mergeOutputAddrs(base, local, remote) {
+		baseOV := outputValue(base, addr)
+		localOV := outputValue(local, addr)
+		remoteOV := outputValue(remote, addr)
+
+		switch {
+		case outputValueEqual(localOV, remoteOV):
+			// Both sides agree; nothing to do.
+		case outputValueEqual(remoteOV, baseOV):
+			// Only the local side changed this output; keep it.
+		case outputValueEqual(localOV, baseOV):
+			// Only the remote side changed this output.
+			if remoteOV == nil {
+				if ms := merged.Module(addr.Module); ms != nil {
+					ms.RemoveOutputValue(addr.OutputValue.Name)
+				}
+				continue
+			}
+			merged.EnsureModule(addr.Module).SetOutputValue(
+				addr.OutputValue.Name, remoteOV.Value, remoteOV.Sensitive,
+			)
+		default:
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Conflicting state changes",
+				fmt.Sprintf("Output value %s was changed by both operations since their common base snapshot, so the changes cannot be merged automatically. This conflict must be resolved manually.", addr),
+			))
+		}
+	}
+
+	return merged, diags
+}
+
+// mergeInstanceAddrs returns the union of the resource instance addresses
+// tracked in the given states, sorted so that Merge visits them in a
+// consistent order.
+func mergeInstanceAddrs(states ...*State) []addrs.AbsResourceInstance {
+	seen := make(map[string]addrs.AbsResourceInstance)
+	for _, state := range states {
+		for _, ms := range state.Modules {
+			for _, rs := range ms.Resources {
+				for key := range rs.Instances {
+					instAddr := rs.Addr.Instance(key).Absolute(ms.Addr)
+					seen[instAddr.String()] = instAddr
+				}
+			}
+		}
+	}
+
+	ret := make([]addrs.AbsResourceInstance, 0, len(seen))
+	for _, instAddr := range seen {
+		ret = append(ret, instAddr)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Less(ret[j])
+	})
+	return ret
+}
+
+// mergeOutputAddrs returns the union of the output value addresses tracked
+// in the given states, sorted by their string representation.
+func mergeOutputAddrs(states ...*State) []addrs.AbsOutputValue {
+	seen := make(map[string]addrs.AbsOutputValue)
+	for _, state := range states {
+		for _, ms := range state.Modules {
+			for name := range ms.OutputValues {
+				addr := addrs.OutputValue{Name: name}.Absolute(ms.Addr)
+				seen[addr.String()] = addr
+			}
+		}
+	}
+
+	ret := make([]addrs.AbsOutputValue, 0, len(seen))
+	for _, addr := range seen {
+		ret = append(ret, addr)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].String() < ret[j].String()
+	})
+	return ret
+}
+
+// currentObjectSrc returns the current object for the given resource
+// instance in the given state, or nil if the instance is not tracked or has
+// no current object.
+func currentObjectSrc(state *State, addr addrs.AbsResourceInstance) *ResourceInstanceObjectSrc {
+	is := state.ResourceInstance(addr)
+	if is == nil {
+		return nil
+	}
+	return is.Current
+}
+
+// objectSrcEqual returns true if the two object records are equivalent for
+// merging purposes, including when both are nil.
+func objectSrcEqual(a, b *ResourceInstanceObjectSrc) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Status == b.Status &&
+		bytes.Equal(a.AttrsJSON, b.AttrsJSON) &&
+		reflect.DeepEqual(a.AttrsFlat, b.AttrsFlat) &&
+		bytes.Equal(a.Private, b.Private)
+}
+
+// outputValue returns the output value with the given address in the given
+// state, or nil if it is not recorded.
+func outputValue(state *State, addr addrs.AbsOutputValue) *OutputValue {
+	ms := state.Module(addr.Module)
+	if ms == nil {
+		return nil
+	}
+	return ms.OutputValues[addr.OutputValue.Name]
+}
+
+// outputValueEqual returns true if the two output value records are
+// equivalent, including when both are nil.
+func outputValueEqual(a, b *OutputValue) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Sensitive == b.Sensitive && a.Value.RawEquals(b.Value)
+}
@@ -0,0 +1,152 @@
+package states
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/addrs"
+)
+
+func testMergeBaseState() *State {
+	return BuildState(func(s *SyncState) {
+		s.SetResourceInstanceCurrent(
+			mergeTestInstanceAddr("a"),
+			&ResourceInstanceObjectSrc{
+				Status:    ObjectReady,
+				AttrsJSON: []byte(`{"id":"a"}`),
+			},
+			mergeTestProviderAddr(),
+		)
+		s.SetResourceInstanceCurrent(
+			mergeTestInstanceAddr("b"),
+			&ResourceInstanceObjectSrc{
+				Status:    ObjectReady,
+				AttrsJSON: []byte(`{"id":"b"}`),
+			},
+			mergeTestProviderAddr(),
+		)
+		s.SetOutputValue(
+			addrs.OutputValue{Name: "greeting"}.Absolute(addrs.RootModuleInstance),
+			cty.StringVal("hello"), false,
+		)
+	})
+}
+
+func mergeTestInstanceAddr(name string) addrs.AbsResourceInstance {
+	return addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_thing",
+		Name: name,
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+}
+
+func mergeTestProviderAddr() addrs.AbsProviderConfig {
+	return addrs.AbsProviderConfig{
+		Provider: addrs.NewLegacyProvider("test"),
+		Module:   addrs.RootModuleInstance,
+	}
+}
+
+func TestMerge_disjointChanges(t *testing.T) {
+	base := testMergeBaseState()
+
+	local := base.DeepCopy()
+	local.SyncWrapper().SetResourceInstanceCurrent(
+		mergeTestInstanceAddr("a"),
+		&ResourceInstanceObjectSrc{
+			Status:    ObjectReady,
+			AttrsJSON: []byte(`{"id":"a","extra":"local"}`),
+		},
+		mergeTestProviderAddr(),
+	)
+
+	remote := base.DeepCopy()
+	remote.SyncWrapper().SetResourceInstanceCurrent(
+		mergeTestInstanceAddr("b"),
+		&ResourceInstanceObjectSrc{
+			Status:    ObjectReady,
+			AttrsJSON: []byte(`{"id":"b","extra":"remote"}`),
+		},
+		mergeTestProviderAddr(),
+	)
+	remote.RootModule().SetOutputValue("greeting", cty.StringVal("howdy"), false)
+
+	merged, diags := Merge(base, local, remote)
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+
+	aObj := merged.ResourceInstance(mergeTestInstanceAddr("a")).Current
+	if got, want := string(aObj.AttrsJSON), `"local"`; !strings.Contains(got, want) {
+		t.Errorf("wrong object for a\ngot:  %s\nwant substring: %s", got, want)
+	}
+	bObj := merged.ResourceInstance(mergeTestInstanceAddr("b")).Current
+	if got, want := string(bObj.AttrsJSON), `"remote"`; !strings.Contains(got, want) {
+		t.Errorf("wrong object for b\ngot:  %s\nwant substring: %s", got, want)
+	}
+	if got, want := merged.RootModule().OutputValues["greeting"].Value, cty.StringVal("howdy"); !want.RawEquals(got) {
+		t.Errorf("wrong output value\ngot:  %#v\nwant: %#v", got, want)
+	}
+}
+
+func TestMerge_remoteRemoval(t *testing.T) {
+	base := testMergeBaseState()
+	local := base.DeepCopy()
+
+	remote := base.DeepCopy()
+	ss := remote.SyncWrapper()
+	ss.ForgetResourceInstanceAll(mergeTestInstanceAddr("b"))
+	ss.RemoveResourceIfEmpty(mergeTestInstanceAddr("b").ContainingResource())
+
+	merged, diags := Merge(base, local, remote)
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+
+	if is := merged.ResourceInstance(mergeTestInstanceAddr("b")); is != nil {
+		t.Error("instance b still present in merged state; should have been removed")
+	}
+	if is := merged.ResourceInstance(mergeTestInstanceAddr("a")); is == nil || !is.HasCurrent() {
+		t.Error("instance a missing from merged state")
+	}
+}
+
+func TestMerge_conflict(t *testing.T) {
+	base := testMergeBaseState()
+
+	local := base.DeepCopy()
+	local.SyncWrapper().SetResourceInstanceCurrent(
+		mergeTestInstanceAddr("a"),
+		&ResourceInstanceObjectSrc{
+			Status:    ObjectReady,
+			AttrsJSON: []byte(`{"id":"a","extra":"local"}`),
+		},
+		mergeTestProviderAddr(),
+	)
+
+	remote := base.DeepCopy()
+	remote.SyncWrapper().SetResourceInstanceCurrent(
+		mergeTestInstanceAddr("a"),
+		&ResourceInstanceObjectSrc{
+			Status:    ObjectReady,
+			AttrsJSON: []byte(`{"id":"a","extra":"remote"}`),
+		},
+		mergeTestProviderAddr(),
+	)
+
+	merged, diags := Merge(base, local, remote)
+	if !diags.HasErrors() {
+		t.Fatal("succeeded; want conflict error")
+	}
+	if got, want := diags.Err().Error(), "Conflicting state changes"; !strings.Contains(got, want) {
+		t.Errorf("wrong error\ngot:  %s\nwant substring: %s", got, want)
+	}
+
+	// The local version must be retained in the merged state for inspection.
+	aObj := merged.ResourceInstance(mergeTestInstanceAddr("a")).Current
+	if got, want := string(aObj.AttrsJSON), `"local"`; !strings.Contains(got, want) {
+		t.Errorf("wrong object for a\ngot:  %s\nwant substring: %s", got, want)
+	}
+}
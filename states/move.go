@@ -0,0 +1,129 @@
+package states
+
+import (
+	"github.com/hashicorp/terraform/addrs"
+)
+
+// MoveAbsResource moves the whole resource at the given source address,
+// including all of its instances, to the given target address.
+//
+// The move succeeds only if there is currently a resource at the source
+// address and nothing at the target address. The return value reports
+// whether the move was performed.
+func (s *State) MoveAbsResource(from, to addrs.AbsResource) bool {
+	fromMod := s.Module(from.Module)
+	if fromMod == nil {
+		return false
+	}
+	rs := fromMod.Resource(from.Resource)
+	if rs == nil {
+		return false
+	}
+
+	toMod := s.Module(to.Module)
+	if toMod != nil && toMod.Resource(to.Resource) != nil {
+		return false
+	}
+	if toMod == nil {
+		toMod = s.EnsureModule(to.Module)
+	}
+
+	delete(fromMod.Resources, from.Resource.String())
+	rs.Addr = to.Resource
+	toMod.Resources[to.Resource.String()] = rs
+	return true
+}
+
+// MoveAbsResourceInstance moves the resource instance at the given source
+// address to the given target address, retaining its current and any
+// deposed objects.
+//
+// The move succeeds only if there is currently an instance at the source
+// address and no instance at the target address. When the source resource
+// has no instances left, its "husk" is removed. The return value reports
+// whether the move was performed.
+func (s *State) MoveAbsResourceInstance(from, to addrs.AbsResourceInstance) bool {
+	fromMod := s.Module(from.Module)
+	if fromMod == nil {
+		return false
+	}
+	fromRes := fromMod.Resource(from.Resource.Resource)
+	if fromRes == nil {
+		return false
+	}
+	is := fromRes.Instance(from.Resource.Key)
+	if is == nil {
+		return false
+	}
+
+	toMod := s.Module(to.Module)
+	if toMod != nil {
+		if toRes := toMod.Resource(to.Resource.Resource); toRes != nil {
+			if toRes.Instance(to.Resource.Key) != nil {
+				return false
+			}
+		}
+	}
+	if toMod == nil {
+		toMod = s.EnsureModule(to.Module)
+	}
+
+	toRes := toMod.Resource(to.Resource.Resource)
+	if toRes == nil {
+		toMod.SetResourceMeta(to.Resource.Resource, fromRes.EachMode, fromRes.ProviderConfig)
+		toRes = toMod.Resource(to.Resource.Resource)
+	}
+
+	delete(fromRes.Instances, from.Resource.Key)
+	toRes.Instances[to.Resource.Key] = is
+	if len(fromRes.Instances) == 0 {
+		fromMod.RemoveResource(from.Resource.Resource)
+	}
+	return true
+}
+
+// MoveModuleInstance moves the module instance at the given source address,
+// along with any descendent module instances, to the given target address.
+//
+// The move succeeds only if there is currently at least one module at or
+// under the source address and no module at or under the target address.
+// The return value reports whether the move was performed.
+func (s *State) MoveModuleInstance(from, to addrs.ModuleInstance) bool {
+	if from.IsRoot() || to.IsRoot() {
+		// The root module can never be moved.
+		return false
+	}
+
+	var moving []*Module
+	for _, ms := range s.Modules {
+		if from.Equal(ms.Addr) || from.IsAncestor(ms.Addr) {
+			moving = append(moving, ms)
+		}
+		if to.Equal(ms.Addr) || to.IsAncestor(ms.Addr) {
+			return false // something is already in the way at the target
+		}
+	}
+	if len(moving) == 0 {
+		return false
+	}
+
+	for _, ms := range moving {
+		delete(s.Modules, ms.Addr.String())
+		newAddr := append(addrs.ModuleInstance{}, to...)
+		newAddr = append(newAddr, ms.Addr[len(from):]...)
+		ms.Addr = newAddr
+		for _, rs := range ms.Resources {
+			// A provider configuration that lived inside the moved module
+			// moves along with it; inherited configurations from elsewhere
+			// keep their original addresses.
+			pcMod := rs.ProviderConfig.Module
+			if from.Equal(pcMod) || from.IsAncestor(pcMod) {
+				newPCMod := append(addrs.ModuleInstance{}, to...)
+				newPCMod = append(newPCMod, pcMod[len(from):]...)
+				rs.ProviderConfig.Module = newPCMod
+			}
+		}
+		s.Modules[newAddr.String()] = ms
+	}
+	return true
+}
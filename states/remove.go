@@ -0,0 +1,166 @@
+package states
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/addrs"
+)
+
+// RemovalImpact describes the other contents of a state that refer to a
+// resource instance that is about to be removed, so that callers can warn
+// about references that would be left dangling by the removal.
+type RemovalImpact struct {
+	// DependentResources are resources elsewhere in the state that have at
+	// least one instance object recording a dependency on the resource
+	// containing the instance being removed.
+	DependentResources []addrs.AbsResource
+
+	// DependentOutputs are output values whose stored values appear to be
+	// derived from the attributes of the instance being removed. The state
+	// does not record output value dependencies directly, so this is a
+	// best-effort detection based on matching attribute values, and it may
+	// miss outputs whose expressions transform the source values.
+	DependentOutputs []addrs.AbsOutputValue
+}
+
+// Empty returns true if the receiver records no dependent state contents.
+func (i *RemovalImpact) Empty() bool {
+	return len(i.DependentResources) == 0 && len(i.DependentOutputs) == 0
+}
+
+// RemovalImpact inspects the state for other contents that refer to the
+// given resource instance, returning a description of what would be left
+// dangling if the instance were removed.
+//
+// The state is not modified; callers that decide to go ahead with a removal
+// should then use ForgetResourceInstanceAll or similar as normal.
+func (s *State) RemovalImpact(addr addrs.AbsResourceInstance) *RemovalImpact {
+	ret := &RemovalImpact{}
+	target := addr.ContainingResource()
+	targetConfig := target.Config()
+
+	seenResources := make(map[string]bool)
+	for _, ms := range s.Modules {
+		for _, rs := range ms.Resources {
+			rsAddr := rs.Addr.Absolute(ms.Addr)
+			if rsAddr.Equal(target) {
+				continue
+			}
+			if seenResources[rsAddr.String()] {
+				continue
+			}
+			for _, is := range rs.Instances {
+				for _, obj := range instanceObjects(is) {
+					for _, dep := range obj.Dependencies {
+						if dep.Equal(targetConfig) {
+							seenResources[rsAddr.String()] = true
+							ret.DependentResources = append(ret.DependentResources, rsAddr)
+						}
+					}
+					if seenResources[rsAddr.String()] {
+						break
+					}
+				}
+				if seenResources[rsAddr.String()] {
+					break
+				}
+			}
+		}
+	}
+
+	candidates := s.removedInstanceLeafValues(addr)
+	if len(candidates) > 0 {
+		for _, ms := range s.Modules {
+			for name, os := range ms.OutputValues {
+				derived := false
+				cty.Walk(os.Value, func(path cty.Path, val cty.Value) (bool, error) {
+					if val.IsKnown() && !val.IsNull() && val.Type() == cty.String && candidates[val.AsString()] {
+						derived = true
+					}
+					return !derived, nil
+				})
+				if derived {
+					ret.DependentOutputs = append(ret.DependentOutputs, addrs.OutputValue{Name: name}.Absolute(ms.Addr))
+				}
+			}
+		}
+	}
+
+	sort.Slice(ret.DependentResources, func(i, j int) bool {
+		return ret.DependentResources[i].String() < ret.DependentResources[j].String()
+	})
+	sort.Slice(ret.DependentOutputs, func(i, j int) bool {
+		return ret.DependentOutputs[i].String() < ret.DependentOutputs[j].String()
+	})
+
+	return ret
+}
+
+// removedInstanceLeafValues collects the primitive string attribute values
+// of all objects associated with the given resource instance, for use in
+// detecting output values that appear to be derived from it.
+func (s *State) removedInstanceLeafValues(addr addrs.AbsResourceInstance) map[string]bool {
+	ret := make(map[string]bool)
+
+	ms := s.Module(addr.Module)
+	if ms == nil {
+		return ret
+	}
+	rs := ms.Resource(addr.Resource.Resource)
+	if rs == nil {
+		return ret
+	}
+	is := rs.Instances[addr.Resource.Key]
+	if is == nil {
+		return ret
+	}
+
+	for _, obj := range instanceObjects(is) {
+		if len(obj.AttrsJSON) > 0 {
+			var raw interface{}
+			if err := json.Unmarshal(obj.AttrsJSON, &raw); err == nil {
+				collectLeafStrings(raw, ret)
+			}
+		}
+		for _, v := range obj.AttrsFlat {
+			if v != "" {
+				ret[v] = true
+			}
+		}
+	}
+
+	return ret
+}
+
+func collectLeafStrings(raw interface{}, into map[string]bool) {
+	switch tv := raw.(type) {
+	case string:
+		if tv != "" {
+			into[tv] = true
+		}
+	case []interface{}:
+		for _, ev := range tv {
+			collectLeafStrings(ev, into)
+		}
+	case map[string]interface{}:
+		for _, ev := range tv {
+			collectLeafStrings(ev, into)
+		}
+	}
+}
+
+// instanceObjects returns all of the object records associated with the
+// given resource instance, both current and deposed.
+func instanceObjects(is *ResourceInstance) []*ResourceInstanceObjectSrc {
+	var ret []*ResourceInstanceObjectSrc
+	if is.Current != nil {
+		ret = append(ret, is.Current)
+	}
+	for _, obj := range is.Deposed {
+		ret = append(ret, obj)
+	}
+	return ret
+}
@@ -0,0 +1,83 @@
+package states
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/addrs"
+)
+
+func TestStateRemovalImpact(t *testing.T) {
+	state := NewState()
+	rootModule := state.RootModule()
+
+	bazAddr := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_thing",
+		Name: "baz",
+	}
+	rootModule.SetResourceInstanceCurrent(
+		bazAddr.Instance(addrs.NoKey),
+		&ResourceInstanceObjectSrc{
+			Status:    ObjectReady,
+			AttrsJSON: []byte(`{"id":"baz-id","nested":{"value":"woozles"}}`),
+		},
+		addrs.AbsProviderConfig{
+			Provider: addrs.NewDefaultProvider("test"),
+			Module:   addrs.RootModuleInstance,
+		},
+	)
+
+	barAddr := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_thing",
+		Name: "bar",
+	}
+	rootModule.SetResourceInstanceCurrent(
+		barAddr.Instance(addrs.NoKey),
+		&ResourceInstanceObjectSrc{
+			Status:    ObjectReady,
+			AttrsJSON: []byte(`{"id":"bar-id"}`),
+			Dependencies: []addrs.ConfigResource{
+				bazAddr.InModule(addrs.RootModule),
+			},
+		},
+		addrs.AbsProviderConfig{
+			Provider: addrs.NewDefaultProvider("test"),
+			Module:   addrs.RootModuleInstance,
+		},
+	)
+
+	rootModule.SetOutputValue("baz_id", cty.StringVal("baz-id"), false)
+	rootModule.SetOutputValue("unrelated", cty.StringVal("hello"), false)
+
+	t.Run("instance with dependents", func(t *testing.T) {
+		impact := state.RemovalImpact(bazAddr.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance))
+		if impact.Empty() {
+			t.Fatal("impact is empty; want dependent resource and output")
+		}
+
+		wantResources := []addrs.AbsResource{
+			barAddr.Absolute(addrs.RootModuleInstance),
+		}
+		if !reflect.DeepEqual(impact.DependentResources, wantResources) {
+			t.Errorf("wrong dependent resources %#v; want %#v", impact.DependentResources, wantResources)
+		}
+
+		wantOutputs := []addrs.AbsOutputValue{
+			addrs.OutputValue{Name: "baz_id"}.Absolute(addrs.RootModuleInstance),
+		}
+		if !reflect.DeepEqual(impact.DependentOutputs, wantOutputs) {
+			t.Errorf("wrong dependent outputs %#v; want %#v", impact.DependentOutputs, wantOutputs)
+		}
+	})
+
+	t.Run("instance without dependents", func(t *testing.T) {
+		impact := state.RemovalImpact(barAddr.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance))
+		if !impact.Empty() {
+			t.Fatalf("impact is not empty: %#v", impact)
+		}
+	})
+}
@@ -23,6 +23,11 @@ type State struct {
 	// Modules contains the state for each module. The keys in this map are
 	// an implementation detail and must not be used by outside callers.
 	Modules map[string]*Module
+
+	// CheckResults records the most recent outcome of each configuration-
+	// defined "check" block, keyed by the string representation of the
+	// check's absolute address.
+	CheckResults map[string]*CheckResult
 }
 
 // NewState constructs a minimal empty state, containing an empty root module.
@@ -30,7 +35,8 @@ func NewState() *State {
 	modules := map[string]*Module{}
 	modules[addrs.RootModuleInstance.String()] = NewModule(addrs.RootModuleInstance)
 	return &State{
-		Modules: modules,
+		Modules:      modules,
+		CheckResults: map[string]*CheckResult{},
 	}
 }
 
@@ -166,6 +172,24 @@ func (s *State) LocalValue(addr addrs.AbsLocalValue) cty.Value {
 	return ms.LocalValues[addr.LocalValue.Name]
 }
 
+// CheckResult returns the recorded result for the check block with the
+// given address, or nil if no result is recorded for it.
+func (s *State) CheckResult(addr addrs.AbsCheck) *CheckResult {
+	if s == nil {
+		return nil
+	}
+	return s.CheckResults[addr.String()]
+}
+
+// SetCheckResult records the result for the check block with the given
+// address, replacing any previously-recorded result for the same check.
+func (s *State) SetCheckResult(addr addrs.AbsCheck, result *CheckResult) {
+	if s.CheckResults == nil {
+		s.CheckResults = map[string]*CheckResult{}
+	}
+	s.CheckResults[addr.String()] = result
+}
+
 // ProviderAddrs returns a list of all of the provider configuration addresses
 // referenced throughout the receiving state.
 //
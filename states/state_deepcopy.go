@@ -28,8 +28,13 @@ func (s *State) DeepCopy() *State {
 	for k, m := range s.Modules {
 		modules[k] = m.DeepCopy()
 	}
+	checkResults := make(map[string]*CheckResult, len(s.CheckResults))
+	for k, r := range s.CheckResults {
+		checkResults[k] = r.DeepCopy()
+	}
 	return &State{
-		Modules: modules,
+		Modules:      modules,
+		CheckResults: checkResults,
 	}
 }
 
@@ -153,9 +158,9 @@ func (obj *ResourceInstanceObjectSrc) DeepCopy() *ResourceInstanceObjectSrc {
 
 	// Some addrs.Referencable implementations are technically mutable, but
 	// we treat them as immutable by convention and so we don't deep-copy here.
-	var dependencies []addrs.AbsResource
+	var dependencies []addrs.ConfigResource
 	if obj.Dependencies != nil {
-		dependencies = make([]addrs.AbsResource, len(obj.Dependencies))
+		dependencies = make([]addrs.ConfigResource, len(obj.Dependencies))
 		copy(dependencies, obj.Dependencies)
 	}
 
@@ -166,13 +171,18 @@ func (obj *ResourceInstanceObjectSrc) DeepCopy() *ResourceInstanceObjectSrc {
 	}
 
 	return &ResourceInstanceObjectSrc{
-		Status:        obj.Status,
-		SchemaVersion: obj.SchemaVersion,
-		Private:       private,
-		AttrsFlat:     attrsFlat,
-		AttrsJSON:     attrsJSON,
-		Dependencies:  dependencies,
-		DependsOn:     dependsOn,
+		Status:                 obj.Status,
+		SchemaVersion:          obj.SchemaVersion,
+		Private:                private,
+		VersionToken:           obj.VersionToken,
+		UniqueID:               obj.UniqueID,
+		AttrsFlat:              attrsFlat,
+		AttrsJSON:              attrsJSON,
+		Dependencies:           dependencies,
+		DependsOn:              dependsOn,
+		HasDestroyProvisioners: obj.HasDestroyProvisioners,
+		CreatedAt:              obj.CreatedAt,
+		UpdatedAt:              obj.UpdatedAt,
 	}
 }
 
@@ -197,17 +207,22 @@ func (obj *ResourceInstanceObject) DeepCopy() *ResourceInstanceObject {
 
 	// Some addrs.Referenceable implementations are technically mutable, but
 	// we treat them as immutable by convention and so we don't deep-copy here.
-	var dependencies []addrs.AbsResource
+	var dependencies []addrs.ConfigResource
 	if obj.Dependencies != nil {
-		dependencies = make([]addrs.AbsResource, len(obj.Dependencies))
+		dependencies = make([]addrs.ConfigResource, len(obj.Dependencies))
 		copy(dependencies, obj.Dependencies)
 	}
 
 	return &ResourceInstanceObject{
-		Value:        obj.Value,
-		Status:       obj.Status,
-		Private:      private,
-		Dependencies: dependencies,
+		Value:                  obj.Value,
+		Status:                 obj.Status,
+		Private:                private,
+		VersionToken:           obj.VersionToken,
+		UniqueID:               obj.UniqueID,
+		Dependencies:           dependencies,
+		HasDestroyProvisioners: obj.HasDestroyProvisioners,
+		CreatedAt:              obj.CreatedAt,
+		UpdatedAt:              obj.UpdatedAt,
 	}
 }
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"sort"
+	"time"
 
 	version "github.com/hashicorp/go-version"
 	ctyjson "github.com/zclconf/go-cty/cty/json"
@@ -188,6 +189,39 @@ func prepareStateV4(sV4 *stateV4) (*File, tfdiags.Diagnostics) {
 				obj.Private = raw
 			}
 
+			obj.VersionToken = isV4.VersionToken
+			obj.UniqueID = isV4.UniqueID
+			obj.HasDestroyProvisioners = isV4.DestroyProvisioners
+
+			{
+				// Timestamps are optional, since objects created by earlier
+				// Terraform versions will not have them recorded.
+				if raw := isV4.CreatedAt; raw != "" {
+					t, err := time.Parse(time.RFC3339, raw)
+					if err != nil {
+						diags = diags.Append(tfdiags.Sourceless(
+							tfdiags.Error,
+							"Invalid resource instance metadata in state",
+							fmt.Sprintf("Instance %s has invalid creation timestamp %q.", instAddr.Absolute(moduleAddr), raw),
+						))
+					} else {
+						obj.CreatedAt = t
+					}
+				}
+				if raw := isV4.UpdatedAt; raw != "" {
+					t, err := time.Parse(time.RFC3339, raw)
+					if err != nil {
+						diags = diags.Append(tfdiags.Sourceless(
+							tfdiags.Error,
+							"Invalid resource instance metadata in state",
+							fmt.Sprintf("Instance %s has invalid modification timestamp %q.", instAddr.Absolute(moduleAddr), raw),
+						))
+					} else {
+						obj.UpdatedAt = t
+					}
+				}
+			}
+
 			{
 				// Allow both the deprecated `depends_on` and new
 				// `dependencies` to coexist for now so resources can be
@@ -218,14 +252,18 @@ func prepareStateV4(sV4 *stateV4) (*File, tfdiags.Diagnostics) {
 
 			{
 				depsRaw := isV4.Dependencies
-				deps := make([]addrs.AbsResource, 0, len(depsRaw))
+				deps := make([]addrs.ConfigResource, 0, len(depsRaw))
 				for _, depRaw := range depsRaw {
+					// Parse as a module-instance-qualified address and then
+					// discard any module instance keys, so that entries
+					// recorded by earlier versions in the expanded form are
+					// upgraded to configuration addresses.
 					addr, addrDiags := addrs.ParseAbsResourceStr(depRaw)
 					diags = diags.Append(addrDiags)
 					if addrDiags.HasErrors() {
 						continue
 					}
-					deps = append(deps, addr)
+					deps = append(deps, addr.Config())
 				}
 				obj.Dependencies = deps
 			}
@@ -309,6 +347,30 @@ func prepareStateV4(sV4 *stateV4) (*File, tfdiags.Diagnostics) {
 		}
 	}
 
+	for _, crV4 := range sV4.CheckResults {
+		result := &states.CheckResult{
+			FailureMessages: crV4.FailureMessages,
+		}
+		switch crV4.Status {
+		case "pass":
+			result.Status = states.CheckStatusPass
+		case "fail":
+			result.Status = states.CheckStatusFail
+		case "error":
+			result.Status = states.CheckStatusError
+		case "unknown":
+			result.Status = states.CheckStatusUnknown
+		default:
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Invalid check result in state",
+				fmt.Sprintf("Check result for %q has status %q, which is unsupported.", crV4.ObjectAddr, crV4.Status),
+			))
+			continue
+		}
+		state.CheckResults[crV4.ObjectAddr] = result
+	}
+
 	file.State = state
 	return file, diags
 }
@@ -437,6 +499,32 @@ func writeStateV4(file *File, w io.Writer) tfdiags.Diagnostics {
 		}
 	}
 
+	for addr, result := range file.State.CheckResults {
+		var status string
+		switch result.Status {
+		case states.CheckStatusPass:
+			status = "pass"
+		case states.CheckStatusFail:
+			status = "fail"
+		case states.CheckStatusError:
+			status = "error"
+		case states.CheckStatusUnknown:
+			status = "unknown"
+		default:
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Failed to serialize check result in state",
+				fmt.Sprintf("Check result for %q has status %s, which cannot be saved in state.", addr, result.Status),
+			))
+			continue
+		}
+		sV4.CheckResults = append(sV4.CheckResults, checkResultV4{
+			ObjectAddr:      addr,
+			Status:          status,
+			FailureMessages: result.FailureMessages,
+		})
+	}
+
 	sV4.normalize()
 
 	src, err := json.MarshalIndent(sV4, "", "  ")
@@ -512,16 +600,29 @@ func appendInstanceObjectStateV4(rs *states.Resource, is *states.ResourceInstanc
 		}
 	}
 
+	var createdAt, updatedAt string
+	if !obj.CreatedAt.IsZero() {
+		createdAt = obj.CreatedAt.UTC().Format(time.RFC3339)
+	}
+	if !obj.UpdatedAt.IsZero() {
+		updatedAt = obj.UpdatedAt.UTC().Format(time.RFC3339)
+	}
+
 	return append(isV4s, instanceObjectStateV4{
-		IndexKey:       rawKey,
-		Deposed:        string(deposed),
-		Status:         status,
-		SchemaVersion:  obj.SchemaVersion,
-		AttributesFlat: obj.AttrsFlat,
-		AttributesRaw:  obj.AttrsJSON,
-		PrivateRaw:     privateRaw,
-		Dependencies:   deps,
-		DependsOn:      depOn,
+		IndexKey:            rawKey,
+		Deposed:             string(deposed),
+		Status:              status,
+		SchemaVersion:       obj.SchemaVersion,
+		AttributesFlat:      obj.AttrsFlat,
+		AttributesRaw:       obj.AttrsJSON,
+		PrivateRaw:          privateRaw,
+		VersionToken:        obj.VersionToken,
+		UniqueID:            obj.UniqueID,
+		Dependencies:        deps,
+		DependsOn:           depOn,
+		DestroyProvisioners: obj.HasDestroyProvisioners,
+		CreatedAt:           createdAt,
+		UpdatedAt:           updatedAt,
 	}), diags
 }
 
@@ -532,6 +633,7 @@ type stateV4 struct {
 	Lineage          string                   `json:"lineage"`
 	RootOutputs      map[string]outputStateV4 `json:"outputs"`
 	Resources        []resourceStateV4        `json:"resources"`
+	CheckResults     []checkResultV4          `json:"check_results,omitempty"`
 }
 
 // normalize makes some in-place changes to normalize the way items are
@@ -542,6 +644,9 @@ func (s *stateV4) normalize() {
 	for _, rs := range s.Resources {
 		sort.Stable(sortInstancesV4(rs.Instances))
 	}
+	sort.Slice(s.CheckResults, func(i, j int) bool {
+		return s.CheckResults[i].ObjectAddr < s.CheckResults[j].ObjectAddr
+	})
 }
 
 type outputStateV4 struct {
@@ -550,6 +655,12 @@ type outputStateV4 struct {
 	Sensitive    bool            `json:"sensitive,omitempty"`
 }
 
+type checkResultV4 struct {
+	ObjectAddr      string   `json:"object_addr"`
+	Status          string   `json:"status"`
+	FailureMessages []string `json:"failure_messages,omitempty"`
+}
+
 type resourceStateV4 struct {
 	Module         string                  `json:"module,omitempty"`
 	Mode           string                  `json:"mode"`
@@ -571,8 +682,17 @@ type instanceObjectStateV4 struct {
 
 	PrivateRaw []byte `json:"private,omitempty"`
 
+	VersionToken string `json:"version_token,omitempty"`
+
+	UniqueID string `json:"unique_id,omitempty"`
+
 	Dependencies []string `json:"dependencies,omitempty"`
 	DependsOn    []string `json:"depends_on,omitempty"`
+
+	DestroyProvisioners bool `json:"destroy_provisioners,omitempty"`
+
+	CreatedAt string `json:"created_at,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
 }
 
 // stateVersionV4 is a weird special type we use to produce our hard-coded
@@ -0,0 +1,52 @@
+package statemgr
+
+import (
+	"fmt"
+)
+
+// DetectConflict compares the metadata of the snapshot that a caller
+// originally read (base) against the metadata currently recorded in
+// persistent storage (current), and returns a non-nil *ConflictError if
+// persisting over the current snapshot would discard changes written by a
+// concurrent operation.
+//
+// Callers should capture the base metadata using
+// PersistentMeta.StateSnapshotMeta immediately after refreshing, and obtain
+// the current metadata immediately before persisting, ideally while holding
+// any lock the manager supports. Managers that hold a lock for the full
+// duration of an operation cannot race in this way, so this check is
+// intended for backends whose locking is unreliable or unsupported.
+//
+// A conflict is reported if the current snapshot has a higher serial than
+// the base, or if the two snapshots have unrelated lineage. Snapshots
+// without lineage (legacy states) cannot be meaningfully compared and so
+// never report a conflict.
+func DetectConflict(base, current SnapshotMeta) *ConflictError {
+	switch current.Compare(base) {
+	case SnapshotNewer, SnapshotUnrelated:
+		return &ConflictError{Base: base, Current: current}
+	default:
+		return nil
+	}
+}
+
+// ConflictError describes a situation where the persistent state snapshot
+// was changed by another operation after the base snapshot was read, as
+// detected by DetectConflict.
+type ConflictError struct {
+	Base    SnapshotMeta
+	Current SnapshotMeta
+}
+
+func (e *ConflictError) Error() string {
+	if e.Base.Lineage != e.Current.Lineage {
+		return fmt.Sprintf(
+			"state snapshot was replaced by an unrelated state with lineage %q while this operation was in progress",
+			e.Current.Lineage,
+		)
+	}
+	return fmt.Sprintf(
+		"state snapshot was changed by another operation while this one was in progress: latest serial is %d, but this operation started from serial %d",
+		e.Current.Serial, e.Base.Serial,
+	)
+}
@@ -0,0 +1,42 @@
+package statemgr
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDetectConflict(t *testing.T) {
+	base := SnapshotMeta{Lineage: "anything", Serial: 2}
+
+	t.Run("unchanged", func(t *testing.T) {
+		if err := DetectConflict(base, SnapshotMeta{Lineage: "anything", Serial: 2}); err != nil {
+			t.Errorf("unexpected conflict: %s", err)
+		}
+	})
+
+	t.Run("newer serial", func(t *testing.T) {
+		err := DetectConflict(base, SnapshotMeta{Lineage: "anything", Serial: 3})
+		if err == nil {
+			t.Fatal("no conflict; want one")
+		}
+		if got, want := err.Error(), "latest serial is 3"; !strings.Contains(got, want) {
+			t.Errorf("wrong error\ngot:  %s\nwant substring: %s", got, want)
+		}
+	})
+
+	t.Run("unrelated lineage", func(t *testing.T) {
+		err := DetectConflict(base, SnapshotMeta{Lineage: "other", Serial: 1})
+		if err == nil {
+			t.Fatal("no conflict; want one")
+		}
+		if got, want := err.Error(), "unrelated state"; !strings.Contains(got, want) {
+			t.Errorf("wrong error\ngot:  %s\nwant substring: %s", got, want)
+		}
+	})
+
+	t.Run("legacy without lineage", func(t *testing.T) {
+		if err := DetectConflict(SnapshotMeta{}, SnapshotMeta{Serial: 5}); err != nil {
+			t.Errorf("unexpected conflict for legacy snapshots: %s", err)
+		}
+	})
+}
@@ -57,6 +57,11 @@ type Filesystem struct {
 	readFile      *statefile.File
 	backupFile    *statefile.File
 	writtenBackup bool
+
+	// historyDir and historyKeep configure optional snapshot history
+	// retention; see EnableSnapshotHistory.
+	historyDir  string
+	historyKeep int
 }
 
 var (
@@ -216,6 +221,8 @@ func (s *Filesystem) writeState(state *states.State, meta *SnapshotMeta) error {
 		return err
 	}
 
+	s.writeHistorySnapshot()
+
 	// Any future reads must come from the file we've now updated
 	s.readPath = s.path
 	return nil
@@ -320,10 +327,57 @@ func (s *Filesystem) Lock(info *LockInfo) (string, error) {
 		return "", lockErr
 	}
 
+	// Since the OS-level lock attempt succeeded, any existing lock metadata
+	// file is stale: it was left behind by a process that exited without
+	// unlocking, and so we just replace it below. The metadata file is
+	// honored in its own right only when the OS-level lock attempt fails,
+	// in which case it describes the holder in the returned LockError.
+	if existing, infoErr := s.lockInfo(); infoErr == nil && existing.ID != info.ID {
+		log.Printf("[WARN] statemgr.Filesystem: replacing stale lock metadata file left behind by lock ID %q", existing.ID)
+	}
+
 	s.lockID = info.ID
 	return s.lockID, s.writeLockInfo(info)
 }
 
+// ForceUnlock releases a lock that was taken by some other process, removing
+// the lock metadata file after verifying that the given id matches the one
+// recorded there.
+//
+// This cannot release an OS-level lock held by a still-running process; it
+// is intended for cleaning up after a process that exited without unlocking,
+// leaving a stale lock metadata file behind.
+func (s *Filesystem) ForceUnlock(id string) error {
+	s.mu.Lock()
+	held := s.lockID != ""
+	s.mu.Unlock()
+	if held {
+		// If we hold the lock ourselves then this is just a normal unlock.
+		return s.Unlock(id)
+	}
+
+	defer s.mutex()()
+
+	info, err := s.lockInfo()
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("state %q is not locked", s.readPath)
+		}
+		return err
+	}
+
+	if id != info.ID {
+		return &LockError{
+			Err:  fmt.Errorf("invalid lock id: %q. current id: %q", id, info.ID),
+			Info: info,
+		}
+	}
+
+	lockInfoPath := s.lockInfoPath()
+	log.Printf("[TRACE] statemgr.Filesystem: force-removing lock metadata file %s", lockInfoPath)
+	return os.Remove(lockInfoPath)
+}
+
 // Unlock is the companion to Lock, completing the implemention of Locker.
 func (s *Filesystem) Unlock(id string) error {
 	defer s.mutex()()
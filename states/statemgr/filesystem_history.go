@@ -0,0 +1,184 @@
+package statemgr
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/states"
+	"github.com/hashicorp/terraform/states/statefile"
+)
+
+// Filesystem implements SnapshotHistory when a history directory has been
+// configured with EnableSnapshotHistory.
+var _ SnapshotHistory = (*Filesystem)(nil)
+
+// EnableSnapshotHistory configures the receiver to retain a copy of each
+// snapshot it writes in the given directory, keeping at most the given
+// number of snapshots per lineage. A keep value of zero or less means no
+// pruning.
+//
+// History is disabled by default, since not all uses of this manager call
+// for it and some (such as reading a state given with -state) must never
+// write anywhere other than the paths they were given.
+func (s *Filesystem) EnableSnapshotHistory(dir string, keep int) {
+	s.historyDir = dir
+	s.historyKeep = keep
+}
+
+// HistorySnapshots is an implementation of SnapshotHistory.
+func (s *Filesystem) HistorySnapshots() ([]SnapshotMeta, error) {
+	files, err := s.historyFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]SnapshotMeta, 0, len(files))
+	for _, path := range files {
+		f, err := s.readHistoryFile(path)
+		if err != nil {
+			log.Printf("[WARN] statemgr.Filesystem: ignoring invalid history snapshot %s: %s", path, err)
+			continue
+		}
+		ret = append(ret, SnapshotMeta{
+			Lineage:          f.Lineage,
+			Serial:           f.Serial,
+			TerraformVersion: f.TerraformVersion,
+		})
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Serial > ret[j].Serial
+	})
+	return ret, nil
+}
+
+// HistorySnapshot is an implementation of SnapshotHistory.
+func (s *Filesystem) HistorySnapshot(serial uint64) (*states.State, error) {
+	files, err := s.historyFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, path := range files {
+		f, err := s.readHistoryFile(path)
+		if err != nil {
+			continue
+		}
+		if f.Serial == serial {
+			return f.State, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no snapshot with serial %d is retained in %s", serial, s.historyDir)
+}
+
+// writeHistorySnapshot retains a copy of the current snapshot in the history
+// directory and prunes snapshots beyond the retention limit. History is
+// strictly best-effort protection, so failures here are logged rather than
+// failing the write that triggered them.
+func (s *Filesystem) writeHistorySnapshot() {
+	if s.historyDir == "" || s.file == nil || s.file.State == nil {
+		return
+	}
+
+	if err := os.MkdirAll(s.historyDir, 0755); err != nil {
+		log.Printf("[WARN] statemgr.Filesystem: failed to create history directory %s: %s", s.historyDir, err)
+		return
+	}
+
+	path := filepath.Join(s.historyDir, fmt.Sprintf("%s-%d.tfstate", s.file.Lineage, s.file.Serial))
+	hf, err := os.Create(path)
+	if err != nil {
+		log.Printf("[WARN] statemgr.Filesystem: failed to create history snapshot %s: %s", path, err)
+		return
+	}
+	err = statefile.Write(s.file, hf)
+	hf.Close()
+	if err != nil {
+		log.Printf("[WARN] statemgr.Filesystem: failed to write history snapshot %s: %s", path, err)
+		return
+	}
+	log.Printf("[TRACE] statemgr.Filesystem: retained history snapshot at %s", path)
+
+	s.pruneHistory()
+}
+
+// pruneHistory removes the oldest snapshots of the current lineage beyond
+// the retention limit.
+func (s *Filesystem) pruneHistory() {
+	if s.historyKeep <= 0 || s.file == nil {
+		return
+	}
+
+	prefix := s.file.Lineage + "-"
+	type candidate struct {
+		path   string
+		serial uint64
+	}
+	var candidates []candidate
+
+	files, err := s.historyFiles()
+	if err != nil {
+		return
+	}
+	for _, path := range files {
+		if !strings.HasPrefix(filepath.Base(path), prefix) {
+			continue
+		}
+		f, err := s.readHistoryFile(path)
+		if err != nil {
+			continue
+		}
+		candidates = append(candidates, candidate{path, f.Serial})
+	}
+
+	if len(candidates) <= s.historyKeep {
+		return
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].serial > candidates[j].serial
+	})
+	for _, old := range candidates[s.historyKeep:] {
+		if err := os.Remove(old.path); err != nil {
+			log.Printf("[WARN] statemgr.Filesystem: failed to prune history snapshot %s: %s", old.path, err)
+		}
+	}
+}
+
+func (s *Filesystem) historyFiles() ([]string, error) {
+	if s.historyDir == "" {
+		return nil, nil
+	}
+
+	entries, err := ioutil.ReadDir(s.historyDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var ret []string
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".tfstate") {
+			continue
+		}
+		ret = append(ret, filepath.Join(s.historyDir, entry.Name()))
+	}
+	return ret, nil
+}
+
+func (s *Filesystem) readHistoryFile(path string) (*statefile.File, error) {
+	fh, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer fh.Close()
+	return statefile.Read(fh)
+}
@@ -0,0 +1,68 @@
+package statemgr
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/states"
+)
+
+func TestFilesystemSnapshotHistory(t *testing.T) {
+	defer testOverrideVersion(t, "1.2.3")()
+	s := testFilesystem(t)
+	defer os.Remove(s.readPath)
+
+	historyDir := filepath.Join(filepath.Dir(s.path), "history")
+	s.EnableSnapshotHistory(historyDir, 2)
+
+	writeSerial := func(value string) uint64 {
+		t.Helper()
+		state := states.BuildState(func(ss *states.SyncState) {
+			ss.SetOutputValue(
+				addrs.OutputValue{Name: "foo"}.Absolute(addrs.RootModuleInstance),
+				cty.StringVal(value), false,
+			)
+		})
+		if err := s.WriteState(state); err != nil {
+			t.Fatal(err)
+		}
+		return s.file.Serial
+	}
+
+	firstSerial := writeSerial("one")
+	secondSerial := writeSerial("two")
+	thirdSerial := writeSerial("three")
+
+	metas, err := s.HistorySnapshots()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Retention is 2, so the first snapshot must have been pruned.
+	if len(metas) != 2 {
+		t.Fatalf("wrong number of snapshots %d; want 2", len(metas))
+	}
+	if metas[0].Serial != thirdSerial || metas[1].Serial != secondSerial {
+		t.Fatalf("wrong snapshot serials %d, %d; want %d, %d", metas[0].Serial, metas[1].Serial, thirdSerial, secondSerial)
+	}
+
+	restored, err := s.HistorySnapshot(secondSerial)
+	if err != nil {
+		t.Fatal(err)
+	}
+	output := restored.RootModule().OutputValues["foo"]
+	if output == nil {
+		t.Fatal("restored snapshot has no output \"foo\"")
+	}
+	if got, want := output.Value, cty.StringVal("two"); !got.RawEquals(want) {
+		t.Fatalf("wrong restored output value %#v; want %#v", got, want)
+	}
+
+	if _, err := s.HistorySnapshot(firstSerial); err == nil {
+		t.Fatal("expected error for pruned serial, got success")
+	}
+}
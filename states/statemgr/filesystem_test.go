@@ -41,6 +41,7 @@ func TestFilesystemRace(t *testing.T) {
 			ls.WriteState(current)
 		}()
 	}
+	wg.Wait()
 }
 
 func TestFilesystemLocks(t *testing.T) {
@@ -102,6 +103,63 @@ func TestFilesystemLocks(t *testing.T) {
 	}
 }
 
+func TestFilesystemLocks_staleLockInfo(t *testing.T) {
+	defer testOverrideVersion(t, "1.2.3")()
+	s := testFilesystem(t)
+	defer os.Remove(s.readPath)
+
+	// Simulate a lock metadata file left behind by a process that exited
+	// without unlocking. The OS-level lock it held is gone, so locking must
+	// succeed and replace the stale metadata.
+	stale := NewLockInfo()
+	stale.Operation = "test"
+	if err := s.writeLockInfo(stale); err != nil {
+		t.Fatal(err)
+	}
+
+	info := NewLockInfo()
+	info.Operation = "test"
+	lockID, err := s.Lock(info)
+	if err != nil {
+		t.Fatalf("unexpected lock failure with stale lock metadata: %s", err)
+	}
+
+	current, err := s.lockInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if current.ID != info.ID {
+		t.Fatalf("lock metadata has ID %q; want %q", current.ID, info.ID)
+	}
+
+	if err := s.Unlock(lockID); err != nil {
+		t.Fatal(err)
+	}
+
+	// ForceUnlock remains available for removing stale metadata without
+	// taking the lock, for example on filesystems where the OS-level lock
+	// is ineffective.
+	if err := s.writeLockInfo(stale); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.ForceUnlock("invalid"); err == nil {
+		t.Fatal("expected force-unlock failure with wrong ID")
+	}
+	if err := s.ForceUnlock(stale.ID); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFilesystem_forceUnlockNotLocked(t *testing.T) {
+	defer testOverrideVersion(t, "1.2.3")()
+	s := testFilesystem(t)
+	defer os.Remove(s.readPath)
+
+	if err := s.ForceUnlock("any"); err == nil {
+		t.Fatal("expected error force-unlocking an unlocked state")
+	}
+}
+
 // Verify that we can write to the state file, as Windows' mandatory locking
 // will prevent writing to a handle different than the one that hold the lock.
 func TestFilesystem_writeWhileLocked(t *testing.T) {
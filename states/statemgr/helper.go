@@ -4,8 +4,11 @@ package statemgr
 // operations done against full state managers.
 
 import (
+	"context"
+
 	"github.com/hashicorp/terraform/states"
 	"github.com/hashicorp/terraform/states/statefile"
+	"github.com/hashicorp/terraform/tracing"
 	"github.com/hashicorp/terraform/version"
 )
 
@@ -45,9 +48,13 @@ func RefreshAndRead(mgr Storage) (*states.State, error) {
 // is required, call WriteState and PersistState on the state manager directly
 // and handle their errors.
 func WriteAndPersist(mgr Storage, state *states.State) error {
+	_, span := tracing.StartSpan(context.Background(), "statemgr.WriteAndPersist")
+	defer span.End()
+
 	err := mgr.WriteState(state)
-	if err != nil {
-		return err
+	if err == nil {
+		err = mgr.PersistState()
 	}
-	return mgr.PersistState()
+	span.RecordError(err)
+	return err
 }
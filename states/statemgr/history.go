@@ -0,0 +1,19 @@
+package statemgr
+
+import (
+	"github.com/hashicorp/terraform/states"
+)
+
+// SnapshotHistory is an optional extension to Full implemented by state
+// managers that retain a bounded history of previously-persisted snapshots,
+// so that a state damaged by a bad apply can be restored without relying on
+// any versioning support in the underlying storage.
+type SnapshotHistory interface {
+	// HistorySnapshots returns metadata describing the retained snapshots,
+	// newest first.
+	HistorySnapshots() ([]SnapshotMeta, error)
+
+	// HistorySnapshot returns the retained state snapshot with the given
+	// serial, or an error if no such snapshot is retained.
+	HistorySnapshot(serial uint64) (*states.State, error)
+}
@@ -0,0 +1,66 @@
+package statemgr
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+)
+
+// WorkspaceMeta is a small metadata document that can optionally be stored
+// alongside a workspace's state snapshots, describing the workspace itself
+// rather than the infrastructure it tracks.
+//
+// Not all state storage implementations support workspace metadata. Those
+// that do keep it in a separate document next to the state so that writing
+// metadata never perturbs the state serial or lineage.
+type WorkspaceMeta struct {
+	// Description is optional freeform prose describing the purpose of
+	// the workspace.
+	Description string `json:"description,omitempty"`
+
+	// VarFile is an optional path, relative to the configuration directory,
+	// of a variables file that should be loaded automatically whenever an
+	// operation runs against this workspace.
+	VarFile string `json:"var_file,omitempty"`
+
+	// CreatedAt is the time at which the workspace was created, if known.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// ReadWorkspaceMeta reads a workspace metadata document from the given path.
+//
+// If no document is present at the given path then it returns a nil
+// WorkspaceMeta and no error, since metadata is always optional.
+func ReadWorkspaceMeta(path string) (*WorkspaceMeta, error) {
+	src, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read workspace metadata: %s", err)
+	}
+
+	meta := &WorkspaceMeta{}
+	if err := json.Unmarshal(src, meta); err != nil {
+		return nil, fmt.Errorf("failed to parse workspace metadata in %s: %s", path, err)
+	}
+	return meta, nil
+}
+
+// WriteWorkspaceMeta writes the given workspace metadata document to the
+// given path, replacing any document already present there.
+func WriteWorkspaceMeta(path string, meta *WorkspaceMeta) error {
+	src, err := json.MarshalIndent(meta, "", "  ")
+	if err != nil {
+		// Should never happen, since WorkspaceMeta is always marshalable.
+		return fmt.Errorf("failed to serialize workspace metadata: %s", err)
+	}
+	src = append(src, '\n')
+
+	if err := ioutil.WriteFile(path, src, 0644); err != nil {
+		return fmt.Errorf("failed to write workspace metadata: %s", err)
+	}
+	return nil
+}
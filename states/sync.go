@@ -1,7 +1,9 @@
 package states
 
 import (
+	"fmt"
 	"log"
+	"sort"
 	"sync"
 
 	"github.com/hashicorp/terraform/addrs"
@@ -31,6 +33,17 @@ import (
 type SyncState struct {
 	state *State
 	lock  sync.RWMutex
+
+	// snapshot is a cached copy of state used to implement the Snapshot
+	// method, and snapshotDirty tracks which parts of state have been
+	// modified since snapshot was taken so that the next call to Snapshot
+	// can re-copy only those parts and share the rest with the previous
+	// snapshot. The keys of snapshotDirty are module keys from
+	// State.Modules; a nil value records that the whole module is dirty,
+	// while a non-nil value enumerates dirty resource keys within the
+	// module. Both fields are nil until Snapshot is first called.
+	snapshot      *State
+	snapshotDirty map[string]map[string]struct{}
 }
 
 // Module returns a snapshot of the state of the module instance with the given
@@ -56,6 +69,7 @@ func (s *SyncState) RemoveModule(addr addrs.ModuleInstance) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
+	s.markModuleDirty(addr.String())
 	s.state.RemoveModule(addr)
 }
 
@@ -80,6 +94,7 @@ func (s *SyncState) SetOutputValue(addr addrs.AbsOutputValue, value cty.Value, s
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
+	s.markModuleDirty(addr.Module.String())
 	ms := s.state.EnsureModule(addr.Module)
 	ms.SetOutputValue(addr.OutputValue.Name, value, sensitive)
 }
@@ -97,6 +112,7 @@ func (s *SyncState) RemoveOutputValue(addr addrs.AbsOutputValue) {
 	if ms == nil {
 		return
 	}
+	s.markModuleDirty(addr.Module.String())
 	ms.RemoveOutputValue(addr.OutputValue.Name)
 	s.maybePruneModule(addr.Module)
 }
@@ -120,6 +136,7 @@ func (s *SyncState) SetLocalValue(addr addrs.AbsLocalValue, value cty.Value) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
+	s.markModuleDirty(addr.Module.String())
 	ms := s.state.EnsureModule(addr.Module)
 	ms.SetLocalValue(addr.LocalValue.Name, value)
 }
@@ -137,6 +154,7 @@ func (s *SyncState) RemoveLocalValue(addr addrs.AbsLocalValue) {
 	if ms == nil {
 		return
 	}
+	s.markModuleDirty(addr.Module.String())
 	ms.RemoveLocalValue(addr.LocalValue.Name)
 	s.maybePruneModule(addr.Module)
 }
@@ -189,6 +207,7 @@ func (s *SyncState) SetResourceMeta(addr addrs.AbsResource, eachMode EachMode, p
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
+	s.markResourceDirty(addr.Module.String(), addr.Resource.String())
 	ms := s.state.EnsureModule(addr.Module)
 	ms.SetResourceMeta(addr.Resource, eachMode, provider)
 }
@@ -202,6 +221,7 @@ func (s *SyncState) RemoveResource(addr addrs.AbsResource) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
+	s.markResourceDirty(addr.Module.String(), addr.Resource.String())
 	ms := s.state.EnsureModule(addr.Module)
 	ms.RemoveResource(addr.Resource)
 	s.maybePruneModule(addr.Module)
@@ -231,6 +251,7 @@ func (s *SyncState) RemoveResourceIfEmpty(addr addrs.AbsResource) bool {
 		// instance-mutation methods to prune those away automatically.
 		return false
 	}
+	s.markResourceDirty(addr.Module.String(), addr.Resource.String())
 	ms.RemoveResource(addr.Resource)
 	s.maybePruneModule(addr.Module)
 	return true
@@ -277,6 +298,7 @@ func (s *SyncState) MaybeFixUpResourceInstanceAddressForCount(addr addrs.AbsReso
 	}
 
 	// If we get here then we need to "rename" from hunt to replace
+	s.markResourceDirty(addr.Module.String(), relAddr.String())
 	rs.Instances[replaceKey] = is
 	delete(rs.Instances, huntKey)
 	return true
@@ -306,11 +328,58 @@ func (s *SyncState) SetResourceInstanceCurrent(addr addrs.AbsResourceInstance, o
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
+	s.markResourceDirty(addr.Module.String(), addr.Resource.Resource.String())
 	ms := s.state.EnsureModule(addr.Module)
 	ms.SetResourceInstanceCurrent(addr.Resource, obj.DeepCopy(), provider)
 	s.maybePruneModule(addr.Module)
 }
 
+// Taint marks the current object for the resource instance at the given
+// address as tainted, so that the next plan will propose to replace it.
+// Tainting an already-tainted object is a no-op.
+//
+// Returns an error if the instance isn't tracked in the state or has no
+// current object, in which case nothing is changed.
+func (s *SyncState) Taint(addr addrs.AbsResourceInstance) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	is := s.state.ResourceInstance(addr)
+	if is == nil || is.Current == nil {
+		return fmt.Errorf("resource instance %s does not have a current object to taint", addr)
+	}
+	if is.Current.Status == ObjectTainted {
+		return nil
+	}
+
+	is.Current.Status = ObjectTainted
+	s.markResourceDirty(addr.Module.String(), addr.Resource.Resource.String())
+	return nil
+}
+
+// Untaint reverses a previous Taint for the resource instance at the given
+// address, restoring its current object to the ready status.
+//
+// Returns an error if the instance isn't tracked in the state, has no
+// current object, or its current object is not tainted, in which case
+// nothing is changed.
+func (s *SyncState) Untaint(addr addrs.AbsResourceInstance) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	is := s.state.ResourceInstance(addr)
+	if is == nil || is.Current == nil {
+		return fmt.Errorf("resource instance %s does not have a current object to untaint", addr)
+	}
+	if is.Current.Status != ObjectTainted {
+		return fmt.Errorf("resource instance %s is not tainted", addr)
+	}
+
+	is.Current.Status = ObjectReady
+	s.markResourceDirty(addr.Module.String(), addr.Resource.Resource.String())
+	return nil
+}
+
 // SetResourceInstanceDeposed saves the given instance object as a deposed
 // generation of the resource instance with the given address and deposed key.
 //
@@ -338,11 +407,41 @@ func (s *SyncState) SetResourceInstanceDeposed(addr addrs.AbsResourceInstance, k
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
+	s.markResourceDirty(addr.Module.String(), addr.Resource.Resource.String())
 	ms := s.state.EnsureModule(addr.Module)
 	ms.SetResourceInstanceDeposed(addr.Resource, key, obj.DeepCopy(), provider)
 	s.maybePruneModule(addr.Module)
 }
 
+// DeposedInstanceObjectKeys returns the keys of all deposed objects recorded
+// against the resource instance with the given address, in a consistent
+// sorted order.
+//
+// The result is nil if the instance is not tracked in the state or has no
+// deposed objects.
+func (s *SyncState) DeposedInstanceObjectKeys(addr addrs.AbsResourceInstance) []DeposedKey {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	ms := s.state.Module(addr.Module)
+	if ms == nil {
+		return nil
+	}
+	is := ms.ResourceInstance(addr.Resource)
+	if is == nil {
+		return nil
+	}
+
+	var ret []DeposedKey
+	for key := range is.Deposed {
+		ret = append(ret, key)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i] < ret[j]
+	})
+	return ret
+}
+
 // DeposeResourceInstanceObject moves the current instance object for the
 // given resource instance address into the deposed set, leaving the instance
 // without a current object.
@@ -363,6 +462,7 @@ func (s *SyncState) DeposeResourceInstanceObject(addr addrs.AbsResourceInstance)
 		return NotDeposed
 	}
 
+	s.markResourceDirty(addr.Module.String(), addr.Resource.Resource.String())
 	return ms.deposeResourceInstanceObject(addr.Resource, NotDeposed)
 }
 
@@ -384,6 +484,7 @@ func (s *SyncState) DeposeResourceInstanceObjectForceKey(addr addrs.AbsResourceI
 		return // Nothing to do, since there can't be any current object either.
 	}
 
+	s.markResourceDirty(addr.Module.String(), addr.Resource.Resource.String())
 	ms.deposeResourceInstanceObject(addr.Resource, forcedKey)
 }
 
@@ -397,6 +498,7 @@ func (s *SyncState) ForgetResourceInstanceAll(addr addrs.AbsResourceInstance) {
 	if ms == nil {
 		return
 	}
+	s.markResourceDirty(addr.Module.String(), addr.Resource.Resource.String())
 	ms.ForgetResourceInstanceAll(addr.Resource)
 	s.maybePruneModule(addr.Module)
 }
@@ -411,6 +513,7 @@ func (s *SyncState) ForgetResourceInstanceDeposed(addr addrs.AbsResourceInstance
 	if ms == nil {
 		return
 	}
+	s.markResourceDirty(addr.Module.String(), addr.Resource.Resource.String())
 	ms.ForgetResourceInstanceDeposed(addr.Resource, key)
 	s.maybePruneModule(addr.Module)
 }
@@ -436,7 +539,11 @@ func (s *SyncState) MaybeRestoreResourceInstanceDeposed(addr addrs.AbsResourceIn
 		return false
 	}
 
-	return ms.maybeRestoreResourceInstanceDeposed(addr.Resource, key)
+	ret := ms.maybeRestoreResourceInstanceDeposed(addr.Resource, key)
+	if ret {
+		s.markResourceDirty(addr.Module.String(), addr.Resource.Resource.String())
+	}
+	return ret
 }
 
 // RemovePlannedResourceInstanceObjects removes from the state any resource
@@ -472,6 +579,7 @@ func (s *SyncState) RemovePlannedResourceInstanceObjects() {
 				if is.Current != nil && is.Current.Status == ObjectPlanned {
 					// Setting the current instance to nil removes it from the
 					// state altogether if there are not also deposed instances.
+					s.markResourceDirty(moduleAddr.String(), resAddr.String())
 					ms.SetResourceInstanceCurrent(instAddr, nil, rs.ProviderConfig)
 				}
 
@@ -479,6 +587,7 @@ func (s *SyncState) RemovePlannedResourceInstanceObjects() {
 					// Deposed objects should never be "planned", but we'll
 					// do this anyway for the sake of completeness.
 					if obj.Status == ObjectPlanned {
+						s.markResourceDirty(moduleAddr.String(), resAddr.String())
 						ms.ForgetResourceInstanceDeposed(instAddr, dk)
 					}
 				}
@@ -492,6 +601,15 @@ func (s *SyncState) RemovePlannedResourceInstanceObjects() {
 	}
 }
 
+// SetCheckResult records the result of evaluating a configuration-defined
+// check block, replacing any previously-recorded result for the same check.
+func (s *SyncState) SetCheckResult(addr addrs.AbsCheck, result *CheckResult) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.state.SetCheckResult(addr, result)
+}
+
 // Lock acquires an explicit lock on the state, allowing direct read and write
 // access to the returned state object. The caller must call Unlock once
 // access is no longer needed, and then immediately discard the state pointer
@@ -501,6 +619,10 @@ func (s *SyncState) RemovePlannedResourceInstanceObjects() {
 // accessors and mutators provided directly on SyncState.
 func (s *SyncState) Lock() *State {
 	s.lock.Lock()
+	// Since the caller gets direct access to the state and may mutate it
+	// arbitrarily, we can no longer trust our record of which parts have
+	// changed since the last snapshot and must discard it.
+	s.invalidateSnapshot()
 	return s.state
 }
 
@@ -532,6 +654,7 @@ func (s *SyncState) maybePruneModule(addr addrs.ModuleInstance) {
 
 	if ms.empty() {
 		log.Printf("[TRACE] states.SyncState: pruning %s because it is empty", addr)
+		s.markModuleDirty(addr.String())
 		s.state.RemoveModule(addr)
 	}
 }
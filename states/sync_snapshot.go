@@ -0,0 +1,137 @@
+package states
+
+// Snapshot returns a copy of the wrapped state that is safe to retain and
+// read after this method returns, even while other callers continue to
+// modify the state through the receiver.
+//
+// Unlike locking the state and calling DeepCopy, Snapshot is a copy-on-write
+// operation: each call re-copies only the parts of the state that have been
+// modified since the previous call, and shares the rest of its backing memory
+// with previously-returned snapshots. That makes it suitable for use in hot
+// paths that need a fresh snapshot after each of many small modifications,
+// such as the state-updated hook called during a graph walk, where repeatedly
+// deep-copying a large state would cost quadratic time overall.
+//
+// Because unchanged portions are shared between snapshots, callers must treat
+// the returned state and everything reachable through it as read-only. A
+// caller that needs a mutable copy can call DeepCopy on the returned
+// snapshot, which is no more costly than deep-copying the live state would
+// have been.
+func (s *SyncState) Snapshot() *State {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.snapshot == nil {
+		// Either this is the first call or an explicit Lock invalidated our
+		// cache, so we must start over with a full copy.
+		s.snapshot = s.state.DeepCopy()
+		s.snapshotDirty = make(map[string]map[string]struct{})
+		return s.snapshot
+	}
+
+	prev := s.snapshot
+	new := &State{
+		Modules: make(map[string]*Module, len(s.state.Modules)),
+
+		// Check results are not dirty-tracked because they are few in number
+		// compared to resources, so we just re-copy them every time.
+		CheckResults: make(map[string]*CheckResult, len(s.state.CheckResults)),
+	}
+	for k, r := range s.state.CheckResults {
+		new.CheckResults[k] = r.DeepCopy()
+	}
+	for k, ms := range s.state.Modules {
+		dirtyResources, dirty := s.snapshotDirty[k]
+		prevMod := prev.Modules[k]
+		switch {
+		case !dirty && prevMod != nil:
+			// The module hasn't changed at all, so the previous snapshot's
+			// copy of it remains correct and can be shared.
+			new.Modules[k] = prevMod
+		case dirtyResources == nil || prevMod == nil:
+			// Either the whole module is dirty or we have no previous copy
+			// to share from, so we must copy it in full.
+			new.Modules[k] = ms.DeepCopy()
+		default:
+			// Only some of the module's resources are dirty, so we can
+			// re-copy just those and share the rest.
+			new.Modules[k] = snapshotModule(ms, prevMod, dirtyResources)
+		}
+	}
+
+	// Modules removed from the live state are handled implicitly here, since
+	// we built the new snapshot only from the modules that remain.
+	s.snapshot = new
+	s.snapshotDirty = make(map[string]map[string]struct{})
+	return new
+}
+
+// snapshotModule produces a copy of the given live module state that shares
+// the copies of any resources not listed in dirtyResources with the given
+// previous snapshot of the same module.
+//
+// This must only be called when all of the module's changes since the
+// previous snapshot are confined to the listed resources; in particular, the
+// output values and local values must be unchanged, since those are shared
+// wholesale with the previous snapshot.
+func snapshotModule(ms, prev *Module, dirtyResources map[string]struct{}) *Module {
+	new := &Module{
+		Addr:         ms.Addr,
+		Resources:    make(map[string]*Resource, len(ms.Resources)),
+		OutputValues: prev.OutputValues,
+		LocalValues:  prev.LocalValues,
+	}
+	for k, rs := range ms.Resources {
+		if _, dirty := dirtyResources[k]; dirty || prev.Resources[k] == nil {
+			new.Resources[k] = rs.DeepCopy()
+		} else {
+			new.Resources[k] = prev.Resources[k]
+		}
+	}
+	return new
+}
+
+// markModuleDirty records that the module with the given key has been
+// modified since the last snapshot, and so the next call to Snapshot must
+// re-copy it in full.
+//
+// The caller must be holding the lock for writing.
+func (s *SyncState) markModuleDirty(moduleKey string) {
+	if s.snapshot == nil {
+		// No snapshot has been taken yet (or the last one was invalidated),
+		// so there's nothing to track against: the next Snapshot call will
+		// copy everything anyway.
+		return
+	}
+	s.snapshotDirty[moduleKey] = nil
+}
+
+// markResourceDirty records that the resource with the given key, in the
+// module with the given key, has been modified since the last snapshot, and
+// so the next call to Snapshot must re-copy it.
+//
+// The caller must be holding the lock for writing.
+func (s *SyncState) markResourceDirty(moduleKey, resourceKey string) {
+	if s.snapshot == nil {
+		return
+	}
+	resources, exists := s.snapshotDirty[moduleKey]
+	if exists && resources == nil {
+		// The whole module is already dirty, which subsumes this.
+		return
+	}
+	if resources == nil {
+		resources = make(map[string]struct{})
+		s.snapshotDirty[moduleKey] = resources
+	}
+	resources[resourceKey] = struct{}{}
+}
+
+// invalidateSnapshot discards any cached snapshot, forcing the next call to
+// Snapshot to take a full copy of the state.
+//
+// The caller must be holding the lock for writing.
+func (s *SyncState) invalidateSnapshot() {
+	s.snapshot = nil
+	s.snapshotDirty = nil
+}
@@ -0,0 +1,201 @@
+package states
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/addrs"
+)
+
+func TestSyncStateSnapshot(t *testing.T) {
+	state := NewState()
+	rootModule := state.RootModule()
+	rootModule.SetOutputValue("bar", cty.StringVal("bar value"), false)
+	rootModule.SetResourceInstanceCurrent(
+		snapshotTestInstanceAddr("a").Resource,
+		&ResourceInstanceObjectSrc{
+			Status:    ObjectReady,
+			AttrsJSON: []byte(`{"id":"a"}`),
+		},
+		snapshotTestProviderAddr(),
+	)
+	rootModule.SetResourceInstanceCurrent(
+		snapshotTestInstanceAddr("b").Resource,
+		&ResourceInstanceObjectSrc{
+			Status:    ObjectReady,
+			AttrsJSON: []byte(`{"id":"b"}`),
+		},
+		snapshotTestProviderAddr(),
+	)
+	childAddr := addrs.RootModuleInstance.Child("child", addrs.NoKey)
+	childModule := state.EnsureModule(childAddr)
+	childModule.SetOutputValue("pizza", cty.StringVal("hawaiian"), false)
+
+	sync := state.SyncWrapper()
+
+	{
+		// Our structure goes deep, so we need to temporarily override the
+		// deep package settings to ensure that we visit the full structure.
+		oldDeepDepth := deep.MaxDepth
+		oldDeepCompareUnexp := deep.CompareUnexportedFields
+		deep.MaxDepth = 50
+		deep.CompareUnexportedFields = true
+		defer func() {
+			deep.MaxDepth = oldDeepDepth
+			deep.CompareUnexportedFields = oldDeepCompareUnexp
+		}()
+	}
+
+	snap1 := sync.Snapshot()
+	for _, problem := range deep.Equal(snap1, state) {
+		t.Errorf("first snapshot: %s", problem)
+	}
+	if snap1.RootModule() == rootModule {
+		t.Fatalf("snapshot shares backing memory with the live state")
+	}
+
+	// Modifying one resource must be reflected in the next snapshot without
+	// changing the previous one, and everything untouched must be shared
+	// with the previous snapshot rather than re-copied.
+	sync.SetResourceInstanceCurrent(
+		snapshotTestInstanceAddr("b"),
+		&ResourceInstanceObjectSrc{
+			Status:    ObjectReady,
+			AttrsJSON: []byte(`{"id":"b","updated":"yes"}`),
+		},
+		snapshotTestProviderAddr(),
+	)
+
+	snap2 := sync.Snapshot()
+	for _, problem := range deep.Equal(snap2, state) {
+		t.Errorf("second snapshot: %s", problem)
+	}
+	if got, want := string(snap1.RootModule().Resources["test_thing.b"].Instances[addrs.NoKey].Current.AttrsJSON), `{"id":"b"}`; got != want {
+		t.Errorf("first snapshot changed after write\ngot:  %s\nwant: %s", got, want)
+	}
+	if snap2.Modules["module.child"] != snap1.Modules["module.child"] {
+		t.Errorf("unmodified module was re-copied rather than shared")
+	}
+	if snap2.RootModule().Resources["test_thing.a"] != snap1.RootModule().Resources["test_thing.a"] {
+		t.Errorf("unmodified resource was re-copied rather than shared")
+	}
+	if snap2.RootModule().Resources["test_thing.b"] == snap1.RootModule().Resources["test_thing.b"] {
+		t.Errorf("modified resource was shared rather than re-copied")
+	}
+
+	// A module-level change (an output value) requires re-copying that
+	// module, but other modules are still shared.
+	sync.SetOutputValue(
+		addrs.OutputValue{Name: "pizza"}.Absolute(childAddr),
+		cty.StringVal("margherita"), false,
+	)
+
+	snap3 := sync.Snapshot()
+	for _, problem := range deep.Equal(snap3, state) {
+		t.Errorf("third snapshot: %s", problem)
+	}
+	if got, want := snap2.Modules["module.child"].OutputValues["pizza"].Value, cty.StringVal("hawaiian"); !want.RawEquals(got) {
+		t.Errorf("second snapshot changed after write\ngot:  %#v\nwant: %#v", got, want)
+	}
+	if snap3.Modules["module.child"] == snap2.Modules["module.child"] {
+		t.Errorf("modified module was shared rather than re-copied")
+	}
+	if snap3.RootModule() != snap2.RootModule() {
+		t.Errorf("unmodified module was re-copied rather than shared")
+	}
+
+	// An explicit Lock hands out the live state for arbitrary modification,
+	// so the snapshot cache must be discarded and the next snapshot must be
+	// a full copy.
+	ls := sync.Lock()
+	ls.RootModule().SetLocalValue("sneaky", cty.StringVal("direct write"))
+	sync.Unlock()
+
+	snap4 := sync.Snapshot()
+	for _, problem := range deep.Equal(snap4, state) {
+		t.Errorf("fourth snapshot: %s", problem)
+	}
+	if snap4.RootModule() == snap3.RootModule() {
+		t.Errorf("snapshot after explicit Lock shared memory with earlier snapshot")
+	}
+}
+
+// benchmarkSyncStateUpdate simulates the per-resource state updates made
+// during a graph walk over a state with the given number of resources,
+// calling the given snapshot function after each update as EvalUpdateStateHook
+// would.
+func benchmarkSyncStateUpdate(b *testing.B, resourceCount int, snapshot func(*SyncState) *State) {
+	state := NewState()
+	rootModule := state.RootModule()
+	providerConfig := snapshotTestProviderAddr()
+	instAddrs := make([]addrs.AbsResourceInstance, resourceCount)
+	for i := range instAddrs {
+		instAddrs[i] = snapshotTestInstanceAddr(fmt.Sprintf("n%d", i))
+		rootModule.SetResourceInstanceCurrent(
+			instAddrs[i].Resource,
+			&ResourceInstanceObjectSrc{
+				Status:    ObjectReady,
+				AttrsJSON: []byte(`{"id":"whatever","several":"attributes","to":"make","the":"object","non":"trivial"}`),
+			},
+			providerConfig,
+		)
+	}
+	sync := state.SyncWrapper()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sync.SetResourceInstanceCurrent(
+			instAddrs[i%resourceCount],
+			&ResourceInstanceObjectSrc{
+				Status:    ObjectReady,
+				AttrsJSON: []byte(`{"id":"updated","several":"attributes","to":"make","the":"object","non":"trivial"}`),
+			},
+			providerConfig,
+		)
+		snapshot(sync)
+	}
+}
+
+func snapshotTestInstanceAddr(name string) addrs.AbsResourceInstance {
+	return addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_thing",
+		Name: name,
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+}
+
+func snapshotTestProviderAddr() addrs.AbsProviderConfig {
+	return addrs.AbsProviderConfig{
+		Provider: addrs.NewLegacyProvider("test"),
+		Module:   addrs.RootModuleInstance,
+	}
+}
+
+func BenchmarkSyncStateSnapshot(b *testing.B) {
+	for _, resourceCount := range []int{16, 256, 4096} {
+		b.Run(fmt.Sprintf("%d resources", resourceCount), func(b *testing.B) {
+			benchmarkSyncStateUpdate(b, resourceCount, func(s *SyncState) *State {
+				return s.Snapshot()
+			})
+		})
+	}
+}
+
+// BenchmarkSyncStateLockDeepCopy exists only for comparison against
+// BenchmarkSyncStateSnapshot: it measures the previous strategy of deep
+// copying the whole state under the lock for each update, whose per-update
+// cost grows linearly with the total number of resources.
+func BenchmarkSyncStateLockDeepCopy(b *testing.B) {
+	for _, resourceCount := range []int{16, 256, 4096} {
+		b.Run(fmt.Sprintf("%d resources", resourceCount), func(b *testing.B) {
+			benchmarkSyncStateUpdate(b, resourceCount, func(s *SyncState) *State {
+				ret := s.Lock().DeepCopy()
+				s.Unlock()
+				return ret
+			})
+		})
+	}
+}
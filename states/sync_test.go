@@ -0,0 +1,70 @@
+package states
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/addrs"
+)
+
+func TestSyncStateTaintUntaint(t *testing.T) {
+	state := NewState()
+	state.RootModule().SetResourceInstanceCurrent(
+		addrs.Resource{
+			Mode: addrs.ManagedResourceMode,
+			Type: "test_thing",
+			Name: "foo",
+		}.Instance(addrs.NoKey),
+		&ResourceInstanceObjectSrc{
+			Status:    ObjectReady,
+			AttrsJSON: []byte(`{"id":"foo"}`),
+		},
+		addrs.AbsProviderConfig{
+			Provider: addrs.NewLegacyProvider("test"),
+			Module:   addrs.RootModuleInstance,
+		},
+	)
+	ss := state.SyncWrapper()
+
+	addr := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_thing",
+		Name: "foo",
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+	missingAddr := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_thing",
+		Name: "bar",
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+
+	// Untainting a ready object is an error.
+	if err := ss.Untaint(addr); err == nil {
+		t.Fatal("untaint of ready object succeeded; want error")
+	}
+
+	if err := ss.Taint(addr); err != nil {
+		t.Fatalf("taint failed: %s", err)
+	}
+	if got := ss.ResourceInstance(addr).Current.Status; got != ObjectTainted {
+		t.Fatalf("wrong status %#v after taint; want %#v", got, ObjectTainted)
+	}
+
+	// Tainting again is a no-op.
+	if err := ss.Taint(addr); err != nil {
+		t.Fatalf("second taint failed: %s", err)
+	}
+
+	if err := ss.Untaint(addr); err != nil {
+		t.Fatalf("untaint failed: %s", err)
+	}
+	if got := ss.ResourceInstance(addr).Current.Status; got != ObjectReady {
+		t.Fatalf("wrong status %#v after untaint; want %#v", got, ObjectReady)
+	}
+
+	// Instances that aren't tracked at all produce errors.
+	if err := ss.Taint(missingAddr); err == nil {
+		t.Fatal("taint of missing instance succeeded; want error")
+	}
+	if err := ss.Untaint(missingAddr); err == nil {
+		t.Fatal("untaint of missing instance succeeded; want error")
+	}
+}
@@ -0,0 +1,12 @@
+// Package upgrade contains the logic for bringing resource instance data
+// recorded by older versions of Terraform up to the current internal state
+// representation.
+//
+// The statefile package is responsible for the purely-structural parts of
+// upgrading, translating state snapshot versions 1 through 3 into the
+// current version 4 layout. What it cannot do alone is convert legacy
+// "flatmap" attribute data into the schema-typed JSON representation used
+// today, because the flatmap format does not carry enough type information
+// to decode without a resource type schema. This package completes that
+// final step, given access to the relevant provider schemas.
+package upgrade
@@ -0,0 +1,118 @@
+package upgrade
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/hashicorp/terraform/states"
+	"github.com/hashicorp/terraform/tfdiags"
+)
+
+// Schemas is the interface through which the upgrade process obtains
+// resource type schemas for decoding legacy attribute data.
+//
+// *terraform.Schemas implements this interface, so a caller that has built
+// a terraform.Context can pass the result of its Schemas method here
+// directly.
+type Schemas interface {
+	// ResourceTypeConfig returns the schema for the given resource type,
+	// along with its current schema version, or a nil schema if none is
+	// available.
+	ResourceTypeConfig(provider addrs.Provider, resourceMode addrs.ResourceMode, resourceType string) (*configschema.Block, uint64)
+}
+
+// NeedsUpgrade returns true if the given state contains any resource
+// instance objects whose attributes are still stored in the legacy flatmap
+// format, and therefore require upgrading before they can be decoded.
+func NeedsUpgrade(state *states.State) bool {
+	if state == nil {
+		return false
+	}
+	for _, ms := range state.Modules {
+		for _, rs := range ms.Resources {
+			for _, is := range rs.Instances {
+				if is.Current != nil && is.Current.AttrsFlat != nil {
+					return true
+				}
+				for _, obj := range is.Deposed {
+					if obj != nil && obj.AttrsFlat != nil {
+						return true
+					}
+				}
+			}
+		}
+	}
+	return false
+}
+
+// State upgrades, in place, any resource instance objects in the given
+// state that are still stored in the legacy flatmap attribute format,
+// re-encoding them as schema-typed JSON.
+//
+// The given schemas must be able to provide a schema for every resource
+// type that has legacy data in the state. Instances whose attributes cannot
+// be upgraded are left in their legacy form and reported in the returned
+// diagnostics, one per affected instance, so the caller can present the
+// full set of problems rather than just the first.
+func State(state *states.State, schemas Schemas) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+	if state == nil {
+		return diags
+	}
+
+	for _, ms := range state.Modules {
+		for _, rs := range ms.Resources {
+			providerType := rs.ProviderConfig.Provider
+			schema, _ := schemas.ResourceTypeConfig(providerType, rs.Addr.Mode, rs.Addr.Type)
+			for key, is := range rs.Instances {
+				instAddr := rs.Addr.Instance(key).Absolute(ms.Addr)
+				objs := map[states.DeposedKey]*states.ResourceInstanceObjectSrc{
+					states.NotDeposed: is.Current,
+				}
+				for dk, obj := range is.Deposed {
+					objs[dk] = obj
+				}
+				for dk, obj := range objs {
+					if obj == nil || obj.AttrsFlat == nil {
+						continue // already in the current format
+					}
+					if schema == nil {
+						diags = diags.Append(tfdiags.Sourceless(
+							tfdiags.Error,
+							"Missing resource type schema",
+							fmt.Sprintf("No schema is available for %s, so its legacy attribute data cannot be upgraded. This is a bug in the provider for %q, or the provider is not available.", instAddr, providerType),
+						))
+						continue
+					}
+					ty := schema.ImpliedType()
+					decoded, err := obj.Decode(ty)
+					if err != nil {
+						diags = diags.Append(tfdiags.Sourceless(
+							tfdiags.Error,
+							"Failed to upgrade resource instance in state",
+							fmt.Sprintf("The attributes of %s could not be decoded using the current resource type schema: %s.", instAddr, err),
+						))
+						continue
+					}
+					newObj, err := obj.CompleteUpgrade(decoded.Value, ty, obj.SchemaVersion)
+					if err != nil {
+						diags = diags.Append(tfdiags.Sourceless(
+							tfdiags.Error,
+							"Failed to upgrade resource instance in state",
+							fmt.Sprintf("The attributes of %s could not be re-encoded in the current state format: %s.", instAddr, err),
+						))
+						continue
+					}
+					if dk == states.NotDeposed {
+						is.Current = newObj
+					} else {
+						is.Deposed[dk] = newObj
+					}
+				}
+			}
+		}
+	}
+
+	return diags
+}
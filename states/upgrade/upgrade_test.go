@@ -0,0 +1,106 @@
+package upgrade
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/hashicorp/terraform/states"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// schemasFixed is a simple Schemas implementation for tests, mapping
+// resource type names to schemas regardless of provider.
+type schemasFixed map[string]*configschema.Block
+
+func (s schemasFixed) ResourceTypeConfig(provider addrs.Provider, resourceMode addrs.ResourceMode, resourceType string) (*configschema.Block, uint64) {
+	return s[resourceType], 0
+}
+
+func testStateWithFlatmap(t *testing.T) *states.State {
+	t.Helper()
+	state := states.NewState()
+	root := state.EnsureModule(addrs.RootModuleInstance)
+	root.SetResourceInstanceCurrent(
+		addrs.Resource{
+			Mode: addrs.ManagedResourceMode,
+			Type: "test_thing",
+			Name: "foo",
+		}.Instance(addrs.NoKey),
+		&states.ResourceInstanceObjectSrc{
+			Status: states.ObjectReady,
+			AttrsFlat: map[string]string{
+				"id":  "baz",
+				"ami": "ami-abc123",
+			},
+		},
+		addrs.AbsProviderConfig{
+			Provider: addrs.NewLegacyProvider("test"),
+			Module:   addrs.RootModuleInstance,
+		},
+	)
+	return state
+}
+
+func TestNeedsUpgrade(t *testing.T) {
+	if NeedsUpgrade(nil) {
+		t.Error("nil state needs upgrade; should not")
+	}
+	if NeedsUpgrade(states.NewState()) {
+		t.Error("empty state needs upgrade; should not")
+	}
+	if !NeedsUpgrade(testStateWithFlatmap(t)) {
+		t.Error("state with flatmap attributes does not need upgrade; should")
+	}
+}
+
+func TestState(t *testing.T) {
+	state := testStateWithFlatmap(t)
+	schemas := schemasFixed{
+		"test_thing": {
+			Attributes: map[string]*configschema.Attribute{
+				"id":  {Type: cty.String, Computed: true},
+				"ami": {Type: cty.String, Optional: true},
+			},
+		},
+	}
+
+	diags := State(state, schemas)
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+	if NeedsUpgrade(state) {
+		t.Error("state still needs upgrade after State")
+	}
+
+	addr := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_thing",
+		Name: "foo",
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+	obj := state.ResourceInstance(addr).Current
+	if obj.AttrsFlat != nil {
+		t.Error("instance still has flatmap attributes")
+	}
+	if got, want := string(obj.AttrsJSON), `"ami-abc123"`; !strings.Contains(got, want) {
+		t.Errorf("wrong upgraded attributes\ngot:  %s\nwant substring: %s", got, want)
+	}
+}
+
+func TestState_missingSchema(t *testing.T) {
+	state := testStateWithFlatmap(t)
+
+	diags := State(state, schemasFixed{})
+	if !diags.HasErrors() {
+		t.Fatal("succeeded without a schema; want error")
+	}
+	if got, want := diags.Err().Error(), "Missing resource type schema"; !strings.Contains(got, want) {
+		t.Errorf("wrong error\ngot:  %s\nwant substring: %s", got, want)
+	}
+
+	// The instance that couldn't be upgraded must be left untouched.
+	if !NeedsUpgrade(state) {
+		t.Error("state no longer needs upgrade; the failed instance should be unchanged")
+	}
+}
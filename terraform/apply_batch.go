@@ -0,0 +1,127 @@
+package terraform
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/plans"
+	"github.com/hashicorp/terraform/providers"
+)
+
+// applyBatchWindow is how long the batcher waits after the first operation
+// arrives for a given batch key before dispatching, gathering any other
+// compatible operations that become ready in the meantime.
+const applyBatchWindow = 25 * time.Millisecond
+
+// applyBatchKey returns the key under which the given operation may be
+// coalesced with others, or an empty string if the operation must not be
+// batched.
+//
+// Only Create and Update operations are coalesced: they act on distinct
+// instances and so cannot invalidate one another, whereas destroy and
+// replace operations have ordering relationships with other nodes that the
+// graph enforces and a batch must not defeat.
+func applyBatchKey(providerAddr addrs.AbsProviderConfig, typeName string, action plans.Action) string {
+	switch action {
+	case plans.Create, plans.Update:
+		return providerAddr.String() + "|" + typeName
+	default:
+		return ""
+	}
+}
+
+// applyBatcher coalesces compatible ApplyResourceChange calls destined for
+// a provider that implements providers.BatchApplier, delivering them as a
+// single batch RPC. A single applyBatcher is shared by all of the
+// EvalContext objects in a graph walk.
+//
+// Operations are grouped by the batch key computed by applyBatchKey; the
+// first operation to arrive for a key opens a short collection window and
+// every operation that arrives for the same key before the window closes
+// joins the same batch. Callers block until their individual result is
+// available, so from the perspective of the graph walk a batched apply
+// behaves exactly like a direct provider call.
+type applyBatcher struct {
+	mu      sync.Mutex
+	pending map[string]*applyBatch
+}
+
+type applyBatch struct {
+	requests []providers.ApplyResourceChangeRequest
+	results  []chan providers.ApplyResourceChangeResponse
+}
+
+func newApplyBatcher() *applyBatcher {
+	return &applyBatcher{
+		pending: make(map[string]*applyBatch),
+	}
+}
+
+// Apply either adds the given request to a batch for the given key or,
+// when batching is not possible, calls the provider directly. In both
+// cases it blocks until the result for this particular request is
+// available.
+//
+// Batching is used only when the provider implements
+// providers.BatchApplier and the key is non-empty. A nil *applyBatcher is
+// accepted and always calls the provider directly, since some codepaths
+// (and many tests) evaluate apply nodes without a full graph walk.
+func (b *applyBatcher) Apply(provider providers.Interface, key string, req providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeResponse {
+	batchApplier, ok := provider.(providers.BatchApplier)
+	if b == nil || !ok || key == "" {
+		return provider.ApplyResourceChange(req)
+	}
+
+	b.mu.Lock()
+	batch, ok := b.pending[key]
+	if !ok {
+		batch = &applyBatch{}
+		b.pending[key] = batch
+		time.AfterFunc(applyBatchWindow, func() {
+			b.dispatch(key, batchApplier)
+		})
+	}
+	ch := make(chan providers.ApplyResourceChangeResponse, 1)
+	batch.requests = append(batch.requests, req)
+	batch.results = append(batch.results, ch)
+	b.mu.Unlock()
+
+	return <-ch
+}
+
+// dispatch removes the pending batch for the given key, delivers it to the
+// provider in a single call, and fans the results back out to the waiting
+// callers.
+func (b *applyBatcher) dispatch(key string, batchApplier providers.BatchApplier) {
+	b.mu.Lock()
+	batch := b.pending[key]
+	delete(b.pending, key)
+	b.mu.Unlock()
+	if batch == nil {
+		return
+	}
+
+	log.Printf("[DEBUG] applyBatcher: dispatching batch of %d changes for %s", len(batch.requests), key)
+	resp := batchApplier.ApplyResourceChangeBatch(providers.ApplyResourceChangeBatchRequest{
+		Changes: batch.requests,
+	})
+
+	for i, ch := range batch.results {
+		if i < len(resp.Results) {
+			ch <- resp.Results[i]
+			continue
+		}
+		// The provider broke the protocol by returning too few results.
+		// We must still unblock the remaining callers, so they each get
+		// an error response.
+		var missing providers.ApplyResourceChangeResponse
+		missing.Diagnostics = missing.Diagnostics.Append(fmt.Errorf(
+			"provider returned %d results for a batch of %d changes; this is a bug in the provider, which should be reported",
+			len(resp.Results), len(batch.requests),
+		))
+		ch <- missing
+	}
+}
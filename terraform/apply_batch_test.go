@@ -0,0 +1,145 @@
+package terraform
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/plans"
+	"github.com/hashicorp/terraform/providers"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// batchingMockProvider wraps MockProvider with an implementation of
+// providers.BatchApplier that records the batches it receives.
+type batchingMockProvider struct {
+	*MockProvider
+
+	mu      sync.Mutex
+	batches [][]providers.ApplyResourceChangeRequest
+}
+
+func (p *batchingMockProvider) ApplyResourceChangeBatch(req providers.ApplyResourceChangeBatchRequest) providers.ApplyResourceChangeBatchResponse {
+	p.mu.Lock()
+	p.batches = append(p.batches, req.Changes)
+	p.mu.Unlock()
+
+	var resp providers.ApplyResourceChangeBatchResponse
+	for _, change := range req.Changes {
+		resp.Results = append(resp.Results, providers.ApplyResourceChangeResponse{
+			NewState: change.PlannedState,
+		})
+	}
+	return resp
+}
+
+func TestApplyBatchKey(t *testing.T) {
+	providerAddr := addrs.RootModuleInstance.ProviderConfigDefault(addrs.NewLegacyProvider("aws"))
+
+	if got := applyBatchKey(providerAddr, "aws_instance", plans.Create); got == "" {
+		t.Error("no batch key for create; want one")
+	}
+	if got := applyBatchKey(providerAddr, "aws_instance", plans.Update); got == "" {
+		t.Error("no batch key for update; want one")
+	}
+	if got := applyBatchKey(providerAddr, "aws_instance", plans.Delete); got != "" {
+		t.Errorf("batch key %q for delete; want none", got)
+	}
+
+	createKey := applyBatchKey(providerAddr, "aws_instance", plans.Create)
+	otherTypeKey := applyBatchKey(providerAddr, "aws_eip", plans.Create)
+	if createKey == otherTypeKey {
+		t.Errorf("same batch key %q for different resource types", createKey)
+	}
+}
+
+func TestApplyBatcher_coalesce(t *testing.T) {
+	provider := &batchingMockProvider{MockProvider: new(MockProvider)}
+	b := newApplyBatcher()
+
+	const n = 3
+	var wg sync.WaitGroup
+	responses := make([]providers.ApplyResourceChangeResponse, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			responses[i] = b.Apply(provider, "provider.aws|aws_instance", providers.ApplyResourceChangeRequest{
+				TypeName: "aws_instance",
+				PlannedState: cty.ObjectVal(map[string]cty.Value{
+					"id": cty.NumberIntVal(int64(i)),
+				}),
+			})
+		}(i)
+	}
+	wg.Wait()
+
+	if got, want := len(provider.batches), 1; got != want {
+		t.Fatalf("%d batch calls; want %d", got, want)
+	}
+	if got, want := len(provider.batches[0]), n; got != want {
+		t.Fatalf("%d changes in batch; want %d", got, want)
+	}
+	if provider.ApplyResourceChangeCalled {
+		t.Error("ApplyResourceChange was called directly; want batch only")
+	}
+	for i, resp := range responses {
+		if resp.Diagnostics.HasErrors() {
+			t.Fatalf("response %d has errors: %s", i, resp.Diagnostics.Err())
+		}
+		got := resp.NewState.GetAttr("id")
+		if want := cty.NumberIntVal(int64(i)); !got.RawEquals(want) {
+			t.Errorf("wrong result for request %d\ngot:  %#v\nwant: %#v", i, got, want)
+		}
+	}
+}
+
+func TestApplyBatcher_fallback(t *testing.T) {
+	b := newApplyBatcher()
+	planned := cty.ObjectVal(map[string]cty.Value{
+		"id": cty.StringVal("foo"),
+	})
+
+	// A provider that doesn't implement BatchApplier gets a direct call...
+	plain := new(MockProvider)
+	plain.ApplyResourceChangeFn = func(req providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeResponse {
+		return providers.ApplyResourceChangeResponse{NewState: req.PlannedState}
+	}
+	resp := b.Apply(plain, "provider.aws|aws_instance", providers.ApplyResourceChangeRequest{
+		TypeName:     "aws_instance",
+		PlannedState: planned,
+	})
+	if !plain.ApplyResourceChangeCalled {
+		t.Fatal("ApplyResourceChange not called for non-batching provider")
+	}
+	if !resp.NewState.RawEquals(planned) {
+		t.Errorf("wrong new state %#v; want %#v", resp.NewState, planned)
+	}
+
+	// ...as does a batch-capable provider when the operation has no batch
+	// key, e.g. because it is a destroy.
+	batching := &batchingMockProvider{MockProvider: new(MockProvider)}
+	batching.ApplyResourceChangeFn = plain.ApplyResourceChangeFn
+	b.Apply(batching, "", providers.ApplyResourceChangeRequest{
+		TypeName:     "aws_instance",
+		PlannedState: planned,
+	})
+	if !batching.ApplyResourceChangeCalled {
+		t.Fatal("ApplyResourceChange not called for unbatchable operation")
+	}
+	if len(batching.batches) != 0 {
+		t.Errorf("unbatchable operation was delivered in a batch")
+	}
+
+	// A nil batcher always calls the provider directly.
+	direct := &batchingMockProvider{MockProvider: new(MockProvider)}
+	direct.ApplyResourceChangeFn = plain.ApplyResourceChangeFn
+	var nilBatcher *applyBatcher
+	nilBatcher.Apply(direct, "provider.aws|aws_instance", providers.ApplyResourceChangeRequest{
+		TypeName:     "aws_instance",
+		PlannedState: planned,
+	})
+	if !direct.ApplyResourceChangeCalled {
+		t.Fatal("ApplyResourceChange not called with nil batcher")
+	}
+}
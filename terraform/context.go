@@ -6,9 +6,14 @@ import (
 	"fmt"
 	"log"
 	"sync"
+	"time"
 
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/hashicorp/terraform/addrs"
 	"github.com/hashicorp/terraform/configs"
+	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/hashicorp/terraform/dag"
 	"github.com/hashicorp/terraform/instances"
 	"github.com/hashicorp/terraform/lang"
 	"github.com/hashicorp/terraform/plans"
@@ -17,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform/states"
 	"github.com/hashicorp/terraform/states/statefile"
 	"github.com/hashicorp/terraform/tfdiags"
+	"github.com/hashicorp/terraform/tracing"
 	"github.com/zclconf/go-cty/cty"
 )
 
@@ -51,6 +57,7 @@ type ContextOpts struct {
 	Changes   *plans.Changes
 	State     *states.State
 	Targets   []addrs.Targetable
+	Excludes  []addrs.Targetable
 	Variables InputValues
 	Meta      *ContextMeta
 	Destroy   bool
@@ -60,11 +67,67 @@ type ContextOpts struct {
 	ProviderResolver providers.Resolver
 	Provisioners     map[string]ProvisionerFactory
 
+	// NodeDurations optionally provides estimated run durations for graph
+	// nodes, keyed by the node name as shown in graph renderings. When a
+	// walk has more ready nodes than the parallelism limit allows, nodes on
+	// the longest estimated critical path are dispatched first. Nodes with
+	// no entry are assumed to take defaultNodeDuration. These estimates are
+	// typically recorded timings from a previous run.
+	NodeDurations map[string]time.Duration
+
 	// If non-nil, will apply as additional constraints on the provider
 	// plugins that will be requested from the provider resolver.
 	ProviderSHA256s    map[string][]byte
 	SkipProviderVerify bool
 
+	// ProviderDevOverrides maps provider addresses to factories that take
+	// precedence over whatever ProviderResolver would select, bypassing
+	// version resolution and installation entirely. This is intended for
+	// provider developers testing local builds or already-running plugin
+	// processes, and must not be used in normal operation.
+	ProviderDevOverrides map[addrs.Provider]providers.Factory
+
+	// ProviderCallBudgets optionally caps the number of resource operations
+	// (read, plan, apply, import, data read) that may be sent to each
+	// provider during operations on this context, keyed by provider source
+	// address. The empty string key applies to any provider that has no
+	// more specific budget. Zero or negative budgets mean unlimited.
+	ProviderCallBudgets map[string]int
+
+	// FederatedStates is an optional set of additional states whose root
+	// output values are mounted read-only into the expression scope under
+	// state.<name>.outputs, allowing configurations to consume results
+	// from other workspaces without a data source.
+	FederatedStates map[string]*states.State
+
+	// ProviderConfigOverrides optionally provides configuration argument
+	// values to merge into every configuration of each provider type,
+	// keyed by provider type name (e.g. "aws"). Each value must be an
+	// object whose attribute names match arguments in the provider's
+	// configuration schema. Overridden arguments take precedence over
+	// values from both configuration and provider input, allowing a host
+	// application to centrally inject settings such as credentials
+	// without modifying the configuration itself.
+	ProviderConfigOverrides map[string]cty.Value
+
+	// PreviousPlan optionally provides the plan from an earlier run against
+	// the same configuration. Resource instances whose planning inputs
+	// (resolved configuration, prior state, and provider) are unchanged
+	// since that plan will reuse its recorded result instead of asking the
+	// provider to plan again, which can considerably speed up planning for
+	// large, mostly-unchanged configurations. Results from the previous
+	// plan are used only when the provider plugin can be verified (via
+	// ProviderSHA256s) to be the same executable that created them.
+	PreviousPlan *plans.Plan
+
+	// DestroyProtectionOverride, when non-empty, causes
+	// lifecycle.prevent_destroy violations to be reported as warnings
+	// rather than errors, so that emergency teardowns don't require
+	// configuration edits. The string should describe who or what
+	// requested the override (for example, the name of a CLI flag or an
+	// operator) and is recorded in the resulting plan for auditing.
+	DestroyProtectionOverride string
+
 	UIInput UIInput
 }
 
@@ -74,6 +137,11 @@ type ContextOpts struct {
 // initializer.
 type ContextMeta struct {
 	Env string // Env is the state environment
+
+	// Version is the Terraform version running this context, exposed into
+	// the configuration as terraform.version. It may be left empty, in
+	// which case terraform.version evaluates to an empty string.
+	Version string
 }
 
 // Context represents all the context that Terraform needs in order to
@@ -84,25 +152,46 @@ type Context struct {
 	changes   *plans.Changes
 	state     *states.State
 	targets   []addrs.Targetable
+	excludes  []addrs.Targetable
 	variables InputValues
 	meta      *ContextMeta
 	destroy   bool
 
+	// destroyProtectionOverride is non-empty if this context was created
+	// with ContextOpts.DestroyProtectionOverride set, in which case it
+	// records who or what requested the override.
+	destroyProtectionOverride string
+
+	// planned records whether Plan has completed on this context, which
+	// gates the use of EvalAfterPlan. plannedState is the working state
+	// left behind by the plan walk, which includes the planned new values
+	// for any changing resource instances.
+	planned      bool
+	plannedState *states.State
+
 	hooks      []Hook
 	components contextComponentFactory
 	schemas    *Schemas
 	sh         *stopHook
 	uiInput    UIInput
 
-	l                   sync.Mutex // Lock acquired during any task
-	parallelSem         Semaphore
-	providerInputConfig map[string]map[string]cty.Value
-	providerSHA256s     map[string][]byte
-	runLock             sync.Mutex
-	runCond             *sync.Cond
-	runContext          context.Context
-	runContextCancel    context.CancelFunc
-	shadowErr           error
+	l                       sync.Mutex // Lock acquired during any task
+	parallelSem             *prioritySemaphore
+	nodeDurations           map[string]time.Duration
+	previousPlanChanges     *plans.Changes
+	importTargets           map[string]*configs.Import
+	removedTargets          []*configs.Removed
+	targetingReport         *plans.TargetingReport
+	providerInputConfig     map[string]map[string]cty.Value
+	providerConfigOverrides map[string]cty.Value
+	providerSHA256s         map[string][]byte
+	providerMetrics         *ProviderCallMetrics
+	federatedStates         map[string]*states.State
+	runLock                 sync.Mutex
+	runCond                 *sync.Cond
+	runContext              context.Context
+	runContextCancel        context.CancelFunc
+	shadowErr               error
 }
 
 // (additional methods on Context can be found in context_*.go files.)
@@ -174,6 +263,13 @@ func NewContext(opts *ContextOpts) (*Context, tfdiags.Diagnostics) {
 	if opts.ProviderResolver != nil {
 		deps := ConfigTreeDependencies(opts.Config, state)
 		reqd := deps.AllPluginRequirements()
+		// Providers served by development overrides are not resolved at
+		// all, so they need not be installed.
+		for name := range reqd {
+			if _, ok := opts.ProviderDevOverrides[addrs.NewLegacyProvider(name)]; ok {
+				delete(reqd, name)
+			}
+		}
 		if opts.ProviderSHA256s != nil && !opts.SkipProviderVerify {
 			reqd.LockExecutables(opts.ProviderSHA256s)
 		}
@@ -189,16 +285,31 @@ func NewContext(opts *ContextOpts) (*Context, tfdiags.Diagnostics) {
 		providerFactories = make(map[addrs.Provider]providers.Factory)
 	}
 
-	components := &basicComponentFactory{
-		providers:    providerFactories,
-		provisioners: opts.Provisioners,
+	// Developer overrides win over anything the resolver selected, so that
+	// provider developers can test local builds without faking a registry.
+	for fqn, factory := range opts.ProviderDevOverrides {
+		log.Printf("[WARN] terraform.NewContext: provider %s is overridden by a development override and will not be verified", fqn)
+		providerFactories[fqn] = factory
 	}
 
-	log.Printf("[TRACE] terraform.NewContext: loading provider schemas")
-	schemas, err := LoadSchemas(opts.Config, opts.State, components)
-	if err != nil {
-		diags = diags.Append(err)
-		return nil, diags
+	// The pooling wrapper lets many aliased configurations of the same
+	// provider share one plugin process where the protocol allows it.
+	components := newPoolingComponentFactory(&basicComponentFactory{
+		providers:    providerFactories,
+		provisioners: opts.Provisioners,
+	})
+
+	// Schemas are loaded lazily, on the first request for each provider or
+	// provisioner, so that we don't pay the plugin startup cost for plugins
+	// whose schemas are never needed by the operations run against this
+	// context, such as providers whose resources are all pruned away by
+	// resource targeting. Any loading failures are collected during graph
+	// walking rather than reported here.
+	log.Printf("[TRACE] terraform.NewContext: deferring schema loading until first use")
+	schemas := &Schemas{
+		Providers:    map[addrs.Provider]*ProviderSchema{},
+		Provisioners: map[string]*configschema.Block{},
+		broker:       newSchemaBroker(components),
 	}
 
 	changes := opts.Changes
@@ -211,6 +322,12 @@ func NewContext(opts *ContextOpts) (*Context, tfdiags.Diagnostics) {
 		config = configs.NewEmptyConfig()
 	}
 
+	importTargets, importDiags := configImportTargets(config)
+	diags = diags.Append(importDiags)
+
+	removedTargets, removedDiags := configRemovedTargets(config)
+	diags = diags.Append(removedDiags)
+
 	log.Printf("[TRACE] terraform.NewContext: complete")
 
 	// By the time we get here, we should have values defined for all of
@@ -235,18 +352,40 @@ func NewContext(opts *ContextOpts) (*Context, tfdiags.Diagnostics) {
 		config:     config,
 		state:      state,
 		targets:    opts.Targets,
+		excludes:   opts.Excludes,
 		uiInput:    opts.UIInput,
 		variables:  variables,
 
-		parallelSem:         NewSemaphore(par),
-		providerInputConfig: make(map[string]map[string]cty.Value),
-		providerSHA256s:     opts.ProviderSHA256s,
-		sh:                  sh,
+		destroyProtectionOverride: opts.DestroyProtectionOverride,
+
+		parallelSem:             newPrioritySemaphore(par),
+		nodeDurations:           opts.NodeDurations,
+		previousPlanChanges:     reusablePlanChanges(opts.PreviousPlan, opts.ProviderSHA256s),
+		importTargets:           importTargets,
+		removedTargets:          removedTargets,
+		providerInputConfig:     make(map[string]map[string]cty.Value),
+		providerConfigOverrides: opts.ProviderConfigOverrides,
+		providerSHA256s:         opts.ProviderSHA256s,
+		providerMetrics:         newProviderCallMetrics(opts.ProviderCallBudgets),
+		federatedStates:         opts.FederatedStates,
+		sh:                      sh,
 	}, diags
 }
 
+// Schemas returns the schemas for the providers and provisioners used by
+// this context's configuration and state, loading any that have not yet
+// been requested by an operation so that callers may enumerate the result
+// in full.
 func (c *Context) Schemas() *Schemas {
-	return c.schemas
+	return c.schemas.allLoaded(c.config, c.state)
+}
+
+// ProviderCallMetrics returns the accumulated provider RPC call counts for
+// all operations performed with this context so far. The result is part of
+// the operation metrics profile and is safe to call at any time, including
+// while a walk is in progress.
+func (c *Context) ProviderCallMetrics() *ProviderCallMetrics {
+	return c.providerMetrics
 }
 
 type ContextGraphOpts struct {
@@ -275,6 +414,7 @@ func (c *Context) Graph(typ GraphType, opts *ContextGraphOpts) (*Graph, tfdiags.
 			Components: c.components,
 			Schemas:    c.schemas,
 			Targets:    c.targets,
+			Excludes:   c.excludes,
 			Destroy:    c.destroy,
 			Validate:   opts.Validate,
 		}).Build(addrs.RootModuleInstance)
@@ -285,12 +425,14 @@ func (c *Context) Graph(typ GraphType, opts *ContextGraphOpts) (*Graph, tfdiags.
 	case GraphTypePlan:
 		// Create the plan graph builder
 		p := &PlanGraphBuilder{
-			Config:     c.config,
-			State:      c.state,
-			Components: c.components,
-			Schemas:    c.schemas,
-			Targets:    c.targets,
-			Validate:   opts.Validate,
+			Config:          c.config,
+			State:           c.state,
+			Components:      c.components,
+			Schemas:         c.schemas,
+			Targets:         c.targets,
+			Excludes:        c.excludes,
+			TargetingReport: c.targetingReport,
+			Validate:        opts.Validate,
 		}
 
 		// Some special cases for other graph types shared with plan currently
@@ -304,12 +446,14 @@ func (c *Context) Graph(typ GraphType, opts *ContextGraphOpts) (*Graph, tfdiags.
 
 	case GraphTypePlanDestroy:
 		return (&DestroyPlanGraphBuilder{
-			Config:     c.config,
-			State:      c.state,
-			Components: c.components,
-			Schemas:    c.schemas,
-			Targets:    c.targets,
-			Validate:   opts.Validate,
+			Config:          c.config,
+			State:           c.state,
+			Components:      c.components,
+			Schemas:         c.schemas,
+			Targets:         c.targets,
+			Excludes:        c.excludes,
+			TargetingReport: c.targetingReport,
+			Validate:        opts.Validate,
 		}).Build(addrs.RootModuleInstance)
 
 	case GraphTypeRefresh:
@@ -319,6 +463,7 @@ func (c *Context) Graph(typ GraphType, opts *ContextGraphOpts) (*Graph, tfdiags.
 			Components: c.components,
 			Schemas:    c.schemas,
 			Targets:    c.targets,
+			Excludes:   c.excludes,
 			Validate:   opts.Validate,
 		}).Build(addrs.RootModuleInstance)
 
@@ -389,6 +534,34 @@ func (c *Context) State() *states.State {
 // to attempt expression evaluation or other analysis, but some expressions
 // may not behave as expected.
 func (c *Context) Eval(path addrs.ModuleInstance) (*lang.Scope, tfdiags.Diagnostics) {
+	return c.eval(path, c.state)
+}
+
+// EvalPlannedValues is like Eval except that resource references resolve to
+// the planned new values from the most recent call to Plan on this context,
+// which may include unknown values for anything not decided until apply.
+//
+// It is an error to call this method before a successful call to Plan.
+func (c *Context) EvalPlannedValues(path addrs.ModuleInstance) (*lang.Scope, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+	if !c.planned {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"No plan available",
+			"EvalPlannedValues may be called only after a successful call to Plan. This is a bug in the program that is embedding Terraform.",
+		))
+		return nil, diags
+	}
+
+	// The evaluation walk must not disturb the context's idea of the
+	// current state, so we'll restore it once we have our scope. The
+	// returned scope keeps its own copy of the planned state.
+	old := c.state
+	defer func() { c.state = old }()
+	return c.eval(path, c.plannedState)
+}
+
+func (c *Context) eval(path addrs.ModuleInstance, state *states.State) (*lang.Scope, tfdiags.Diagnostics) {
 	// This is intended for external callers such as the "terraform console"
 	// command. Internally, we create an evaluator in c.walk before walking
 	// the graph, and create scopes in ContextGraphWalker.
@@ -398,7 +571,7 @@ func (c *Context) Eval(path addrs.ModuleInstance) (*lang.Scope, tfdiags.Diagnost
 
 	// Start with a copy of state so that we don't affect any instances
 	// that other methods may have already returned.
-	c.state = c.state.DeepCopy()
+	c.state = state.DeepCopy()
 	var walker *ContextGraphWalker
 
 	graph, graphDiags := c.Graph(GraphTypeEval, nil)
@@ -426,6 +599,38 @@ func (c *Context) Eval(path addrs.ModuleInstance) (*lang.Scope, tfdiags.Diagnost
 	return evalCtx.EvaluationScope(nil, EvalDataForNoInstanceKey), diags
 }
 
+// EvalAfterPlan evaluates the given expression source against the planned
+// new values produced by the most recent call to Plan on this context,
+// returning the resulting value along with any diagnostics.
+//
+// Because a plan can include values that won't be decided until apply, the
+// result may contain unknown values. Callers that can't handle unknowns
+// should check the result with IsWhollyKnown before using it.
+//
+// This is intended for ancillary tooling, such as policy checks, that needs
+// to inspect planned values using the same expression language that the
+// configuration itself uses. It is an error to call this method before a
+// successful call to Plan.
+func (c *Context) EvalAfterPlan(src string) (cty.Value, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	expr, parseDiags := hclsyntax.ParseExpression([]byte(src), "<eval-after-plan>", hcl.Pos{Line: 1, Column: 1})
+	diags = diags.Append(parseDiags)
+	if parseDiags.HasErrors() {
+		return cty.DynamicVal, diags
+	}
+
+	scope, scopeDiags := c.EvalPlannedValues(addrs.RootModuleInstance)
+	diags = diags.Append(scopeDiags)
+	if scope == nil {
+		return cty.DynamicVal, diags
+	}
+
+	val, valDiags := scope.EvalExpr(expr, cty.DynamicPseudoType)
+	diags = diags.Append(valDiags)
+	return val, diags
+}
+
 // Apply applies the changes represented by this context and returns
 // the resulting state.
 //
@@ -519,6 +724,16 @@ The -target option is not for routine use, and is provided only for exceptional
 		))
 	}
 
+	if len(c.excludes) > 0 {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Warning,
+			"Resource exclusion is in effect",
+			`You are creating a plan with the -exclude option, which means that the excluded resources and anything that depends on them have been ignored, so the result of this plan may not represent all of the changes requested by the current configuration.
+
+The -exclude option is not for routine use, and is provided only for exceptional situations such as working around a problematic resource until it can be fixed.`,
+		))
+	}
+
 	varVals := make(map[string]plans.DynamicValue, len(c.variables))
 	for k, iv := range c.variables {
 		// We use cty.DynamicPseudoType here so that we'll save both the
@@ -536,10 +751,18 @@ The -target option is not for routine use, and is provided only for exceptional
 		varVals[k] = dv
 	}
 
+	// Apply any "moved" blocks from the configuration to the state before
+	// we diff against it, so that moved objects are compared against the
+	// configuration at their new addresses rather than planned for
+	// destruction and re-creation.
+	moves := processMoves(c.config, c.state)
+
 	p := &plans.Plan{
 		VariableValues:  varVals,
 		TargetAddrs:     c.targets,
 		ProviderSHA256s: c.providerSHA256s,
+		Moves:           moves,
+		Destroy:         c.destroy,
 	}
 
 	var operation walkOperation
@@ -562,6 +785,13 @@ The -target option is not for routine use, and is provided only for exceptional
 		operation = walkPlan
 	}
 
+	// If targeting is in effect then we'll have the targeting transformer
+	// record what it implicitly included and excluded, so the UI can show
+	// the operator the full effect of their -target options.
+	if len(c.targets) > 0 {
+		c.targetingReport = &plans.TargetingReport{}
+	}
+
 	// Build the graph.
 	graphType := GraphTypePlan
 	if c.destroy {
@@ -581,10 +811,60 @@ The -target option is not for routine use, and is provided only for exceptional
 		return nil, diags
 	}
 	p.Changes = c.changes
+	p.Targeting = c.targetingReport
+
+	if c.destroyProtectionOverride != "" {
+		// Record the override and any instances it permitted to be
+		// destroyed, so that the plan file carries an audit trail of why
+		// prevent_destroy did not block this plan.
+		p.DestroyProtectionOverride = c.destroyProtectionOverride
+		p.ProtectedDestroys = c.protectedDestroys(p.Changes)
+	}
+
+	// Keep hold of the working state from the walk, which has the planned
+	// new values written into it, so that EvalAfterPlan can resolve
+	// references against it. (For a normal plan this is the temporary state
+	// that our deferred cleanup above will detach from c.state.)
+	c.planned = true
+	c.plannedState = c.state
+
+	for _, dp := range p.Changes.DeferredProvisioners {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Warning,
+			"Provisioner execution deferred until apply",
+			fmt.Sprintf("The configuration for the %q provisioner of %s refers to values that cannot be determined until apply, so it will be evaluated again during the apply phase.", dp.Type, dp.InstanceAddr),
+		))
+	}
 
 	return p, diags
 }
 
+// protectedDestroys returns the addresses of resource instances in the given
+// changes that are planned for destruction despite having
+// lifecycle.prevent_destroy set, which can happen only when the destroy
+// protection override is in effect.
+func (c *Context) protectedDestroys(changes *plans.Changes) []addrs.AbsResourceInstance {
+	var ret []addrs.AbsResourceInstance
+	if c.config == nil {
+		return ret
+	}
+	for _, rc := range changes.Resources {
+		if rc.Action != plans.Delete && !rc.Action.IsReplace() {
+			continue
+		}
+		mc := c.config.DescendentForInstance(rc.Addr.Module)
+		if mc == nil {
+			continue
+		}
+		r := mc.Module.ResourceByAddr(rc.Addr.Resource.Resource)
+		if r == nil || r.Managed == nil || !r.Managed.PreventDestroy {
+			continue
+		}
+		ret = append(ret, rc.Addr)
+	}
+	return ret
+}
+
 // Refresh goes through all the resources in the state and refreshes them
 // to their latest state. This will update the state that this context
 // works with, along with returning it.
@@ -663,6 +943,17 @@ func (c *Context) Stop() {
 	log.Printf("[WARN] terraform: stop complete")
 }
 
+// InterruptedInstances returns the addresses of the resource instances whose
+// operations were still in progress when Stop was called during the most
+// recent operation, so that callers can tell the user which instances may
+// have been left partially modified.
+//
+// The result is empty if the most recent operation ran to completion without
+// a stop request.
+func (c *Context) InterruptedInstances() []addrs.AbsResourceInstance {
+	return c.sh.Interrupted()
+}
+
 // Validate performs semantic validation of the configuration, and returning
 // any warnings or errors.
 //
@@ -771,16 +1062,43 @@ func (c *Context) walk(graph *Graph, operation walkOperation) (*ContextGraphWalk
 
 	walker := c.graphWalker(operation)
 
+	// If tracing is enabled then the whole walk is recorded as one span,
+	// with each vertex evaluation and provider call as a child span.
+	traceCtx, walkSpan := tracing.StartSpan(context.Background(), "terraform."+operation.String())
+	if walkSpan != nil {
+		walkSpan.SetAttribute("graph.vertices", len(graph.Vertices()))
+		walker.TraceContext = traceCtx
+	}
+
+	// Compute per-node priorities for the walk so that, when more nodes are
+	// ready than the parallelism limit allows, the ones with the longest
+	// estimated critical path are dispatched first.
+	walker.Priorities = criticalPaths(graph, func(v dag.Vertex) time.Duration {
+		if d, ok := c.nodeDurations[dag.VertexName(v)]; ok {
+			return d
+		}
+		return defaultNodeDuration
+	})
+
 	// Watch for a stop so we can call the provider Stop() API.
 	watchStop, watchWait := c.watchStop(walker)
 
 	// Walk the real graph, this will block until it completes
 	diags := graph.Walk(walker)
 
+	// Building and walking the graph may have loaded schemas on demand, so
+	// collect any loading failures that occurred along the way.
+	diags = diags.Append(c.schemas.pendingDiagnostics())
+
 	// Close the channel so the watcher stops, and wait for it to return.
 	close(watchStop)
 	<-watchWait
 
+	if diags.HasErrors() {
+		walkSpan.RecordError(diags.Err())
+	}
+	walkSpan.End()
+
 	return walker, diags
 }
 
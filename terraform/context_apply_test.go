@@ -297,7 +297,7 @@ func TestContext2Apply_resourceDependsOnModuleStateOnly(t *testing.T) {
 		&states.ResourceInstanceObjectSrc{
 			Status:       states.ObjectReady,
 			AttrsJSON:    []byte(`{"id":"parent"}`),
-			Dependencies: []addrs.AbsResource{mustResourceAddr("module.child.aws_instance.child")},
+			Dependencies: []addrs.ConfigResource{mustResourceAddr("module.child.aws_instance.child")},
 		},
 		mustProviderConfig(`provider["registry.terraform.io/-/aws"]`),
 	)
@@ -1272,7 +1272,7 @@ func testContext2Apply_destroyDependsOn(t *testing.T) {
 		&states.ResourceInstanceObjectSrc{
 			Status:       states.ObjectReady,
 			AttrsJSON:    []byte(`{"id":"foo"}`),
-			Dependencies: []addrs.AbsResource{mustResourceAddr("aws_instance.bar")},
+			Dependencies: []addrs.ConfigResource{mustResourceAddr("aws_instance.bar")},
 		},
 		mustProviderConfig(`provider["registry.terraform.io/-/aws"]`),
 	)
@@ -1328,7 +1328,7 @@ func TestContext2Apply_destroyDependsOnStateOnly(t *testing.T) {
 		&states.ResourceInstanceObjectSrc{
 			Status:       states.ObjectReady,
 			AttrsJSON:    []byte(`{"id":"foo"}`),
-			Dependencies: []addrs.AbsResource{},
+			Dependencies: []addrs.ConfigResource{},
 		},
 		addrs.AbsProviderConfig{
 			Provider: addrs.NewLegacyProvider("aws"),
@@ -1344,14 +1344,14 @@ func TestContext2Apply_destroyDependsOnStateOnly(t *testing.T) {
 		&states.ResourceInstanceObjectSrc{
 			Status:    states.ObjectReady,
 			AttrsJSON: []byte(`{"id":"bar"}`),
-			Dependencies: []addrs.AbsResource{
-				addrs.AbsResource{
+			Dependencies: []addrs.ConfigResource{
+				addrs.ConfigResource{
 					Resource: addrs.Resource{
 						Mode: addrs.ManagedResourceMode,
 						Type: "aws_instance",
 						Name: "foo",
 					},
-					Module: root.Addr,
+					Module: root.Addr.Module(),
 				},
 			},
 		},
@@ -1426,7 +1426,7 @@ func TestContext2Apply_destroyDependsOnStateOnlyModule(t *testing.T) {
 		&states.ResourceInstanceObjectSrc{
 			Status:       states.ObjectReady,
 			AttrsJSON:    []byte(`{"id":"foo"}`),
-			Dependencies: []addrs.AbsResource{},
+			Dependencies: []addrs.ConfigResource{},
 		},
 		addrs.AbsProviderConfig{
 			Provider: addrs.NewLegacyProvider("aws"),
@@ -1442,14 +1442,14 @@ func TestContext2Apply_destroyDependsOnStateOnlyModule(t *testing.T) {
 		&states.ResourceInstanceObjectSrc{
 			Status:    states.ObjectReady,
 			AttrsJSON: []byte(`{"id":"bar"}`),
-			Dependencies: []addrs.AbsResource{
-				addrs.AbsResource{
+			Dependencies: []addrs.ConfigResource{
+				addrs.ConfigResource{
 					Resource: addrs.Resource{
 						Mode: addrs.ManagedResourceMode,
 						Type: "aws_instance",
 						Name: "foo",
 					},
-					Module: child.Addr,
+					Module: child.Addr.Module(),
 				},
 			},
 		},
@@ -2707,7 +2707,7 @@ func TestContext2Apply_moduleDestroyOrder(t *testing.T) {
 		&states.ResourceInstanceObjectSrc{
 			Status:       states.ObjectReady,
 			AttrsJSON:    []byte(`{"id":"b"}`),
-			Dependencies: []addrs.AbsResource{mustResourceAddr("module.child.aws_instance.a")},
+			Dependencies: []addrs.ConfigResource{mustResourceAddr("module.child.aws_instance.a")},
 		},
 		mustProviderConfig(`provider["registry.terraform.io/-/aws"]`),
 	)
@@ -2847,6 +2847,28 @@ func TestContext2Apply_orphanResource(t *testing.T) {
 		}, providerAddr)
 	})
 
+	// The apply step records creation/modification timestamps and a unique
+	// ID on the new object, which are not deterministic, so we'll check that
+	// they were recorded and then discard them before comparing.
+	for _, ms := range state.Modules {
+		for _, rs := range ms.Resources {
+			for _, is := range rs.Instances {
+				if is.Current == nil {
+					continue
+				}
+				if is.Current.CreatedAt.IsZero() || is.Current.UpdatedAt.IsZero() {
+					t.Errorf("instance object is missing its timestamps")
+				}
+				if is.Current.UniqueID == "" {
+					t.Errorf("instance object is missing its unique ID")
+				}
+				is.Current.CreatedAt = time.Time{}
+				is.Current.UpdatedAt = time.Time{}
+				is.Current.UniqueID = ""
+			}
+		}
+	}
+
 	// compare the marshaled form to easily remove empty and nil slices
 	if !statefile.StatesMarshalEqual(state, want) {
 		t.Fatalf("wrong state after step 1\n%s", cmp.Diff(want, state))
@@ -5606,6 +5628,112 @@ aws_instance.foo["a"]:
 	}
 }
 
+// Verify that applying a resource with destroy-time provisioners records
+// that fact in the state, so that a later destroy without the configuration
+// can warn that they will not run.
+func TestContext2Apply_provisionerDestroyRecorded(t *testing.T) {
+	m := testModule(t, "apply-provisioner-destroy")
+	p := testProvider("aws")
+	pr := testProvisioner()
+	p.ApplyFn = testApplyFn
+	p.DiffFn = testDiffFn
+	pr.ApplyFn = func(rs *InstanceState, c *ResourceConfig) error {
+		return nil
+	}
+
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+			},
+		),
+		Provisioners: map[string]ProvisionerFactory{
+			"shell": testProvisionerFuncFixed(pr),
+		},
+		Variables: InputValues{
+			"input": &InputValue{
+				Value: cty.MapVal(map[string]cty.Value{
+					"a": cty.StringVal("b"),
+				}),
+				SourceType: ValueFromInput,
+			},
+		},
+	})
+
+	if _, diags := ctx.Plan(); diags.HasErrors() {
+		t.Fatalf("plan errors: %s", diags.Err())
+	}
+
+	state, diags := ctx.Apply()
+	if diags.HasErrors() {
+		t.Fatalf("diags: %s", diags.Err())
+	}
+
+	is := state.ResourceInstance(mustResourceInstanceAddr(`aws_instance.foo["a"]`))
+	if is == nil || is.Current == nil {
+		t.Fatal("no state recorded for aws_instance.foo[\"a\"]")
+	}
+	if !is.Current.HasDestroyProvisioners {
+		t.Fatal("destroy-time provisioners were not recorded in state")
+	}
+}
+
+// Verify that destroying an instance whose configuration has been removed
+// warns that its recorded destroy-time provisioners cannot run.
+func TestContext2Apply_provisionerDestroyRemovedWarning(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": ``,
+	})
+	p := testProvider("aws")
+	p.ApplyFn = testApplyFn
+	p.DiffFn = testDiffFn
+
+	state := states.NewState()
+	root := state.RootModule()
+	root.SetResourceInstanceCurrent(
+		mustResourceInstanceAddr(`aws_instance.foo`).Resource,
+		&states.ResourceInstanceObjectSrc{
+			Status:                 states.ObjectReady,
+			AttrsJSON:              []byte(`{"id":"bar"}`),
+			HasDestroyProvisioners: true,
+		},
+		mustProviderConfig(`provider["registry.terraform.io/-/aws"]`),
+	)
+
+	ctx := testContext2(t, &ContextOpts{
+		Config:  m,
+		State:   state,
+		Destroy: true,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+			},
+		),
+	})
+
+	if _, diags := ctx.Plan(); diags.HasErrors() {
+		t.Fatalf("plan errors: %s", diags.Err())
+	}
+
+	state, diags := ctx.Apply()
+	if diags.HasErrors() {
+		t.Fatalf("diags: %s", diags.Err())
+	}
+
+	checkStateString(t, state, `<no state>`)
+
+	found := false
+	for _, diag := range diags {
+		if diag.Severity() == tfdiags.Warning && diag.Description().Summary == "Destroy-time provisioners skipped" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("no warning about skipped destroy-time provisioners in %s", diags.ErrWithWarnings())
+	}
+}
+
 func TestContext2Apply_provisionerResourceRef(t *testing.T) {
 	m := testModule(t, "apply-provisioner-resource-ref")
 	p := testProvider("aws")
@@ -7351,6 +7479,50 @@ func TestContext2Apply_hook(t *testing.T) {
 	}
 }
 
+func TestContext2Apply_hookPreApplyVeto(t *testing.T) {
+	m := testModule(t, "apply-good")
+	h := new(MockHook)
+	h.PreApplyVetoFn = func(addr addrs.AbsResourceInstance, change *plans.ResourceInstanceChange) (HookAction, error) {
+		if addr.String() == "aws_instance.foo" {
+			return HookActionContinue, errors.New("not allowed by policy")
+		}
+		return HookActionContinue, nil
+	}
+	p := testProvider("aws")
+	p.ApplyFn = testApplyFn
+	p.DiffFn = testDiffFn
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		Hooks:  []Hook{h},
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+			},
+		),
+	})
+
+	if _, diags := ctx.Plan(); diags.HasErrors() {
+		t.Fatalf("plan errors: %s", diags.Err())
+	}
+
+	state, diags := ctx.Apply()
+	if !diags.HasErrors() {
+		t.Fatal("apply succeeded; want veto error")
+	}
+	if got, want := diags.Err().Error(), "vetoed at apply time: not allowed by policy"; !strings.Contains(got, want) {
+		t.Errorf("wrong error %q; want substring %q", got, want)
+	}
+
+	if !h.PreApplyVetoCalled {
+		t.Fatal("PreApplyVeto should be called")
+	}
+
+	// The vetoed instance must not have been created.
+	if is := state.ResourceInstance(mustResourceInstanceAddr("aws_instance.foo")); is != nil {
+		t.Error("aws_instance.foo was applied despite the veto")
+	}
+}
+
 func TestContext2Apply_hookOrphan(t *testing.T) {
 	m := testModule(t, "apply-blank")
 	h := new(MockHook)
@@ -8024,7 +8196,7 @@ func TestContext2Apply_targetedDestroyCountDeps(t *testing.T) {
 		&states.ResourceInstanceObjectSrc{
 			Status:       states.ObjectReady,
 			AttrsJSON:    []byte(`{"id":"i-abc123"}`),
-			Dependencies: []addrs.AbsResource{mustResourceAddr("aws_instance.foo")},
+			Dependencies: []addrs.ConfigResource{mustResourceAddr("aws_instance.foo")},
 		},
 		mustProviderConfig(`provider["registry.terraform.io/-/aws"]`),
 	)
@@ -8630,14 +8802,14 @@ func TestContext2Apply_createBefore_depends(t *testing.T) {
 		&states.ResourceInstanceObjectSrc{
 			Status:    states.ObjectReady,
 			AttrsJSON: []byte(`{"id":"baz","instance":"bar"}`),
-			Dependencies: []addrs.AbsResource{
-				addrs.AbsResource{
+			Dependencies: []addrs.ConfigResource{
+				addrs.ConfigResource{
 					Resource: addrs.Resource{
 						Mode: addrs.ManagedResourceMode,
 						Type: "aws_instance",
 						Name: "web",
 					},
-					Module: addrs.RootModuleInstance,
+					Module: addrs.RootModule,
 				},
 			},
 		},
@@ -8763,14 +8935,14 @@ func TestContext2Apply_singleDestroy(t *testing.T) {
 		&states.ResourceInstanceObjectSrc{
 			Status:    states.ObjectReady,
 			AttrsJSON: []byte(`{"id":"baz","instance":"bar"}`),
-			Dependencies: []addrs.AbsResource{
-				addrs.AbsResource{
+			Dependencies: []addrs.ConfigResource{
+				addrs.ConfigResource{
 					Resource: addrs.Resource{
 						Mode: addrs.ManagedResourceMode,
 						Type: "aws_instance",
 						Name: "web",
 					},
-					Module: addrs.RootModuleInstance,
+					Module: addrs.RootModule,
 				},
 			},
 		},
@@ -10638,22 +10810,22 @@ func TestContext2Apply_cbdCycle(t *testing.T) {
 		&states.ResourceInstanceObjectSrc{
 			Status:    states.ObjectReady,
 			AttrsJSON: []byte(`{"id":"a","require_new":"old","foo":"b"}`),
-			Dependencies: []addrs.AbsResource{
-				addrs.AbsResource{
+			Dependencies: []addrs.ConfigResource{
+				addrs.ConfigResource{
 					Resource: addrs.Resource{
 						Mode: addrs.ManagedResourceMode,
 						Type: "test_instance",
 						Name: "b",
 					},
-					Module: addrs.RootModuleInstance,
+					Module: addrs.RootModule,
 				},
-				addrs.AbsResource{
+				addrs.ConfigResource{
 					Resource: addrs.Resource{
 						Mode: addrs.ManagedResourceMode,
 						Type: "test_instance",
 						Name: "c",
 					},
-					Module: addrs.RootModuleInstance,
+					Module: addrs.RootModule,
 				},
 			},
 		},
@@ -10671,14 +10843,14 @@ func TestContext2Apply_cbdCycle(t *testing.T) {
 		&states.ResourceInstanceObjectSrc{
 			Status:    states.ObjectReady,
 			AttrsJSON: []byte(`{"id":"b","require_new":"old","foo":"c"}`),
-			Dependencies: []addrs.AbsResource{
-				addrs.AbsResource{
+			Dependencies: []addrs.ConfigResource{
+				addrs.ConfigResource{
 					Resource: addrs.Resource{
 						Mode: addrs.ManagedResourceMode,
 						Type: "test_instance",
 						Name: "c",
 					},
-					Module: addrs.RootModuleInstance,
+					Module: addrs.RootModule,
 				},
 			},
 		},
@@ -10741,3 +10913,248 @@ func TestContext2Apply_cbdCycle(t *testing.T) {
 		t.Fatalf("diags: %s", diags.Err())
 	}
 }
+
+func TestContext2Apply_resourcePostcondition(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+resource "aws_instance" "foo" {
+  num = 2
+
+  lifecycle {
+    postcondition {
+      condition     = self.id == "bar"
+      error_message = "Must have the id \"bar\"."
+    }
+  }
+}`,
+	})
+
+	p := testProvider("aws")
+	p.ApplyFn = testApplyFn
+	p.DiffFn = testDiffFn
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+			},
+		),
+	})
+
+	// The id attribute isn't known until apply, so during planning the
+	// postcondition passes pending a re-check against the final value.
+	if _, diags := ctx.Plan(); diags.HasErrors() {
+		t.Fatalf("plan errors: %s", diags.Err())
+	}
+
+	// testApplyFn assigns the id "foo", so the postcondition fails once
+	// the final value is known.
+	state, diags := ctx.Apply()
+	if !diags.HasErrors() {
+		t.Fatal("succeeded; want errors")
+	}
+
+	gotErrStr := diags.Err().Error()
+	if !strings.Contains(gotErrStr, "Resource postcondition failed") {
+		t.Errorf("missing expected error\ngot: %s\n\nwant: error containing %q", gotErrStr, "Resource postcondition failed")
+	}
+	if !strings.Contains(gotErrStr, `Must have the id "bar".`) {
+		t.Errorf("missing custom error message\ngot: %s\n\nwant: error containing %q", gotErrStr, `Must have the id "bar".`)
+	}
+
+	// The object was still created and recorded, since the postcondition
+	// doesn't roll back the apply itself.
+	if got := state.ResourceInstance(mustResourceInstanceAddr("aws_instance.foo")); got == nil {
+		t.Errorf("aws_instance.foo is missing from the final state")
+	}
+}
+
+func TestContext2Apply_sensitiveVariable(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+variable "secret" {
+  sensitive = true
+  default   = "shh"
+}
+
+resource "aws_instance" "foo" {
+  foo = var.secret
+}
+
+output "direct" {
+  value = var.secret
+}
+
+output "derived" {
+  value = "prefix-${var.secret}"
+}
+
+output "plain" {
+  value = "hello"
+}`,
+	})
+
+	p := testProvider("aws")
+	p.ApplyFn = testApplyFn
+	p.DiffFn = testDiffFn
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+			},
+		),
+	})
+
+	if _, diags := ctx.Plan(); diags.HasErrors() {
+		t.Fatalf("plan errors: %s", diags.Err())
+	}
+
+	state, diags := ctx.Apply()
+	if diags.HasErrors() {
+		t.Fatalf("apply errors: %s", diags.Err())
+	}
+
+	// The marks must be stripped before the value reaches the provider, so
+	// the recorded attribute value is the bare string.
+	mod := state.RootModule()
+	instAttrs := mod.Resources["aws_instance.foo"].Instances[addrs.NoKey].Current.AttrsJSON
+	if !strings.Contains(string(instAttrs), `"shh"`) {
+		t.Errorf("missing attribute value in state: %s", instAttrs)
+	}
+
+	tests := map[string]struct {
+		value     cty.Value
+		sensitive bool
+	}{
+		"direct":  {cty.StringVal("shh"), true},
+		"derived": {cty.StringVal("prefix-shh"), true},
+		"plain":   {cty.StringVal("hello"), false},
+	}
+	for name, test := range tests {
+		os := mod.OutputValues[name]
+		if os == nil {
+			t.Fatalf("missing output %q", name)
+		}
+		if got, want := os.Sensitive, test.sensitive; got != want {
+			t.Errorf("wrong sensitivity for output %q: got %#v, want %#v", name, got, want)
+		}
+		if got, want := os.Value, test.value; !want.RawEquals(got) {
+			t.Errorf("wrong value for output %q: got %#v, want %#v", name, got, want)
+		}
+	}
+}
+
+func TestContext2Apply_lifecycleTimeout(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+resource "aws_instance" "foo" {
+  num = 2
+
+  lifecycle {
+    timeouts {
+      create = "50ms"
+    }
+  }
+}
+`,
+	})
+
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+	p.ApplyFn = func(info *InstanceInfo, is *InstanceState, id *InstanceDiff) (*InstanceState, error) {
+		time.Sleep(500 * time.Millisecond)
+		return testApplyFn(info, is, id)
+	}
+
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+			},
+		),
+	})
+
+	if _, diags := ctx.Plan(); diags.HasErrors() {
+		t.Fatalf("plan errors: %s", diags.Err())
+	}
+
+	_, diags := ctx.Apply()
+	if !diags.HasErrors() {
+		t.Fatal("apply succeeded; want timeout error")
+	}
+	if got, want := diags.Err().Error(), "Resource operation timed out"; !strings.Contains(got, want) {
+		t.Fatalf("wrong error\ngot:  %s\nwant substring: %s", got, want)
+	}
+	if !p.StopCalled {
+		t.Fatal("provider was not asked to stop after the timeout")
+	}
+}
+
+func TestContext2Apply_checkBlock(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+resource "aws_instance" "foo" {
+  foo = "bar"
+}
+
+check "instance_configured" {
+  assert {
+    condition     = aws_instance.foo.foo == "bar"
+    error_message = "Instance has the wrong foo."
+  }
+}
+
+check "instance_misconfigured" {
+  assert {
+    condition     = aws_instance.foo.foo == "baz"
+    error_message = "Instance does not have foo set to baz."
+  }
+}`,
+	})
+
+	p := testProvider("aws")
+	p.ApplyFn = testApplyFn
+	p.DiffFn = testDiffFn
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+			},
+		),
+	})
+
+	if _, diags := ctx.Plan(); diags.HasErrors() {
+		t.Fatalf("plan errors: %s", diags.Err())
+	}
+
+	state, diags := ctx.Apply()
+	if diags.HasErrors() {
+		// A failing check must not block the apply.
+		t.Fatalf("apply errors: %s", diags.Err())
+	}
+
+	passed := state.CheckResult(addrs.RootModuleInstance.Check("instance_configured"))
+	if passed == nil {
+		t.Fatal("no check result recorded for check.instance_configured")
+	}
+	if got, want := passed.Status, states.CheckStatusPass; got != want {
+		t.Errorf("wrong status for check.instance_configured: %s; want %s", got, want)
+	}
+
+	failed := state.CheckResult(addrs.RootModuleInstance.Check("instance_misconfigured"))
+	if failed == nil {
+		t.Fatal("no check result recorded for check.instance_misconfigured")
+	}
+	if got, want := failed.Status, states.CheckStatusFail; got != want {
+		t.Errorf("wrong status for check.instance_misconfigured: %s; want %s", got, want)
+	}
+	if got, want := len(failed.FailureMessages), 1; got != want {
+		t.Fatalf("%d failure messages; want %d", got, want)
+	}
+	if got, want := failed.FailureMessages[0], "Instance does not have foo set to baz."; got != want {
+		t.Errorf("wrong failure message %q; want %q", got, want)
+	}
+}
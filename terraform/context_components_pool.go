@@ -0,0 +1,110 @@
+package terraform
+
+import (
+	"sync"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/providers"
+	"github.com/hashicorp/terraform/provisioners"
+)
+
+// poolingComponentFactory wraps another contextComponentFactory and arranges
+// for multiple requests for the same provider type to share a single
+// underlying provider instance, and thus a single plugin process, when the
+// provider advertises the MultiSession capability.
+//
+// Each AbsProviderConfig still calls Configure separately on the instance it
+// is handed, so sharing is safe only for providers whose protocol supports
+// several independent configure sessions in one process. Providers without
+// that capability get a dedicated instance per request, exactly as if this
+// wrapper were not present.
+type poolingComponentFactory struct {
+	inner contextComponentFactory
+
+	mu    sync.Mutex
+	pools map[addrs.Provider]*providerPool
+}
+
+// providerPool tracks a shared provider instance and the number of
+// outstanding references to it. The underlying instance is closed only when
+// the last reference is closed.
+type providerPool struct {
+	shared providers.Interface
+	refs   int
+}
+
+func newPoolingComponentFactory(inner contextComponentFactory) *poolingComponentFactory {
+	return &poolingComponentFactory{
+		inner: inner,
+		pools: make(map[addrs.Provider]*providerPool),
+	}
+}
+
+func (c *poolingComponentFactory) ResourceProvider(typ addrs.Provider) (providers.Interface, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if pool, ok := c.pools[typ]; ok && pool.refs > 0 {
+		pool.refs++
+		return &pooledProvider{Interface: pool.shared, factory: c, typ: typ}, nil
+	}
+
+	inst, err := c.inner.ResourceProvider(typ)
+	if err != nil {
+		return nil, err
+	}
+
+	// GetSchema is the cheapest way to learn the provider's capabilities;
+	// implementations cache their schema in memory, and core fetches it for
+	// every provider anyway.
+	if !inst.GetSchema().Capabilities.MultiSession {
+		return inst, nil
+	}
+
+	c.pools[typ] = &providerPool{shared: inst, refs: 1}
+	return &pooledProvider{Interface: inst, factory: c, typ: typ}, nil
+}
+
+func (c *poolingComponentFactory) ResourceProviders() []string {
+	return c.inner.ResourceProviders()
+}
+
+func (c *poolingComponentFactory) ResourceProvisioner(typ string) (provisioners.Interface, error) {
+	return c.inner.ResourceProvisioner(typ)
+}
+
+func (c *poolingComponentFactory) ResourceProvisioners() []string {
+	return c.inner.ResourceProvisioners()
+}
+
+// release decrements the reference count for the given provider type,
+// closing the shared instance once no references remain.
+func (c *poolingComponentFactory) release(typ addrs.Provider) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	pool, ok := c.pools[typ]
+	if !ok {
+		return nil
+	}
+	pool.refs--
+	if pool.refs > 0 {
+		return nil
+	}
+	delete(c.pools, typ)
+	return pool.shared.Close()
+}
+
+// pooledProvider is a reference-counted handle on a shared provider
+// instance. All operations are delegated to the shared instance except
+// Close, which releases this handle's reference instead of shutting down
+// the plugin process directly.
+type pooledProvider struct {
+	providers.Interface
+	factory *poolingComponentFactory
+	typ     addrs.Provider
+}
+
+func (p *pooledProvider) Close() error {
+	return p.factory.release(p.typ)
+}
@@ -0,0 +1,89 @@
+package terraform
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/providers"
+)
+
+func TestPoolingComponentFactory_multiSession(t *testing.T) {
+	launches := 0
+	provider := simpleMockProvider()
+	provider.GetSchemaReturn.Capabilities = providers.Capabilities{
+		ProtocolVersion: 6,
+		MultiSession:    true,
+	}
+
+	inner := &basicComponentFactory{
+		providers: map[addrs.Provider]providers.Factory{
+			addrs.NewLegacyProvider("test"): func() (providers.Interface, error) {
+				launches++
+				return provider, nil
+			},
+		},
+	}
+	pooling := newPoolingComponentFactory(inner)
+
+	first, err := pooling.ResourceProvider(addrs.NewLegacyProvider("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := pooling.ResourceProvider(addrs.NewLegacyProvider("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if launches != 1 {
+		t.Fatalf("%d provider launches; want 1", launches)
+	}
+
+	// Closing one reference must not shut down the shared instance while
+	// the other is still in use.
+	if err := first.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if provider.CloseCalled {
+		t.Fatal("shared provider closed while a reference was still held")
+	}
+	if err := second.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if !provider.CloseCalled {
+		t.Fatal("shared provider not closed after the last reference was released")
+	}
+
+	// A request after the pool has drained must launch a fresh instance.
+	provider.CloseCalled = false
+	if _, err := pooling.ResourceProvider(addrs.NewLegacyProvider("test")); err != nil {
+		t.Fatal(err)
+	}
+	if launches != 2 {
+		t.Fatalf("%d provider launches; want 2", launches)
+	}
+}
+
+func TestPoolingComponentFactory_singleSession(t *testing.T) {
+	launches := 0
+	inner := &basicComponentFactory{
+		providers: map[addrs.Provider]providers.Factory{
+			addrs.NewLegacyProvider("test"): func() (providers.Interface, error) {
+				launches++
+				// The default mock capabilities don't include MultiSession,
+				// so each request must get its own instance.
+				return simpleMockProvider(), nil
+			},
+		},
+	}
+	pooling := newPoolingComponentFactory(inner)
+
+	if _, err := pooling.ResourceProvider(addrs.NewLegacyProvider("test")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := pooling.ResourceProvider(addrs.NewLegacyProvider("test")); err != nil {
+		t.Fatal(err)
+	}
+	if launches != 2 {
+		t.Fatalf("%d provider launches; want 2", launches)
+	}
+}
@@ -1123,6 +1123,58 @@ func TestContext2Plan_moduleProviderInherit(t *testing.T) {
 	}
 }
 
+// A child module can declare an additional (aliased) provider configuration
+// via configuration_aliases in required_providers, without an empty proxy
+// provider block, and have the caller pass one in through the providers
+// argument on the module call.
+func TestContext2Plan_moduleProviderConfigurationAliases(t *testing.T) {
+	var l sync.Mutex
+	var configured []string
+
+	m := testModule(t, "plan-module-provider-aliases")
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): func() (providers.Interface, error) {
+					l.Lock()
+					defer l.Unlock()
+
+					p := testProvider("aws")
+					p.DiffFn = testDiffFn
+					p.ConfigureFn = func(c *ResourceConfig) error {
+						v, _ := c.Get("foo")
+
+						l.Lock()
+						defer l.Unlock()
+						configured = append(configured, v.(string))
+						return nil
+					}
+					return p, nil
+				},
+			},
+		),
+	})
+
+	plan, diags := ctx.Plan()
+	if diags.HasErrors() {
+		t.Fatalf("plan errors: %s", diags.Err())
+	}
+
+	if len(plan.Changes.Resources) != 1 {
+		t.Fatalf("got %d changes; want 1", len(plan.Changes.Resources))
+	}
+	if got, want := plan.Changes.Resources[0].Addr.String(), "module.child.aws_instance.foo"; got != want {
+		t.Errorf("wrong resource address %s; want %s", got, want)
+	}
+
+	// The child's aws.dns configuration resolves to the root module's
+	// aws.us_east_1 configuration, so that is the only one configured.
+	if !reflect.DeepEqual(configured, []string{"east"}) {
+		t.Errorf("wrong configurations %#v; want only the root aliased config", configured)
+	}
+}
+
 // This tests (for GH-11282) that deeply nested modules properly inherit
 // configuration.
 func TestContext2Plan_moduleProviderInheritDeep(t *testing.T) {
@@ -1522,6 +1574,58 @@ func TestContext2Plan_preventDestroy_bad(t *testing.T) {
 	}
 }
 
+func TestContext2Plan_preventDestroy_override(t *testing.T) {
+	m := testModule(t, "plan-prevent-destroy-bad")
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+			},
+		),
+		State: MustShimLegacyState(&State{
+			Modules: []*ModuleState{
+				&ModuleState{
+					Path: rootModulePath,
+					Resources: map[string]*ResourceState{
+						"aws_instance.foo": &ResourceState{
+							Type: "aws_instance",
+							Primary: &InstanceState{
+								ID: "i-abc123",
+							},
+						},
+					},
+				},
+			},
+		}),
+		DestroyProtectionOverride: "the emergency teardown runbook",
+	})
+
+	plan, diags := ctx.Plan()
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+
+	warned := false
+	for _, diag := range diags {
+		if diag.Severity() == tfdiags.Warning && diag.Description().Summary == "Destruction of protected instance allowed by override" {
+			warned = true
+		}
+	}
+	if !warned {
+		t.Errorf("no warning about the overridden protection; want one")
+	}
+
+	if got, want := plan.DestroyProtectionOverride, "the emergency teardown runbook"; got != want {
+		t.Errorf("wrong DestroyProtectionOverride %q; want %q", got, want)
+	}
+	if len(plan.ProtectedDestroys) != 1 || plan.ProtectedDestroys[0].String() != "aws_instance.foo" {
+		t.Errorf("wrong ProtectedDestroys %#v; want just aws_instance.foo", plan.ProtectedDestroys)
+	}
+}
+
 func TestContext2Plan_preventDestroy_good(t *testing.T) {
 	m := testModule(t, "plan-prevent-destroy-good")
 	p := testProvider("aws")
@@ -1967,6 +2071,162 @@ func TestContext2Plan_computedDataResource(t *testing.T) {
 	)
 }
 
+func TestContext2Plan_orphanedProviderConfig(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+resource "aws_instance" "foo" {
+}
+`,
+	})
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+
+	// The prior state contains objects that were created with an aliased
+	// provider configuration that has since been removed from the
+	// configuration, so planning must fail with a diagnostic that names
+	// both the missing configuration and the objects that require it.
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+			},
+		),
+		State: MustShimLegacyState(&State{
+			Modules: []*ModuleState{
+				&ModuleState{
+					Path: rootModulePath,
+					Resources: map[string]*ResourceState{
+						"aws_instance.bar": &ResourceState{
+							Type:     "aws_instance",
+							Provider: "provider.aws.extra",
+							Primary: &InstanceState{
+								ID: "bar",
+							},
+						},
+					},
+				},
+			},
+		}),
+	})
+
+	_, diags := ctx.Plan()
+	if !diags.HasErrors() {
+		t.Fatal("succeeded; want error about missing provider configuration")
+	}
+
+	found := false
+	for _, diag := range diags {
+		if tfdiags.CodeForDiagnostic(diag) != OrphanedProviderConfigDiagCode {
+			continue
+		}
+		found = true
+		desc := diag.Description()
+		if got, want := desc.Summary, "Provider configuration not present"; got != want {
+			t.Errorf("wrong diagnostic summary %q; want %q", got, want)
+		}
+		if !strings.Contains(desc.Detail, `provider["registry.terraform.io/-/aws"].extra`) {
+			t.Errorf("diagnostic detail does not name the missing provider configuration:\n%s", desc.Detail)
+		}
+		if !strings.Contains(desc.Detail, "aws_instance.bar") {
+			t.Errorf("diagnostic detail does not name the affected resource:\n%s", desc.Detail)
+		}
+		if !strings.Contains(desc.Detail, "terraform state rm") {
+			t.Errorf("diagnostic detail does not suggest \"terraform state rm\":\n%s", desc.Detail)
+		}
+	}
+	if !found {
+		t.Errorf("no diagnostic with code %q; got: %s", OrphanedProviderConfigDiagCode, diags.Err())
+	}
+}
+
+func TestContext2Plan_dataResourceDependsOn(t *testing.T) {
+	m := testModule(t, "plan-data-source-depends-on")
+	p := testProvider("aws")
+	p.GetSchemaReturn = &ProviderSchema{
+		ResourceTypes: map[string]*configschema.Block{
+			"aws_instance": {
+				Attributes: map[string]*configschema.Attribute{
+					"id":  {Type: cty.String, Computed: true},
+					"num": {Type: cty.String, Optional: true},
+				},
+			},
+		},
+		DataSources: map[string]*configschema.Block{
+			"aws_vpc": {
+				Attributes: map[string]*configschema.Attribute{
+					"id":  {Type: cty.String, Computed: true},
+					"foo": {Type: cty.String, Optional: true},
+				},
+			},
+		},
+	}
+	p.DiffFn = testDiffFn
+
+	// The prior state contains both objects already, and the data source's
+	// configuration is unchanged, so the only reason to re-read it is that
+	// its depends_on refers to a resource with a pending change.
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+			},
+		),
+		State: MustShimLegacyState(&State{
+			Modules: []*ModuleState{
+				&ModuleState{
+					Path: rootModulePath,
+					Resources: map[string]*ResourceState{
+						"aws_instance.foo": &ResourceState{
+							Type:     "aws_instance",
+							Provider: "provider.aws",
+							Primary: &InstanceState{
+								ID: "i-abc123",
+								Attributes: map[string]string{
+									"id":  "i-abc123",
+									"num": "1",
+								},
+							},
+						},
+						"data.aws_vpc.bar": &ResourceState{
+							Type:     "aws_vpc",
+							Provider: "provider.aws",
+							Primary: &InstanceState{
+								ID: "vpc-abc123",
+								Attributes: map[string]string{
+									"id":  "vpc-abc123",
+									"foo": "static",
+								},
+							},
+						},
+					},
+				},
+			},
+		}),
+	})
+
+	plan, diags := ctx.Plan()
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+
+	if rc := plan.Changes.ResourceInstance(addrs.Resource{Mode: addrs.ManagedResourceMode, Type: "aws_instance", Name: "foo"}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)); rc == nil {
+		t.Fatalf("missing diff for aws_instance.foo")
+	}
+	rcs := plan.Changes.ResourceInstance(addrs.Resource{
+		Mode: addrs.DataResourceMode,
+		Type: "aws_vpc",
+		Name: "bar",
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance))
+	if rcs == nil {
+		t.Fatalf("missing diff for data.aws_vpc.bar; its read should be deferred because aws_instance.foo has a pending change")
+	}
+	if got, want := rcs.Action, plans.Read; got != want {
+		t.Fatalf("wrong action for data.aws_vpc.bar %s; want %s", got, want)
+	}
+}
+
 func TestContext2Plan_computedInFunction(t *testing.T) {
 	m := testModule(t, "plan-computed-in-function")
 	p := testProvider("aws")
@@ -3302,7 +3562,7 @@ func TestContext2Plan_countIncreaseFromOneCorrupted(t *testing.T) {
 // A common pattern in TF configs is to have a set of resources with the same
 // count and to use count.index to create correspondences between them:
 //
-//    foo_id = "${foo.bar.*.id[count.index]}"
+//	foo_id = "${foo.bar.*.id[count.index]}"
 //
 // This test is for the situation where some instances already exist and the
 // count is increased. In that case, we should see only the create diffs
@@ -3456,6 +3716,108 @@ func TestContext2Plan_forEach(t *testing.T) {
 	}
 }
 
+func TestContext2Plan_forEachOrphans(t *testing.T) {
+	// When a key is removed from the for_each value between runs, the
+	// instance tracked in state under that key must be planned for deletion
+	// while the remaining keys plan as updates or no-ops.
+	m := testModule(t, "plan-for-each-orphans")
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+
+	s := states.BuildState(func(ss *states.SyncState) {
+		for _, key := range []string{"a", "b", "d"} {
+			ss.SetResourceInstanceCurrent(
+				addrs.Resource{
+					Mode: addrs.ManagedResourceMode,
+					Type: "aws_instance",
+					Name: "foo",
+				}.Instance(addrs.StringKey(key)).Absolute(addrs.RootModuleInstance),
+				&states.ResourceInstanceObjectSrc{
+					Status:    states.ObjectReady,
+					AttrsJSON: []byte(`{"id":"i-abc123","num":"3","type":"aws_instance"}`),
+				},
+				addrs.AbsProviderConfig{
+					Provider: addrs.NewLegacyProvider("aws"),
+					Module:   addrs.RootModuleInstance,
+				},
+			)
+		}
+	})
+
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+			},
+		),
+		State: s,
+	})
+
+	plan, diags := ctx.Plan()
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+
+	actions := map[string]plans.Action{}
+	for _, res := range plan.Changes.Resources {
+		actions[res.Addr.String()] = res.Action
+	}
+
+	if got, want := actions[`aws_instance.foo["d"]`], plans.Delete; got != want {
+		t.Errorf("wrong action for removed key %q: got %s, want %s", "d", got, want)
+	}
+	for _, key := range []string{"a", "b"} {
+		addr := fmt.Sprintf("aws_instance.foo[%q]", key)
+		if got := actions[addr]; got == plans.Delete {
+			t.Errorf("surviving key %q is planned for deletion", key)
+		}
+	}
+}
+
+func TestContext2Plan_federatedState(t *testing.T) {
+	m := testModule(t, "plan-federated-state")
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+
+	networking := states.BuildState(func(ss *states.SyncState) {
+		ss.SetOutputValue(
+			addrs.OutputValue{Name: "vpc_id"}.Absolute(addrs.RootModuleInstance),
+			cty.StringVal("vpc-abc123"), false,
+		)
+	})
+
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+			},
+		),
+		FederatedStates: map[string]*states.State{
+			"networking": networking,
+		},
+	})
+
+	plan, diags := ctx.Plan()
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+
+	if got, want := len(plan.Changes.Resources), 1; got != want {
+		t.Fatalf("wrong number of changes %d; want %d", got, want)
+	}
+	res := plan.Changes.Resources[0]
+	schema := p.GetSchemaReturn.ResourceTypes[res.Addr.Resource.Resource.Type]
+	ric, err := res.Decode(schema.ImpliedType())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := ric.After.GetAttr("foo"), cty.StringVal("vpc-abc123"); !got.RawEquals(want) {
+		t.Errorf("wrong planned value for foo\ngot:  %#v\nwant: %#v", got, want)
+	}
+}
+
 func TestContext2Plan_forEachUnknownValue(t *testing.T) {
 	// This module has a variable defined, but it's value is unknown. We
 	// expect this to produce an error, but not to panic.
@@ -3557,6 +3919,68 @@ func TestContext2Plan_destroy(t *testing.T) {
 	}
 }
 
+func TestContext2Plan_destroyProviderCapability(t *testing.T) {
+	m := testModule(t, "plan-destroy")
+	p := testProvider("aws")
+
+	// This provider advertises the planned destroy capability, so it must
+	// be consulted via PlanResourceChange rather than core constructing the
+	// destroy changes on its own.
+	p.GetSchemaReturn.Capabilities = providers.Capabilities{
+		ProtocolVersion: 6,
+		PlannedDestroy:  true,
+	}
+	p.PlanResourceChangeFn = func(r providers.PlanResourceChangeRequest) providers.PlanResourceChangeResponse {
+		if !r.ProposedNewState.IsNull() {
+			t.Errorf("wrong proposed new state for destroy plan of %s: %#v", r.TypeName, r.ProposedNewState)
+		}
+		return providers.PlanResourceChangeResponse{
+			PlannedState: r.ProposedNewState,
+		}
+	}
+
+	s := MustShimLegacyState(&State{
+		Modules: []*ModuleState{
+			&ModuleState{
+				Path: rootModulePath,
+				Resources: map[string]*ResourceState{
+					"aws_instance.one": &ResourceState{
+						Type: "aws_instance",
+						Primary: &InstanceState{
+							ID: "bar",
+						},
+					},
+				},
+			},
+		},
+	})
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+			},
+		),
+		State:   s,
+		Destroy: true,
+	})
+
+	plan, diags := ctx.Plan()
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+
+	if !p.PlanResourceChangeCalled {
+		t.Fatal("provider was not consulted for the destroy plan")
+	}
+
+	for _, res := range plan.Changes.Resources {
+		if res.Action != plans.Delete {
+			t.Fatalf("resource %s should be removed, got %s", res.Addr, res.Action)
+		}
+	}
+}
+
 func TestContext2Plan_moduleDestroy(t *testing.T) {
 	m := testModule(t, "plan-module-destroy")
 	p := testProvider("aws")
@@ -3982,6 +4406,54 @@ func TestContext2Plan_closeProvider(t *testing.T) {
 	}
 }
 
+func TestContext2Plan_provisionerDeferred(t *testing.T) {
+	// A provisioner whose configuration refers to a value that isn't known
+	// until apply must be recorded in the plan as deferred, with a warning,
+	// rather than failing.
+	m := testModule(t, "plan-provisioner-deferred")
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+	pr := testProvisioner()
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+			},
+		),
+		Provisioners: map[string]ProvisionerFactory{
+			"shell": testProvisionerFuncFixed(pr),
+		},
+	})
+
+	plan, diags := ctx.Plan()
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+
+	if got, want := len(plan.Changes.DeferredProvisioners), 1; got != want {
+		t.Fatalf("wrong number of deferred provisioners %d; want %d", got, want)
+	}
+	dp := plan.Changes.DeferredProvisioners[0]
+	if got, want := dp.InstanceAddr.String(), "aws_instance.bar"; got != want {
+		t.Errorf("wrong deferred instance %q; want %q", got, want)
+	}
+	if got, want := dp.Type, "shell"; got != want {
+		t.Errorf("wrong deferred provisioner type %q; want %q", got, want)
+	}
+
+	// The deferral must also be visible as a warning diagnostic.
+	found := false
+	for _, diag := range diags {
+		if diag.Severity() == tfdiags.Warning && strings.Contains(diag.Description().Summary, "deferred") {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("no warning diagnostic about the deferred provisioner")
+	}
+}
+
 func TestContext2Plan_orphan(t *testing.T) {
 	m := testModule(t, "plan-orphan")
 	p := testProvider("aws")
@@ -4210,6 +4682,9 @@ func TestContext2Plan_taint(t *testing.T) {
 			if res.Action != plans.DeleteThenCreate {
 				t.Fatalf("resource %s should be replaced", i)
 			}
+			if got, want := res.ActionReason, plans.ResourceInstanceReplaceBecauseTainted; got != want {
+				t.Fatalf("resource %s has wrong action reason %q; want %q", i, got, want)
+			}
 			checkVals(t, objectVal(t, schema, map[string]cty.Value{
 				"id":   cty.UnknownVal(cty.String),
 				"foo":  cty.StringVal("2"),
@@ -4443,6 +4918,179 @@ func TestContext2Plan_targeted(t *testing.T) {
 	}
 }
 
+func TestContext2Plan_targetedTargetingReport(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+resource "aws_instance" "foo" {
+  num = "2"
+}
+
+resource "aws_instance" "bar" {
+  foo = aws_instance.foo.num
+}
+
+resource "aws_instance" "baz" {
+  num = "3"
+}
+`,
+	})
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+			},
+		),
+		Targets: []addrs.Targetable{
+			addrs.RootModuleInstance.Resource(
+				addrs.ManagedResourceMode, "aws_instance", "bar",
+			),
+		},
+	})
+
+	plan, diags := ctx.Plan()
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+
+	if plan.Targeting == nil {
+		t.Fatal("plan has no targeting report")
+	}
+
+	var included, excluded []string
+	for _, addr := range plan.Targeting.ImplicitlyIncluded {
+		included = append(included, addr.String())
+	}
+	for _, addr := range plan.Targeting.Excluded {
+		excluded = append(excluded, addr.String())
+	}
+
+	if want := []string{"aws_instance.foo"}; !reflect.DeepEqual(included, want) {
+		t.Errorf("wrong implicitly-included resources %#v; want %#v", included, want)
+	}
+	if want := []string{"aws_instance.baz"}; !reflect.DeepEqual(excluded, want) {
+		t.Errorf("wrong excluded resources %#v; want %#v", excluded, want)
+	}
+}
+
+func TestContext2Plan_untargetedTargetingReport(t *testing.T) {
+	m := testModule(t, "plan-targeted")
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+			},
+		),
+	})
+
+	plan, diags := ctx.Plan()
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+
+	if plan.Targeting != nil {
+		t.Errorf("plan without targeting has a targeting report: %#v", plan.Targeting)
+	}
+}
+
+func TestContext2Plan_excluded(t *testing.T) {
+	m := testModule(t, "plan-targeted")
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+			},
+		),
+		Excludes: []addrs.Targetable{
+			addrs.RootModuleInstance.Resource(
+				addrs.ManagedResourceMode, "aws_instance", "bar",
+			),
+		},
+	})
+
+	plan, diags := ctx.Plan()
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+	schema := p.GetSchemaReturn.ResourceTypes["aws_instance"]
+	ty := schema.ImpliedType()
+
+	if len(plan.Changes.Resources) != 1 {
+		t.Fatal("expected 1 changes, got", len(plan.Changes.Resources))
+	}
+
+	for _, res := range plan.Changes.Resources {
+		ric, err := res.Decode(ty)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		switch i := ric.Addr.String(); i {
+		case "aws_instance.foo":
+			if res.Action != plans.Create {
+				t.Fatalf("resource %s should be created", i)
+			}
+			checkVals(t, objectVal(t, schema, map[string]cty.Value{
+				"id":   cty.UnknownVal(cty.String),
+				"num":  cty.NumberIntVal(2),
+				"type": cty.StringVal("aws_instance"),
+			}), ric.After)
+		default:
+			t.Fatal("unknown instance:", i)
+		}
+	}
+}
+
+// Excluding a resource must also prune anything that depends on it, since
+// those dependents could not be planned without their dependency.
+func TestContext2Plan_excludedDependents(t *testing.T) {
+	m := testModule(t, "plan-targeted")
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+			},
+		),
+		Excludes: []addrs.Targetable{
+			addrs.RootModuleInstance.Resource(
+				addrs.ManagedResourceMode, "aws_instance", "foo",
+			),
+		},
+	})
+
+	plan, diags := ctx.Plan()
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+
+	// aws_instance.bar refers to aws_instance.foo, so excluding foo must
+	// exclude bar as well.
+	if len(plan.Changes.Resources) != 0 {
+		t.Fatal("expected 0 changes, got", len(plan.Changes.Resources))
+	}
+
+	found := false
+	for _, diag := range diags {
+		if diag.Severity() == tfdiags.Warning && diag.Description().Summary == "Resource exclusion is in effect" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatal("missing warning about resource exclusion")
+	}
+}
+
 // Test that targeting a module properly plans any inputs that depend
 // on another module.
 func TestContext2Plan_targetedCrossModule(t *testing.T) {
@@ -4874,9 +5522,11 @@ func TestContext2Plan_provider(t *testing.T) {
 	}
 }
 
-func TestContext2Plan_varListErr(t *testing.T) {
-	m := testModule(t, "plan-var-list-err")
+func TestContext2Plan_providerCycle(t *testing.T) {
+	m := testModule(t, "plan-provider-cycle")
 	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+
 	ctx := testContext2(t, &ContextOpts{
 		Config: m,
 		ProviderResolver: providers.ResolverFixed(
@@ -4886,35 +5536,35 @@ func TestContext2Plan_varListErr(t *testing.T) {
 		),
 	})
 
-	_, err := ctx.Plan()
+	_, diags := ctx.Plan()
+	if !diags.HasErrors() {
+		t.Fatal("plan succeeded; want cycle error")
+	}
 
-	if err == nil {
-		t.Fatal("should error")
+	got := diags.Err().Error()
+	if want := "Provider configuration cycle"; !strings.Contains(got, want) {
+		t.Errorf("missing expected summary\ngot: %s\nwant substring: %s", got, want)
+	}
+	if want := "aws_instance.foo"; !strings.Contains(got, want) {
+		t.Errorf("diagnostic does not name the resource\ngot: %s", got)
+	}
+	if want := "Cycle:"; strings.Contains(got, want) {
+		t.Errorf("generic cycle error should be replaced\ngot: %s", got)
 	}
 }
 
-func TestContext2Plan_ignoreChanges(t *testing.T) {
-	m := testModule(t, "plan-ignore-changes")
+func TestContext2Plan_providerConfigOverride(t *testing.T) {
+	m := testModule(t, "plan-provider")
 	p := testProvider("aws")
-
 	p.DiffFn = testDiffFn
 
-	s := MustShimLegacyState(&State{
-		Modules: []*ModuleState{
-			&ModuleState{
-				Path: rootModulePath,
-				Resources: map[string]*ResourceState{
-					"aws_instance.foo": &ResourceState{
-						Type: "aws_instance",
-						Primary: &InstanceState{
-							ID:         "bar",
-							Attributes: map[string]string{"ami": "ami-abcd1234"},
-						},
-					},
-				},
-			},
-		},
-	})
+	var foo, region interface{}
+	p.ConfigureFn = func(c *ResourceConfig) error {
+		foo, _ = c.Get("foo")
+		region, _ = c.Get("region")
+		return nil
+	}
+
 	ctx := testContext2(t, &ContextOpts{
 		Config: m,
 		ProviderResolver: providers.ResolverFixed(
@@ -4924,16 +5574,129 @@ func TestContext2Plan_ignoreChanges(t *testing.T) {
 		),
 		Variables: InputValues{
 			"foo": &InputValue{
-				Value:      cty.StringVal("ami-1234abcd"),
+				Value:      cty.StringVal("bar"),
 				SourceType: ValueFromCaller,
 			},
 		},
-		State: s,
+		ProviderConfigOverrides: map[string]cty.Value{
+			"aws": cty.ObjectVal(map[string]cty.Value{
+				"foo":    cty.StringVal("injected"),
+				"region": cty.StringVal("us-west-2"),
+			}),
+		},
 	})
 
-	plan, diags := ctx.Plan()
-	if diags.HasErrors() {
-		t.Fatalf("unexpected errors: %s", diags.Err())
+	if _, diags := ctx.Plan(); diags.HasErrors() {
+		t.Fatalf("plan errors: %s", diags.Err())
+	}
+
+	// The override must win over the value from configuration, and must
+	// also be able to set arguments the configuration leaves unset.
+	if foo != "injected" {
+		t.Errorf("wrong value for foo %#v; want %#v", foo, "injected")
+	}
+	if region != "us-west-2" {
+		t.Errorf("wrong value for region %#v; want %#v", region, "us-west-2")
+	}
+}
+
+func TestContext2Plan_providerConfigOverrideInvalid(t *testing.T) {
+	m := testModule(t, "plan-provider")
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+			},
+		),
+		Variables: InputValues{
+			"foo": &InputValue{
+				Value:      cty.StringVal("bar"),
+				SourceType: ValueFromCaller,
+			},
+		},
+		ProviderConfigOverrides: map[string]cty.Value{
+			"aws": cty.ObjectVal(map[string]cty.Value{
+				"nonexist": cty.StringVal("baz"),
+			}),
+		},
+	})
+
+	_, diags := ctx.Plan()
+	if !diags.HasErrors() {
+		t.Fatal("plan succeeded; want error")
+	}
+	if got, want := diags.Err().Error(), `no argument named "nonexist"`; !strings.Contains(got, want) {
+		t.Errorf("wrong error\ngot: %s\nwant substring: %s", got, want)
+	}
+	if p.ConfigureCalled {
+		t.Error("provider was configured despite the invalid override")
+	}
+}
+
+func TestContext2Plan_varListErr(t *testing.T) {
+	m := testModule(t, "plan-var-list-err")
+	p := testProvider("aws")
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+			},
+		),
+	})
+
+	_, err := ctx.Plan()
+
+	if err == nil {
+		t.Fatal("should error")
+	}
+}
+
+func TestContext2Plan_ignoreChanges(t *testing.T) {
+	m := testModule(t, "plan-ignore-changes")
+	p := testProvider("aws")
+
+	p.DiffFn = testDiffFn
+
+	s := MustShimLegacyState(&State{
+		Modules: []*ModuleState{
+			&ModuleState{
+				Path: rootModulePath,
+				Resources: map[string]*ResourceState{
+					"aws_instance.foo": &ResourceState{
+						Type: "aws_instance",
+						Primary: &InstanceState{
+							ID:         "bar",
+							Attributes: map[string]string{"ami": "ami-abcd1234"},
+						},
+					},
+				},
+			},
+		},
+	})
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+			},
+		),
+		Variables: InputValues{
+			"foo": &InputValue{
+				Value:      cty.StringVal("ami-1234abcd"),
+				SourceType: ValueFromCaller,
+			},
+		},
+		State: s,
+	})
+
+	plan, diags := ctx.Plan()
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
 	}
 
 	schema := p.GetSchemaReturn.ResourceTypes["aws_instance"]
@@ -6150,3 +6913,647 @@ func TestContext2Plan_requiredModuleObject(t *testing.T) {
 		})
 	}
 }
+
+func TestContext2Plan_resourcePreconditionFail(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+variable "boop" {
+  default = "nope"
+}
+
+resource "aws_instance" "foo" {
+  num = 2
+
+  lifecycle {
+    precondition {
+      condition     = var.boop == "boop"
+      error_message = "Wrong boop."
+    }
+  }
+}`,
+	})
+
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+			},
+		),
+	})
+
+	_, diags := ctx.Plan()
+	if !diags.HasErrors() {
+		t.Fatal("succeeded; want errors")
+	}
+
+	gotErrStr := diags.Err().Error()
+	if !strings.Contains(gotErrStr, "Resource precondition failed") {
+		t.Errorf("missing expected error\ngot: %s\n\nwant: error containing %q", gotErrStr, "Resource precondition failed")
+	}
+	if !strings.Contains(gotErrStr, "Wrong boop.") {
+		t.Errorf("missing custom error message\ngot: %s\n\nwant: error containing %q", gotErrStr, "Wrong boop.")
+	}
+}
+
+func TestContext2Plan_resourcePostcondition(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+resource "aws_instance" "foo" {
+  num = 2
+
+  lifecycle {
+    postcondition {
+      condition     = self.num == 3
+      error_message = "Must have three num."
+    }
+  }
+}`,
+	})
+
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+			},
+		),
+	})
+
+	// The postcondition is evaluated against the planned new values, so it
+	// can fail already during plan for attributes that are decided by the
+	// configuration.
+	_, diags := ctx.Plan()
+	if !diags.HasErrors() {
+		t.Fatal("succeeded; want errors")
+	}
+
+	gotErrStr := diags.Err().Error()
+	if !strings.Contains(gotErrStr, "Resource postcondition failed") {
+		t.Errorf("missing expected error\ngot: %s\n\nwant: error containing %q", gotErrStr, "Resource postcondition failed")
+	}
+	if !strings.Contains(gotErrStr, "Must have three num.") {
+		t.Errorf("missing custom error message\ngot: %s\n\nwant: error containing %q", gotErrStr, "Must have three num.")
+	}
+}
+
+func TestContext2Plan_outputPrecondition(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+resource "aws_instance" "foo" {
+  num = 2
+}
+
+output "num" {
+  value = aws_instance.foo.num
+
+  precondition {
+    condition     = aws_instance.foo.num == 3
+    error_message = "Wrong num."
+  }
+}`,
+	})
+
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+			},
+		),
+	})
+
+	_, diags := ctx.Plan()
+	if !diags.HasErrors() {
+		t.Fatal("succeeded; want errors")
+	}
+
+	gotErrStr := diags.Err().Error()
+	if !strings.Contains(gotErrStr, "Module output value precondition failed") {
+		t.Errorf("missing expected error\ngot: %s\n\nwant: error containing %q", gotErrStr, "Module output value precondition failed")
+	}
+}
+
+func TestContext2Plan_evalAfterPlan(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+resource "aws_instance" "foo" {
+  num = 2
+}
+`,
+	})
+
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+			},
+		),
+	})
+
+	// Calling EvalAfterPlan before Plan is an error.
+	_, diags := ctx.EvalAfterPlan(`aws_instance.foo.num`)
+	if !diags.HasErrors() {
+		t.Fatal("succeeded before Plan; want error")
+	}
+
+	_, diags = ctx.Plan()
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+
+	got, diags := ctx.EvalAfterPlan(`aws_instance.foo.num`)
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+	if want := cty.NumberIntVal(2); !got.RawEquals(want) {
+		t.Errorf("wrong result for num\ngot:  %#v\nwant: %#v", got, want)
+	}
+
+	// The id isn't decided until apply, so it should evaluate to an
+	// unknown value rather than failing.
+	got, diags = ctx.EvalAfterPlan(`aws_instance.foo.id`)
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+	if got.IsKnown() {
+		t.Errorf("result for id is known; want unknown\ngot: %#v", got)
+	}
+
+	// Invalid expressions are reported as diagnostics rather than panics.
+	_, diags = ctx.EvalAfterPlan(`aws_instance.nonexist.id`)
+	if !diags.HasErrors() {
+		t.Fatal("succeeded for undeclared resource; want error")
+	}
+}
+
+func TestContext2Plan_reusePreviousPlan(t *testing.T) {
+	// When a previous plan is provided and a resource instance's planning
+	// inputs (configuration and prior state) are unchanged since it was
+	// created, the provider should not be asked to plan that instance
+	// again; the previous result is reused instead.
+	m := testModule(t, "plan-good")
+
+	baseState := states.BuildState(func(ss *states.SyncState) {
+		ss.SetResourceInstanceCurrent(
+			addrs.Resource{
+				Mode: addrs.ManagedResourceMode,
+				Type: "aws_instance",
+				Name: "foo",
+			}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance),
+			&states.ResourceInstanceObjectSrc{
+				Status:    states.ObjectReady,
+				AttrsJSON: []byte(`{"id":"i-abc123","num":"1","type":"aws_instance"}`),
+			},
+			addrs.AbsProviderConfig{
+				Provider: addrs.NewLegacyProvider("aws"),
+				Module:   addrs.RootModuleInstance,
+			},
+		)
+		ss.SetResourceInstanceCurrent(
+			addrs.Resource{
+				Mode: addrs.ManagedResourceMode,
+				Type: "aws_instance",
+				Name: "bar",
+			}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance),
+			&states.ResourceInstanceObjectSrc{
+				Status:    states.ObjectReady,
+				AttrsJSON: []byte(`{"id":"i-abc456","foo":"1","type":"aws_instance"}`),
+			},
+			addrs.AbsProviderConfig{
+				Provider: addrs.NewLegacyProvider("aws"),
+				Module:   addrs.RootModuleInstance,
+			},
+		)
+	})
+
+	plan := func(t *testing.T, state *states.State, prev *plans.Plan) (*MockProvider, *plans.Plan) {
+		p := testProvider("aws")
+		p.DiffFn = testDiffFn
+		ctx := testContext2(t, &ContextOpts{
+			Config: m,
+			ProviderResolver: providers.ResolverFixed(
+				map[addrs.Provider]providers.Factory{
+					addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+				},
+			),
+			State:        state,
+			PreviousPlan: prev,
+		})
+		plan, diags := ctx.Plan()
+		if diags.HasErrors() {
+			t.Fatalf("unexpected errors: %s", diags.Err())
+		}
+		return p, plan
+	}
+
+	actions := func(plan *plans.Plan) map[string]plans.Action {
+		ret := map[string]plans.Action{}
+		for _, rc := range plan.Changes.Resources {
+			ret[rc.Addr.String()] = rc.Action
+		}
+		return ret
+	}
+
+	p1, plan1 := plan(t, baseState.DeepCopy(), nil)
+	if !p1.PlanResourceChangeCalled {
+		t.Fatal("first plan did not call PlanResourceChange")
+	}
+	for _, rc := range plan1.Changes.Resources {
+		if len(rc.InputsHash) == 0 {
+			t.Errorf("first plan recorded no inputs hash for %s", rc.Addr)
+		}
+	}
+
+	// Same config and state again: everything should be reused.
+	p2, plan2 := plan(t, baseState.DeepCopy(), plan1)
+	if p2.PlanResourceChangeCalled {
+		t.Error("second plan called PlanResourceChange; want all results reused from the previous plan")
+	}
+	if got, want := actions(plan2), actions(plan1); !reflect.DeepEqual(got, want) {
+		t.Errorf("second plan has different actions\ngot:  %#v\nwant: %#v", got, want)
+	}
+
+	// If the prior state changes then the affected instance must be planned
+	// afresh rather than reusing the stale result.
+	changedState := baseState.DeepCopy()
+	changedState.ResourceInstance(mustResourceInstanceAddr("aws_instance.foo")).Current.AttrsJSON = []byte(`{"id":"i-abc123","num":"5","type":"aws_instance"}`)
+	p3, _ := plan(t, changedState, plan1)
+	if !p3.PlanResourceChangeCalled {
+		t.Error("third plan did not call PlanResourceChange after the prior state changed")
+	}
+}
+
+func TestContext2Plan_importBlock(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+resource "aws_instance" "foo" {
+  num = 2
+}
+
+import {
+  to = aws_instance.foo
+  id = "bar"
+}
+`,
+	})
+
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+	p.ImportResourceStateFn = func(req providers.ImportResourceStateRequest) providers.ImportResourceStateResponse {
+		return providers.ImportResourceStateResponse{
+			ImportedResources: []providers.ImportedResource{
+				{
+					TypeName: req.TypeName,
+					State: cty.ObjectVal(map[string]cty.Value{
+						"id": cty.StringVal(req.ID),
+					}),
+				},
+			},
+		}
+	}
+	p.ReadResourceFn = func(req providers.ReadResourceRequest) providers.ReadResourceResponse {
+		newState, err := p.GetSchemaReturn.ResourceTypes[req.TypeName].CoerceValue(req.PriorState)
+		if err != nil {
+			t.Fatalf("failed to coerce imported state: %s", err)
+		}
+		return providers.ReadResourceResponse{NewState: newState}
+	}
+
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+			},
+		),
+	})
+
+	plan, diags := ctx.Plan()
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+
+	if !p.ImportResourceStateCalled {
+		t.Fatal("ImportResourceState was not called during plan")
+	}
+	if got, want := p.ImportResourceStateRequest.ID, "bar"; got != want {
+		t.Errorf("wrong import id %q; want %q", got, want)
+	}
+
+	addr := mustResourceInstanceAddr("aws_instance.foo")
+	rcs := plan.Changes.ResourceInstance(addr)
+	if rcs == nil {
+		t.Fatal("no change recorded for aws_instance.foo")
+	}
+	if got, want := rcs.Action, plans.Create; got != want {
+		t.Errorf("wrong action %s; want %s", got, want)
+	}
+	if !rcs.Importing {
+		t.Error("change is not marked as importing")
+	}
+	if got, want := rcs.ImportID, "bar"; got != want {
+		t.Errorf("wrong ImportID %q; want %q", got, want)
+	}
+
+	state, diags := ctx.Apply()
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors from apply: %s", diags.Err())
+	}
+	if p.ApplyResourceChangeCalled {
+		t.Error("ApplyResourceChange was called; imported objects should be adopted without applying")
+	}
+	is := state.ResourceInstance(addr)
+	if is == nil || is.Current == nil {
+		t.Fatal("no instance for aws_instance.foo in final state")
+	}
+	if !strings.Contains(string(is.Current.AttrsJSON), `"id":"bar"`) {
+		t.Errorf("imported object not recorded in state; got %s", is.Current.AttrsJSON)
+	}
+}
+
+func TestContext2Plan_importBlockExistingState(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+resource "aws_instance" "foo" {
+  num = 2
+}
+
+import {
+  to = aws_instance.foo
+  id = "bar"
+}
+`,
+	})
+
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+
+	state := states.NewState()
+	root := state.RootModule()
+	root.SetResourceInstanceCurrent(
+		mustResourceInstanceAddr("aws_instance.foo").Resource,
+		&states.ResourceInstanceObjectSrc{
+			Status:    states.ObjectReady,
+			AttrsJSON: []byte(`{"id":"bar","num":2,"type":"aws_instance"}`),
+		},
+		addrs.AbsProviderConfig{
+			Provider: addrs.NewLegacyProvider("aws"),
+			Module:   addrs.RootModuleInstance,
+		},
+	)
+
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		State:  state,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+			},
+		),
+	})
+
+	_, diags := ctx.Plan()
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+
+	// The target is already tracked in state, so the import block is inert.
+	if p.ImportResourceStateCalled {
+		t.Fatal("ImportResourceState was called, but the target already has state")
+	}
+}
+
+func TestContext2Plan_importBlockNoResource(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+import {
+  to = aws_instance.foo
+  id = "bar"
+}
+`,
+	})
+
+	p := testProvider("aws")
+	_, diags := NewContext(&ContextOpts{
+		Config: m,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+			},
+		),
+	})
+	if !diags.HasErrors() {
+		t.Fatal("succeeded; want error about missing resource configuration")
+	}
+	if got, want := diags.Err().Error(), "Import target does not exist"; !strings.Contains(got, want) {
+		t.Errorf("wrong error %q; want %q", got, want)
+	}
+}
+
+func TestContext2Plan_removedBlockForget(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+removed {
+  from = aws_instance.foo
+
+  lifecycle {
+    destroy = false
+  }
+}
+`,
+	})
+
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+
+	state := states.NewState()
+	root := state.RootModule()
+	root.SetResourceInstanceCurrent(
+		mustResourceInstanceAddr("aws_instance.foo").Resource,
+		&states.ResourceInstanceObjectSrc{
+			Status:    states.ObjectReady,
+			AttrsJSON: []byte(`{"id":"bar","num":2,"type":"aws_instance"}`),
+		},
+		addrs.AbsProviderConfig{
+			Provider: addrs.NewLegacyProvider("aws"),
+			Module:   addrs.RootModuleInstance,
+		},
+	)
+
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		State:  state,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+			},
+		),
+	})
+
+	plan, diags := ctx.Plan()
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+
+	addr := mustResourceInstanceAddr("aws_instance.foo")
+	rcs := plan.Changes.ResourceInstance(addr)
+	if rcs == nil {
+		t.Fatal("no change recorded for aws_instance.foo")
+	}
+	if got, want := rcs.Action, plans.Forget; got != want {
+		t.Fatalf("wrong action %s; want %s", got, want)
+	}
+
+	finalState, diags := ctx.Apply()
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors from apply: %s", diags.Err())
+	}
+	if p.ApplyResourceChangeCalled {
+		t.Error("ApplyResourceChange was called; forgotten objects should not be destroyed")
+	}
+	if is := finalState.ResourceInstance(addr); is != nil {
+		t.Errorf("aws_instance.foo is still tracked in the final state")
+	}
+}
+
+func TestContext2Plan_removedBlockDestroy(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+removed {
+  from = aws_instance.foo
+}
+`,
+	})
+
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+
+	state := states.NewState()
+	root := state.RootModule()
+	root.SetResourceInstanceCurrent(
+		mustResourceInstanceAddr("aws_instance.foo").Resource,
+		&states.ResourceInstanceObjectSrc{
+			Status:    states.ObjectReady,
+			AttrsJSON: []byte(`{"id":"bar","num":2,"type":"aws_instance"}`),
+		},
+		addrs.AbsProviderConfig{
+			Provider: addrs.NewLegacyProvider("aws"),
+			Module:   addrs.RootModuleInstance,
+		},
+	)
+
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		State:  state,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+			},
+		),
+	})
+
+	plan, diags := ctx.Plan()
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+
+	// Without destroy = false the removed block plans an ordinary destroy,
+	// just as if the resource block had been deleted with no removed block.
+	rcs := plan.Changes.ResourceInstance(mustResourceInstanceAddr("aws_instance.foo"))
+	if rcs == nil {
+		t.Fatal("no change recorded for aws_instance.foo")
+	}
+	if got, want := rcs.Action, plans.Delete; got != want {
+		t.Fatalf("wrong action %s; want %s", got, want)
+	}
+}
+
+func TestContext2Plan_removedBlockResourceExists(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+resource "aws_instance" "foo" {
+  num = 2
+}
+
+removed {
+  from = aws_instance.foo
+}
+`,
+	})
+
+	p := testProvider("aws")
+	_, diags := NewContext(&ContextOpts{
+		Config: m,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+			},
+		),
+	})
+	if !diags.HasErrors() {
+		t.Fatal("succeeded; want error about resource still being declared")
+	}
+	if got, want := diags.Err().Error(), "Removed resource still exists"; !strings.Contains(got, want) {
+		t.Errorf("wrong error %q; want %q", got, want)
+	}
+}
+
+func TestContext2Plan_countInstanceContextDiagnostics(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+locals {
+  notamap = "hello"
+}
+
+resource "aws_instance" "foo" {
+  count = 2
+  num   = local.notamap[count.index]
+}
+`,
+	})
+
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+			},
+		),
+	})
+
+	_, diags := ctx.Plan()
+	if !diags.HasErrors() {
+		t.Fatal("plan succeeded; want evaluation error")
+	}
+
+	// The evaluation errors should carry instance context identifying which
+	// instance of the counted resource was being evaluated.
+	found := false
+	for _, diag := range diags {
+		if instCtx := tfdiags.InstanceContextForDiagnostic(diag); instCtx != nil {
+			found = true
+			if got := instCtx.InstanceKey; got != "0" && got != "1" {
+				t.Errorf("wrong instance key %q; want \"0\" or \"1\"", got)
+			}
+			if got, want := instCtx.ModuleAddr, ""; got != want {
+				t.Errorf("wrong module address %q; want %q (the root module)", got, want)
+			}
+		}
+	}
+	if !found {
+		t.Error("no diagnostic has instance context")
+	}
+}
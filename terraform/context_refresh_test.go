@@ -89,6 +89,74 @@ func TestContext2Refresh(t *testing.T) {
 	}
 }
 
+func TestContext2Refresh_versionTokenUnchanged(t *testing.T) {
+	p := testProvider("aws")
+	m := testModule(t, "refresh-basic")
+
+	startingState := states.BuildState(func(ss *states.SyncState) {
+		ss.SetResourceInstanceCurrent(
+			addrs.Resource{
+				Mode: addrs.ManagedResourceMode,
+				Type: "aws_instance",
+				Name: "web",
+			}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance),
+			&states.ResourceInstanceObjectSrc{
+				Status:       states.ObjectReady,
+				AttrsJSON:    []byte(`{"id":"foo","foo":"bar"}`),
+				VersionToken: "v1",
+			},
+			addrs.AbsProviderConfig{
+				Provider: addrs.NewLegacyProvider("aws"),
+				Module:   addrs.RootModuleInstance,
+			},
+		)
+	})
+
+	// The provider recognizes the token and reports the object unchanged
+	// without returning a new value at all.
+	p.ReadResourceFn = func(req providers.ReadResourceRequest) providers.ReadResourceResponse {
+		if got, want := req.PriorVersionToken, "v1"; got != want {
+			t.Errorf("wrong version token in request: got %q, want %q", got, want)
+		}
+		return providers.ReadResourceResponse{
+			Unchanged:    true,
+			VersionToken: "v2",
+		}
+	}
+
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+			},
+		),
+		State: startingState,
+	})
+
+	s, diags := ctx.Refresh()
+	if diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+
+	if !p.ReadResourceCalled {
+		t.Fatal("ReadResource should be called")
+	}
+
+	schema := p.GetSchemaReturn.ResourceTypes["aws_instance"]
+	obj := s.RootModule().Resources["aws_instance.web"].Instances[addrs.NoKey].Current
+	fromState, err := obj.Decode(schema.ImpliedType())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := fromState.Value.GetAttr("foo"), cty.StringVal("bar"); !got.RawEquals(want) {
+		t.Errorf("prior value was not retained: got %#v, want %#v", got, want)
+	}
+	if got, want := obj.VersionToken, "v2"; got != want {
+		t.Errorf("wrong version token in state: got %q, want %q", got, want)
+	}
+}
+
 func TestContext2Refresh_dynamicAttr(t *testing.T) {
 	m := testModule(t, "refresh-dynamic")
 
@@ -1985,10 +2053,10 @@ func TestRefresh_updateDependencies(t *testing.T) {
 		&states.ResourceInstanceObjectSrc{
 			Status:    states.ObjectReady,
 			AttrsJSON: []byte(`{"id":"foo"}`),
-			Dependencies: []addrs.AbsResource{
+			Dependencies: []addrs.ConfigResource{
 				// Existing dependencies should not be removed during refresh
 				{
-					Module: addrs.RootModuleInstance,
+					Module: addrs.RootModule,
 					Resource: addrs.Resource{
 						Mode: addrs.ManagedResourceMode,
 						Type: "aws_instance",
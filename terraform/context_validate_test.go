@@ -779,6 +779,43 @@ func TestContext2Validate_badProvisionerConnection(t *testing.T) {
 	}
 }
 
+func TestContext2Validate_badConnectionTimeout(t *testing.T) {
+	m := testModule(t, "validate-bad-connection-timeout")
+	p := testProvider("aws")
+	p.GetSchemaReturn = &ProviderSchema{
+		ResourceTypes: map[string]*configschema.Block{
+			"aws_instance": {
+				Attributes: map[string]*configschema.Attribute{
+					"foo": {Type: cty.String, Optional: true},
+				},
+			},
+		},
+	}
+
+	pr := simpleMockProvisioner()
+
+	c := testContext2(t, &ContextOpts{
+		Config: m,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+			},
+		),
+		Provisioners: map[string]ProvisionerFactory{
+			"shell": testProvisionerFuncFixed(pr),
+		},
+	})
+
+	diags := c.Validate()
+	t.Log(diags.Err())
+	if !diags.HasErrors() {
+		t.Fatalf("succeeded; want error")
+	}
+	if got, want := diags.Err().Error(), "Invalid connection timeout"; !strings.Contains(got, want) {
+		t.Fatalf("wrong error %q; want substring %q", got, want)
+	}
+}
+
 func TestContext2Validate_provisionerConfig_good(t *testing.T) {
 	m := testModule(t, "validate-bad-prov-conf")
 	p := testProvider("aws")
@@ -1478,14 +1515,6 @@ func TestContext2Validate_variableCustomValidationsRoot(t *testing.T) {
 	// altogether. (Root module variables are never known during validation.)
 	m := testModuleInline(t, map[string]string{
 		"main.tf": `
-# This feature is currently experimental.
-# (If you're currently cleaning up after concluding the experiment,
-# remember to also clean up similar references in the configs package
-# under "invalid-files" and "invalid-modules".)
-terraform {
-  experiments = [variable_validation]
-}
-
 variable "test" {
   type = string
 
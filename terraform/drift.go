@@ -0,0 +1,166 @@
+package terraform
+
+import (
+	"bytes"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/states"
+	"github.com/hashicorp/terraform/tfdiags"
+)
+
+// DriftReport describes how the objects recorded in state differ from the
+// real remote objects, as discovered by refreshing them. It considers only
+// differences against the prior state, and not any changes that might be
+// needed to move towards the configuration.
+type DriftReport struct {
+	// Instances describes each resource instance whose remote object has
+	// changed or disappeared, sorted by address. Instances that match their
+	// prior state are not included.
+	Instances []*InstanceDrift
+}
+
+// Empty returns true if no drift was detected.
+func (r *DriftReport) Empty() bool {
+	return len(r.Instances) == 0
+}
+
+// InstanceDrift describes how a single resource instance's remote object
+// differs from its prior state.
+type InstanceDrift struct {
+	Addr addrs.AbsResourceInstance
+
+	// Deleted is true if the remote object no longer exists, meaning that
+	// it was deleted outside of Terraform.
+	Deleted bool
+
+	// ChangedAttrs lists the names of the top-level attributes whose values
+	// differ from the prior state, sorted. It is empty when Deleted is set,
+	// and may also be empty if the objects could not be decoded for
+	// attribute-level comparison even though their raw data differs.
+	ChangedAttrs []string
+}
+
+// DetectDrift refreshes all of the resource instances tracked in the state
+// and compares the refreshed objects against the prior ones, returning a
+// report of the differences. Unlike Plan, it does not compute any
+// config-driven changes, so the report reflects only changes made outside
+// of Terraform.
+//
+// As with Refresh, the state tracked by this context is replaced with the
+// refreshed state on success.
+func (c *Context) DetectDrift() (*DriftReport, tfdiags.Diagnostics) {
+	prior := c.state.DeepCopy()
+
+	refreshed, diags := c.Refresh()
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	report := &DriftReport{}
+	for _, ms := range prior.Modules {
+		for _, rs := range ms.Resources {
+			if rs.Addr.Mode != addrs.ManagedResourceMode {
+				continue
+			}
+			for key, is := range rs.Instances {
+				if is.Current == nil {
+					continue
+				}
+				instAddr := rs.Addr.Instance(key).Absolute(ms.Addr)
+
+				var refreshedObj *states.ResourceInstanceObjectSrc
+				if ris := refreshed.ResourceInstance(instAddr); ris != nil {
+					refreshedObj = ris.Current
+				}
+				if refreshedObj == nil {
+					// The refresh walk may have moved the instance across
+					// the "count boundary" (between a no-key address and
+					// index zero) to match the configuration, so check the
+					// equivalent address on the other side before we
+					// conclude that the object has been deleted.
+					var altAddr addrs.AbsResourceInstance
+					switch key {
+					case addrs.NoKey:
+						altAddr = rs.Addr.Instance(addrs.IntKey(0)).Absolute(ms.Addr)
+					case addrs.IntKey(0):
+						altAddr = rs.Addr.Instance(addrs.NoKey).Absolute(ms.Addr)
+					}
+					if altAddr.Resource.Resource.Type != "" {
+						if ris := refreshed.ResourceInstance(altAddr); ris != nil {
+							refreshedObj = ris.Current
+						}
+					}
+				}
+				if refreshedObj == nil {
+					report.Instances = append(report.Instances, &InstanceDrift{
+						Addr:    instAddr,
+						Deleted: true,
+					})
+					continue
+				}
+
+				changedAttrs, equal := c.driftedAttrs(rs, is.Current, refreshedObj)
+				if equal {
+					continue
+				}
+				report.Instances = append(report.Instances, &InstanceDrift{
+					Addr:         instAddr,
+					ChangedAttrs: changedAttrs,
+				})
+			}
+		}
+	}
+
+	sort.Slice(report.Instances, func(i, j int) bool {
+		return report.Instances[i].Addr.Less(report.Instances[j].Addr)
+	})
+
+	return report, diags
+}
+
+// driftedAttrs compares a prior and refreshed object for the given resource,
+// returning the names of the top-level attributes that changed and whether
+// the two objects are equal. If the objects cannot be decoded using the
+// resource type schema then the comparison falls back to the raw data, in
+// which case the attribute names are not available.
+func (c *Context) driftedAttrs(rs *states.Resource, prior, refreshed *states.ResourceInstanceObjectSrc) ([]string, bool) {
+	rawEqual := prior.Status == refreshed.Status &&
+		bytes.Equal(prior.AttrsJSON, refreshed.AttrsJSON) &&
+		reflect.DeepEqual(prior.AttrsFlat, refreshed.AttrsFlat)
+	if rawEqual {
+		return nil, true
+	}
+
+	if prior.AttrsJSON == nil || refreshed.AttrsJSON == nil || c.schemas == nil {
+		return nil, false
+	}
+	providerSchema := c.schemas.ProviderSchema(rs.ProviderConfig.Provider)
+	if providerSchema == nil {
+		return nil, false
+	}
+	schema, _ := providerSchema.SchemaForResourceAddr(rs.Addr)
+	if schema == nil {
+		return nil, false
+	}
+
+	ty := schema.ImpliedType()
+	priorObj, err := prior.Decode(ty)
+	if err != nil {
+		return nil, false
+	}
+	refreshedObj, err := refreshed.Decode(ty)
+	if err != nil {
+		return nil, false
+	}
+
+	var changed []string
+	for name := range ty.AttributeTypes() {
+		if !priorObj.Value.GetAttr(name).RawEquals(refreshedObj.Value.GetAttr(name)) {
+			changed = append(changed, name)
+		}
+	}
+	sort.Strings(changed)
+	return changed, len(changed) == 0 && prior.Status == refreshed.Status
+}
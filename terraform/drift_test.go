@@ -0,0 +1,183 @@
+package terraform
+
+import (
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/hashicorp/terraform/providers"
+	"github.com/hashicorp/terraform/states"
+)
+
+func TestContext2DetectDrift(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+resource "test_instance" "web" {
+}
+
+resource "test_instance" "gone" {
+}
+
+resource "test_instance" "same" {
+}
+`,
+	})
+
+	p := testProvider("test")
+	p.GetSchemaReturn = &ProviderSchema{
+		ResourceTypes: map[string]*configschema.Block{
+			"test_instance": {
+				Attributes: map[string]*configschema.Attribute{
+					"id":  {Type: cty.String, Computed: true},
+					"foo": {Type: cty.String, Optional: true},
+				},
+			},
+		},
+	}
+
+	providerAddr := addrs.AbsProviderConfig{
+		Provider: addrs.NewLegacyProvider("test"),
+		Module:   addrs.RootModuleInstance,
+	}
+	startingState := states.BuildState(func(ss *states.SyncState) {
+		for _, name := range []string{"web", "gone", "same"} {
+			ss.SetResourceInstanceCurrent(
+				addrs.Resource{
+					Mode: addrs.ManagedResourceMode,
+					Type: "test_instance",
+					Name: name,
+				}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance),
+				&states.ResourceInstanceObjectSrc{
+					Status:    states.ObjectReady,
+					AttrsJSON: []byte(`{"id":"` + name + `","foo":"bar"}`),
+				},
+				providerAddr,
+			)
+		}
+	})
+
+	p.ReadResourceFn = func(req providers.ReadResourceRequest) providers.ReadResourceResponse {
+		switch req.PriorState.GetAttr("id").AsString() {
+		case "web":
+			// Changed outside of Terraform.
+			return providers.ReadResourceResponse{
+				NewState: cty.ObjectVal(map[string]cty.Value{
+					"id":  cty.StringVal("web"),
+					"foo": cty.StringVal("baz"),
+				}),
+			}
+		case "gone":
+			// Deleted outside of Terraform.
+			return providers.ReadResourceResponse{
+				NewState: cty.NullVal(req.PriorState.Type()),
+			}
+		default:
+			return providers.ReadResourceResponse{
+				NewState: req.PriorState,
+			}
+		}
+	}
+
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("test"): testProviderFuncFixed(p),
+			},
+		),
+		State: startingState,
+	})
+
+	report, diags := ctx.DetectDrift()
+	if diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+	if report.Empty() {
+		t.Fatal("report is empty; want two drifted instances")
+	}
+	if got, want := len(report.Instances), 2; got != want {
+		t.Fatalf("wrong number of drifted instances %d; want %d\n%#v", got, want, report.Instances)
+	}
+
+	gone := report.Instances[0]
+	if got, want := gone.Addr.String(), "test_instance.gone"; got != want {
+		t.Errorf("wrong first instance %s; want %s", got, want)
+	}
+	if !gone.Deleted {
+		t.Errorf("test_instance.gone not marked as deleted")
+	}
+
+	web := report.Instances[1]
+	if got, want := web.Addr.String(), "test_instance.web"; got != want {
+		t.Errorf("wrong second instance %s; want %s", got, want)
+	}
+	if web.Deleted {
+		t.Errorf("test_instance.web wrongly marked as deleted")
+	}
+	if len(web.ChangedAttrs) != 1 || web.ChangedAttrs[0] != "foo" {
+		t.Errorf("wrong changed attributes %#v for test_instance.web; want just \"foo\"", web.ChangedAttrs)
+	}
+}
+
+func TestContext2DetectDrift_noDrift(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+resource "test_instance" "web" {
+}
+`,
+	})
+
+	p := testProvider("test")
+	p.GetSchemaReturn = &ProviderSchema{
+		ResourceTypes: map[string]*configschema.Block{
+			"test_instance": {
+				Attributes: map[string]*configschema.Attribute{
+					"id": {Type: cty.String, Computed: true},
+				},
+			},
+		},
+	}
+	p.ReadResourceFn = func(req providers.ReadResourceRequest) providers.ReadResourceResponse {
+		return providers.ReadResourceResponse{
+			NewState: req.PriorState,
+		}
+	}
+
+	startingState := states.BuildState(func(ss *states.SyncState) {
+		ss.SetResourceInstanceCurrent(
+			addrs.Resource{
+				Mode: addrs.ManagedResourceMode,
+				Type: "test_instance",
+				Name: "web",
+			}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance),
+			&states.ResourceInstanceObjectSrc{
+				Status:    states.ObjectReady,
+				AttrsJSON: []byte(`{"id":"web"}`),
+			},
+			addrs.AbsProviderConfig{
+				Provider: addrs.NewLegacyProvider("test"),
+				Module:   addrs.RootModuleInstance,
+			},
+		)
+	})
+
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("test"): testProviderFuncFixed(p),
+			},
+		),
+		State: startingState,
+	})
+
+	report, diags := ctx.DetectDrift()
+	if diags.HasErrors() {
+		t.Fatal(diags.Err())
+	}
+	if !report.Empty() {
+		t.Fatalf("unexpected drift: %#v", report.Instances)
+	}
+}
@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
+	uuid "github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/hcl/v2"
 	"github.com/zclconf/go-cty/cty"
 
@@ -19,6 +21,10 @@ import (
 	"github.com/hashicorp/terraform/tfdiags"
 )
 
+// stillApplyingInterval is how often the StillApplying hook is called while
+// a provider is applying a change to a single instance.
+const stillApplyingInterval = 10 * time.Second
+
 // EvalApply is an EvalNode implementation that writes the diff to
 // the full diff.
 type EvalApply struct {
@@ -34,6 +40,24 @@ type EvalApply struct {
 	Error          *error
 }
 
+// applyTimeout returns the user-configured duration cap for the given change
+// action, or zero if the resource's lifecycle configuration doesn't limit
+// that operation.
+func (n *EvalApply) applyTimeout(action plans.Action) time.Duration {
+	if n.Config == nil || n.Config.Managed == nil || n.Config.Managed.Timeouts == nil {
+		return 0
+	}
+	timeouts := n.Config.Managed.Timeouts
+	switch action {
+	case plans.Create:
+		return timeouts.Create
+	case plans.Delete:
+		return timeouts.Delete
+	default:
+		return timeouts.Update
+	}
+}
+
 // TODO: test
 func (n *EvalApply) Eval(ctx EvalContext) (interface{}, error) {
 	var diags tfdiags.Diagnostics
@@ -57,6 +81,36 @@ func (n *EvalApply) Eval(ctx EvalContext) (interface{}, error) {
 		*n.CreateNew = (change.Action == plans.Create || change.Action.IsReplace())
 	}
 
+	// A Forget change removes the object from state without touching the
+	// remote object it tracks, so there's nothing for the provider to do.
+	if change.Action == plans.Forget {
+		log.Printf("[DEBUG] %s: removing from state without destroying, as requested by a removed block", absAddr)
+		if n.Output != nil {
+			*n.Output = nil
+		}
+		return nil, nil
+	}
+
+	// For a change planned from an "import" block the remote object already
+	// exists, so rather than asking the provider to create anything we just
+	// adopt the object that was read from it during planning.
+	if change.Importing {
+		log.Printf("[DEBUG] %s: adopting imported object %q instead of applying", absAddr, change.ImportID)
+		if n.CreateNew != nil {
+			// The instance is new to the state but the remote object is not,
+			// so create-time provisioners must not run.
+			*n.CreateNew = false
+		}
+		if n.Output != nil {
+			*n.Output = &states.ResourceInstanceObject{
+				Status:  states.ObjectReady,
+				Value:   change.After,
+				Private: change.Private,
+			}
+		}
+		return nil, nil
+	}
+
 	configVal := cty.NullVal(cty.DynamicPseudoType)
 	if n.Config != nil {
 		var configDiags tfdiags.Diagnostics
@@ -67,6 +121,10 @@ func (n *EvalApply) Eval(ctx EvalContext) (interface{}, error) {
 		if configDiags.HasErrors() {
 			return nil, diags.Err()
 		}
+
+		// Strip any marks before sending the value to the provider, since
+		// the wire protocol can't represent them.
+		configVal, _ = configVal.UnmarkDeep()
 	}
 
 	if !configVal.IsWhollyKnown() {
@@ -76,14 +134,78 @@ func (n *EvalApply) Eval(ctx EvalContext) (interface{}, error) {
 		)
 	}
 
+	// While the provider is working we periodically call the StillApplying
+	// hook so that UIs can report that a long-running operation is still in
+	// progress.
+	var gen states.Generation = states.CurrentGen
+	if change.DeposedKey != states.NotDeposed {
+		gen = change.DeposedKey
+	}
+	applyStart := time.Now()
+	heartbeatDone := make(chan struct{})
+	heartbeatStopped := make(chan struct{})
+	go func() {
+		defer close(heartbeatStopped)
+		ticker := time.NewTicker(stillApplyingInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-heartbeatDone:
+				return
+			case <-ticker.C:
+				if resourceHasUserVisibleApply(n.Addr) {
+					ctx.Hook(func(h Hook) (HookAction, error) {
+						return h.StillApplying(absAddr, gen, time.Since(applyStart))
+					})
+				}
+			}
+		}
+	}()
+
 	log.Printf("[DEBUG] %s: applying the planned %s change", n.Addr.Absolute(ctx.Path()), change.Action)
-	resp := provider.ApplyResourceChange(providers.ApplyResourceChangeRequest{
-		TypeName:       n.Addr.Resource.Type,
-		PriorState:     change.Before,
-		Config:         configVal,
-		PlannedState:   change.After,
-		PlannedPrivate: change.Private,
-	})
+	batchKey := applyBatchKey(n.ProviderAddr, n.Addr.Resource.Type, change.Action)
+	respCh := make(chan providers.ApplyResourceChangeResponse, 1)
+	go func() {
+		respCh <- ctx.ApplyBatcher().Apply(provider, batchKey, providers.ApplyResourceChangeRequest{
+			TypeName:       n.Addr.Resource.Type,
+			PriorState:     change.Before,
+			Config:         configVal,
+			PlannedState:   change.After,
+			PlannedPrivate: change.Private,
+		})
+	}()
+
+	var resp providers.ApplyResourceChangeResponse
+	timedOut := false
+	if timeout := n.applyTimeout(change.Action); timeout > 0 {
+		timer := time.NewTimer(timeout)
+		select {
+		case resp = <-respCh:
+			timer.Stop()
+		case <-timer.C:
+			// Ask the provider to abandon the operation and then wait for
+			// the call to actually return, so that we capture whatever
+			// partial result it is able to report.
+			timedOut = true
+			log.Printf("[WARN] %s: %s operation exceeded its configured timeout of %s; stopping provider", absAddr, change.Action, timeout)
+			provider.Stop()
+			resp = <-respCh
+		}
+	} else {
+		resp = <-respCh
+	}
+	close(heartbeatDone)
+	<-heartbeatStopped
+	if timedOut {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Resource operation timed out",
+			fmt.Sprintf(
+				"The %s operation for %s did not complete within its configured timeout of %s, and was stopped after %s.\n\nAny partial result reported by the provider has still been recorded in the state.",
+				change.Action, absAddr, n.applyTimeout(change.Action), time.Since(applyStart).Round(time.Second),
+			),
+		))
+	}
 	applyDiags := resp.Diagnostics
 	if n.Config != nil {
 		applyDiags = applyDiags.InConfigBody(n.Config.Config)
@@ -278,9 +400,51 @@ func (n *EvalApply) Eval(ctx EvalContext) (interface{}, error) {
 	var newState *states.ResourceInstanceObject
 	if !newVal.IsNull() { // null value indicates that the object is deleted, so we won't set a new state in that case
 		newState = &states.ResourceInstanceObject{
-			Status:  newStatus,
-			Value:   newVal,
-			Private: resp.Private,
+			Status:       newStatus,
+			Value:        newVal,
+			Private:      resp.Private,
+			VersionToken: resp.VersionToken,
+		}
+
+		// Record whether the configuration includes any destroy-time
+		// provisioners, so that a later destroy of this object without
+		// configuration can warn that they will not run.
+		if n.Config != nil && n.Config.Managed != nil {
+			for _, p := range n.Config.Managed.Provisioners {
+				if p.When == configs.ProvisionerWhenDestroy {
+					newState.HasDestroyProvisioners = true
+					break
+				}
+			}
+		}
+
+		// Carry forward the audit timestamps from the prior object, and then
+		// advance them only if the apply actually succeeded; in the recovery
+		// case above we retained the prior value, so the object was not
+		// really modified.
+		newState.CreatedAt = state.CreatedAt
+		newState.UpdatedAt = state.UpdatedAt
+		if !diags.HasErrors() {
+			now := time.Now().UTC()
+			if change.Action == plans.Create {
+				newState.CreatedAt = now
+			}
+			newState.UpdatedAt = now
+		}
+
+		// The unique ID is assigned once when the object is first created
+		// and then retained for the rest of its life, so that external
+		// systems can correlate the object across renames and moves. Objects
+		// created before unique IDs were introduced are assigned one on
+		// their next successful apply.
+		newState.UniqueID = state.UniqueID
+		if newState.UniqueID == "" && !diags.HasErrors() {
+			id, err := uuid.GenerateUUID()
+			if err != nil {
+				diags = diags.Append(fmt.Errorf("failed to generate unique ID for %s: %s", absAddr, err))
+			} else {
+				newState.UniqueID = id
+			}
 		}
 	}
 
@@ -321,6 +485,23 @@ func (n *EvalApplyPre) Eval(ctx EvalContext) (interface{}, error) {
 		panic(fmt.Sprintf("EvalApplyPre for %s called with nil Change", absAddr))
 	}
 
+	// Give hooks an opportunity to veto this change before it is applied.
+	// A veto error aborts just this change, leaving the rest of the walk
+	// to continue; a hook that wants to cancel the change without an error
+	// can return HookActionHalt instead.
+	if change.Action != plans.NoOp {
+		err := ctx.Hook(func(h Hook) (HookAction, error) {
+			action, err := h.PreApplyVeto(absAddr, change)
+			if err != nil {
+				err = fmt.Errorf("change for %s was vetoed at apply time: %s", absAddr, err)
+			}
+			return action, err
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if resourceHasUserVisibleApply(n.Addr) {
 		priorState := change.Before
 		plannedNewState := change.After
@@ -367,6 +548,15 @@ func (n *EvalApplyPost) Eval(ctx EvalContext) (interface{}, error) {
 		if hookErr != nil {
 			return nil, hookErr
 		}
+
+		if err == nil && state != nil && state.UniqueID != "" {
+			hookErr := ctx.Hook(func(h Hook) (HookAction, error) {
+				return h.PostApplyUniqueID(absAddr, n.Gen, state.UniqueID)
+			})
+			if hookErr != nil {
+				return nil, hookErr
+			}
+		}
 	}
 
 	return nil, *n.Error
@@ -519,6 +709,33 @@ func (n *EvalApplyProvisioners) Eval(ctx EvalContext) (interface{}, error) {
 	return nil, nil
 }
 
+// EvalSkippedDestroyProvisioners is an EvalNode implementation that emits a
+// warning when an object recorded as having destroy-time provisioners is
+// being destroyed without any configuration, such as after its resource
+// block has been removed, since in that case the provisioners cannot run.
+type EvalSkippedDestroyProvisioners struct {
+	Addr  addrs.ResourceInstance
+	State **states.ResourceInstanceObject
+}
+
+func (n *EvalSkippedDestroyProvisioners) Eval(ctx EvalContext) (interface{}, error) {
+	state := *n.State
+	if state == nil || !state.HasDestroyProvisioners {
+		return nil, nil
+	}
+
+	var diags tfdiags.Diagnostics
+	diags = diags.Append(tfdiags.Sourceless(
+		tfdiags.Warning,
+		"Destroy-time provisioners skipped",
+		fmt.Sprintf(
+			"%s has destroy-time provisioners recorded in the state, but it is being destroyed without its configuration, so those provisioners will not run. Any cleanup actions they would have performed must be completed some other way.",
+			n.Addr.Absolute(ctx.Path()),
+		),
+	))
+	return nil, diags.ErrWithWarnings()
+}
+
 // filterProvisioners filters the provisioners on the resource to only
 // the provisioners specified by the "when" option.
 func (n *EvalApplyProvisioners) filterProvisioners() []*configs.Provisioner {
@@ -584,6 +801,10 @@ func (n *EvalApplyProvisioners) apply(ctx EvalContext, provs []*configs.Provisio
 			return diags.Err()
 		}
 
+		// Provisioners cross the plugin wire protocol too, which can't
+		// represent marks.
+		config, _ = config.UnmarkDeep()
+
 		// If the provisioner block contains a connection block of its own then
 		// it can override the base connection configuration, if any.
 		var localConn hcl.Body
@@ -617,6 +838,7 @@ func (n *EvalApplyProvisioners) apply(ctx EvalContext, provs []*configs.Provisio
 				// provisioner itself, not to invalid configuration.
 				return diags.Err()
 			}
+			connInfo, _ = connInfo.UnmarkDeep()
 		}
 
 		{
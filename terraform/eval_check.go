@@ -0,0 +1,94 @@
+package terraform
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/configs"
+	"github.com/hashicorp/terraform/states"
+	"github.com/hashicorp/terraform/tfdiags"
+)
+
+// evalCheck is an EvalNode implementation that evaluates the assertions of
+// a single configuration-defined "check" block and records the outcome in
+// the state.
+//
+// Unlike the preconditions and postconditions handled by evalCheckRules,
+// check blocks are advisory: a failed or erroring assertion produces only
+// warning diagnostics, and the recorded status allows later operations to
+// keep reporting the problem until it is resolved.
+type evalCheck struct {
+	Addr   addrs.AbsCheck
+	Config *configs.Check
+}
+
+func (n *evalCheck) Eval(ctx EvalContext) (interface{}, error) {
+	var diags tfdiags.Diagnostics
+	result := &states.CheckResult{
+		Status: states.CheckStatusPass,
+	}
+
+	for _, rule := range n.Config.Asserts {
+		val, condDiags := ctx.EvaluateExpr(rule.Condition, cty.Bool, nil)
+		if condDiags.HasErrors() {
+			// Problems with the condition itself must not block the apply,
+			// so we downgrade them to warnings while recording an error
+			// status for the check as a whole.
+			result.Status = states.CheckStatusError
+			for _, d := range condDiags {
+				desc := d.Description()
+				diags = diags.Append(tfdiags.Sourceless(
+					tfdiags.Warning,
+					fmt.Sprintf("Invalid check assertion: %s", desc.Summary),
+					fmt.Sprintf("%s (in %s)", desc.Detail, n.Addr),
+				))
+			}
+			continue
+		}
+
+		if !val.IsKnown() {
+			// The values this assertion depends on haven't been decided
+			// during this operation, so we can't determine an outcome yet.
+			if result.Status == states.CheckStatusPass {
+				result.Status = states.CheckStatusUnknown
+			}
+			continue
+		}
+		if val.IsNull() {
+			result.Status = states.CheckStatusError
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Warning,
+				"Invalid check assertion result",
+				fmt.Sprintf("Condition expression in %s must return either true or false, not null.", n.Addr),
+			))
+			continue
+		}
+
+		// A sensitive condition result doesn't change the outcome, so we can
+		// safely discard any marks before inspecting the value.
+		val, _ = val.Unmark()
+
+		if val.True() {
+			continue
+		}
+
+		if result.Status != states.CheckStatusError {
+			result.Status = states.CheckStatusFail
+		}
+		message := evalConditionErrorMessage(ctx, rule.ErrorMessage, nil)
+		result.FailureMessages = append(result.FailureMessages, message)
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Warning,
+			fmt.Sprintf("Check %q failed", n.Addr.Check.Name),
+			message,
+		))
+	}
+
+	log.Printf("[TRACE] evalCheck: %s has status %s", n.Addr, result.Status)
+	ctx.State().SetCheckResult(n.Addr, result)
+
+	return nil, diags.ErrWithWarnings()
+}
@@ -31,6 +31,24 @@ func (n *EvalCheckPreventDestroy) Eval(ctx EvalContext) (interface{}, error) {
 
 	if (change.Action == plans.Delete || change.Action.IsReplace()) && preventDestroy {
 		var diags tfdiags.Diagnostics
+
+		if override := ctx.DestroyProtectionOverride(); override != "" {
+			// The operation was explicitly configured to bypass the
+			// protection, so we downgrade the error to a warning that
+			// records who or what requested the override. The plan itself
+			// also records the override, for auditing.
+			diags = diags.Append(&hcl.Diagnostic{
+				Severity: hcl.DiagWarning,
+				Summary:  "Destruction of protected instance allowed by override",
+				Detail: fmt.Sprintf(
+					"Resource %s has lifecycle.prevent_destroy set, but the plan calls for this resource to be destroyed. The protection was overridden by %s.",
+					n.Addr.Absolute(ctx.Path()).String(), override,
+				),
+				Subject: &n.Config.DeclRange,
+			})
+			return nil, diags.ErrWithWarnings()
+		}
+
 		diags = diags.Append(&hcl.Diagnostic{
 			Severity: hcl.DiagError,
 			Summary:  "Instance cannot be destroyed",
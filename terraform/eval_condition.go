@@ -0,0 +1,110 @@
+package terraform
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/configs"
+	"github.com/hashicorp/terraform/tfdiags"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// evalCheckRules is an EvalNode implementation that evaluates a set of
+// configuration-defined check rules, such as the preconditions and
+// postconditions for a resource, halting the walk with an error if any of
+// them fail.
+type evalCheckRules struct {
+	Rules []*configs.CheckRule
+
+	// Self is the object that "self" refers to in the rule expressions, if
+	// any. Preconditions are evaluated before their container has a value,
+	// so this is set only for postconditions.
+	Self addrs.Referenceable
+
+	// CheckType is a human-oriented noun phrase describing what kind of rule
+	// is being checked, for use in diagnostic messages.
+	CheckType string
+}
+
+func (n *evalCheckRules) Eval(ctx EvalContext) (interface{}, error) {
+	var diags tfdiags.Diagnostics
+	for _, rule := range n.Rules {
+		diags = diags.Append(evalResourceCondition(ctx, rule.Condition, rule.ErrorMessage, n.Self, n.CheckType))
+	}
+	return nil, diags.ErrWithWarnings()
+}
+
+// evalResourceCondition evaluates a configuration-defined condition
+// expression for a resource instance, with "self" bound to the instance so
+// that both the condition and its custom error message can refer to the
+// instance's own attribute values.
+//
+// checkType is a human-oriented noun phrase describing the kind of check,
+// such as "Resource postcondition", used to construct the diagnostic summary
+// when the condition fails.
+//
+// This is shared between the plan and apply walks: during plan the condition
+// sees the planned new values, and during apply it sees the final ones. A
+// condition whose result is not yet known is treated as passing, on the
+// assumption that it will be re-checked during a later walk once its
+// dependencies have been decided.
+func evalResourceCondition(ctx EvalContext, condition, errorMessage hcl.Expression, self addrs.Referenceable, checkType string) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+
+	val, condDiags := ctx.EvaluateExpr(condition, cty.Bool, self)
+	diags = diags.Append(condDiags)
+	if condDiags.HasErrors() {
+		return diags
+	}
+
+	if !val.IsKnown() {
+		// We'll wait until all of the values the condition depends on have
+		// been decided and then check it again.
+		return diags
+	}
+	if val.IsNull() {
+		diags = diags.Append(&hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Invalid condition result",
+			Detail:   "Condition expression must return either true or false, not null.",
+			Subject:  condition.Range().Ptr(),
+		})
+		return diags
+	}
+
+	// A sensitive condition result doesn't change the outcome, so we can
+	// safely discard any marks before inspecting the value.
+	val, _ = val.Unmark()
+
+	if val.True() {
+		return diags
+	}
+
+	diags = diags.Append(&hcl.Diagnostic{
+		Severity: hcl.DiagError,
+		Summary:  fmt.Sprintf("%s failed", checkType),
+		Detail:   evalConditionErrorMessage(ctx, errorMessage, self),
+		Subject:  condition.Range().Ptr(),
+	})
+	return diags
+}
+
+// evalConditionErrorMessage produces the detail message for a failed
+// condition, evaluating the configuration-defined error message with "self"
+// bound in the same way as the condition itself. If the message cannot be
+// evaluated then we use a generic message rather than failing, because the
+// error message is subordinate to the condition failure we're reporting.
+func evalConditionErrorMessage(ctx EvalContext, errorMessage hcl.Expression, self addrs.Referenceable) string {
+	const fallback = "This check failed, but has an invalid error message."
+
+	if errorMessage == nil {
+		return fallback
+	}
+
+	val, diags := ctx.EvaluateExpr(errorMessage, cty.String, self)
+	if diags.HasErrors() || !val.IsKnown() || val.IsNull() {
+		return fallback
+	}
+	return val.AsString()
+}
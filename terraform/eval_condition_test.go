@@ -0,0 +1,115 @@
+package terraform
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hcltest"
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/tfdiags"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+)
+
+func TestEvalResourceCondition(t *testing.T) {
+	selfAddr := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_object",
+		Name: "a",
+	}.Instance(addrs.NoKey)
+	selfVal := cty.ObjectVal(map[string]cty.Value{
+		"id":   cty.StringVal("i-abc123"),
+		"size": cty.NumberIntVal(0),
+	})
+
+	parseExpr := func(t *testing.T, src string) hcl.Expression {
+		t.Helper()
+		expr, hclDiags := hclsyntax.ParseExpression([]byte(src), "test.tf", hcl.Pos{Line: 1, Column: 1})
+		if hclDiags.HasErrors() {
+			t.Fatal(hclDiags.Error())
+		}
+		return expr
+	}
+
+	ctx := &MockEvalContext{}
+	ctx.EvaluateExprResultFunc = func(expr hcl.Expression, wantType cty.Type, self addrs.Referenceable) (cty.Value, tfdiags.Diagnostics) {
+		var diags tfdiags.Diagnostics
+		if self == nil || self.String() != selfAddr.String() {
+			t.Errorf("wrong self address %v; want %v", self, selfAddr)
+		}
+		val, hclDiags := expr.Value(&hcl.EvalContext{
+			Variables: map[string]cty.Value{"self": selfVal},
+		})
+		diags = diags.Append(hclDiags)
+		if hclDiags.HasErrors() {
+			return cty.DynamicVal, diags
+		}
+		val, err := convert.Convert(val, wantType)
+		if err != nil {
+			diags = diags.Append(err)
+			return cty.DynamicVal, diags
+		}
+		return val, diags
+	}
+
+	t.Run("passing", func(t *testing.T) {
+		diags := evalResourceCondition(
+			ctx,
+			parseExpr(t, `self.id != ""`),
+			parseExpr(t, `"should not be seen"`),
+			selfAddr, "Resource postcondition",
+		)
+		if diags.HasErrors() {
+			t.Fatalf("unexpected errors: %s", diags.Err())
+		}
+	})
+
+	t.Run("failing with self in message", func(t *testing.T) {
+		diags := evalResourceCondition(
+			ctx,
+			parseExpr(t, `self.size > 0`),
+			parseExpr(t, `"instance ${self.id} must have a nonzero size"`),
+			selfAddr, "Resource postcondition",
+		)
+		if !diags.HasErrors() {
+			t.Fatal("expected error, got success")
+		}
+		desc := diags[0].Description()
+		if got, want := desc.Summary, "Resource postcondition failed"; got != want {
+			t.Errorf("wrong summary %q; want %q", got, want)
+		}
+		if !strings.Contains(desc.Detail, "i-abc123") {
+			t.Errorf("detail does not interpolate self: %q", desc.Detail)
+		}
+	})
+
+	t.Run("unknown condition", func(t *testing.T) {
+		diags := evalResourceCondition(
+			ctx,
+			hcltest.MockExprLiteral(cty.UnknownVal(cty.Bool)),
+			parseExpr(t, `"should not be seen"`),
+			selfAddr, "Resource postcondition",
+		)
+		if diags.HasErrors() {
+			t.Fatalf("unexpected errors for unknown condition: %s", diags.Err())
+		}
+	})
+
+	t.Run("invalid error message", func(t *testing.T) {
+		diags := evalResourceCondition(
+			ctx,
+			parseExpr(t, `self.size > 0`),
+			parseExpr(t, `self.no_such_attr`),
+			selfAddr, "Resource postcondition",
+		)
+		if !diags.HasErrors() {
+			t.Fatal("expected error, got success")
+		}
+		desc := diags[0].Description()
+		if !strings.Contains(desc.Detail, "invalid error message") {
+			t.Errorf("detail does not mention the invalid error message: %q", desc.Detail)
+		}
+	})
+}
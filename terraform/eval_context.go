@@ -3,6 +3,7 @@ package terraform
 import (
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/configs"
 	"github.com/hashicorp/terraform/configs/configschema"
 	"github.com/hashicorp/terraform/instances"
 	"github.com/hashicorp/terraform/lang"
@@ -161,4 +162,34 @@ type EvalContext interface {
 	// The InstanceExpander is a global object that is shared across all of the
 	// EvalContext objects for a given configuration.
 	InstanceExpander() *instances.Expander
+
+	// ApplyBatcher returns the shared scheduler that coalesces compatible
+	// apply operations into batches for providers that support the batch
+	// RPC. It may return nil, in which case callers must fall back to
+	// direct per-resource provider calls.
+	ApplyBatcher() *applyBatcher
+
+	// DestroyProtectionOverride returns a non-empty string if the current
+	// operation was configured to bypass lifecycle.prevent_destroy
+	// protections, in which case the string describes who or what requested
+	// the override so that it can be recorded for auditing. It returns an
+	// empty string in normal operation.
+	DestroyProtectionOverride() string
+
+	// PreviousPlanChange returns the change recorded for the given resource
+	// instance's current object in a previous plan that was provided for
+	// reuse, or nil if there is no such change. Callers must compare the
+	// change's InputsHash against a digest of their own planning inputs
+	// before reusing its result.
+	PreviousPlanChange(addr addrs.AbsResourceInstance) *plans.ResourceInstanceChangeSrc
+
+	// ImportTarget returns the configuration's "import" block for the given
+	// resource instance, or nil if the configuration doesn't ask for that
+	// instance to be imported.
+	ImportTarget(addr addrs.AbsResourceInstance) *configs.Import
+
+	// RemovedTarget returns the configuration's "removed" block whose
+	// address matches the given resource instance, or nil if the
+	// configuration doesn't ask for that instance to be removed from state.
+	RemovedTarget(addr addrs.AbsResourceInstance) *configs.Removed
 }
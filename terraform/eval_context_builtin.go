@@ -15,12 +15,14 @@ import (
 	"github.com/hashicorp/terraform/states"
 
 	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/terraform/configs"
 	"github.com/hashicorp/terraform/configs/configschema"
 	"github.com/hashicorp/terraform/lang"
 	"github.com/hashicorp/terraform/tfdiags"
 
 	"github.com/hashicorp/terraform/addrs"
 	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
 )
 
 // BuiltinEvalContext is an EvalContext implementation that is used by
@@ -29,6 +31,10 @@ type BuiltinEvalContext struct {
 	// StopContext is the context used to track whether we're complete
 	StopContext context.Context
 
+	// TraceContext, if non-nil, carries the tracing span for the current
+	// graph walk, under which provider RPCs are recorded as child spans.
+	TraceContext context.Context
+
 	// PathValue is the Path that this context is operating within.
 	PathValue addrs.ModuleInstance
 
@@ -58,6 +64,7 @@ type BuiltinEvalContext struct {
 	Hooks                 []Hook
 	InputValue            UIInput
 	ProviderCache         map[string]providers.Interface
+	ProviderMetrics       *ProviderCallMetrics
 	ProviderInputConfig   map[string]map[string]cty.Value
 	ProviderLock          *sync.Mutex
 	ProvisionerCache      map[string]provisioners.Interface
@@ -65,6 +72,30 @@ type BuiltinEvalContext struct {
 	ChangesValue          *plans.ChangesSync
 	StateValue            *states.SyncState
 	InstanceExpanderValue *instances.Expander
+	ApplyBatcherValue     *applyBatcher
+
+	// DestroyProtectionOverrideValue is non-empty if the operation was
+	// configured to bypass lifecycle.prevent_destroy, describing who or
+	// what requested the override.
+	DestroyProtectionOverrideValue string
+
+	// ProviderConfigOverrides are configuration argument values to merge
+	// into every provider configuration at configure time, keyed by
+	// provider type name. See ContextOpts.ProviderConfigOverrides for
+	// the intended use and the precedence rules.
+	ProviderConfigOverrides map[string]cty.Value
+
+	// PreviousPlanChanges are resource instance changes from a previous
+	// plan that are candidates for reuse during this walk, or nil if no
+	// previous plan was provided. See ContextOpts.PreviousPlan.
+	PreviousPlanChanges *plans.Changes
+
+	// ImportTargets are the configuration's "import" blocks, keyed by the
+	// target resource instance address string.
+	ImportTargets map[string]*configs.Import
+
+	// RemovedTargets are the configuration's "removed" blocks.
+	RemovedTargets []*configs.Removed
 
 	once sync.Once
 }
@@ -130,6 +161,20 @@ func (ctx *BuiltinEvalContext) InitProvider(addr addrs.AbsProviderConfig) (provi
 	if err != nil {
 		return nil, err
 	}
+	if ctx.ProviderMetrics != nil {
+		p = &meteredProvider{
+			inner:   p,
+			addr:    addr.Provider.String(),
+			metrics: ctx.ProviderMetrics,
+		}
+	}
+	if ctx.TraceContext != nil {
+		p = &tracedProvider{
+			inner:    p,
+			addr:     addr.Provider.String(),
+			traceCtx: ctx.TraceContext,
+		}
+	}
 
 	log.Printf("[TRACE] BuiltinEvalContext: Initialized %q provider for %s", addr.LegacyString(), absAddr)
 	ctx.ProviderCache[key] = p
@@ -193,6 +238,15 @@ func (ctx *BuiltinEvalContext) ConfigureProvider(addr addrs.AbsProviderConfig, c
 		return diags
 	}
 
+	if override, exists := ctx.ProviderConfigOverrides[addr.Provider.Type]; exists {
+		var overrideDiags tfdiags.Diagnostics
+		cfg, overrideDiags = mergeProviderConfigOverride(absAddr, cfg, override, providerSchema.Provider)
+		diags = diags.Append(overrideDiags)
+		if diags.HasErrors() {
+			return diags
+		}
+	}
+
 	req := providers.ConfigureRequest{
 		TerraformVersion: version.String(),
 		Config:           cfg,
@@ -202,6 +256,51 @@ func (ctx *BuiltinEvalContext) ConfigureProvider(addr addrs.AbsProviderConfig, c
 	return resp.Diagnostics
 }
 
+// mergeProviderConfigOverride applies a caller-supplied configuration
+// override to a provider configuration value, returning a new value with
+// the overridden arguments replaced. Override values take precedence over
+// values from both configuration and provider input, so that a host
+// application can inject settings such as credentials regardless of what
+// the configuration says.
+func mergeProviderConfigOverride(addr addrs.AbsProviderConfig, cfg, override cty.Value, schema *configschema.Block) (cty.Value, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	if override.IsNull() || !override.Type().IsObjectType() {
+		diags = diags.Append(fmt.Errorf("invalid configuration override for %s: override value must be an object", addr))
+		return cfg, diags
+	}
+
+	var attrs map[string]cty.Value
+	if !cfg.IsNull() && cfg.Type().IsObjectType() {
+		attrs = cfg.AsValueMap()
+	}
+	if attrs == nil {
+		attrs = make(map[string]cty.Value)
+		for name, attrTy := range schema.ImpliedType().AttributeTypes() {
+			attrs[name] = cty.NullVal(attrTy)
+		}
+	}
+
+	for name, overrideVal := range override.AsValueMap() {
+		attrS, exists := schema.Attributes[name]
+		if !exists {
+			diags = diags.Append(fmt.Errorf("invalid configuration override for %s: provider has no argument named %q", addr, name))
+			continue
+		}
+		v, err := convert.Convert(overrideVal, attrS.Type)
+		if err != nil {
+			diags = diags.Append(fmt.Errorf("invalid configuration override for %s: unsuitable value for argument %q: %s", addr, name, err))
+			continue
+		}
+		attrs[name] = v
+	}
+	if diags.HasErrors() {
+		return cfg, diags
+	}
+
+	return cty.ObjectVal(attrs), diags
+}
+
 func (ctx *BuiltinEvalContext) ProviderInput(pc addrs.AbsProviderConfig) map[string]cty.Value {
 	ctx.ProviderLock.Lock()
 	defer ctx.ProviderLock.Unlock()
@@ -299,6 +398,14 @@ func (ctx *BuiltinEvalContext) EvaluateBlock(body hcl.Body, schema *configschema
 	diags = diags.Append(evalDiags)
 	val, evalDiags := scope.EvalBlock(body, schema)
 	diags = diags.Append(evalDiags)
+	if diags.HasErrors() {
+		// For counted and for_each objects the error messages alone often
+		// don't identify which instance failed, so we attach the module
+		// path and instance key for renderers that can use them.
+		if instCtx, ok := instanceContextForEval(ctx.PathValue, keyData); ok {
+			diags = diags.WithInstanceContext(instCtx)
+		}
+	}
 	return val, body, diags
 }
 
@@ -362,5 +469,33 @@ func (ctx *BuiltinEvalContext) InstanceExpander() *instances.Expander {
 	return ctx.InstanceExpanderValue
 }
 
+func (ctx *BuiltinEvalContext) ApplyBatcher() *applyBatcher {
+	return ctx.ApplyBatcherValue
+}
+
+func (ctx *BuiltinEvalContext) DestroyProtectionOverride() string {
+	return ctx.DestroyProtectionOverrideValue
+}
+
+func (ctx *BuiltinEvalContext) PreviousPlanChange(addr addrs.AbsResourceInstance) *plans.ResourceInstanceChangeSrc {
+	if ctx.PreviousPlanChanges == nil {
+		return nil
+	}
+	return ctx.PreviousPlanChanges.ResourceInstance(addr)
+}
+
+func (ctx *BuiltinEvalContext) ImportTarget(addr addrs.AbsResourceInstance) *configs.Import {
+	return ctx.ImportTargets[addr.String()]
+}
+
+func (ctx *BuiltinEvalContext) RemovedTarget(addr addrs.AbsResourceInstance) *configs.Removed {
+	for _, rm := range ctx.RemovedTargets {
+		if rm.From != nil && rm.From.Subject.TargetContains(addr) {
+			return rm
+		}
+	}
+	return nil
+}
+
 func (ctx *BuiltinEvalContext) init() {
 }
@@ -4,6 +4,7 @@ import (
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hcldec"
 	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/configs"
 	"github.com/hashicorp/terraform/configs/configschema"
 	"github.com/hashicorp/terraform/instances"
 	"github.com/hashicorp/terraform/lang"
@@ -128,6 +129,24 @@ type MockEvalContext struct {
 
 	InstanceExpanderCalled   bool
 	InstanceExpanderExpander *instances.Expander
+
+	ApplyBatcherCalled  bool
+	ApplyBatcherBatcher *applyBatcher
+
+	DestroyProtectionOverrideCalled bool
+	DestroyProtectionOverrideValue  string
+
+	PreviousPlanChangeCalled bool
+	PreviousPlanChangeAddr   addrs.AbsResourceInstance
+	PreviousPlanChangeChange *plans.ResourceInstanceChangeSrc
+
+	ImportTargetCalled bool
+	ImportTargetAddr   addrs.AbsResourceInstance
+	ImportTargetImport *configs.Import
+
+	RemovedTargetCalled  bool
+	RemovedTargetAddr    addrs.AbsResourceInstance
+	RemovedTargetRemoved *configs.Removed
 }
 
 // MockEvalContext implements EvalContext
@@ -336,3 +355,31 @@ func (c *MockEvalContext) InstanceExpander() *instances.Expander {
 	c.InstanceExpanderCalled = true
 	return c.InstanceExpanderExpander
 }
+
+func (c *MockEvalContext) ApplyBatcher() *applyBatcher {
+	c.ApplyBatcherCalled = true
+	return c.ApplyBatcherBatcher
+}
+
+func (c *MockEvalContext) DestroyProtectionOverride() string {
+	c.DestroyProtectionOverrideCalled = true
+	return c.DestroyProtectionOverrideValue
+}
+
+func (c *MockEvalContext) PreviousPlanChange(addr addrs.AbsResourceInstance) *plans.ResourceInstanceChangeSrc {
+	c.PreviousPlanChangeCalled = true
+	c.PreviousPlanChangeAddr = addr
+	return c.PreviousPlanChangeChange
+}
+
+func (c *MockEvalContext) ImportTarget(addr addrs.AbsResourceInstance) *configs.Import {
+	c.ImportTargetCalled = true
+	c.ImportTargetAddr = addr
+	return c.ImportTargetImport
+}
+
+func (c *MockEvalContext) RemovedTarget(addr addrs.AbsResourceInstance) *configs.Removed {
+	c.RemovedTargetCalled = true
+	c.RemovedTargetAddr = addr
+	return c.RemovedTargetRemoved
+}
@@ -58,6 +58,10 @@ func evaluateResourceCountExpressionKnown(expr hcl.Expression, ctx EvalContext)
 		return -1, true, diags
 	}
 
+	// The number of instances isn't treated as sensitive, so we discard any
+	// marks that arrived via a sensitive input variable.
+	countVal, _ = countVal.Unmark()
+
 	switch {
 	case countVal.IsNull():
 		diags = diags.Append(&hcl.Diagnostic{
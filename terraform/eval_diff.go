@@ -1,6 +1,7 @@
 package terraform
 
 import (
+	"bytes"
 	"fmt"
 	"log"
 	"strings"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/hashicorp/terraform/addrs"
 	"github.com/hashicorp/terraform/configs"
+	"github.com/hashicorp/terraform/configs/configschema"
 	"github.com/hashicorp/terraform/plans"
 	"github.com/hashicorp/terraform/plans/objchange"
 	"github.com/hashicorp/terraform/providers"
@@ -140,6 +142,11 @@ func (n *EvalDiff) Eval(ctx EvalContext) (interface{}, error) {
 		return nil, diags.Err()
 	}
 
+	// We currently can't propagate marks into resource instance objects, and
+	// the provider wire protocol can't represent them anyway, so we strip
+	// any that arrived here via sensitive input variables.
+	configVal, _ = configVal.UnmarkDeep()
+
 	absAddr := n.Addr.Absolute(ctx.Path())
 	var priorVal cty.Value
 	var priorValTainted cty.Value
@@ -161,6 +168,64 @@ func (n *EvalDiff) Eval(ctx EvalContext) (interface{}, error) {
 		priorVal = cty.NullVal(schema.ImpliedType())
 	}
 
+	// If the configuration has an "import" block for this instance and we
+	// aren't tracking an object for it yet then we adopt the existing
+	// remote object instead of planning to create a new one.
+	if !n.Stub && n.PreviousDiff == nil && state == nil {
+		if imp := ctx.ImportTarget(absAddr); imp != nil {
+			return n.evalImport(ctx, imp, schema, absAddr, configVal)
+		}
+	}
+
+	// In the apply phase an import change passes through as-is: the object
+	// was already read from the provider during planning, and re-planning
+	// against a null prior state here would just propose creating a
+	// duplicate of it.
+	if n.PreviousDiff != nil && *n.PreviousDiff != nil && (*n.PreviousDiff).Importing {
+		prev := *n.PreviousDiff
+		if !n.Stub {
+			err := ctx.Hook(func(h Hook) (HookAction, error) {
+				return h.PreDiff(absAddr, states.CurrentGen, prev.Before, prev.After)
+			})
+			if err != nil {
+				return nil, err
+			}
+			err = ctx.Hook(func(h Hook) (HookAction, error) {
+				return h.PostDiff(absAddr, states.CurrentGen, prev.Action, prev.Before, prev.After)
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+		if n.OutputChange != nil {
+			*n.OutputChange = prev
+		}
+		if n.OutputValue != nil {
+			*n.OutputValue = configVal
+		}
+		if n.OutputState != nil {
+			*n.OutputState = &states.ResourceInstanceObject{
+				Status:  states.ObjectPlanned,
+				Value:   prev.After,
+				Private: prev.Private,
+			}
+		}
+		return nil, nil
+	}
+
+	// If a previous plan was provided for reuse then we digest the inputs
+	// that determine this instance's result so we can both compare against
+	// the previous plan below and record the digest in our own change for
+	// the next plan to compare against. We decline to compute a digest (and
+	// thus to reuse anything) when ignore_changes is configured, since the
+	// ignore rules themselves influence the result but are not captured in
+	// the digest, and when the prior object is tainted, since that forces
+	// a replacement regardless of the inputs.
+	var inputsHash []byte
+	if !n.Stub && n.PreviousDiff == nil && priorValTainted == cty.NilVal && !n.hasIgnoreChanges() {
+		inputsHash = resourceInstanceInputsHash(schema, absAddr, n.ProviderAddr, configVal, priorVal)
+	}
+
 	proposedNewVal := objchange.ProposedNewObject(schema, priorVal, configVal)
 
 	// Call pre-diff hook
@@ -173,6 +238,50 @@ func (n *EvalDiff) Eval(ctx EvalContext) (interface{}, error) {
 		}
 	}
 
+	// A previous plan whose digest matches ours was produced from exactly
+	// the same configuration, prior state, and provider, so its result is
+	// still valid and we can skip asking the provider to plan again. We
+	// reuse only NoOp and Update results; anything rarer isn't worth the
+	// additional codepaths to reproduce faithfully.
+	if inputsHash != nil {
+		if prev := ctx.PreviousPlanChange(absAddr); prev != nil && prev.DeposedKey == states.NotDeposed && bytes.Equal(prev.InputsHash, inputsHash) {
+			switch prev.Action {
+			case plans.NoOp, plans.Update:
+				prevChange, err := prev.Decode(schema.ImpliedType())
+				if err != nil {
+					// A decoding failure suggests the previous plan was
+					// created against a different schema version, so we'll
+					// just plan afresh.
+					log.Printf("[WARN] EvalDiff: failed to decode previous change for %s, so re-planning: %s", absAddr, err)
+					break
+				}
+				log.Printf("[TRACE] EvalDiff: reusing previous plan result for %s because its planning inputs are unchanged", absAddr)
+
+				err = ctx.Hook(func(h Hook) (HookAction, error) {
+					return h.PostDiff(absAddr, states.CurrentGen, prevChange.Action, prevChange.Before, prevChange.After)
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				if n.OutputChange != nil {
+					*n.OutputChange = prevChange
+				}
+				if n.OutputValue != nil {
+					*n.OutputValue = configVal
+				}
+				if n.OutputState != nil {
+					*n.OutputState = &states.ResourceInstanceObject{
+						Status:  states.ObjectPlanned,
+						Value:   prevChange.After,
+						Private: prevChange.Private,
+					}
+				}
+				return nil, nil
+			}
+		}
+	}
+
 	log.Printf("[TRACE] Re-validating config for %q", n.Addr.Absolute(ctx.Path()))
 	// Allow the provider to validate the final set of values.
 	// The config was statically validated early on, but there may have been
@@ -412,12 +521,14 @@ func (n *EvalDiff) Eval(ctx EvalContext) (interface{}, error) {
 	// If our prior value was tainted then we actually want this to appear
 	// as a replace change, even though so far we've been treating it as a
 	// create.
+	var actionReason plans.ResourceInstanceChangeActionReason
 	if action == plans.Create && priorValTainted != cty.NilVal {
 		if n.CreateBeforeDestroy {
 			action = plans.CreateThenDelete
 		} else {
 			action = plans.DeleteThenCreate
 		}
+		actionReason = plans.ResourceInstanceReplaceBecauseTainted
 		priorVal = priorValTainted
 	}
 
@@ -458,9 +569,19 @@ func (n *EvalDiff) Eval(ctx EvalContext) (interface{}, error) {
 				After:  plannedNewVal,
 			},
 			RequiredReplace: reqRep,
+			InputsHash:      inputsHash,
+			ActionReason:    actionReason,
 		}
 	}
 
+	// If this change will run provisioners during apply, do a best-effort
+	// early evaluation of their configurations so we can record in the plan
+	// which ones refer to values that aren't known yet. Those will be
+	// evaluated again during apply, once the values are known.
+	if !n.Stub && config.Managed != nil && (action == plans.Create || action.IsReplace()) {
+		n.checkDeferredProvisioners(ctx, absAddr, config)
+	}
+
 	if n.OutputValue != nil {
 		*n.OutputValue = configVal
 	}
@@ -483,6 +604,155 @@ func (n *EvalDiff) Eval(ctx EvalContext) (interface{}, error) {
 	return nil, nil
 }
 
+// evalImport handles planning for a resource instance that the
+// configuration asks to import via an "import" block: instead of proposing
+// to create a new object, we ask the provider to import and then read the
+// existing one, and record a Create change that carries the resulting
+// object so that apply can adopt it into the state.
+func (n *EvalDiff) evalImport(ctx EvalContext, imp *configs.Import, schema *configschema.Block, absAddr addrs.AbsResourceInstance, configVal cty.Value) (interface{}, error) {
+	provider := *n.Provider
+	var diags tfdiags.Diagnostics
+
+	idVal, idDiags := ctx.EvaluateExpr(imp.ID, cty.String, nil)
+	diags = diags.Append(idDiags)
+	if diags.HasErrors() {
+		return nil, diags.Err()
+	}
+	if idVal.IsNull() || !idVal.IsKnown() {
+		diags = diags.Append(&hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Invalid import id",
+			Detail:   "The import id must be a non-null string whose value is known at plan time.",
+			Subject:  imp.ID.Range().Ptr(),
+		})
+		return nil, diags.Err()
+	}
+	importID := idVal.AsString()
+
+	log.Printf("[TRACE] EvalDiff: importing %q to %s as requested by configuration", importID, absAddr)
+
+	err := ctx.Hook(func(h Hook) (HookAction, error) {
+		return h.PreImportState(absAddr, importID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := provider.ImportResourceState(providers.ImportResourceStateRequest{
+		TypeName: n.Addr.Resource.Type,
+		ID:       importID,
+	})
+	diags = diags.Append(resp.Diagnostics)
+	if diags.HasErrors() {
+		return nil, diags.Err()
+	}
+
+	var imported *providers.ImportedResource
+	for i, obj := range resp.ImportedResources {
+		if obj.TypeName == n.Addr.Resource.Type {
+			imported = &resp.ImportedResources[i]
+			break
+		}
+	}
+	if imported == nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Import produced no matching object",
+			fmt.Sprintf(
+				"While importing %q to %s, provider %q returned no object of type %q.\n\nThis is a bug in the provider, which should be reported in the provider's own issue tracker.",
+				importID, absAddr, n.ProviderAddr.Provider.LegacyString(), n.Addr.Resource.Type,
+			),
+		))
+		return nil, diags.Err()
+	}
+
+	// Refresh the imported object so that the value we record reflects the
+	// real remote object, rather than whatever partial skeleton the
+	// provider's import produced.
+	readResp := provider.ReadResource(providers.ReadResourceRequest{
+		TypeName:   n.Addr.Resource.Type,
+		PriorState: imported.State,
+		Private:    imported.Private,
+	})
+	diags = diags.Append(readResp.Diagnostics)
+	if diags.HasErrors() {
+		return nil, diags.Err()
+	}
+
+	newVal := readResp.NewState
+	if newVal == cty.NilVal || newVal.IsNull() {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Cannot import non-existent remote object",
+			fmt.Sprintf(
+				"While attempting to import an existing object to %s, the provider detected that no object exists with the id %q. Only pre-existing objects can be imported; check that the id is correct and that it is associated with the provider's configured region or endpoint.",
+				absAddr, importID,
+			),
+		))
+		return nil, diags.Err()
+	}
+
+	err = ctx.Hook(func(h Hook) (HookAction, error) {
+		return h.PostImportState(absAddr, []providers.ImportedResource{*imported})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	priorVal := cty.NullVal(schema.ImpliedType())
+
+	// Fire the diff hooks too, for parity with the normal planning path.
+	err = ctx.Hook(func(h Hook) (HookAction, error) {
+		return h.PreDiff(absAddr, states.CurrentGen, priorVal, newVal)
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = ctx.Hook(func(h Hook) (HookAction, error) {
+		return h.PostDiff(absAddr, states.CurrentGen, plans.Create, priorVal, newVal)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if n.OutputChange != nil {
+		*n.OutputChange = &plans.ResourceInstanceChange{
+			Addr:         absAddr,
+			Private:      readResp.Private,
+			ProviderAddr: n.ProviderAddr,
+			Change: plans.Change{
+				Action: plans.Create,
+				Before: priorVal,
+				After:  newVal,
+			},
+			Importing: true,
+			ImportID:  importID,
+		}
+	}
+	if n.OutputValue != nil {
+		*n.OutputValue = configVal
+	}
+	if n.OutputState != nil {
+		*n.OutputState = &states.ResourceInstanceObject{
+			Status:  states.ObjectPlanned,
+			Value:   newVal,
+			Private: readResp.Private,
+		}
+	}
+
+	return nil, nil
+}
+
+// hasIgnoreChanges returns true if the resource's configuration has any
+// ignore_changes rules, in which case the result of planning depends on
+// more than just the inputs captured by resourceInstanceInputsHash.
+func (n *EvalDiff) hasIgnoreChanges() bool {
+	if n.Config == nil || n.Config.Managed == nil {
+		return false
+	}
+	return len(n.Config.Managed.IgnoreChanges) > 0 || n.Config.Managed.IgnoreAllChanges
+}
+
 func (n *EvalDiff) processIgnoreChanges(prior, proposed cty.Value) (cty.Value, tfdiags.Diagnostics) {
 	// ignore_changes only applies when an object already exists, since we
 	// can't ignore changes to a thing we've not created yet.
@@ -589,6 +859,43 @@ func (f flatAttrDiff) keepDiff(ignoreChanges map[string]bool) bool {
 	return false
 }
 
+// checkDeferredProvisioners evaluates the configuration of each of the
+// given resource's create-time provisioners and records any that refer to
+// values not yet known in the plan's deferred provisioner list.
+//
+// This is advisory only: evaluation errors are ignored here, since the
+// authoritative evaluation (with full error reporting) happens during
+// apply in EvalApplyProvisioners.
+func (n *EvalDiff) checkDeferredProvisioners(ctx EvalContext, absAddr addrs.AbsResourceInstance, config configs.Resource) {
+	if len(config.Managed.Provisioners) == 0 {
+		return
+	}
+
+	forEach, _ := evaluateResourceForEachExpression(config.ForEach, ctx)
+	keyData := EvalDataForInstanceKey(n.Addr.Key, forEach)
+
+	for _, prov := range config.Managed.Provisioners {
+		if prov.When != configs.ProvisionerWhenCreate {
+			continue
+		}
+		schema := ctx.ProvisionerSchema(prov.Type)
+		if schema == nil {
+			continue
+		}
+		provVal, _, provDiags := ctx.EvaluateBlock(prov.Config, schema, n.Addr, keyData)
+		if provDiags.HasErrors() || provVal == cty.NilVal {
+			continue
+		}
+		if !provVal.IsWhollyKnown() {
+			log.Printf("[TRACE] EvalDiff: %s provisioner %q configuration refers to values not known until apply; deferring", absAddr, prov.Type)
+			ctx.Changes().AppendDeferredProvisioner(&plans.DeferredProvisioner{
+				InstanceAddr: absAddr,
+				Type:         prov.Type,
+			})
+		}
+	}
+}
+
 // EvalDiffDestroy is an EvalNode implementation that returns a plain
 // destroy diff.
 type EvalDiffDestroy struct {
@@ -597,6 +904,13 @@ type EvalDiffDestroy struct {
 	State        **states.ResourceInstanceObject
 	ProviderAddr addrs.AbsProviderConfig
 
+	// Provider and ProviderSchema are optional. If set and the provider's
+	// negotiated capabilities include planned destroy, the provider is
+	// consulted via PlanResourceChange instead of core constructing the
+	// destroy change on its own.
+	Provider       *providers.Interface
+	ProviderSchema **ProviderSchema
+
 	Output      **plans.ResourceInstanceChange
 	OutputState **states.ResourceInstanceObject
 }
@@ -632,18 +946,51 @@ func (n *EvalDiffDestroy) Eval(ctx EvalContext) (interface{}, error) {
 		return nil, err
 	}
 
-	// Change is always the same for a destroy. We don't need the provider's
-	// help for this one.
-	// TODO: Should we give the provider an opportunity to veto this?
+	// If a "removed" block covers this instance and doesn't ask for the
+	// remote object to be destroyed then we plan to merely forget it,
+	// removing it from state while leaving the remote object in place.
+	action := plans.Delete
+	if rm := ctx.RemovedTarget(absAddr); rm != nil && !rm.Destroy {
+		action = plans.Forget
+	}
+
+	// By default the change for a destroy is always the same, and we don't
+	// need the provider's help to construct it. Providers whose negotiated
+	// capabilities include planned destroy get consulted instead, so they
+	// have an opportunity to veto or annotate the destroy. When forgetting
+	// we skip that consultation, since the remote object isn't affected.
+	after := cty.NullVal(cty.DynamicPseudoType)
+	private := state.Private
+	if action != plans.Forget && n.Provider != nil && n.ProviderSchema != nil && *n.ProviderSchema != nil && (*n.ProviderSchema).Capabilities.PlannedDestroy {
+		schema, _ := (*n.ProviderSchema).SchemaForResourceAddr(n.Addr.ContainingResource())
+		if schema != nil {
+			nullVal := cty.NullVal(schema.ImpliedType())
+			resp := (*n.Provider).PlanResourceChange(providers.PlanResourceChangeRequest{
+				TypeName:         n.Addr.Resource.Type,
+				Config:           nullVal,
+				PriorState:       state.Value,
+				ProposedNewState: nullVal,
+				PriorPrivate:     state.Private,
+			})
+			if resp.Diagnostics.HasErrors() {
+				return nil, resp.Diagnostics.Err()
+			}
+			if resp.PlannedState != cty.NilVal {
+				after = resp.PlannedState
+			}
+			private = resp.PlannedPrivate
+		}
+	}
+
 	change := &plans.ResourceInstanceChange{
 		Addr:       absAddr,
 		DeposedKey: n.DeposedKey,
 		Change: plans.Change{
-			Action: plans.Delete,
+			Action: action,
 			Before: state.Value,
-			After:  cty.NullVal(cty.DynamicPseudoType),
+			After:  after,
 		},
-		Private:      state.Private,
+		Private:      private,
 		ProviderAddr: n.ProviderAddr,
 	}
 
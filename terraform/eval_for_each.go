@@ -53,6 +53,17 @@ func evaluateResourceForEachExpressionKnown(expr hcl.Expression, ctx EvalContext
 		return nil, true, diags
 	case !forEachVal.IsKnown():
 		return map[string]cty.Value{}, false, diags
+	case forEachVal.IsMarked():
+		// The for_each keys become part of resource instance addresses,
+		// which appear in the UI and in the state, so we can't honor the
+		// sensitivity of the value here.
+		diags = diags.Append(&hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Invalid for_each argument",
+			Detail:   `Sensitive values, or values derived from sensitive values, cannot be used as for_each arguments. If used, the sensitive value could be exposed as a resource instance key.`,
+			Subject:  expr.Range().Ptr(),
+		})
+		return nil, true, diags
 	}
 
 	if !forEachVal.CanIterateElements() || forEachVal.Type().IsListType() || forEachVal.Type().IsTupleType() {
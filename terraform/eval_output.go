@@ -48,6 +48,16 @@ func (n *EvalWriteOutput) Eval(ctx EvalContext) (interface{}, error) {
 	val, diags := ctx.EvaluateExpr(n.Expr, cty.DynamicPseudoType, nil)
 	// We'll handle errors below, after we have loaded the module.
 
+	// If the value contains any sensitive marks then the output as a whole
+	// becomes sensitive, regardless of whether it was declared that way.
+	// We must also strip the marks before storing the value, because
+	// neither the state nor the plan can represent them directly.
+	sensitive := n.Sensitive
+	if val.ContainsMarked() {
+		sensitive = true
+		val, _ = val.UnmarkDeep()
+	}
+
 	state := ctx.State()
 	if state == nil {
 		return nil, nil
@@ -62,25 +72,25 @@ func (n *EvalWriteOutput) Eval(ctx EvalContext) (interface{}, error) {
 			// if we're continuing, make sure the output is included, and
 			// marked as unknown. If the evaluator was able to find a type
 			// for the value in spite of the error then we'll use it.
-			n.setValue(addr, state, changes, cty.UnknownVal(val.Type()))
+			n.setValue(addr, state, changes, cty.UnknownVal(val.Type()), sensitive)
 			return nil, EvalEarlyExitError{}
 		}
 		return nil, diags.Err()
 	}
 
-	n.setValue(addr, state, changes, val)
+	n.setValue(addr, state, changes, val, sensitive)
 
 	return nil, nil
 }
 
-func (n *EvalWriteOutput) setValue(addr addrs.AbsOutputValue, state *states.SyncState, changes *plans.ChangesSync, val cty.Value) {
+func (n *EvalWriteOutput) setValue(addr addrs.AbsOutputValue, state *states.SyncState, changes *plans.ChangesSync, val cty.Value, sensitive bool) {
 	if val.IsKnown() && !val.IsNull() {
 		// The state itself doesn't represent unknown values, so we null them
 		// out here and then we'll save the real unknown value in the planned
 		// changeset below, if we have one on this graph walk.
 		log.Printf("[TRACE] EvalWriteOutput: Saving value for %s in state", addr)
 		stateVal := cty.UnknownAsNull(val)
-		state.SetOutputValue(addr, stateVal, n.Sensitive)
+		state.SetOutputValue(addr, stateVal, sensitive)
 	} else {
 		log.Printf("[TRACE] EvalWriteOutput: Removing %s from state (it is now null)", addr)
 		state.RemoveOutputValue(addr)
@@ -100,7 +110,7 @@ func (n *EvalWriteOutput) setValue(addr addrs.AbsOutputValue, state *states.Sync
 		if !val.IsNull() {
 			change = &plans.OutputChange{
 				Addr:      addr,
-				Sensitive: n.Sensitive,
+				Sensitive: sensitive,
 				Change: plans.Change{
 					Action: plans.Create,
 					Before: cty.NullVal(cty.DynamicPseudoType),
@@ -110,7 +120,7 @@ func (n *EvalWriteOutput) setValue(addr addrs.AbsOutputValue, state *states.Sync
 		} else {
 			change = &plans.OutputChange{
 				Addr:      addr,
-				Sensitive: n.Sensitive,
+				Sensitive: sensitive,
 				Change: plans.Change{
 					// This is just a weird placeholder delete action since
 					// we don't have an actual prior value to indicate.
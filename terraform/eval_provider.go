@@ -78,6 +78,10 @@ func (n *EvalConfigProvider) Eval(ctx EvalContext) (interface{}, error) {
 		return nil, diags.NonFatalErr()
 	}
 
+	// Strip any marks before sending the value to the provider, since the
+	// wire protocol can't represent them.
+	configVal, _ = configVal.UnmarkDeep()
+
 	configDiags := ctx.ConfigureProvider(n.Addr, configVal)
 	configDiags = configDiags.InConfigBody(configBody)
 
@@ -104,6 +104,10 @@ func (n *EvalReadData) Eval(ctx EvalContext) (interface{}, error) {
 		return nil, diags.Err()
 	}
 
+	// Strip any marks before sending the value to the provider, since the
+	// wire protocol can't represent them.
+	configVal, _ = configVal.UnmarkDeep()
+
 	proposedNewVal := objchange.PlannedDataResourceObject(schema, configVal)
 
 	// If our configuration contains any unknown values then we must defer the
@@ -358,6 +362,12 @@ func (n *EvalReadDataApply) Eval(ctx EvalContext) (interface{}, error) {
 	}
 
 	newVal := resp.State
+	if newVal == cty.NilVal {
+		// This can happen with incompletely-configured mocks. We'll allow it
+		// and treat it as an alias for a properly-typed null value.
+		newVal = cty.NullVal(schema.ImpliedType())
+	}
+
 	for _, err := range newVal.Type().TestConformance(schema.ImpliedType()) {
 		diags = diags.Append(tfdiags.Sourceless(
 			tfdiags.Error,
@@ -53,9 +53,10 @@ func (n *EvalRefresh) Eval(ctx EvalContext) (interface{}, error) {
 	// Refresh!
 	priorVal := state.Value
 	req := providers.ReadResourceRequest{
-		TypeName:   n.Addr.Resource.Type,
-		PriorState: priorVal,
-		Private:    state.Private,
+		TypeName:          n.Addr.Resource.Type,
+		PriorState:        priorVal,
+		Private:           state.Private,
+		PriorVersionToken: state.VersionToken,
 	}
 
 	provider := *n.Provider
@@ -65,6 +66,30 @@ func (n *EvalRefresh) Eval(ctx EvalContext) (interface{}, error) {
 		return nil, diags.Err()
 	}
 
+	if resp.Unchanged && req.PriorVersionToken != "" {
+		// The provider compared our version token against the remote object
+		// and determined that it hasn't changed, so we can skip the usual
+		// post-read processing and keep the prior value as-is.
+		log.Printf("[DEBUG] refresh: %s: provider reported object unchanged for version token, so skipping full read", absAddr)
+
+		newState := state.DeepCopy()
+		if resp.VersionToken != "" {
+			newState.VersionToken = resp.VersionToken
+		}
+
+		err = ctx.Hook(func(h Hook) (HookAction, error) {
+			return h.PostRefresh(absAddr, states.CurrentGen, priorVal, newState.Value)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if n.Output != nil {
+			*n.Output = newState
+		}
+		return nil, diags.ErrWithWarnings()
+	}
+
 	if resp.NewState == cty.NilVal {
 		// This ought not to happen in real cases since it's not possible to
 		// send NilVal over the plugin RPC channel, but it can come up in
@@ -89,6 +114,7 @@ func (n *EvalRefresh) Eval(ctx EvalContext) (interface{}, error) {
 	newState := state.DeepCopy()
 	newState.Value = resp.NewState
 	newState.Private = resp.Private
+	newState.VersionToken = resp.VersionToken
 	newState.Dependencies = state.Dependencies
 
 	// Call post-refresh hook
@@ -3,6 +3,8 @@ package terraform
 import (
 	"fmt"
 	"log"
+	"strconv"
+	"time"
 
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/terraform/addrs"
@@ -177,8 +179,43 @@ func (n *EvalValidateProvisioner) validateConnConfig(ctx EvalContext, config *co
 	// We evaluate here just by evaluating the block and returning any
 	// diagnostics we get, since evaluation alone is enough to check for
 	// extraneous arguments and incorrectly-typed arguments.
-	_, _, configDiags := n.evaluateBlock(ctx, config.Config, connectionBlockSupersetSchema)
+	connVal, _, configDiags := n.evaluateBlock(ctx, config.Config, connectionBlockSupersetSchema)
 	diags = diags.Append(configDiags)
+	if configDiags.HasErrors() {
+		return diags
+	}
+
+	// Some connection arguments are loosely-typed in the superset schema
+	// because their values must survive being flattened into the string map
+	// we send to provisioner plugins, so we additionally check any known
+	// values here rather than letting a malformed one fail partway through
+	// an apply.
+	if connVal != cty.NilVal && !connVal.IsNull() && connVal.IsKnown() {
+		for _, name := range []string{"timeout", "bastion_timeout"} {
+			v, _ := connVal.GetAttr(name).Unmark()
+			if v.IsNull() || !v.IsKnown() {
+				continue
+			}
+			if _, err := time.ParseDuration(v.AsString()); err != nil {
+				diags = diags.Append(&hcl.Diagnostic{
+					Severity: hcl.DiagError,
+					Summary:  "Invalid connection timeout",
+					Detail:   fmt.Sprintf("The %q argument must be a duration string such as \"5m\", not %q.", name, v.AsString()),
+					Subject:  config.DeclRange.Ptr(),
+				})
+			}
+		}
+		if v, _ := connVal.GetAttr("port").Unmark(); !v.IsNull() && v.IsKnown() {
+			if _, err := strconv.Atoi(v.AsString()); err != nil {
+				diags = diags.Append(&hcl.Diagnostic{
+					Severity: hcl.DiagError,
+					Summary:  "Invalid connection port",
+					Detail:   fmt.Sprintf("The \"port\" argument must be a whole number, not %q.", v.AsString()),
+					Subject:  config.DeclRange.Ptr(),
+				})
+			}
+		}
+	}
 
 	return diags
 }
@@ -281,6 +318,9 @@ var connectionBlockSupersetSchema = &configschema.Block{
 			Type:     cty.String,
 			Optional: true,
 		},
+		// bastion_host may be a single host or a comma-separated chain of
+		// hosts to connect through in order, for sites that require more
+		// than one jump host to reach the target.
 		"bastion_host": {
 			Type:     cty.String,
 			Optional: true,
@@ -309,6 +349,10 @@ var connectionBlockSupersetSchema = &configschema.Block{
 			Type:     cty.String,
 			Optional: true,
 		},
+		"bastion_timeout": {
+			Type:     cty.String,
+			Optional: true,
+		},
 
 		// For type=winrm only (enforced in winrm communicator)
 		"https": {
@@ -327,6 +371,10 @@ var connectionBlockSupersetSchema = &configschema.Block{
 			Type:     cty.Bool,
 			Optional: true,
 		},
+		"proxy_url": {
+			Type:     cty.String,
+			Optional: true,
+		},
 	},
 }
 
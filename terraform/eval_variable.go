@@ -183,6 +183,10 @@ func (n *evalVariableValidations) Eval(ctx EvalContext) (interface{}, error) {
 			continue
 		}
 
+		// A sensitive value doesn't change whether the condition passed,
+		// so we can safely discard any marks here.
+		result, _ = result.Unmark()
+
 		if result.False() {
 			if n.Expr != nil {
 				diags = diags.Append(&hcl.Diagnostic{
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform/configs/configschema"
 	"github.com/hashicorp/terraform/instances"
 	"github.com/hashicorp/terraform/lang"
+	"github.com/hashicorp/terraform/lang/marks"
 	"github.com/hashicorp/terraform/plans"
 	"github.com/hashicorp/terraform/states"
 	"github.com/hashicorp/terraform/tfdiags"
@@ -53,6 +54,11 @@ type Evaluator struct {
 	// This must not be mutated during evaluation.
 	Schemas *Schemas
 
+	// FederatedStates is an optional set of additional states mounted
+	// read-only into the expression scope under "state.<name>", keyed by
+	// mount name. This must not be mutated during evaluation.
+	FederatedStates map[string]*states.State
+
 	// State is the current state, embedded in a wrapper that ensures that
 	// it can be safely accessed and modified concurrently.
 	State *states.SyncState
@@ -131,6 +137,25 @@ func EvalDataForInstanceKey(key addrs.InstanceKey, forEachMap map[string]cty.Val
 // is relevant.
 var EvalDataForNoInstanceKey = InstanceKeyEvalData{}
 
+// instanceContextForEval returns a tfdiags.InstanceContext describing the
+// given module path and instance key data, for attaching to error
+// diagnostics produced while evaluating a "count" or "for_each" object.
+// The second return value is false if the key data carries no instance
+// key, in which case diagnostics need no extra context.
+func instanceContextForEval(path addrs.ModuleInstance, keyData InstanceKeyEvalData) (tfdiags.InstanceContext, bool) {
+	ret := tfdiags.InstanceContext{ModuleAddr: path.String()}
+	switch {
+	case keyData.CountIndex != cty.NilVal && keyData.CountIndex.IsKnown():
+		idx, _ := keyData.CountIndex.AsBigFloat().Int64()
+		ret.InstanceKey = strconv.FormatInt(idx, 10)
+	case keyData.EachKey != cty.NilVal && keyData.EachKey.IsKnown():
+		ret.InstanceKey = keyData.EachKey.AsString()
+	default:
+		return ret, false
+	}
+	return ret, true
+}
+
 // evaluationStateData must implement lang.Data
 var _ lang.Data = (*evaluationStateData)(nil)
 
@@ -271,9 +296,10 @@ func (d *evaluationStateData) GetInputVariable(addr addrs.InputVariable, rng tfd
 	val, isSet := vals[addr.Name]
 	if !isSet {
 		if config.Default != cty.NilVal {
-			return config.Default, diags
+			val = config.Default
+		} else {
+			val = cty.UnknownVal(wantType)
 		}
-		return cty.UnknownVal(wantType), diags
 	}
 
 	var err error
@@ -292,6 +318,12 @@ func (d *evaluationStateData) GetInputVariable(addr addrs.InputVariable, rng tfd
 		val = cty.UnknownVal(wantType)
 	}
 
+	// Mark if sensitive, so that any expressions derived from this variable
+	// will also carry the mark.
+	if config.Sensitive {
+		val = val.Mark(marks.Sensitive)
+	}
+
 	return val, diags
 }
 
@@ -444,7 +476,11 @@ func (d *evaluationStateData) GetModuleInstanceOutput(addr addrs.ModuleCallOutpu
 		}
 		// We care only about the "after" value, which is the value this output
 		// will take on after the plan is applied.
-		return change.After, diags
+		ret := change.After
+		if config.Sensitive || change.Sensitive {
+			ret = ret.Mark(marks.Sensitive)
+		}
+		return ret, diags
 	}
 
 	os := d.Evaluator.State.OutputValue(absAddr)
@@ -453,7 +489,12 @@ func (d *evaluationStateData) GetModuleInstanceOutput(addr addrs.ModuleCallOutpu
 		return cty.DynamicVal, diags
 	}
 
-	return os.Value, diags
+	ret := os.Value
+	if config.Sensitive || os.Sensitive {
+		ret = ret.Mark(marks.Sensitive)
+	}
+
+	return ret, diags
 }
 
 func (d *evaluationStateData) GetPathAttr(addr addrs.PathAttr, rng tfdiags.SourceRange) (cty.Value, tfdiags.Diagnostics) {
@@ -807,6 +848,39 @@ func (d *evaluationStateData) coerceInstanceKey(key addrs.InstanceKey, mode stat
 	}
 }
 
+func (d *evaluationStateData) GetFederatedState(addr addrs.StateReference, rng tfdiags.SourceRange) (cty.Value, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	st, exists := d.Evaluator.FederatedStates[addr.Name]
+	if !exists {
+		var suggestions []string
+		for name := range d.Evaluator.FederatedStates {
+			suggestions = append(suggestions, name)
+		}
+		suggestion := nameSuggestion(addr.Name, suggestions)
+		if suggestion != "" {
+			suggestion = fmt.Sprintf(" Did you mean %q?", suggestion)
+		}
+		diags = diags.Append(&hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  `Reference to undeclared federated state`,
+			Detail:   fmt.Sprintf(`There is no federated state mounted with the name %q.%s`, addr.Name, suggestion),
+			Subject:  rng.ToHCL().Ptr(),
+		})
+		return cty.DynamicVal, diags
+	}
+
+	outputs := map[string]cty.Value{}
+	if root := st.RootModule(); root != nil {
+		for name, os := range root.OutputValues {
+			outputs[name] = os.Value
+		}
+	}
+	return cty.ObjectVal(map[string]cty.Value{
+		"outputs": cty.ObjectVal(outputs),
+	}), diags
+}
+
 func (d *evaluationStateData) GetTerraformAttr(addr addrs.TerraformAttr, rng tfdiags.SourceRange) (cty.Value, tfdiags.Diagnostics) {
 	var diags tfdiags.Diagnostics
 	switch addr.Name {
@@ -815,6 +889,34 @@ func (d *evaluationStateData) GetTerraformAttr(addr addrs.TerraformAttr, rng tfd
 		workspaceName := d.Evaluator.Meta.Env
 		return cty.StringVal(workspaceName), diags
 
+	case "version":
+		version := ""
+		if meta := d.Evaluator.Meta; meta != nil {
+			version = meta.Version
+		}
+		return cty.StringVal(version), diags
+
+	case "phase":
+		// The phase is a coarse classification of the current walk so that
+		// modules can, for example, relax constraints during planning that
+		// must hold by the time changes are applied.
+		var phase string
+		switch d.Operation {
+		case walkPlan, walkPlanDestroy:
+			phase = "plan"
+		case walkApply, walkDestroy:
+			phase = "apply"
+		case walkValidate:
+			phase = "validate"
+		case walkRefresh:
+			phase = "refresh"
+		case walkImport:
+			phase = "import"
+		default:
+			phase = "unknown"
+		}
+		return cty.StringVal(phase), diags
+
 	case "env":
 		// Prior to Terraform 0.12 there was an attribute "env", which was
 		// an alias name for "workspace". This was deprecated and is now
@@ -831,7 +933,7 @@ func (d *evaluationStateData) GetTerraformAttr(addr addrs.TerraformAttr, rng tfd
 		diags = diags.Append(&hcl.Diagnostic{
 			Severity: hcl.DiagError,
 			Summary:  `Invalid "terraform" attribute`,
-			Detail:   fmt.Sprintf(`The "terraform" object does not have an attribute named %q. The only supported attribute is terraform.workspace, the name of the currently-selected workspace.`, addr.Name),
+			Detail:   fmt.Sprintf(`The "terraform" object does not have an attribute named %q. The supported attributes are terraform.workspace, the name of the currently-selected workspace, terraform.version, the version of Terraform running the operation, and terraform.phase, the kind of operation being run.`, addr.Name),
 			Subject:  rng.ToHCL().Ptr(),
 		})
 		return cty.DynamicVal, diags
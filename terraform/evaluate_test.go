@@ -14,11 +14,13 @@ import (
 func TestEvaluatorGetTerraformAttr(t *testing.T) {
 	evaluator := &Evaluator{
 		Meta: &ContextMeta{
-			Env: "foo",
+			Env:     "foo",
+			Version: "0.0.0-test",
 		},
 	}
 	data := &evaluationStateData{
 		Evaluator: evaluator,
+		Operation: walkPlan,
 	}
 	scope := evaluator.Scope(data, nil)
 
@@ -34,6 +36,32 @@ func TestEvaluatorGetTerraformAttr(t *testing.T) {
 			t.Errorf("wrong result %q; want %q", got, want)
 		}
 	})
+
+	t.Run("version", func(t *testing.T) {
+		want := cty.StringVal("0.0.0-test")
+		got, diags := scope.Data.GetTerraformAttr(addrs.TerraformAttr{
+			Name: "version",
+		}, tfdiags.SourceRange{})
+		if len(diags) != 0 {
+			t.Errorf("unexpected diagnostics %s", spew.Sdump(diags))
+		}
+		if !got.RawEquals(want) {
+			t.Errorf("wrong result %q; want %q", got, want)
+		}
+	})
+
+	t.Run("phase", func(t *testing.T) {
+		want := cty.StringVal("plan")
+		got, diags := scope.Data.GetTerraformAttr(addrs.TerraformAttr{
+			Name: "phase",
+		}, tfdiags.SourceRange{})
+		if len(diags) != 0 {
+			t.Errorf("unexpected diagnostics %s", spew.Sdump(diags))
+		}
+		if !got.RawEquals(want) {
+			t.Errorf("wrong result %q; want %q", got, want)
+		}
+	})
 }
 
 func TestEvaluatorGetPathAttr(t *testing.T) {
@@ -68,10 +68,72 @@ func (b *BasicGraphBuilder) Build(path addrs.ModuleInstance) (*Graph, tfdiags.Di
 	if b.Validate {
 		if err := g.Validate(); err != nil {
 			log.Printf("[ERROR] Graph validation failed. Graph:\n\n%s", g.String())
-			diags = diags.Append(err)
+			cycleDiags, explainedAll := providerCycleDiagnostics(g)
+			diags = diags.Append(cycleDiags)
+			if !explainedAll {
+				diags = diags.Append(err)
+			}
 			return nil, diags
 		}
 	}
 
 	return g, diags
 }
+
+// providerCycleDiagnostics inspects the cycles in the given graph and
+// returns specialized diagnostics for any cycle caused by a provider
+// configuration depending on a resource that is itself managed by that same
+// provider configuration, which is by far the most common way for users to
+// create a cycle. The second return value is true only if every problem in
+// the graph was explained this way, in which case the caller should prefer
+// these diagnostics over the generic cycle error.
+func providerCycleDiagnostics(g *Graph) (tfdiags.Diagnostics, bool) {
+	var diags tfdiags.Diagnostics
+
+	cycles := g.Cycles()
+	if len(cycles) == 0 {
+		// The validation failure was something other than a cycle, such as
+		// a self-reference, which we have nothing further to say about.
+		return nil, false
+	}
+
+	explainedAll := true
+	for _, cycle := range cycles {
+		explained := false
+		for _, v := range cycle {
+			provider, ok := v.(GraphNodeProvider)
+			if !ok {
+				continue
+			}
+			// Look for a resource in the same cycle that is configured by
+			// this provider, which we can recognize by its direct dependency
+			// edge on the provider node.
+			for _, rv := range cycle {
+				rn, ok := rv.(GraphNodeResource)
+				if !ok {
+					continue
+				}
+				if !g.DownEdges(rv).Include(v) {
+					continue
+				}
+
+				providerAddr := provider.ProviderAddr()
+				resourceAddr := rn.ResourceAddr()
+				diags = diags.Append(tfdiags.Sourceless(
+					tfdiags.Error,
+					"Provider configuration cycle",
+					fmt.Sprintf(
+						"The configuration for %s depends on %s, but that resource is itself managed by that provider configuration, so Terraform cannot determine a safe order of operations.\n\nTo break the cycle, split the provider configuration: manage %s with a separate (possibly aliased) configuration of the same provider whose arguments do not refer to it.",
+						providerAddr, resourceAddr, resourceAddr,
+					),
+				))
+				explained = true
+			}
+		}
+		if !explained {
+			explainedAll = false
+		}
+	}
+
+	return diags, explainedAll
+}
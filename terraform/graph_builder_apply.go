@@ -40,6 +40,9 @@ type ApplyGraphBuilder struct {
 	// outputs should go into the diff so that this is unnecessary.
 	Targets []addrs.Targetable
 
+	// Excludes are resources to exclude, along with their dependents
+	Excludes []addrs.Targetable
+
 	// DisableReduce, if true, will not reduce the graph. Great for testing.
 	DisableReduce bool
 
@@ -140,6 +143,9 @@ func (b *ApplyGraphBuilder) Steps() []GraphTransformer {
 		// Add the outputs
 		&OutputTransformer{Config: b.Config},
 
+		// Add any configuration-defined post-apply checks
+		&CheckTransformer{Config: b.Config, Destroy: b.Destroy},
+
 		// Add module variables
 		&ModuleVariableTransformer{Config: b.Config},
 
@@ -192,6 +198,10 @@ func (b *ApplyGraphBuilder) Steps() []GraphTransformer {
 		// Target
 		&TargetsTransformer{Targets: b.Targets},
 
+		// Exclude. The destroy edges created above already encode the
+		// correct ordering, so we don't set "Destroy: true" here.
+		&ExcludeTransformer{Excludes: b.Excludes},
+
 		// Close opened plugin connections
 		&CloseProviderTransformer{},
 		&CloseProvisionerTransformer{},
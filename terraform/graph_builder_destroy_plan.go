@@ -4,6 +4,7 @@ import (
 	"github.com/hashicorp/terraform/addrs"
 	"github.com/hashicorp/terraform/configs"
 	"github.com/hashicorp/terraform/dag"
+	"github.com/hashicorp/terraform/plans"
 	"github.com/hashicorp/terraform/states"
 	"github.com/hashicorp/terraform/tfdiags"
 )
@@ -31,6 +32,13 @@ type DestroyPlanGraphBuilder struct {
 	// Targets are resources to target
 	Targets []addrs.Targetable
 
+	// Excludes are resources to exclude, along with their dependents
+	Excludes []addrs.Targetable
+
+	// TargetingReport, if non-nil, is populated with the resources that
+	// targeting implicitly included or excluded from the graph.
+	TargetingReport *plans.TargetingReport
+
 	// Validate will do structural validation of the graph.
 	Validate bool
 }
@@ -90,7 +98,11 @@ func (b *DestroyPlanGraphBuilder) Steps() []GraphTransformer {
 
 		// Target. Note we don't set "Destroy: true" here since we already
 		// created proper destroy ordering.
-		&TargetsTransformer{Targets: b.Targets},
+		&TargetsTransformer{Targets: b.Targets, Report: b.TargetingReport},
+
+		// Exclude. As with Target above, destroy ordering is already
+		// correct, so we don't set "Destroy: true" here either.
+		&ExcludeTransformer{Excludes: b.Excludes},
 
 		// Single root
 		&RootTransformer{},
@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform/addrs"
 	"github.com/hashicorp/terraform/configs"
 	"github.com/hashicorp/terraform/dag"
+	"github.com/hashicorp/terraform/plans"
 	"github.com/hashicorp/terraform/states"
 	"github.com/hashicorp/terraform/tfdiags"
 )
@@ -39,6 +40,13 @@ type PlanGraphBuilder struct {
 	// Targets are resources to target
 	Targets []addrs.Targetable
 
+	// Excludes are resources to exclude, along with their dependents
+	Excludes []addrs.Targetable
+
+	// TargetingReport, if non-nil, is populated with the resources that
+	// targeting implicitly included or excluded from the graph.
+	TargetingReport *plans.TargetingReport
+
 	// DisableReduce, if true, will not reduce the graph. Great for testing.
 	DisableReduce bool
 
@@ -154,6 +162,7 @@ func (b *PlanGraphBuilder) Steps() []GraphTransformer {
 		// Target
 		&TargetsTransformer{
 			Targets: b.Targets,
+			Report:  b.TargetingReport,
 
 			// Resource nodes from config have not yet been expanded for
 			// "count", so we must apply targeting without indices. Exact
@@ -162,6 +171,12 @@ func (b *PlanGraphBuilder) Steps() []GraphTransformer {
 			IgnoreIndices: true,
 		},
 
+		// Exclude, using the same index handling as targeting above
+		&ExcludeTransformer{
+			Excludes:      b.Excludes,
+			IgnoreIndices: true,
+		},
+
 		// Detect when create_before_destroy must be forced on for a particular
 		// node due to dependency edges, to avoid graph cycles during apply.
 		&ForcedCBDTransformer{},
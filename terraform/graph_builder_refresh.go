@@ -41,6 +41,9 @@ type RefreshGraphBuilder struct {
 	// Targets are resources to target
 	Targets []addrs.Targetable
 
+	// Excludes are resources to exclude, along with their dependents
+	Excludes []addrs.Targetable
+
 	// DisableReduce, if true, will not reduce the graph. Great for testing.
 	DisableReduce bool
 
@@ -183,6 +186,12 @@ func (b *RefreshGraphBuilder) Steps() []GraphTransformer {
 			IgnoreIndices: true,
 		},
 
+		// Exclude, using the same index handling as targeting above
+		&ExcludeTransformer{
+			Excludes:      b.Excludes,
+			IgnoreIndices: true,
+		},
+
 		// Close opened plugin connections
 		&CloseProviderTransformer{},
 
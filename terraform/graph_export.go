@@ -0,0 +1,199 @@
+package terraform
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/dag"
+)
+
+// GraphExport is a serialization-friendly description of a built graph of
+// any type (plan, apply, destroy, validate), annotated with the
+// Terraform-specific metadata each node can provide. It supports both JSON
+// and DOT renderings, and can be reduced to the subgraph around a
+// particular vertex or to the vertices involved in cycles, which is often
+// the only tractable way to inspect graphs from large configurations.
+type GraphExport struct {
+	Vertices []*GraphExportVertex `json:"vertices"`
+	Edges    []GraphExportEdge    `json:"edges"`
+	Cycles   [][]string           `json:"cycles,omitempty"`
+}
+
+// GraphExportVertex describes a single vertex in an exported graph.
+type GraphExportVertex struct {
+	// Name is the vertex name as used in graph renderings, which for most
+	// nodes is the address of the object the node represents.
+	Name string `json:"name"`
+
+	// Type is the name of the node implementation, identifying what kind
+	// of operation the vertex represents.
+	Type string `json:"type"`
+
+	// Module is the address of the module instance the node belongs to,
+	// or empty for the root module.
+	Module string `json:"module,omitempty"`
+
+	// Provider is the address of the provider configuration the node uses
+	// or provides, if any.
+	Provider string `json:"provider,omitempty"`
+}
+
+// GraphExportEdge describes a single dependency edge in an exported graph,
+// referring to vertices by name.
+type GraphExportEdge struct {
+	Source string `json:"source"`
+	Target string `json:"target"`
+}
+
+// Export produces a GraphExport describing the receiving graph.
+func (g *Graph) Export() *GraphExport {
+	ret := &GraphExport{}
+
+	for _, v := range g.Vertices() {
+		ev := &GraphExportVertex{
+			Name: dag.VertexName(v),
+			Type: strings.TrimPrefix(fmt.Sprintf("%T", v), "*terraform."),
+		}
+		if pn, ok := v.(GraphNodeSubPath); ok {
+			ev.Module = pn.Path().String()
+		}
+		switch tv := v.(type) {
+		case GraphNodeProvider:
+			ev.Provider = tv.ProviderAddr().String()
+		case GraphNodeProviderConsumer:
+			addr, _ := tv.ProvidedBy()
+			ev.Provider = addr.String()
+		}
+		ret.Vertices = append(ret.Vertices, ev)
+	}
+	sort.Slice(ret.Vertices, func(i, j int) bool {
+		return ret.Vertices[i].Name < ret.Vertices[j].Name
+	})
+
+	for _, e := range g.Edges() {
+		ret.Edges = append(ret.Edges, GraphExportEdge{
+			Source: dag.VertexName(e.Source()),
+			Target: dag.VertexName(e.Target()),
+		})
+	}
+	sort.Slice(ret.Edges, func(i, j int) bool {
+		if ret.Edges[i].Source != ret.Edges[j].Source {
+			return ret.Edges[i].Source < ret.Edges[j].Source
+		}
+		return ret.Edges[i].Target < ret.Edges[j].Target
+	})
+
+	for _, cycle := range g.Cycles() {
+		var names []string
+		for _, v := range cycle {
+			names = append(names, dag.VertexName(v))
+		}
+		sort.Strings(names)
+		ret.Cycles = append(ret.Cycles, names)
+	}
+
+	return ret
+}
+
+// JSON returns the export in JSON format.
+func (e *GraphExport) JSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// Dot returns the export as a basic DOT digraph, using the vertex names as
+// node identifiers.
+func (e *GraphExport) Dot() []byte {
+	var buf bytes.Buffer
+	buf.WriteString("digraph {\n")
+	buf.WriteString("\tcompound = \"true\"\n")
+	for _, v := range e.Vertices {
+		fmt.Fprintf(&buf, "\t%q\n", v.Name)
+	}
+	for _, edge := range e.Edges {
+		fmt.Fprintf(&buf, "\t%q -> %q\n", edge.Source, edge.Target)
+	}
+	buf.WriteString("}\n")
+	return buf.Bytes()
+}
+
+// FilterAround returns a new export reduced to the named vertex along with
+// everything it transitively depends on and everything that transitively
+// depends on it.
+func (e *GraphExport) FilterAround(name string) *GraphExport {
+	// The dependencies of a vertex follow edges from source to target, and
+	// its dependents follow them the other way. The two closures must be
+	// computed independently so that we don't also pull in unrelated
+	// dependents of the target's own dependencies.
+	keep := e.closure(name, func(edge GraphExportEdge) (string, string) {
+		return edge.Source, edge.Target
+	})
+	for vertexName := range e.closure(name, func(edge GraphExportEdge) (string, string) {
+		return edge.Target, edge.Source
+	}) {
+		keep[vertexName] = true
+	}
+
+	return e.filter(keep)
+}
+
+// closure returns the set of vertex names reachable from the given one by
+// repeatedly following edges in the direction chosen by dir.
+func (e *GraphExport) closure(name string, dir func(GraphExportEdge) (from, to string)) map[string]bool {
+	keep := map[string]bool{name: true}
+	for {
+		grew := false
+		for _, edge := range e.Edges {
+			from, to := dir(edge)
+			if keep[from] && !keep[to] {
+				keep[to] = true
+				grew = true
+			}
+		}
+		if !grew {
+			break
+		}
+	}
+	return keep
+}
+
+// FilterCycles returns a new export reduced to only the vertices that
+// participate in a cycle, along with the edges between them.
+func (e *GraphExport) FilterCycles() *GraphExport {
+	keep := map[string]bool{}
+	for _, cycle := range e.Cycles {
+		for _, name := range cycle {
+			keep[name] = true
+		}
+	}
+	return e.filter(keep)
+}
+
+func (e *GraphExport) filter(keep map[string]bool) *GraphExport {
+	ret := &GraphExport{}
+	for _, v := range e.Vertices {
+		if keep[v.Name] {
+			ret.Vertices = append(ret.Vertices, v)
+		}
+	}
+	for _, edge := range e.Edges {
+		if keep[edge.Source] && keep[edge.Target] {
+			ret.Edges = append(ret.Edges, edge)
+		}
+	}
+	for _, cycle := range e.Cycles {
+		all := true
+		for _, name := range cycle {
+			if !keep[name] {
+				all = false
+				break
+			}
+		}
+		if all {
+			ret.Cycles = append(ret.Cycles, cycle)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,131 @@
+package terraform
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/hashicorp/terraform/providers"
+)
+
+func graphExportTestGraph(t *testing.T) *Graph {
+	t.Helper()
+
+	awsProvider := &MockProvider{
+		GetSchemaReturn: &ProviderSchema{
+			Provider: simpleTestSchema(),
+			ResourceTypes: map[string]*configschema.Block{
+				"aws_security_group": simpleTestSchema(),
+				"aws_instance":       simpleTestSchema(),
+				"aws_load_balancer":  simpleTestSchema(),
+			},
+		},
+	}
+	openstackProvider := &MockProvider{
+		GetSchemaReturn: &ProviderSchema{
+			Provider: simpleTestSchema(),
+			ResourceTypes: map[string]*configschema.Block{
+				"openstack_floating_ip": simpleTestSchema(),
+			},
+		},
+	}
+	components := &basicComponentFactory{
+		providers: map[addrs.Provider]providers.Factory{
+			addrs.NewLegacyProvider("aws"):       providers.FactoryFixed(awsProvider),
+			addrs.NewLegacyProvider("openstack"): providers.FactoryFixed(openstackProvider),
+		},
+	}
+
+	b := &PlanGraphBuilder{
+		Config:     testModule(t, "graph-builder-plan-basic"),
+		Components: components,
+		Schemas: &Schemas{
+			Providers: map[addrs.Provider]*ProviderSchema{
+				addrs.NewLegacyProvider("aws"):       awsProvider.GetSchemaReturn,
+				addrs.NewLegacyProvider("openstack"): openstackProvider.GetSchemaReturn,
+			},
+		},
+		DisableReduce: true,
+	}
+
+	g, err := b.Build(addrs.RootModuleInstance)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	return g
+}
+
+func TestGraphExport(t *testing.T) {
+	g := graphExportTestGraph(t)
+	export := g.Export()
+
+	byName := map[string]*GraphExportVertex{}
+	for _, v := range export.Vertices {
+		byName[v.Name] = v
+	}
+
+	web := byName["aws_instance.web"]
+	if web == nil {
+		t.Fatalf("missing vertex for aws_instance.web; have %d vertices", len(export.Vertices))
+	}
+	if web.Type == "" {
+		t.Errorf("aws_instance.web vertex has no type")
+	}
+	if !strings.Contains(web.Provider, "aws") {
+		t.Errorf("wrong provider %q for aws_instance.web", web.Provider)
+	}
+
+	found := false
+	for _, e := range export.Edges {
+		if e.Source == "aws_load_balancer.weblb" && e.Target == "aws_instance.web" {
+			found = true
+			break
+		}
+	}
+	if !found {
+		t.Errorf("missing edge from aws_load_balancer.weblb to aws_instance.web")
+	}
+
+	// The export must round-trip through its JSON rendering.
+	j, err := export.JSON()
+	if err != nil {
+		t.Fatalf("JSON export failed: %s", err)
+	}
+	var decoded GraphExport
+	if err := json.Unmarshal(j, &decoded); err != nil {
+		t.Fatalf("invalid JSON produced: %s", err)
+	}
+	if len(decoded.Vertices) != len(export.Vertices) {
+		t.Errorf("JSON round-trip lost vertices: %d != %d", len(decoded.Vertices), len(export.Vertices))
+	}
+
+	dot := string(export.Dot())
+	if !strings.Contains(dot, `"aws_instance.web"`) {
+		t.Errorf("DOT export does not mention aws_instance.web:\n%s", dot)
+	}
+}
+
+func TestGraphExportFilterAround(t *testing.T) {
+	g := graphExportTestGraph(t)
+	export := g.Export().FilterAround("aws_instance.web")
+
+	byName := map[string]*GraphExportVertex{}
+	for _, v := range export.Vertices {
+		byName[v.Name] = v
+	}
+
+	if byName["aws_instance.web"] == nil {
+		t.Errorf("filtered export is missing the target vertex")
+	}
+	if byName["aws_load_balancer.weblb"] == nil {
+		t.Errorf("filtered export is missing dependent aws_load_balancer.weblb")
+	}
+	if byName["aws_security_group.firewall"] == nil {
+		t.Errorf("filtered export is missing dependency aws_security_group.firewall")
+	}
+	if byName["output.instance_id"] == nil {
+		t.Errorf("filtered export is missing transitive dependent output.instance_id")
+	}
+}
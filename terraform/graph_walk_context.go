@@ -4,6 +4,7 @@ import (
 	"context"
 	"log"
 	"sync"
+	"time"
 
 	"github.com/zclconf/go-cty/cty"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform/provisioners"
 	"github.com/hashicorp/terraform/states"
 	"github.com/hashicorp/terraform/tfdiags"
+	"github.com/hashicorp/terraform/tracing"
 )
 
 // ContextGraphWalker is the GraphWalker implementation used with the
@@ -32,6 +34,16 @@ type ContextGraphWalker struct {
 	StopContext        context.Context
 	RootVariableValues InputValues
 
+	// Priorities optionally gives the estimated critical-path duration for
+	// each vertex, used to decide which ready vertices get the available
+	// parallelism slots first. Vertices without an entry have the lowest
+	// priority.
+	Priorities map[dag.Vertex]time.Duration
+
+	// TraceContext, if non-nil, carries the tracing span covering the whole
+	// walk; each vertex evaluation is then recorded as a child span.
+	TraceContext context.Context
+
 	// This is an output. Do not set this, nor read it while a graph walk
 	// is in progress.
 	NonFatalDiagnostics tfdiags.Diagnostics
@@ -48,6 +60,9 @@ type ContextGraphWalker struct {
 	provisionerCache   map[string]provisioners.Interface
 	provisionerSchemas map[string]*configschema.Block
 	provisionerLock    sync.Mutex
+	applyBatcher       *applyBatcher
+	spans              map[dag.Vertex]*tracing.Span
+	spansLock          sync.Mutex
 }
 
 func (w *ContextGraphWalker) EnterPath(path addrs.ModuleInstance) EvalContext {
@@ -72,12 +87,14 @@ func (w *ContextGraphWalker) EnterPath(path addrs.ModuleInstance) EvalContext {
 		State:              w.State,
 		Changes:            w.Changes,
 		Schemas:            w.Context.schemas,
+		FederatedStates:    w.Context.federatedStates,
 		VariableValues:     w.variableValues,
 		VariableValuesLock: &w.variableValuesLock,
 	}
 
 	ctx := &BuiltinEvalContext{
 		StopContext:           w.StopContext,
+		TraceContext:          w.TraceContext,
 		PathValue:             path,
 		Hooks:                 w.Context.hooks,
 		InputValue:            w.Context.uiInput,
@@ -85,15 +102,23 @@ func (w *ContextGraphWalker) EnterPath(path addrs.ModuleInstance) EvalContext {
 		Components:            w.Context.components,
 		Schemas:               w.Context.schemas,
 		ProviderCache:         w.providerCache,
+		ProviderMetrics:       w.Context.providerMetrics,
 		ProviderInputConfig:   w.Context.providerInputConfig,
 		ProviderLock:          &w.providerLock,
 		ProvisionerCache:      w.provisionerCache,
 		ProvisionerLock:       &w.provisionerLock,
 		ChangesValue:          w.Changes,
 		StateValue:            w.State,
+		ApplyBatcherValue:     w.applyBatcher,
 		Evaluator:             evaluator,
 		VariableValues:        w.variableValues,
 		VariableValuesLock:    &w.variableValuesLock,
+
+		DestroyProtectionOverrideValue: w.Context.destroyProtectionOverride,
+		ProviderConfigOverrides:        w.Context.providerConfigOverrides,
+		PreviousPlanChanges:            w.Context.previousPlanChanges,
+		ImportTargets:                  w.Context.importTargets,
+		RemovedTargets:                 w.Context.removedTargets,
 	}
 
 	w.contexts[key] = ctx
@@ -103,8 +128,18 @@ func (w *ContextGraphWalker) EnterPath(path addrs.ModuleInstance) EvalContext {
 func (w *ContextGraphWalker) EnterEvalTree(v dag.Vertex, n EvalNode) EvalNode {
 	log.Printf("[TRACE] [%s] Entering eval tree: %s", w.Operation, dag.VertexName(v))
 
-	// Acquire a lock on the semaphore
-	w.Context.parallelSem.Acquire()
+	// Acquire a lock on the semaphore, letting nodes with longer critical
+	// paths jump the queue when slots are contended.
+	w.Context.parallelSem.Acquire(int64(w.Priorities[v]))
+
+	if w.TraceContext != nil {
+		w.once.Do(w.init)
+		_, span := tracing.StartSpan(w.TraceContext, dag.VertexName(v))
+		span.SetAttribute("terraform.operation", w.Operation.String())
+		w.spansLock.Lock()
+		w.spans[v] = span
+		w.spansLock.Unlock()
+	}
 
 	// We want to filter the evaluation tree to only include operations
 	// that belong in this operation.
@@ -117,6 +152,15 @@ func (w *ContextGraphWalker) ExitEvalTree(v dag.Vertex, output interface{}, err
 	// Release the semaphore
 	w.Context.parallelSem.Release()
 
+	if w.TraceContext != nil {
+		w.spansLock.Lock()
+		span := w.spans[v]
+		delete(w.spans, v)
+		w.spansLock.Unlock()
+		span.RecordError(err)
+		span.End()
+	}
+
 	if err == nil {
 		return nil
 	}
@@ -145,11 +189,13 @@ func (w *ContextGraphWalker) ExitEvalTree(v dag.Vertex, output interface{}, err
 
 func (w *ContextGraphWalker) init() {
 	w.contexts = make(map[string]*BuiltinEvalContext)
+	w.spans = make(map[dag.Vertex]*tracing.Span)
 	w.providerCache = make(map[string]providers.Interface)
 	w.providerSchemas = make(map[string]*ProviderSchema)
 	w.provisionerCache = make(map[string]provisioners.Interface)
 	w.provisionerSchemas = make(map[string]*configschema.Block)
 	w.variableValues = make(map[string]map[string]cty.Value)
+	w.applyBatcher = newApplyBatcher()
 
 	// Populate root module variable values. Other modules will be populated
 	// during the graph walk.
@@ -1,6 +1,8 @@
 package terraform
 
 import (
+	"time"
+
 	"github.com/zclconf/go-cty/cty"
 
 	"github.com/hashicorp/terraform/addrs"
@@ -36,6 +38,25 @@ type Hook interface {
 	PreApply(addr addrs.AbsResourceInstance, gen states.Generation, action plans.Action, priorState, plannedNewState cty.Value) (HookAction, error)
 	PostApply(addr addrs.AbsResourceInstance, gen states.Generation, newState cty.Value, err error) (HookAction, error)
 
+	// PreApplyVeto is called before PreApply for each resource instance
+	// change that is about to be applied, giving the hook an opportunity to
+	// veto the change based on its decoded content. Returning a non-nil
+	// error vetoes the change and surfaces the error as a diagnostic while
+	// the rest of the walk continues; returning HookActionHalt instead
+	// cancels the change without recording an error.
+	PreApplyVeto(addr addrs.AbsResourceInstance, change *plans.ResourceInstanceChange) (HookAction, error)
+
+	// StillApplying is called periodically while the apply for a single
+	// instance remains in progress, giving the time elapsed since the apply
+	// began so that UIs can report on long-running operations.
+	StillApplying(addr addrs.AbsResourceInstance, gen states.Generation, elapsed time.Duration) (HookAction, error)
+
+	// PostApplyUniqueID is called after a successful apply for a single
+	// instance, giving the stable unique ID recorded in the state for the
+	// affected object so that hooks integrating with external systems can
+	// correlate the object independently of its resource address.
+	PostApplyUniqueID(addr addrs.AbsResourceInstance, gen states.Generation, uniqueID string) (HookAction, error)
+
 	// PreDiff and PostDiff are called before and after a provider is given
 	// the opportunity to customize the proposed new state to produce the
 	// planned new state.
@@ -77,8 +98,10 @@ type Hook interface {
 	PostImportState(addr addrs.AbsResourceInstance, imported []providers.ImportedResource) (HookAction, error)
 
 	// PostStateUpdate is called each time the state is updated. It receives
-	// a deep copy of the state, which it may therefore access freely without
-	// any need for locks to protect from concurrent writes from the caller.
+	// a read-only snapshot of the state, which it may therefore read freely
+	// without any need for locks to protect from concurrent writes from the
+	// caller, but which it must not modify because unchanged portions may be
+	// shared with snapshots given to other calls.
 	PostStateUpdate(new *states.State) (HookAction, error)
 }
 
@@ -97,6 +120,18 @@ func (*NilHook) PostApply(addr addrs.AbsResourceInstance, gen states.Generation,
 	return HookActionContinue, nil
 }
 
+func (*NilHook) PreApplyVeto(addr addrs.AbsResourceInstance, change *plans.ResourceInstanceChange) (HookAction, error) {
+	return HookActionContinue, nil
+}
+
+func (*NilHook) StillApplying(addr addrs.AbsResourceInstance, gen states.Generation, elapsed time.Duration) (HookAction, error) {
+	return HookActionContinue, nil
+}
+
+func (*NilHook) PostApplyUniqueID(addr addrs.AbsResourceInstance, gen states.Generation, uniqueID string) (HookAction, error) {
+	return HookActionContinue, nil
+}
+
 func (*NilHook) PreDiff(addr addrs.AbsResourceInstance, gen states.Generation, priorState, proposedNewState cty.Value) (HookAction, error) {
 	return HookActionContinue, nil
 }
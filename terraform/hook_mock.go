@@ -2,6 +2,7 @@ package terraform
 
 import (
 	"sync"
+	"time"
 
 	"github.com/zclconf/go-cty/cty"
 
@@ -34,6 +35,27 @@ type MockHook struct {
 	PostApplyReturnError error
 	PostApplyFn          func(addrs.AbsResourceInstance, states.Generation, cty.Value, error) (HookAction, error)
 
+	PreApplyVetoCalled bool
+	PreApplyVetoAddr   addrs.AbsResourceInstance
+	PreApplyVetoChange *plans.ResourceInstanceChange
+	PreApplyVetoFn     func(addrs.AbsResourceInstance, *plans.ResourceInstanceChange) (HookAction, error)
+	PreApplyVetoReturn HookAction
+	PreApplyVetoError  error
+
+	StillApplyingCalled      bool
+	StillApplyingAddr        addrs.AbsResourceInstance
+	StillApplyingGen         states.Generation
+	StillApplyingElapsed     time.Duration
+	StillApplyingReturn      HookAction
+	StillApplyingReturnError error
+
+	PostApplyUniqueIDCalled      bool
+	PostApplyUniqueIDAddr        addrs.AbsResourceInstance
+	PostApplyUniqueIDGen         states.Generation
+	PostApplyUniqueIDValue       string
+	PostApplyUniqueIDReturn      HookAction
+	PostApplyUniqueIDReturnError error
+
 	PreDiffCalled        bool
 	PreDiffAddr          addrs.AbsResourceInstance
 	PreDiffGen           states.Generation
@@ -146,6 +168,45 @@ func (h *MockHook) PostApply(addr addrs.AbsResourceInstance, gen states.Generati
 	return h.PostApplyReturn, h.PostApplyReturnError
 }
 
+func (h *MockHook) PreApplyVeto(addr addrs.AbsResourceInstance, change *plans.ResourceInstanceChange) (HookAction, error) {
+	h.Lock()
+	defer h.Unlock()
+
+	h.PreApplyVetoCalled = true
+	h.PreApplyVetoAddr = addr
+	h.PreApplyVetoChange = change
+
+	if h.PreApplyVetoFn != nil {
+		return h.PreApplyVetoFn(addr, change)
+	}
+
+	return h.PreApplyVetoReturn, h.PreApplyVetoError
+}
+
+func (h *MockHook) StillApplying(addr addrs.AbsResourceInstance, gen states.Generation, elapsed time.Duration) (HookAction, error) {
+	h.Lock()
+	defer h.Unlock()
+
+	h.StillApplyingCalled = true
+	h.StillApplyingAddr = addr
+	h.StillApplyingGen = gen
+	h.StillApplyingElapsed = elapsed
+
+	return h.StillApplyingReturn, h.StillApplyingReturnError
+}
+
+func (h *MockHook) PostApplyUniqueID(addr addrs.AbsResourceInstance, gen states.Generation, uniqueID string) (HookAction, error) {
+	h.Lock()
+	defer h.Unlock()
+
+	h.PostApplyUniqueIDCalled = true
+	h.PostApplyUniqueIDAddr = addr
+	h.PostApplyUniqueIDGen = gen
+	h.PostApplyUniqueIDValue = uniqueID
+
+	return h.PostApplyUniqueIDReturn, h.PostApplyUniqueIDReturnError
+}
+
 func (h *MockHook) PreDiff(addr addrs.AbsResourceInstance, gen states.Generation, priorState, proposedNewState cty.Value) (HookAction, error) {
 	h.Lock()
 	defer h.Unlock()
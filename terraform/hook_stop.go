@@ -1,7 +1,10 @@
 package terraform
 
 import (
+	"sort"
+	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/zclconf/go-cty/cty"
 
@@ -15,15 +18,36 @@ import (
 // signal when to stop or cancel actions.
 type stopHook struct {
 	stop uint32
+
+	// mu guards the in-flight tracking below, which records which resource
+	// instance operations were running when a stop was requested so that
+	// the caller can report them as interrupted.
+	mu          sync.Mutex
+	inFlight    map[string]addrs.AbsResourceInstance
+	interrupted []addrs.AbsResourceInstance
 }
 
 var _ Hook = (*stopHook)(nil)
 
 func (h *stopHook) PreApply(addr addrs.AbsResourceInstance, gen states.Generation, action plans.Action, priorState, plannedNewState cty.Value) (HookAction, error) {
+	h.track(addr)
 	return h.hook()
 }
 
 func (h *stopHook) PostApply(addr addrs.AbsResourceInstance, gen states.Generation, newState cty.Value, err error) (HookAction, error) {
+	h.untrack(addr)
+	return h.hook()
+}
+
+func (h *stopHook) PreApplyVeto(addr addrs.AbsResourceInstance, change *plans.ResourceInstanceChange) (HookAction, error) {
+	return h.hook()
+}
+
+func (h *stopHook) StillApplying(addr addrs.AbsResourceInstance, gen states.Generation, elapsed time.Duration) (HookAction, error) {
+	return h.hook()
+}
+
+func (h *stopHook) PostApplyUniqueID(addr addrs.AbsResourceInstance, gen states.Generation, uniqueID string) (HookAction, error) {
 	return h.hook()
 }
 
@@ -89,12 +113,54 @@ func (h *stopHook) hook() (HookAction, error) {
 // reset should be called within the lock context
 func (h *stopHook) Reset() {
 	atomic.StoreUint32(&h.stop, 0)
+
+	h.mu.Lock()
+	h.inFlight = nil
+	h.interrupted = nil
+	h.mu.Unlock()
 }
 
 func (h *stopHook) Stop() {
 	atomic.StoreUint32(&h.stop, 1)
+
+	// Whatever operations are running right now will run to completion but
+	// anything after them is halted, so these are the ones interrupted.
+	h.mu.Lock()
+	for _, addr := range h.inFlight {
+		h.interrupted = append(h.interrupted, addr)
+	}
+	sort.Slice(h.interrupted, func(i, j int) bool {
+		return h.interrupted[i].Less(h.interrupted[j])
+	})
+	h.mu.Unlock()
 }
 
 func (h *stopHook) Stopped() bool {
 	return atomic.LoadUint32(&h.stop) == 1
 }
+
+// Interrupted returns the addresses of the resource instances whose
+// operations were in progress when Stop was called, in a consistent sorted
+// order.
+func (h *stopHook) Interrupted() []addrs.AbsResourceInstance {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	ret := make([]addrs.AbsResourceInstance, len(h.interrupted))
+	copy(ret, h.interrupted)
+	return ret
+}
+
+func (h *stopHook) track(addr addrs.AbsResourceInstance) {
+	h.mu.Lock()
+	if h.inFlight == nil {
+		h.inFlight = make(map[string]addrs.AbsResourceInstance)
+	}
+	h.inFlight[addr.String()] = addr
+	h.mu.Unlock()
+}
+
+func (h *stopHook) untrack(addr addrs.AbsResourceInstance) {
+	h.mu.Lock()
+	delete(h.inFlight, addr.String())
+	h.mu.Unlock()
+}
@@ -2,8 +2,48 @@ package terraform
 
 import (
 	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/plans"
+	"github.com/hashicorp/terraform/states"
 )
 
 func TestStopHook_impl(t *testing.T) {
 	var _ Hook = new(stopHook)
 }
+
+func TestStopHook_interrupted(t *testing.T) {
+	h := new(stopHook)
+	h.Reset()
+
+	fooAddr := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_thing",
+		Name: "foo",
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+	barAddr := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_thing",
+		Name: "bar",
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+
+	// foo completes before the stop, so only bar should be reported.
+	h.PreApply(fooAddr, states.CurrentGen, plans.Create, cty.NilVal, cty.NilVal)
+	h.PostApply(fooAddr, states.CurrentGen, cty.NilVal, nil)
+	h.PreApply(barAddr, states.CurrentGen, plans.Create, cty.NilVal, cty.NilVal)
+
+	h.Stop()
+
+	got := h.Interrupted()
+	if len(got) != 1 || got[0].String() != barAddr.String() {
+		t.Fatalf("wrong interrupted instances %#v; want just %s", got, barAddr)
+	}
+
+	// Reset must clear the record for the next run.
+	h.Reset()
+	if got := h.Interrupted(); len(got) != 0 {
+		t.Fatalf("interrupted instances remain after Reset: %#v", got)
+	}
+}
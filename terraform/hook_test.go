@@ -2,6 +2,7 @@ package terraform
 
 import (
 	"testing"
+	"time"
 
 	"github.com/zclconf/go-cty/cty"
 
@@ -42,6 +43,23 @@ func (h *testHook) PostApply(addr addrs.AbsResourceInstance, gen states.Generati
 	return HookActionContinue, nil
 }
 
+func (h *testHook) PreApplyVeto(addr addrs.AbsResourceInstance, change *plans.ResourceInstanceChange) (HookAction, error) {
+	// Not logged: this is a decision point rather than a lifecycle event,
+	// and recording it would disturb the call sequences existing tests
+	// assert against.
+	return HookActionContinue, nil
+}
+
+func (h *testHook) StillApplying(addr addrs.AbsResourceInstance, gen states.Generation, elapsed time.Duration) (HookAction, error) {
+	h.Calls = append(h.Calls, &testHookCall{"StillApplying", addr.String()})
+	return HookActionContinue, nil
+}
+
+func (h *testHook) PostApplyUniqueID(addr addrs.AbsResourceInstance, gen states.Generation, uniqueID string) (HookAction, error) {
+	h.Calls = append(h.Calls, &testHookCall{"PostApplyUniqueID", addr.String()})
+	return HookActionContinue, nil
+}
+
 func (h *testHook) PreDiff(addr addrs.AbsResourceInstance, gen states.Generation, priorState, proposedNewState cty.Value) (HookAction, error) {
 	h.Calls = append(h.Calls, &testHookCall{"PreDiff", addr.String()})
 	return HookActionContinue, nil
@@ -0,0 +1,78 @@
+package terraform
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+
+	"github.com/hashicorp/terraform/configs"
+	"github.com/hashicorp/terraform/tfdiags"
+)
+
+// configImportTargets collects the "import" blocks from the given
+// configuration into a lookup table keyed by the target resource instance
+// address, for use during the plan walk.
+//
+// The target of each block must be a resource that is declared in the
+// configuration, since the point of importing is to bring an existing
+// object under management of that resource's configuration. Import blocks
+// are also only supported in the root module, since their "to" addresses
+// are absolute; both constraints are reported as error diagnostics here.
+func configImportTargets(config *configs.Config) (map[string]*configs.Import, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+	if config == nil {
+		return nil, diags
+	}
+
+	config.DeepEach(func(c *configs.Config) {
+		if len(c.Module.Imports) != 0 && !c.Path.IsRoot() {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Invalid import block",
+				fmt.Sprintf("Module %s declares an import block, but import blocks are only supported in the root module.", c.Path),
+			))
+		}
+	})
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	if len(config.Module.Imports) == 0 {
+		return nil, diags
+	}
+
+	ret := make(map[string]*configs.Import, len(config.Module.Imports))
+	for _, imp := range config.Module.Imports {
+		if imp.ID == nil {
+			continue // invalid block; already reported during loading
+		}
+		key := imp.To.String()
+		if key == "" {
+			continue // invalid address; already reported during loading
+		}
+		if config.Module.ResourceByAddr(imp.To.Resource.Resource) == nil {
+			diags = diags.Append(&hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Import target does not exist",
+				Detail:   fmt.Sprintf("The import block for %s requires a corresponding resource %q %q block in the configuration, describing the resource that the imported object will be managed as.", key, imp.To.Resource.Resource.Type, imp.To.Resource.Resource.Name),
+				Subject:  imp.DeclRange.Ptr(),
+			})
+			continue
+		}
+		if prev, exists := ret[key]; exists {
+			diags = diags.Append(&hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Duplicate import block",
+				Detail:   fmt.Sprintf("An import block for %s was already declared at %s. Each resource instance can be imported only once.", key, prev.DeclRange),
+				Subject:  imp.DeclRange.Ptr(),
+			})
+			continue
+		}
+		ret[key] = imp
+	}
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	return ret, diags
+}
@@ -0,0 +1,102 @@
+package terraform
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/configs"
+	"github.com/hashicorp/terraform/plans"
+	"github.com/hashicorp/terraform/states"
+)
+
+// processMoves applies any "moved" blocks from the given configuration to
+// the given state, rewriting the addresses of any objects that are still
+// tracked at a block's "from" address so that the subsequent plan compares
+// them against the configuration at the "to" address instead of proposing
+// to destroy and re-create them.
+//
+// The addresses in a moved block are relative to the module where the block
+// was declared, so a single block can cause several rewrites if that module
+// was called with count or for_each.
+//
+// The returned moves describe the rewrites that were actually performed,
+// for recording in the plan. Blocks whose "from" address matches nothing in
+// state, or whose "to" address is already in use, are silently ignored:
+// both are expected when a configuration is applied repeatedly after a
+// refactor.
+func processMoves(config *configs.Config, state *states.State) []plans.Move {
+	if config == nil || state == nil {
+		return nil
+	}
+
+	var moves []plans.Move
+	config.DeepEach(func(c *configs.Config) {
+		if len(c.Module.Moved) == 0 {
+			return
+		}
+
+		// Find the instances of this module that exist in state. The
+		// addresses in the moved blocks are relative to each of them.
+		var instances []addrs.ModuleInstance
+		for _, ms := range state.Modules {
+			if ms.Addr.Module().Equal(c.Path) {
+				instances = append(instances, ms.Addr)
+			}
+		}
+
+		for _, mb := range c.Module.Moved {
+			if mb.From == nil || mb.To == nil {
+				continue // invalid block; already reported during loading
+			}
+			for _, inst := range instances {
+				move := applyMove(state, inst, mb.From.Subject, mb.To.Subject)
+				if move != nil {
+					log.Printf("[TRACE] processMoves: moved %s to %s", move.From, move.To)
+					moves = append(moves, *move)
+				}
+			}
+		}
+	})
+	return moves
+}
+
+// applyMove attempts a single move within the given module instance,
+// returning a description of the move if it was performed, or nil if not.
+func applyMove(state *states.State, mod addrs.ModuleInstance, from, to addrs.Targetable) *plans.Move {
+	switch from := from.(type) {
+	case addrs.ModuleInstance:
+		to, ok := to.(addrs.ModuleInstance)
+		if !ok {
+			return nil // mismatched kinds; already reported during loading
+		}
+		absFrom := append(append(addrs.ModuleInstance{}, mod...), from...)
+		absTo := append(append(addrs.ModuleInstance{}, mod...), to...)
+		if state.MoveModuleInstance(absFrom, absTo) {
+			return &plans.Move{From: absFrom, To: absTo}
+		}
+	case addrs.AbsResource:
+		to, ok := to.(addrs.AbsResource)
+		if !ok {
+			// A whole resource can also move to an instance address or
+			// vice-versa, when adding or removing count/for_each; handle
+			// only the like-for-like cases here.
+			return nil
+		}
+		absFrom := from.Resource.Absolute(append(append(addrs.ModuleInstance{}, mod...), from.Module...))
+		absTo := to.Resource.Absolute(append(append(addrs.ModuleInstance{}, mod...), to.Module...))
+		if state.MoveAbsResource(absFrom, absTo) {
+			return &plans.Move{From: absFrom, To: absTo}
+		}
+	case addrs.AbsResourceInstance:
+		to, ok := to.(addrs.AbsResourceInstance)
+		if !ok {
+			return nil
+		}
+		absFrom := from.Resource.Absolute(append(append(addrs.ModuleInstance{}, mod...), from.Module...))
+		absTo := to.Resource.Absolute(append(append(addrs.ModuleInstance{}, mod...), to.Module...))
+		if state.MoveAbsResourceInstance(absFrom, absTo) {
+			return &plans.Move{From: absFrom, To: absTo}
+		}
+	}
+	return nil
+}
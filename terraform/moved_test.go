@@ -0,0 +1,60 @@
+package terraform
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/states"
+)
+
+func TestProcessMoves_resource(t *testing.T) {
+	m := testModule(t, "plan-moved")
+
+	fooAddr := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "aws_instance",
+		Name: "foo",
+	}.Absolute(addrs.RootModuleInstance)
+	barAddr := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "aws_instance",
+		Name: "bar",
+	}.Absolute(addrs.RootModuleInstance)
+
+	state := states.NewState()
+	root := state.EnsureModule(addrs.RootModuleInstance)
+	root.SetResourceInstanceCurrent(
+		fooAddr.Resource.Instance(addrs.NoKey),
+		&states.ResourceInstanceObjectSrc{
+			Status:    states.ObjectReady,
+			AttrsJSON: []byte(`{"id":"bar","num":"2","type":"aws_instance"}`),
+		},
+		addrs.AbsProviderConfig{
+			Provider: addrs.NewLegacyProvider("aws"),
+		},
+	)
+
+	moves := processMoves(m, state)
+	if got, want := len(moves), 1; got != want {
+		t.Fatalf("wrong number of moves %d; want %d", got, want)
+	}
+	if got, want := moves[0].From.String(), fooAddr.String(); got != want {
+		t.Errorf("wrong move source %q; want %q", got, want)
+	}
+	if got, want := moves[0].To.String(), barAddr.String(); got != want {
+		t.Errorf("wrong move target %q; want %q", got, want)
+	}
+
+	if state.Resource(fooAddr) != nil {
+		t.Errorf("state still tracks %s after move", fooAddr)
+	}
+	if state.Resource(barAddr) == nil {
+		t.Errorf("state does not track %s after move", barAddr)
+	}
+
+	// A second pass must be a no-op, since the move already happened.
+	moves = processMoves(m, state)
+	if got, want := len(moves), 0; got != want {
+		t.Fatalf("wrong number of moves on second pass %d; want %d", got, want)
+	}
+}
@@ -0,0 +1,130 @@
+package terraform
+
+import (
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/configs"
+	"github.com/hashicorp/terraform/dag"
+	"github.com/hashicorp/terraform/lang"
+)
+
+// nodeCheckPlannable is the placeholder for a "check" block that has not yet
+// had its module path expanded.
+type nodeCheckPlannable struct {
+	Addr   addrs.Check
+	Module addrs.Module
+	Config *configs.Check
+}
+
+var (
+	_ GraphNodeSubPath           = (*nodeCheckPlannable)(nil)
+	_ RemovableIfNotTargeted     = (*nodeCheckPlannable)(nil)
+	_ GraphNodeReferencer        = (*nodeCheckPlannable)(nil)
+	_ GraphNodeDynamicExpandable = (*nodeCheckPlannable)(nil)
+)
+
+func (n *nodeCheckPlannable) Name() string {
+	return n.Addr.Absolute(n.Module.UnkeyedInstanceShim()).String()
+}
+
+// GraphNodeSubPath
+func (n *nodeCheckPlannable) Path() addrs.ModuleInstance {
+	return n.Module.UnkeyedInstanceShim()
+}
+
+// GraphNodeDynamicExpandable
+func (n *nodeCheckPlannable) DynamicExpand(ctx EvalContext) (*Graph, error) {
+	var g Graph
+	expander := ctx.InstanceExpander()
+	for _, module := range expander.ExpandModule(ctx.Path().Module()) {
+		c := &nodeCheckApplyable{
+			Addr:   n.Addr.Absolute(module),
+			Config: n.Config,
+		}
+		g.Add(c)
+	}
+	return &g, nil
+}
+
+// GraphNodeReferencer
+func (n *nodeCheckPlannable) References() []*addrs.Reference {
+	return referencesForCheck(n.Config)
+}
+
+// RemovableIfNotTargeted
+func (n *nodeCheckPlannable) RemoveIfNotTargeted() bool {
+	return true
+}
+
+// nodeCheckApplyable represents a "check" block in a particular module
+// instance, ready to have its assertions evaluated.
+type nodeCheckApplyable struct {
+	Addr   addrs.AbsCheck
+	Config *configs.Check
+}
+
+var (
+	_ GraphNodeSubPath       = (*nodeCheckApplyable)(nil)
+	_ RemovableIfNotTargeted = (*nodeCheckApplyable)(nil)
+	_ GraphNodeReferencer    = (*nodeCheckApplyable)(nil)
+	_ GraphNodeEvalable      = (*nodeCheckApplyable)(nil)
+	_ dag.GraphNodeDotter    = (*nodeCheckApplyable)(nil)
+)
+
+func (n *nodeCheckApplyable) Name() string {
+	return n.Addr.String()
+}
+
+// GraphNodeSubPath
+func (n *nodeCheckApplyable) Path() addrs.ModuleInstance {
+	return n.Addr.Module
+}
+
+// GraphNodeReferencer
+func (n *nodeCheckApplyable) References() []*addrs.Reference {
+	return referencesForCheck(n.Config)
+}
+
+// RemovableIfNotTargeted
+func (n *nodeCheckApplyable) RemoveIfNotTargeted() bool {
+	return true
+}
+
+// GraphNodeEvalable
+func (n *nodeCheckApplyable) EvalTree() EvalNode {
+	// We evaluate the assertions only once the apply has otherwise
+	// completed, because they are intended to describe the final state of
+	// the objects they refer to. During other walks the referenced values
+	// are generally not decided yet, so there's nothing useful to check.
+	return &EvalOpFilter{
+		Ops: []walkOperation{walkApply},
+		Node: &evalCheck{
+			Addr:   n.Addr,
+			Config: n.Config,
+		},
+	}
+}
+
+// referencesForCheck returns all of the references made from the assertions
+// of the given check block, which the graph uses to make the check depend
+// on the objects it is checking.
+func referencesForCheck(c *configs.Check) []*addrs.Reference {
+	var refs []*addrs.Reference
+	for _, rule := range c.Asserts {
+		condRefs, _ := lang.ReferencesInExpr(rule.Condition)
+		msgRefs, _ := lang.ReferencesInExpr(rule.ErrorMessage)
+		refs = append(refs, condRefs...)
+		refs = append(refs, msgRefs...)
+	}
+	return refs
+}
+
+// dag.GraphNodeDotter impl.
+func (n *nodeCheckApplyable) DotNode(name string, opts *dag.DotOpts) *dag.DotNode {
+	return &dag.DotNode{
+		Name: name,
+		Attrs: map[string]string{
+			"label": n.Name(),
+			"shape": "note",
+		},
+	}
+}
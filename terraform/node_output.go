@@ -205,6 +205,16 @@ func (n *NodeApplyableOutput) References() []*addrs.Reference {
 func (n *NodeApplyableOutput) EvalTree() EvalNode {
 	return &EvalSequence{
 		Nodes: []EvalNode{
+			// We check the preconditions only during plan and apply because
+			// during other walks, such as validate, the objects the
+			// conditions refer to won't have useful values yet.
+			&EvalOpFilter{
+				Ops: []walkOperation{walkPlan, walkApply},
+				Node: &evalCheckRules{
+					Rules:     n.Config.Preconditions,
+					CheckType: "Module output value precondition",
+				},
+			},
 			&EvalOpFilter{
 				Ops: []walkOperation{walkRefresh, walkPlan, walkApply, walkValidate, walkDestroy, walkPlanDestroy},
 				Node: &EvalWriteOutput{
@@ -99,7 +99,7 @@ func (n *NodeApplyableResourceInstance) References() []*addrs.Reference {
 }
 
 // GraphNodeAttachDependencies
-func (n *NodeApplyableResourceInstance) AttachDependencies(deps []addrs.AbsResource) {
+func (n *NodeApplyableResourceInstance) AttachDependencies(deps []addrs.ConfigResource) {
 	n.Dependencies = deps
 }
 
@@ -280,6 +280,14 @@ func (n *NodeApplyableResourceInstance) evalTreeManagedResource(addr addrs.AbsRe
 				Change:         &diff,
 			},
 
+			// Re-check the preconditions now that any upstream objects have
+			// their final values, in case a condition that was unknown during
+			// planning can now be resolved.
+			&evalCheckRules{
+				Rules:     n.Config.Managed.Preconditions,
+				CheckType: "Resource precondition",
+			},
+
 			// Make a new diff, in case we've learned new values in the state
 			// during apply which we can now incorporate.
 			&EvalDiff{
@@ -426,6 +434,15 @@ func (n *NodeApplyableResourceInstance) evalTreeManagedResource(addr addrs.AbsRe
 				Error: &err,
 			},
 			&EvalUpdateStateHook{},
+
+			// Check the postconditions against the final values. We can only
+			// get this far if the apply itself succeeded, because
+			// EvalApplyPost above halts the walk on error.
+			&evalCheckRules{
+				Rules:     n.Config.Managed.Postconditions,
+				Self:      addr.Resource,
+				CheckType: "Resource postcondition",
+			},
 		},
 	}
 }
@@ -191,9 +191,39 @@ func (n *NodeDestroyResourceInstance) EvalTree() EvalNode {
 					Change: &changeApply,
 				},
 
-				// Run destroy provisioners if not tainted
+				// If the resource has been removed from configuration then
+				// any destroy-time provisioners it had can no longer run, so
+				// warn about that before we proceed with the destroy.
 				&EvalIf{
 					If: func(ctx EvalContext) (bool, error) {
+						if n.Config != nil {
+							return false, nil
+						}
+						if changeApply != nil && changeApply.Action == plans.Forget {
+							// The remote object isn't being destroyed, so
+							// there are no provisioners being skipped.
+							return false, nil
+						}
+						if state != nil && state.Status == states.ObjectTainted {
+							// Tainted objects skip their provisioners anyway.
+							return false, nil
+						}
+						return true, nil
+					},
+
+					Then: &EvalSkippedDestroyProvisioners{
+						Addr:  addr.Resource,
+						State: &state,
+					},
+				},
+
+				// Run destroy provisioners if not tainted, unless we're just
+				// forgetting the object rather than destroying it.
+				&EvalIf{
+					If: func(ctx EvalContext) (bool, error) {
+						if changeApply != nil && changeApply.Action == plans.Forget {
+							return false, nil
+						}
 						if state != nil && state.Status == states.ObjectTainted {
 							return false, nil
 						}
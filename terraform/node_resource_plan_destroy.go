@@ -68,10 +68,12 @@ func (n *NodePlanDestroyableResourceInstance) EvalTree() EvalNode {
 				Output: &state,
 			},
 			&EvalDiffDestroy{
-				Addr:         addr.Resource,
-				ProviderAddr: n.ResolvedProvider,
-				State:        &state,
-				Output:       &change,
+				Addr:           addr.Resource,
+				ProviderAddr:   n.ResolvedProvider,
+				Provider:       &provider,
+				ProviderSchema: &providerSchema,
+				State:          &state,
+				Output:         &change,
 			},
 			&EvalCheckPreventDestroy{
 				Addr:   addr.Resource,
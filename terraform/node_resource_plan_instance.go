@@ -79,13 +79,24 @@ func (n *NodePlannableResourceInstance) evalTreeDataResource(addr addrs.AbsResou
 					// Check and see if any of our dependencies have changes.
 					changes := ctx.Changes()
 					for _, d := range n.References() {
-						ri, ok := d.Subject.(addrs.ResourceInstance)
-						if !ok {
-							continue
+						switch sub := d.Subject.(type) {
+						case addrs.ResourceInstance:
+							change := changes.GetResourceInstanceChange(sub.Absolute(ctx.Path()), states.CurrentGen)
+							if change != nil && change.Action != plans.NoOp {
+								depChanges = true
+							}
+						case addrs.Resource:
+							// A reference to a whole resource, which is how
+							// depends_on entries appear, covers all of that
+							// resource's instances.
+							for _, change := range changes.GetChangesForAbsResource(sub.Absolute(ctx.Path())) {
+								if change != nil && change.Action != plans.NoOp {
+									depChanges = true
+									break
+								}
+							}
 						}
-						change := changes.GetResourceInstanceChange(ri.Absolute(ctx.Path()), states.CurrentGen)
-						if change != nil && change.Action != plans.NoOp {
-							depChanges = true
+						if depChanges {
 							break
 						}
 					}
@@ -168,6 +179,11 @@ func (n *NodePlannableResourceInstance) evalTreeManagedResource(addr addrs.AbsRe
 				ProviderSchema: &providerSchema,
 			},
 
+			&evalCheckRules{
+				Rules:     config.Managed.Preconditions,
+				CheckType: "Resource precondition",
+			},
+
 			&EvalDiff{
 				Addr:                addr.Resource,
 				Config:              n.Config,
@@ -195,6 +211,16 @@ func (n *NodePlannableResourceInstance) evalTreeManagedResource(addr addrs.AbsRe
 				ProviderSchema: &providerSchema,
 				Change:         &change,
 			},
+
+			// The postconditions are evaluated against the planned new
+			// values here, and will be re-checked against the final values
+			// during the apply walk. Conditions that can't be resolved until
+			// apply time are treated as passing for now.
+			&evalCheckRules{
+				Rules:     config.Managed.Postconditions,
+				Self:      addr.Resource,
+				CheckType: "Resource postcondition",
+			},
 		},
 	}
 }
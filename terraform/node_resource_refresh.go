@@ -21,7 +21,7 @@ type NodeRefreshableManagedResource struct {
 
 	// We attach dependencies to the Resource during refresh, since the
 	// instances are instantiated during DynamicExpand.
-	Dependencies []addrs.AbsResource
+	Dependencies []addrs.ConfigResource
 }
 
 var (
@@ -35,7 +35,7 @@ var (
 )
 
 // GraphNodeAttachDependencies
-func (n *NodeRefreshableManagedResource) AttachDependencies(deps []addrs.AbsResource) {
+func (n *NodeRefreshableManagedResource) AttachDependencies(deps []addrs.ConfigResource) {
 	n.Dependencies = deps
 }
 
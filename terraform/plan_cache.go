@@ -0,0 +1,95 @@
+package terraform
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"log"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/hashicorp/terraform/plans"
+)
+
+// resourceInstanceInputsHash produces a digest of the inputs that determine
+// the planned change for a resource instance: its address, the provider
+// configuration it is planned with, its resolved configuration value, and
+// the prior state value the plan is relative to.
+//
+// Two plans that compute equal digests for the same instance are guaranteed
+// to have sent the same request to the provider, so the earlier result can
+// be reused in place of calling the provider again, as long as the provider
+// itself has not changed in the meantime. (Callers must separately ensure
+// that the provider plugin is the same; see ContextOpts.PreviousPlan.)
+//
+// Returns nil if the digest cannot be computed, in which case the caller
+// must not reuse any previous result.
+func resourceInstanceInputsHash(schema *configschema.Block, addr addrs.AbsResourceInstance, providerAddr addrs.AbsProviderConfig, configVal, priorVal cty.Value) []byte {
+	ty := schema.ImpliedType()
+	configRaw, err := plans.NewDynamicValue(configVal, ty)
+	if err != nil {
+		// Unlikely, but a config we can't serialize is a config we can't
+		// compare, so we just decline to produce a digest.
+		return nil
+	}
+	priorRaw, err := plans.NewDynamicValue(priorVal, ty)
+	if err != nil {
+		return nil
+	}
+
+	h := sha256.New()
+	// The writes below can never fail, per the hash.Hash contract. Each
+	// variable-length component is preceded by its length so that component
+	// boundaries are unambiguous.
+	w := func(b []byte) {
+		var lenBuf [8]byte
+		l := len(b)
+		for i := range lenBuf {
+			lenBuf[i] = byte(l >> (8 * uint(i)))
+		}
+		h.Write(lenBuf[:])
+		h.Write(b)
+	}
+	w([]byte(addr.String()))
+	w([]byte(providerAddr.String()))
+	w(configRaw)
+	w(priorRaw)
+	return h.Sum(nil)
+}
+
+// reusablePlanChanges filters the resource instance changes of a previous
+// plan down to those that are candidates for reuse during a new plan against
+// the same configuration and provider plugins.
+//
+// Changes are dropped if the provider plugin that created them cannot be
+// verified to be the same executable that the new plan will use, comparing
+// the SHA256 digests recorded in the previous plan against the given current
+// digests, since a different plugin release may produce a different result
+// for the same inputs. Changes without an inputs digest are also dropped,
+// since they can never match anything.
+//
+// Returns nil if there is nothing worth consulting, which callers should
+// treat the same as having no previous plan at all.
+func reusablePlanChanges(prev *plans.Plan, providerSHA256s map[string][]byte) *plans.Changes {
+	if prev == nil || prev.Changes == nil {
+		return nil
+	}
+
+	ret := plans.NewChanges()
+	for _, rc := range prev.Changes.Resources {
+		if len(rc.InputsHash) == 0 {
+			continue
+		}
+		name := rc.ProviderAddr.Provider.LegacyString()
+		if !bytes.Equal(prev.ProviderSHA256s[name], providerSHA256s[name]) {
+			log.Printf("[TRACE] reusablePlanChanges: discarding previous change for %s because provider %s has changed", rc.Addr, name)
+			continue
+		}
+		ret.Resources = append(ret.Resources, rc)
+	}
+	if len(ret.Resources) == 0 {
+		return nil
+	}
+	return ret
+}
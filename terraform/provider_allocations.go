@@ -0,0 +1,111 @@
+package terraform
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/configs"
+)
+
+// ProviderAllocation describes which provider configuration manages one
+// resource instance tracked in the state, alongside the provider
+// configuration that config resolution would select for it today.
+type ProviderAllocation struct {
+	// Resource is the instance the allocation describes.
+	Resource addrs.AbsResourceInstance
+
+	// StateProvider is the provider configuration recorded for the
+	// instance in the state.
+	StateProvider addrs.AbsProviderConfig
+
+	// ConfigProvider is the provider configuration that resolving the
+	// current configuration selects for the resource, taking aliases and
+	// module inheritance into account. It is nil when the resource is no
+	// longer present in the configuration.
+	ConfigProvider *addrs.AbsProviderConfig
+
+	// Mismatch is true when the recorded provider configuration no longer
+	// matches what config resolution would choose, including when the
+	// resource has been removed from the configuration entirely. Such
+	// instances will be handled by a different (or missing) provider
+	// configuration on the next operation.
+	Mismatch bool
+}
+
+// ProviderAllocations produces a mapping of every resource instance in the
+// state to its provider configuration, flagging instances whose recorded
+// provider no longer matches what the configuration resolves to. The result
+// is sorted by resource address.
+func (c *Context) ProviderAllocations() []ProviderAllocation {
+	var ret []ProviderAllocation
+
+	for _, ms := range c.state.Modules {
+		moduleAddr := ms.Addr
+		for _, rs := range ms.Resources {
+			configProvider := c.configProviderFor(moduleAddr, rs.Addr)
+			for key := range rs.Instances {
+				alloc := ProviderAllocation{
+					Resource:       rs.Addr.Instance(key).Absolute(moduleAddr),
+					StateProvider:  rs.ProviderConfig,
+					ConfigProvider: configProvider,
+				}
+				alloc.Mismatch = configProvider == nil || configProvider.String() != rs.ProviderConfig.String()
+				ret = append(ret, alloc)
+			}
+		}
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Resource.String() < ret[j].Resource.String()
+	})
+	return ret
+}
+
+// configProviderFor determines the provider configuration that the current
+// configuration selects for the given resource, or nil if the resource is
+// not declared in the configuration.
+func (c *Context) configProviderFor(moduleAddr addrs.ModuleInstance, addr addrs.Resource) *addrs.AbsProviderConfig {
+	cfg := c.config.DescendentForInstance(moduleAddr)
+	if cfg == nil {
+		return nil
+	}
+	rc := cfg.Module.ResourceByAddr(addr)
+	if rc == nil {
+		return nil
+	}
+
+	local := rc.ProviderConfigAddr()
+	resolved := c.config.ResolveAbsProviderAddr(local, moduleAddr)
+
+	// A provider configuration that is not declared in the resource's own
+	// module is inherited from the closest ancestor module that declares
+	// it, defaulting to the root module, so the effective configuration
+	// lives at that ancestor's address.
+	if !moduleConfiguresProvider(cfg.Module, local) {
+		path := moduleAddr
+		for !path.IsRoot() {
+			path = path.Parent()
+			ancestor := c.config.DescendentForInstance(path)
+			if ancestor == nil {
+				break
+			}
+			if moduleConfiguresProvider(ancestor.Module, local) {
+				break
+			}
+		}
+		resolved.Module = path
+	}
+
+	return &resolved
+}
+
+// moduleConfiguresProvider returns whether the given module has its own
+// "provider" block for the given local provider configuration address.
+func moduleConfiguresProvider(m *configs.Module, addr addrs.LocalProviderConfig) bool {
+	for _, pc := range m.ProviderConfigs {
+		if pc.Name == addr.LocalName && pc.Alias == addr.Alias {
+			return true
+		}
+	}
+	return false
+}
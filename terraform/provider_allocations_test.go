@@ -0,0 +1,163 @@
+package terraform
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/providers"
+)
+
+func TestContext2ProviderAllocations(t *testing.T) {
+	m := testModuleInline(t, map[string]string{
+		"main.tf": `
+provider "aws" {
+}
+
+resource "aws_instance" "ok" {
+}
+
+resource "aws_instance" "moved" {
+}
+`,
+	})
+
+	p := testProvider("aws")
+	s := MustShimLegacyState(&State{
+		Modules: []*ModuleState{
+			&ModuleState{
+				Path: []string{"root"},
+				Resources: map[string]*ResourceState{
+					"aws_instance.ok": &ResourceState{
+						Type: "aws_instance",
+						Primary: &InstanceState{
+							ID: "foo",
+						},
+						Provider: "provider.aws",
+					},
+					"aws_instance.moved": &ResourceState{
+						Type: "aws_instance",
+						Primary: &InstanceState{
+							ID: "bar",
+						},
+						Provider: "provider.aws.extra",
+					},
+					"aws_instance.gone": &ResourceState{
+						Type: "aws_instance",
+						Primary: &InstanceState{
+							ID: "baz",
+						},
+						Provider: "provider.aws",
+					},
+				},
+			},
+		},
+	})
+
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+			},
+		),
+		State: s,
+	})
+
+	allocs := ctx.ProviderAllocations()
+	if got, want := len(allocs), 3; got != want {
+		t.Fatalf("wrong number of allocations %d; want %d", got, want)
+	}
+
+	byAddr := map[string]ProviderAllocation{}
+	for _, alloc := range allocs {
+		byAddr[alloc.Resource.String()] = alloc
+	}
+
+	ok := byAddr["aws_instance.ok"]
+	if ok.Mismatch {
+		t.Errorf("aws_instance.ok is flagged as a mismatch; should not be")
+	}
+	if ok.ConfigProvider == nil {
+		t.Fatalf("aws_instance.ok has no config provider; should have one")
+	}
+	if got, want := ok.ConfigProvider.String(), ok.StateProvider.String(); got != want {
+		t.Errorf("aws_instance.ok config provider is %s; state records %s", got, want)
+	}
+
+	moved := byAddr["aws_instance.moved"]
+	if !moved.Mismatch {
+		t.Errorf("aws_instance.moved is not flagged as a mismatch; should be")
+	}
+	if moved.ConfigProvider == nil {
+		t.Fatalf("aws_instance.moved has no config provider; should have one")
+	}
+	if got := moved.StateProvider.Alias; got != "extra" {
+		t.Errorf("aws_instance.moved state provider alias is %q; want %q", got, "extra")
+	}
+	if got := moved.ConfigProvider.Alias; got != "" {
+		t.Errorf("aws_instance.moved config provider alias is %q; want the default configuration", got)
+	}
+
+	gone := byAddr["aws_instance.gone"]
+	if !gone.Mismatch {
+		t.Errorf("aws_instance.gone is not flagged as a mismatch; should be")
+	}
+	if gone.ConfigProvider != nil {
+		t.Errorf("aws_instance.gone has config provider %s; should have none", gone.ConfigProvider)
+	}
+}
+
+func TestContext2ProviderAllocations_moduleInherited(t *testing.T) {
+	m := testModule(t, "apply-module-only-provider")
+
+	p := testProvider("aws")
+	pTest := testProvider("test")
+	s := MustShimLegacyState(&State{
+		Modules: []*ModuleState{
+			&ModuleState{
+				Path: []string{"root", "child"},
+				Resources: map[string]*ResourceState{
+					"aws_instance.foo": &ResourceState{
+						Type: "aws_instance",
+						Primary: &InstanceState{
+							ID: "foo",
+						},
+						Provider: "provider.aws",
+					},
+				},
+			},
+		},
+	})
+
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"):  testProviderFuncFixed(p),
+				addrs.NewLegacyProvider("test"): testProviderFuncFixed(pTest),
+			},
+		),
+		State: s,
+	})
+
+	allocs := ctx.ProviderAllocations()
+	if got, want := len(allocs), 1; got != want {
+		t.Fatalf("wrong number of allocations %d; want %d", got, want)
+	}
+
+	alloc := allocs[0]
+	if got, want := alloc.Resource.String(), "module.child.aws_instance.foo"; got != want {
+		t.Fatalf("wrong resource address %s; want %s", got, want)
+	}
+	if alloc.ConfigProvider == nil {
+		t.Fatalf("no config provider; should have one")
+	}
+	// The child module has no provider "aws" block of its own, so the
+	// resource inherits the root module's configuration.
+	if got := alloc.ConfigProvider.Module; !got.IsRoot() {
+		t.Errorf("config provider is in module %s; want the root module", got)
+	}
+	if alloc.Mismatch {
+		t.Errorf("flagged as a mismatch; should not be")
+	}
+}
@@ -0,0 +1,208 @@
+package terraform
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform/providers"
+	"github.com/hashicorp/terraform/tfdiags"
+)
+
+// ProviderCallMetrics accumulates counts of provider RPC calls made during
+// graph walks, grouped by provider configuration address, by operation name,
+// and by resource type where the operation relates to a particular resource.
+//
+// It can optionally enforce per-provider budgets on the number of resource
+// operations, causing further operations against an exhausted provider to
+// fail with an explicit diagnostic. This is intended to help operations stay
+// within cloud API quotas during very large applies.
+//
+// All methods are safe to call concurrently, since provider calls are made
+// from many goroutines during a graph walk.
+type ProviderCallMetrics struct {
+	mu sync.Mutex
+
+	// budgets caps the number of resource operations per provider
+	// configuration address. The empty string key, if present, applies to
+	// any provider without a more specific budget. A zero or negative
+	// budget means unlimited.
+	budgets map[string]int
+
+	byOperation map[string]map[string]int
+	byResource  map[string]map[string]int
+}
+
+func newProviderCallMetrics(budgets map[string]int) *ProviderCallMetrics {
+	return &ProviderCallMetrics{
+		budgets:     budgets,
+		byOperation: make(map[string]map[string]int),
+		byResource:  make(map[string]map[string]int),
+	}
+}
+
+// record increments the counters for one call. If enforce is set and the
+// provider's budget is already exhausted then an error diagnostic is
+// returned and the call should not proceed.
+func (m *ProviderCallMetrics) record(provider, operation, resourceType string, enforce bool) tfdiags.Diagnostics {
+	var diags tfdiags.Diagnostics
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if enforce {
+		budget, ok := m.budgets[provider]
+		if !ok {
+			budget = m.budgets[""]
+		}
+		if budget > 0 && m.resourceOpCount(provider) >= budget {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Provider operation budget exhausted",
+				fmt.Sprintf("The configured budget of %d operations for provider %s has been reached, so Terraform will not call %s. Either raise the budget or apply with -target to reduce the scope of this operation.", budget, provider, operation),
+			))
+			return diags
+		}
+	}
+
+	ops := m.byOperation[provider]
+	if ops == nil {
+		ops = make(map[string]int)
+		m.byOperation[provider] = ops
+	}
+	ops[operation]++
+
+	if resourceType != "" {
+		resources := m.byResource[provider]
+		if resources == nil {
+			resources = make(map[string]int)
+			m.byResource[provider] = resources
+		}
+		resources[resourceType]++
+	}
+
+	return diags
+}
+
+// resourceOpCount sums the budget-relevant operations for the given
+// provider. The caller must hold m.mu.
+func (m *ProviderCallMetrics) resourceOpCount(provider string) int {
+	total := 0
+	for op, n := range m.byOperation[provider] {
+		switch op {
+		case "ReadResource", "PlanResourceChange", "ApplyResourceChange", "ImportResourceState", "ReadDataSource":
+			total += n
+		}
+	}
+	return total
+}
+
+// OperationCounts returns a copy of the per-operation call counts for each
+// provider configuration that has been called so far.
+func (m *ProviderCallMetrics) OperationCounts() map[string]map[string]int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return copyCountMap(m.byOperation)
+}
+
+// ResourceCounts returns a copy of the per-resource-type call counts for
+// each provider configuration that has been called so far.
+func (m *ProviderCallMetrics) ResourceCounts() map[string]map[string]int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return copyCountMap(m.byResource)
+}
+
+func copyCountMap(src map[string]map[string]int) map[string]map[string]int {
+	ret := make(map[string]map[string]int, len(src))
+	for k, inner := range src {
+		retInner := make(map[string]int, len(inner))
+		for ik, v := range inner {
+			retInner[ik] = v
+		}
+		ret[k] = retInner
+	}
+	return ret
+}
+
+// meteredProvider wraps a providers.Interface so that every RPC is recorded
+// in (and possibly vetoed by) a ProviderCallMetrics.
+type meteredProvider struct {
+	inner   providers.Interface
+	addr    string
+	metrics *ProviderCallMetrics
+}
+
+var _ providers.Interface = (*meteredProvider)(nil)
+
+func (p *meteredProvider) GetSchema() providers.GetSchemaResponse {
+	p.metrics.record(p.addr, "GetSchema", "", false)
+	return p.inner.GetSchema()
+}
+
+func (p *meteredProvider) PrepareProviderConfig(req providers.PrepareProviderConfigRequest) providers.PrepareProviderConfigResponse {
+	p.metrics.record(p.addr, "PrepareProviderConfig", "", false)
+	return p.inner.PrepareProviderConfig(req)
+}
+
+func (p *meteredProvider) ValidateResourceTypeConfig(req providers.ValidateResourceTypeConfigRequest) providers.ValidateResourceTypeConfigResponse {
+	p.metrics.record(p.addr, "ValidateResourceTypeConfig", req.TypeName, false)
+	return p.inner.ValidateResourceTypeConfig(req)
+}
+
+func (p *meteredProvider) ValidateDataSourceConfig(req providers.ValidateDataSourceConfigRequest) providers.ValidateDataSourceConfigResponse {
+	p.metrics.record(p.addr, "ValidateDataSourceConfig", req.TypeName, false)
+	return p.inner.ValidateDataSourceConfig(req)
+}
+
+func (p *meteredProvider) UpgradeResourceState(req providers.UpgradeResourceStateRequest) providers.UpgradeResourceStateResponse {
+	p.metrics.record(p.addr, "UpgradeResourceState", req.TypeName, false)
+	return p.inner.UpgradeResourceState(req)
+}
+
+func (p *meteredProvider) Configure(req providers.ConfigureRequest) providers.ConfigureResponse {
+	p.metrics.record(p.addr, "Configure", "", false)
+	return p.inner.Configure(req)
+}
+
+func (p *meteredProvider) Stop() error {
+	return p.inner.Stop()
+}
+
+func (p *meteredProvider) ReadResource(req providers.ReadResourceRequest) providers.ReadResourceResponse {
+	if diags := p.metrics.record(p.addr, "ReadResource", req.TypeName, true); diags.HasErrors() {
+		return providers.ReadResourceResponse{Diagnostics: diags}
+	}
+	return p.inner.ReadResource(req)
+}
+
+func (p *meteredProvider) PlanResourceChange(req providers.PlanResourceChangeRequest) providers.PlanResourceChangeResponse {
+	if diags := p.metrics.record(p.addr, "PlanResourceChange", req.TypeName, true); diags.HasErrors() {
+		return providers.PlanResourceChangeResponse{Diagnostics: diags}
+	}
+	return p.inner.PlanResourceChange(req)
+}
+
+func (p *meteredProvider) ApplyResourceChange(req providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeResponse {
+	if diags := p.metrics.record(p.addr, "ApplyResourceChange", req.TypeName, true); diags.HasErrors() {
+		return providers.ApplyResourceChangeResponse{Diagnostics: diags}
+	}
+	return p.inner.ApplyResourceChange(req)
+}
+
+func (p *meteredProvider) ImportResourceState(req providers.ImportResourceStateRequest) providers.ImportResourceStateResponse {
+	if diags := p.metrics.record(p.addr, "ImportResourceState", req.TypeName, true); diags.HasErrors() {
+		return providers.ImportResourceStateResponse{Diagnostics: diags}
+	}
+	return p.inner.ImportResourceState(req)
+}
+
+func (p *meteredProvider) ReadDataSource(req providers.ReadDataSourceRequest) providers.ReadDataSourceResponse {
+	if diags := p.metrics.record(p.addr, "ReadDataSource", req.TypeName, true); diags.HasErrors() {
+		return providers.ReadDataSourceResponse{Diagnostics: diags}
+	}
+	return p.inner.ReadDataSource(req)
+}
+
+func (p *meteredProvider) Close() error {
+	return p.inner.Close()
+}
@@ -0,0 +1,66 @@
+package terraform
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/providers"
+)
+
+func TestContext2Plan_providerCallMetrics(t *testing.T) {
+	m := testModule(t, "plan-good")
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+			},
+		),
+	})
+
+	_, diags := ctx.Plan()
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+
+	counts := ctx.ProviderCallMetrics().OperationCounts()
+	providerAddr := addrs.NewLegacyProvider("aws").String()
+	if got := counts[providerAddr]["PlanResourceChange"]; got != 2 {
+		t.Errorf("wrong PlanResourceChange count for %s: got %d, want 2", providerAddr, got)
+	}
+
+	resourceCounts := ctx.ProviderCallMetrics().ResourceCounts()
+	// The per-resource counts include validate calls as well as the plan
+	// calls themselves, so we just check the floor here.
+	if got := resourceCounts[providerAddr]["aws_instance"]; got < 2 {
+		t.Errorf("wrong aws_instance count for %s: got %d, want at least 2", providerAddr, got)
+	}
+}
+
+func TestContext2Plan_providerCallBudgetExhausted(t *testing.T) {
+	m := testModule(t, "plan-good")
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("aws"): testProviderFuncFixed(p),
+			},
+		),
+		ProviderCallBudgets: map[string]int{
+			"": 1, // plan-good needs two PlanResourceChange calls
+		},
+	})
+
+	_, diags := ctx.Plan()
+	if !diags.HasErrors() {
+		t.Fatal("plan succeeded; want budget exhaustion error")
+	}
+	if got := diags.Err().Error(); !strings.Contains(got, "budget") {
+		t.Errorf("wrong error %q; want budget exhaustion message", got)
+	}
+}
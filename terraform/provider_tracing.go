@@ -0,0 +1,126 @@
+package terraform
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform/providers"
+	"github.com/hashicorp/terraform/tfdiags"
+	"github.com/hashicorp/terraform/tracing"
+)
+
+// tracedProvider wraps a providers.Interface so that every RPC is recorded
+// as a tracing span, parented to the span of the graph walk that made the
+// call. This is what lets an operator see which provider calls a slow
+// operation is spending its time in.
+type tracedProvider struct {
+	inner    providers.Interface
+	addr     string
+	traceCtx context.Context
+}
+
+var _ providers.Interface = (*tracedProvider)(nil)
+
+// span begins the span for one RPC, recording the provider address and,
+// where the call relates to a particular resource, its type.
+func (p *tracedProvider) span(operation, typeName string) *tracing.Span {
+	_, span := tracing.StartSpan(p.traceCtx, "provider."+operation)
+	span.SetAttribute("provider.addr", p.addr)
+	if typeName != "" {
+		span.SetAttribute("resource.type", typeName)
+	}
+	return span
+}
+
+// endSpan finishes an RPC span, marking it as failed if the response
+// carried error diagnostics.
+func endSpan(span *tracing.Span, diags tfdiags.Diagnostics) {
+	if diags.HasErrors() {
+		span.RecordError(diags.Err())
+	}
+	span.End()
+}
+
+func (p *tracedProvider) GetSchema() providers.GetSchemaResponse {
+	span := p.span("GetSchema", "")
+	resp := p.inner.GetSchema()
+	endSpan(span, resp.Diagnostics)
+	return resp
+}
+
+func (p *tracedProvider) PrepareProviderConfig(req providers.PrepareProviderConfigRequest) providers.PrepareProviderConfigResponse {
+	span := p.span("PrepareProviderConfig", "")
+	resp := p.inner.PrepareProviderConfig(req)
+	endSpan(span, resp.Diagnostics)
+	return resp
+}
+
+func (p *tracedProvider) ValidateResourceTypeConfig(req providers.ValidateResourceTypeConfigRequest) providers.ValidateResourceTypeConfigResponse {
+	span := p.span("ValidateResourceTypeConfig", req.TypeName)
+	resp := p.inner.ValidateResourceTypeConfig(req)
+	endSpan(span, resp.Diagnostics)
+	return resp
+}
+
+func (p *tracedProvider) ValidateDataSourceConfig(req providers.ValidateDataSourceConfigRequest) providers.ValidateDataSourceConfigResponse {
+	span := p.span("ValidateDataSourceConfig", req.TypeName)
+	resp := p.inner.ValidateDataSourceConfig(req)
+	endSpan(span, resp.Diagnostics)
+	return resp
+}
+
+func (p *tracedProvider) UpgradeResourceState(req providers.UpgradeResourceStateRequest) providers.UpgradeResourceStateResponse {
+	span := p.span("UpgradeResourceState", req.TypeName)
+	resp := p.inner.UpgradeResourceState(req)
+	endSpan(span, resp.Diagnostics)
+	return resp
+}
+
+func (p *tracedProvider) Configure(req providers.ConfigureRequest) providers.ConfigureResponse {
+	span := p.span("Configure", "")
+	resp := p.inner.Configure(req)
+	endSpan(span, resp.Diagnostics)
+	return resp
+}
+
+func (p *tracedProvider) Stop() error {
+	return p.inner.Stop()
+}
+
+func (p *tracedProvider) ReadResource(req providers.ReadResourceRequest) providers.ReadResourceResponse {
+	span := p.span("ReadResource", req.TypeName)
+	resp := p.inner.ReadResource(req)
+	endSpan(span, resp.Diagnostics)
+	return resp
+}
+
+func (p *tracedProvider) PlanResourceChange(req providers.PlanResourceChangeRequest) providers.PlanResourceChangeResponse {
+	span := p.span("PlanResourceChange", req.TypeName)
+	resp := p.inner.PlanResourceChange(req)
+	endSpan(span, resp.Diagnostics)
+	return resp
+}
+
+func (p *tracedProvider) ApplyResourceChange(req providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeResponse {
+	span := p.span("ApplyResourceChange", req.TypeName)
+	resp := p.inner.ApplyResourceChange(req)
+	endSpan(span, resp.Diagnostics)
+	return resp
+}
+
+func (p *tracedProvider) ImportResourceState(req providers.ImportResourceStateRequest) providers.ImportResourceStateResponse {
+	span := p.span("ImportResourceState", req.TypeName)
+	resp := p.inner.ImportResourceState(req)
+	endSpan(span, resp.Diagnostics)
+	return resp
+}
+
+func (p *tracedProvider) ReadDataSource(req providers.ReadDataSourceRequest) providers.ReadDataSourceResponse {
+	span := p.span("ReadDataSource", req.TypeName)
+	resp := p.inner.ReadDataSource(req)
+	endSpan(span, resp.Diagnostics)
+	return resp
+}
+
+func (p *tracedProvider) Close() error {
+	return p.inner.Close()
+}
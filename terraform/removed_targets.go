@@ -0,0 +1,80 @@
+package terraform
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/configs"
+	"github.com/hashicorp/terraform/tfdiags"
+)
+
+// configRemovedTargets collects the "removed" blocks from the given
+// configuration, for use during the plan walk.
+//
+// A removed block records that a resource was deliberately deleted from the
+// configuration, so its target must _not_ still be declared as a resource;
+// that situation is reported as an error diagnostic here. Removed blocks are
+// also only supported in the root module, since their "from" addresses are
+// absolute.
+func configRemovedTargets(config *configs.Config) ([]*configs.Removed, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+	if config == nil {
+		return nil, diags
+	}
+
+	config.DeepEach(func(c *configs.Config) {
+		if len(c.Module.Removed) != 0 && !c.Path.IsRoot() {
+			diags = diags.Append(tfdiags.Sourceless(
+				tfdiags.Error,
+				"Invalid removed block",
+				fmt.Sprintf("Module %s declares a removed block, but removed blocks are only supported in the root module.", c.Path),
+			))
+		}
+	})
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	if len(config.Module.Removed) == 0 {
+		return nil, diags
+	}
+
+	ret := make([]*configs.Removed, 0, len(config.Module.Removed))
+	for _, rm := range config.Module.Removed {
+		if rm.From == nil {
+			continue // invalid block; already reported during loading
+		}
+
+		var resAddr addrs.Resource
+		var modAddr addrs.ModuleInstance
+		switch subj := rm.From.Subject.(type) {
+		case addrs.AbsResource:
+			resAddr = subj.Resource
+			modAddr = subj.Module
+		case addrs.AbsResourceInstance:
+			resAddr = subj.Resource.Resource
+			modAddr = subj.Module
+		default:
+			continue // module addresses are rejected during loading
+		}
+
+		if modAddr.IsRoot() && config.Module.ResourceByAddr(resAddr) != nil {
+			diags = diags.Append(&hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Removed resource still exists",
+				Detail:   fmt.Sprintf("This removed block asks Terraform to stop managing %s, but that resource is still declared in the configuration. Remove the resource block first, or remove this removed block to keep managing the resource.", rm.From.Subject),
+				Subject:  rm.DeclRange.Ptr(),
+			})
+			continue
+		}
+
+		ret = append(ret, rm)
+	}
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	return ret, diags
+}
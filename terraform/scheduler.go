@@ -0,0 +1,114 @@
+package terraform
+
+import (
+	"container/heap"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform/dag"
+)
+
+// defaultNodeDuration is the estimated duration used for graph nodes that
+// have no recorded timing from a previous run.
+const defaultNodeDuration = time.Second
+
+// prioritySemaphore is a counting semaphore that admits blocked waiters in
+// priority order (highest first) rather than in arrival order. The graph
+// walk uses it to dispatch ready nodes with the longest estimated critical
+// path first, so that slow chains of work get started as early as possible
+// under the parallelism limit.
+type prioritySemaphore struct {
+	mu      sync.Mutex
+	free    int
+	waiters waiterQueue
+}
+
+func newPrioritySemaphore(n int) *prioritySemaphore {
+	return &prioritySemaphore{free: n}
+}
+
+// Acquire blocks until a slot is available. When several callers are
+// blocked, slots are granted to the highest-priority callers first.
+func (s *prioritySemaphore) Acquire(priority int64) {
+	s.mu.Lock()
+	if s.free > 0 {
+		s.free--
+		s.mu.Unlock()
+		return
+	}
+	w := &waiter{priority: priority, ready: make(chan struct{})}
+	heap.Push(&s.waiters, w)
+	s.mu.Unlock()
+
+	<-w.ready
+}
+
+// Release returns a slot, waking the highest-priority waiter if there is
+// one.
+func (s *prioritySemaphore) Release() {
+	s.mu.Lock()
+	if len(s.waiters) > 0 {
+		w := heap.Pop(&s.waiters).(*waiter)
+		s.mu.Unlock()
+		close(w.ready)
+		return
+	}
+	s.free++
+	s.mu.Unlock()
+}
+
+type waiter struct {
+	priority int64
+	ready    chan struct{}
+	index    int
+}
+
+// waiterQueue is a heap of waiters ordered by descending priority.
+type waiterQueue []*waiter
+
+func (q waiterQueue) Len() int            { return len(q) }
+func (q waiterQueue) Less(i, j int) bool  { return q[i].priority > q[j].priority }
+func (q waiterQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i]; q[i].index = i; q[j].index = j }
+func (q *waiterQueue) Push(x interface{}) { w := x.(*waiter); w.index = len(*q); *q = append(*q, w) }
+func (q *waiterQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	w := old[n-1]
+	old[n-1] = nil
+	*q = old[:n-1]
+	return w
+}
+
+// criticalPaths returns, for each vertex in the graph, the estimated
+// duration of the longest chain of work that cannot begin until that vertex
+// has completed, including the vertex's own estimated duration. This is the
+// priority used when dispatching ready vertices during a walk: the vertex
+// with the most work downstream of it goes first.
+func criticalPaths(g *Graph, estimate func(dag.Vertex) time.Duration) map[dag.Vertex]time.Duration {
+	memo := make(map[dag.Vertex]time.Duration, len(g.Vertices()))
+
+	var visit func(v dag.Vertex) time.Duration
+	visit = func(v dag.Vertex) time.Duration {
+		if d, ok := memo[v]; ok {
+			return d
+		}
+
+		// The vertices with an edge _to_ v are the ones that can only run
+		// once v has completed.
+		var longest time.Duration
+		for _, dependent := range g.UpEdges(v).List() {
+			if d := visit(dependent); d > longest {
+				longest = d
+			}
+		}
+
+		d := estimate(v) + longest
+		memo[v] = d
+		return d
+	}
+
+	for _, v := range g.Vertices() {
+		visit(v)
+	}
+	return memo
+}
@@ -0,0 +1,87 @@
+package terraform
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/dag"
+)
+
+func TestPrioritySemaphore(t *testing.T) {
+	s := newPrioritySemaphore(1)
+
+	// Take the only slot so that subsequent acquirers block.
+	s.Acquire(0)
+
+	admitted := make(chan int64, 3)
+	for _, priority := range []int64{1, 3, 2} {
+		priority := priority
+		go func() {
+			s.Acquire(priority)
+			admitted <- priority
+			s.Release()
+		}()
+	}
+
+	// Wait for all three goroutines to be blocked before releasing, so
+	// that the admission order is decided purely by priority.
+	deadline := time.After(5 * time.Second)
+	for {
+		s.mu.Lock()
+		n := len(s.waiters)
+		s.mu.Unlock()
+		if n == 3 {
+			break
+		}
+		select {
+		case <-deadline:
+			t.Fatalf("timed out waiting for goroutines to block; have %d waiters", n)
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+
+	s.Release()
+
+	want := []int64{3, 2, 1}
+	for i, w := range want {
+		select {
+		case got := <-admitted:
+			if got != w {
+				t.Errorf("waiter %d admitted with priority %d; want %d", i, got, w)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for waiter %d to be admitted", i)
+		}
+	}
+}
+
+func TestCriticalPaths(t *testing.T) {
+	// c depends on b depends on a, with d standing alone, so a heads a
+	// three-node chain while d's critical path is just itself.
+	var g Graph
+	g.Add("a")
+	g.Add("b")
+	g.Add("c")
+	g.Add("d")
+	g.Connect(dag.BasicEdge("b", "a"))
+	g.Connect(dag.BasicEdge("c", "b"))
+
+	paths := criticalPaths(&g, func(v dag.Vertex) time.Duration {
+		if v == "b" {
+			return 5 * time.Second
+		}
+		return 1 * time.Second
+	})
+
+	want := map[dag.Vertex]time.Duration{
+		"a": 7 * time.Second,
+		"b": 6 * time.Second,
+		"c": 1 * time.Second,
+		"d": 1 * time.Second,
+	}
+	for v, w := range want {
+		if got := paths[v]; got != w {
+			t.Errorf("wrong critical path %s for %q; want %s", got, v, w)
+		}
+	}
+}
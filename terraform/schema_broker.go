@@ -0,0 +1,188 @@
+package terraform
+
+import (
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/hashicorp/terraform/tfdiags"
+)
+
+// schemaBroker loads provider and provisioner schemas on first request and
+// caches them for any subsequent requests, so that a plugin is launched for
+// its schema only if something actually asks for it.
+//
+// This replaces the previous strategy of eagerly fetching the schema for
+// every provider mentioned in the configuration or state during NewContext,
+// which paid the plugin startup cost even for providers whose objects never
+// appear in the graph being processed, such as state-only providers during
+// a validate walk or providers whose resources are all pruned away by
+// resource targeting before the walk begins.
+//
+// A schemaBroker is safe for concurrent use. Schema loading itself is
+// serialized by the broker's mutex, matching the serial loading behavior of
+// the old eager loader.
+type schemaBroker struct {
+	components contextComponentFactory
+
+	mu           sync.Mutex
+	providers    map[addrs.Provider]*ProviderSchema
+	provisioners map[string]*configschema.Block
+	diags        tfdiags.Diagnostics
+}
+
+func newSchemaBroker(components contextComponentFactory) *schemaBroker {
+	return &schemaBroker{
+		components:   components,
+		providers:    map[addrs.Provider]*ProviderSchema{},
+		provisioners: map[string]*configschema.Block{},
+	}
+}
+
+// ProviderSchema returns the schema for the given provider, loading it from
+// the provider plugin if this is the first request.
+//
+// If the schema cannot be loaded then the error is recorded for later
+// collection by takeDiagnostics and a non-nil empty schema is returned and
+// cached, so that the failure is reported only once no matter how many
+// callers ask for the same schema.
+func (b *schemaBroker) ProviderSchema(fqn addrs.Provider) *ProviderSchema {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if s, exists := b.providers[fqn]; exists {
+		return s
+	}
+
+	// TODO: LegacyString() will be removed in an upcoming release
+	typeName := fqn.LegacyString()
+
+	log.Printf("[TRACE] schemaBroker: retrieving schema for provider type %q", typeName)
+	provider, err := b.components.ResourceProvider(fqn)
+	if err != nil {
+		// We'll put a stub in the map so we won't re-attempt this on
+		// future calls.
+		stub := &ProviderSchema{}
+		b.providers[fqn] = stub
+		b.diags = b.diags.Append(
+			fmt.Errorf("Failed to instantiate provider %q to obtain schema: %s", typeName, err),
+		)
+		return stub
+	}
+	defer func() {
+		provider.Close()
+	}()
+
+	resp := provider.GetSchema()
+	if resp.Diagnostics.HasErrors() {
+		// We'll put a stub in the map so we won't re-attempt this on
+		// future calls.
+		stub := &ProviderSchema{}
+		b.providers[fqn] = stub
+		b.diags = b.diags.Append(
+			fmt.Errorf("Failed to retrieve schema from provider %q: %s", typeName, resp.Diagnostics.Err()),
+		)
+		return stub
+	}
+
+	s := &ProviderSchema{
+		Provider:      resp.Provider.Block,
+		ResourceTypes: make(map[string]*configschema.Block),
+		DataSources:   make(map[string]*configschema.Block),
+
+		ResourceTypeSchemaVersions: make(map[string]uint64),
+
+		Capabilities: resp.Capabilities,
+	}
+
+	if resp.Provider.Version < 0 {
+		// We're not using the version numbers here yet, but we'll check
+		// for validity anyway in case we start using them in future.
+		b.diags = b.diags.Append(
+			fmt.Errorf("invalid negative schema version provider configuration for provider %q", typeName),
+		)
+	}
+
+	for t, r := range resp.ResourceTypes {
+		s.ResourceTypes[t] = r.Block
+		s.ResourceTypeSchemaVersions[t] = uint64(r.Version)
+		if r.Version < 0 {
+			b.diags = b.diags.Append(
+				fmt.Errorf("invalid negative schema version for resource type %s in provider %q", t, typeName),
+			)
+		}
+	}
+
+	for t, d := range resp.DataSources {
+		s.DataSources[t] = d.Block
+		if d.Version < 0 {
+			// We're not using the version numbers here yet, but we'll check
+			// for validity anyway in case we start using them in future.
+			b.diags = b.diags.Append(
+				fmt.Errorf("invalid negative schema version for data source %s in provider %q", t, typeName),
+			)
+		}
+	}
+
+	b.providers[fqn] = s
+	return s
+}
+
+// ProvisionerSchema returns the configuration schema for the given
+// provisioner, loading it from the provisioner plugin if this is the first
+// request. Failures are handled the same way as for ProviderSchema.
+func (b *schemaBroker) ProvisionerSchema(name string) *configschema.Block {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if s, exists := b.provisioners[name]; exists {
+		return s
+	}
+
+	log.Printf("[TRACE] schemaBroker: retrieving schema for provisioner %q", name)
+	provisioner, err := b.components.ResourceProvisioner(name)
+	if err != nil {
+		// We'll put a stub in the map so we won't re-attempt this on
+		// future calls.
+		stub := &configschema.Block{}
+		b.provisioners[name] = stub
+		b.diags = b.diags.Append(
+			fmt.Errorf("Failed to instantiate provisioner %q to obtain schema: %s", name, err),
+		)
+		return stub
+	}
+	defer func() {
+		if closer, ok := provisioner.(ResourceProvisionerCloser); ok {
+			closer.Close()
+		}
+	}()
+
+	resp := provisioner.GetSchema()
+	if resp.Diagnostics.HasErrors() {
+		// We'll put a stub in the map so we won't re-attempt this on
+		// future calls.
+		stub := &configschema.Block{}
+		b.provisioners[name] = stub
+		b.diags = b.diags.Append(
+			fmt.Errorf("Failed to retrieve schema from provisioner %q: %s", name, resp.Diagnostics.Err()),
+		)
+		return stub
+	}
+
+	b.provisioners[name] = resp.Provisioner
+	return resp.Provisioner
+}
+
+// takeDiagnostics returns any diagnostics produced by schema loading since
+// the last call, transferring ownership of them to the caller so that each
+// problem is reported only once.
+func (b *schemaBroker) takeDiagnostics() tfdiags.Diagnostics {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	diags := b.diags
+	b.diags = nil
+	return diags
+}
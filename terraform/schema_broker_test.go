@@ -0,0 +1,141 @@
+package terraform
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/providers"
+	"github.com/hashicorp/terraform/provisioners"
+)
+
+func TestSchemaBroker_providerLazy(t *testing.T) {
+	launches := 0
+	unusedLaunches := 0
+	provider := simpleMockProvider()
+
+	components := &basicComponentFactory{
+		providers: map[addrs.Provider]providers.Factory{
+			addrs.NewLegacyProvider("test"): func() (providers.Interface, error) {
+				launches++
+				return provider, nil
+			},
+			addrs.NewLegacyProvider("unused"): func() (providers.Interface, error) {
+				unusedLaunches++
+				return simpleMockProvider(), nil
+			},
+		},
+	}
+	broker := newSchemaBroker(components)
+
+	// Merely creating the broker must not launch anything; the whole point
+	// is to defer the plugin startup cost until a schema is requested.
+	if launches != 0 || unusedLaunches != 0 {
+		t.Fatalf("%d launches before any schema request; want 0", launches+unusedLaunches)
+	}
+
+	first := broker.ProviderSchema(addrs.NewLegacyProvider("test"))
+	if first == nil {
+		t.Fatal("nil schema from first request")
+	}
+	if _, ok := first.ResourceTypes["test_object"]; !ok {
+		t.Fatal("schema from first request is missing the test_object resource type")
+	}
+	if launches != 1 {
+		t.Fatalf("%d provider launches after first request; want 1", launches)
+	}
+
+	second := broker.ProviderSchema(addrs.NewLegacyProvider("test"))
+	if second != first {
+		t.Fatal("second request returned a different schema object; want the cached one")
+	}
+	if launches != 1 {
+		t.Fatalf("%d provider launches after second request; want 1", launches)
+	}
+
+	// The provider we never asked about must never have been launched.
+	if unusedLaunches != 0 {
+		t.Fatalf("%d launches of the unused provider; want 0", unusedLaunches)
+	}
+
+	// The schema-only launch must not leave the plugin process running.
+	if !provider.CloseCalled {
+		t.Fatal("provider was not closed after its schema was retrieved")
+	}
+
+	if diags := broker.takeDiagnostics(); diags.HasErrors() {
+		t.Fatalf("unexpected diagnostics: %s", diags.Err())
+	}
+}
+
+func TestSchemaBroker_providerError(t *testing.T) {
+	launches := 0
+	components := &basicComponentFactory{
+		providers: map[addrs.Provider]providers.Factory{
+			addrs.NewLegacyProvider("test"): func() (providers.Interface, error) {
+				launches++
+				return nil, errors.New("no plugin for you")
+			},
+		},
+	}
+	broker := newSchemaBroker(components)
+
+	first := broker.ProviderSchema(addrs.NewLegacyProvider("test"))
+	if first == nil {
+		t.Fatal("nil schema after failed load; want a non-nil stub")
+	}
+	if diags := broker.takeDiagnostics(); !diags.HasErrors() {
+		t.Fatal("no diagnostics after failed load")
+	}
+
+	// The failure is cached like a success, so repeated requests neither
+	// retry the launch nor report the same problem again.
+	second := broker.ProviderSchema(addrs.NewLegacyProvider("test"))
+	if second != first {
+		t.Fatal("second request returned a different stub; want the cached one")
+	}
+	if launches != 1 {
+		t.Fatalf("%d launch attempts; want 1", launches)
+	}
+	if diags := broker.takeDiagnostics(); diags.HasErrors() {
+		t.Fatalf("failure reported twice: %s", diags.Err())
+	}
+}
+
+func TestSchemaBroker_provisionerLazy(t *testing.T) {
+	launches := 0
+	unusedLaunches := 0
+
+	components := &basicComponentFactory{
+		provisioners: map[string]ProvisionerFactory{
+			"test": func() (provisioners.Interface, error) {
+				launches++
+				return simpleMockProvisioner(), nil
+			},
+			"unused": func() (provisioners.Interface, error) {
+				unusedLaunches++
+				return simpleMockProvisioner(), nil
+			},
+		},
+	}
+	broker := newSchemaBroker(components)
+
+	first := broker.ProvisionerSchema("test")
+	if first == nil {
+		t.Fatal("nil schema from first request")
+	}
+	second := broker.ProvisionerSchema("test")
+	if second != first {
+		t.Fatal("second request returned a different schema object; want the cached one")
+	}
+	if launches != 1 {
+		t.Fatalf("%d provisioner launches; want 1", launches)
+	}
+	if unusedLaunches != 0 {
+		t.Fatalf("%d launches of the unused provisioner; want 0", unusedLaunches)
+	}
+
+	if diags := broker.takeDiagnostics(); diags.HasErrors() {
+		t.Fatalf("unexpected diagnostics: %s", diags.Err())
+	}
+}
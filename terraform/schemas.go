@@ -17,6 +17,13 @@ import (
 type Schemas struct {
 	Providers    map[addrs.Provider]*ProviderSchema
 	Provisioners map[string]*configschema.Block
+
+	// broker, if set, is consulted for any schema not already present in
+	// the maps above, loading it from the relevant plugin on first use.
+	// When broker is nil the maps are the complete set of known schemas,
+	// which is the case for pre-loaded schema repositories such as those
+	// used in tests.
+	broker *schemaBroker
 }
 
 // ProviderSchema returns the entire ProviderSchema object that was produced
@@ -25,10 +32,13 @@ type Schemas struct {
 // It's usually better to go use the more precise methods offered by type
 // Schemas to handle this detail automatically.
 func (ss *Schemas) ProviderSchema(provider addrs.Provider) *ProviderSchema {
-	if ss.Providers == nil {
-		return nil
+	if s, exists := ss.Providers[provider]; exists {
+		return s
+	}
+	if ss.broker != nil {
+		return ss.broker.ProviderSchema(provider)
 	}
-	return ss.Providers[provider]
+	return nil
 }
 
 // ProviderConfig returns the schema for the provider configuration of the
@@ -61,7 +71,86 @@ func (ss *Schemas) ResourceTypeConfig(provider addrs.Provider, resourceMode addr
 // ProvisionerConfig returns the schema for the configuration of a given
 // provisioner, or nil of no such schema is available.
 func (ss *Schemas) ProvisionerConfig(name string) *configschema.Block {
-	return ss.Provisioners[name]
+	if s, exists := ss.Provisioners[name]; exists {
+		return s
+	}
+	if ss.broker != nil {
+		return ss.broker.ProvisionerSchema(name)
+	}
+	return nil
+}
+
+// pendingDiagnostics returns any diagnostics produced by lazy schema loading
+// since the last call, or nil if schemas were pre-loaded rather than being
+// loaded on demand. Callers that trigger schema loading, such as graph
+// building and walking, should collect these so that loading failures are
+// reported to the user.
+func (ss *Schemas) pendingDiagnostics() tfdiags.Diagnostics {
+	if ss.broker == nil {
+		return nil
+	}
+	return ss.broker.takeDiagnostics()
+}
+
+// allLoaded returns a schema repository equivalent to the receiver but with
+// the schemas for every provider and provisioner used by the given
+// configuration and state already present in the Providers and Provisioners
+// maps, for the benefit of consumers that enumerate the maps directly rather
+// than requesting individual schemas.
+//
+// The result is a separate object so that populating it cannot race with
+// concurrent lookups against the receiver, such as those made during a
+// graph walk.
+func (ss *Schemas) allLoaded(config *configs.Config, state *states.State) *Schemas {
+	if ss.broker == nil {
+		// Without a broker the maps are already the complete set.
+		return ss
+	}
+
+	ret := &Schemas{
+		Providers:    make(map[addrs.Provider]*ProviderSchema, len(ss.Providers)),
+		Provisioners: make(map[string]*configschema.Block, len(ss.Provisioners)),
+		broker:       ss.broker,
+	}
+	for fqn, s := range ss.Providers {
+		ret.Providers[fqn] = s
+	}
+	for name, s := range ss.Provisioners {
+		ret.Provisioners[name] = s
+	}
+
+	ensureProvider := func(fqn addrs.Provider) {
+		if _, exists := ret.Providers[fqn]; !exists {
+			ret.Providers[fqn] = ss.ProviderSchema(fqn)
+		}
+	}
+	var ensureProvisioners func(cfg *configs.Config)
+	ensureProvisioners = func(cfg *configs.Config) {
+		for _, rc := range cfg.Module.ManagedResources {
+			for _, pc := range rc.Managed.Provisioners {
+				if _, exists := ret.Provisioners[pc.Type]; !exists {
+					ret.Provisioners[pc.Type] = ss.ProvisionerConfig(pc.Type)
+				}
+			}
+		}
+		for _, cc := range cfg.Children {
+			ensureProvisioners(cc)
+		}
+	}
+
+	if config != nil {
+		for _, fqn := range config.ProviderTypes() {
+			ensureProvider(fqn)
+		}
+		ensureProvisioners(config)
+	}
+	if state != nil {
+		for _, fqn := range providers.AddressedTypesAbs(state.ProviderAddrs()) {
+			ensureProvider(fqn)
+		}
+	}
+
+	return ret
 }
 
 // LoadSchemas searches the given configuration, state  and plan (any of which
@@ -132,6 +221,8 @@ func loadProviderSchemas(schemas map[addrs.Provider]*ProviderSchema, config *con
 			DataSources:   make(map[string]*configschema.Block),
 
 			ResourceTypeSchemaVersions: make(map[string]uint64),
+
+			Capabilities: resp.Capabilities,
 		}
 
 		if resp.Provider.Version < 0 {
@@ -250,6 +341,11 @@ type ProviderSchema struct {
 	DataSources   map[string]*configschema.Block
 
 	ResourceTypeSchemaVersions map[string]uint64
+
+	// Capabilities describes the optional protocol features the provider
+	// supports, as negotiated at plugin handshake time. Graph nodes consult
+	// these to choose between legacy shims and newer request flows.
+	Capabilities providers.Capabilities
 }
 
 // SchemaForResourceType attempts to find a schema for the given mode and type.
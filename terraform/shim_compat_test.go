@@ -0,0 +1,301 @@
+package terraform
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/hashicorp/terraform/configs/hcl2shim"
+	"github.com/hashicorp/terraform/flatmap"
+	"github.com/hashicorp/terraform/plans"
+	"github.com/hashicorp/terraform/providers"
+	"github.com/hashicorp/terraform/states"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// This file contains a compatibility matrix for the legacy ResourceProvider
+// shims: each case describes one logical provider behavior and runs it
+// through both the legacy DiffFn/ApplyFn path (which exercises
+// InstanceDiff.ApplyToValue and the flatmap conversions) and the native
+// providers.Interface path, asserting that plan and apply produce identical
+// outcomes. Divergence here means a remaining shim bug that must be fixed
+// before the legacy layer can be removed.
+
+// shimCompatSchema is the schema shared by both provider implementations in
+// the matrix, covering the value kinds most affected by flatmap shimming:
+// primitives, lists and maps.
+var shimCompatSchema = &configschema.Block{
+	Attributes: map[string]*configschema.Attribute{
+		"id":    {Type: cty.String, Computed: true},
+		"ami":   {Type: cty.String, Optional: true},
+		"ports": {Type: cty.List(cty.String), Optional: true},
+		"tags":  {Type: cty.Map(cty.String), Optional: true},
+	},
+}
+
+// shimCompatCase is one entry in the compatibility matrix: a configuration
+// and optional prior state that must plan and apply to the same outcome
+// through both provider code paths.
+type shimCompatCase struct {
+	name   string
+	config string
+	state  *State
+}
+
+var shimCompatCases = []shimCompatCase{
+	{
+		name: "create",
+		config: `
+resource "compat_object" "a" {
+  ami   = "ami-123"
+  ports = ["80", "443"]
+  tags = {
+    env = "test"
+  }
+}
+`,
+	},
+	{
+		name: "update in place",
+		config: `
+resource "compat_object" "a" {
+  ami   = "ami-new"
+  ports = ["80", "443"]
+  tags = {
+    env = "test"
+  }
+}
+`,
+		state: shimCompatPriorState(map[string]string{
+			"id":       "existing",
+			"ami":      "ami-old",
+			"ports.#":  "2",
+			"ports.0":  "80",
+			"ports.1":  "443",
+			"tags.%":   "1",
+			"tags.env": "test",
+		}),
+	},
+	{
+		name: "no changes",
+		config: `
+resource "compat_object" "a" {
+  ami   = "ami-123"
+  ports = ["80"]
+}
+`,
+		state: shimCompatPriorState(map[string]string{
+			"id":      "existing",
+			"ami":     "ami-123",
+			"ports.#": "1",
+			"ports.0": "80",
+		}),
+	},
+	{
+		name: "update collections",
+		config: `
+resource "compat_object" "a" {
+  ports = ["80", "8080"]
+  tags = {
+    env  = "prod"
+    team = "infra"
+  }
+}
+`,
+		state: shimCompatPriorState(map[string]string{
+			"id":       "existing",
+			"ports.#":  "2",
+			"ports.0":  "80",
+			"ports.1":  "443",
+			"tags.%":   "1",
+			"tags.env": "test",
+		}),
+	},
+	{
+		name: "ignore_changes",
+		config: `
+resource "compat_object" "a" {
+  ami = "ami-new"
+
+  lifecycle {
+    ignore_changes = [ami]
+  }
+}
+`,
+		state: shimCompatPriorState(map[string]string{
+			"id":  "existing",
+			"ami": "ami-old",
+		}),
+	},
+}
+
+func TestShimCompat_matrix(t *testing.T) {
+	for _, tc := range shimCompatCases {
+		t.Run(tc.name, func(t *testing.T) {
+			legacyActions, legacyState := runShimCompatCase(t, tc, shimCompatLegacyProvider())
+			nativeActions, nativeState := runShimCompatCase(t, tc, shimCompatNativeProvider())
+
+			if len(legacyActions) != len(nativeActions) {
+				t.Fatalf("plan mismatch\nlegacy: %#v\nnative: %#v", legacyActions, nativeActions)
+			}
+			for addr, legacyAction := range legacyActions {
+				if nativeAction, ok := nativeActions[addr]; !ok || nativeAction != legacyAction {
+					t.Errorf("wrong action for %s\nlegacy: %s\nnative: %s", addr, legacyAction, nativeAction)
+				}
+			}
+
+			if got, want := nativeState.String(), legacyState.String(); got != want {
+				t.Errorf("state mismatch\nlegacy:\n%s\nnative:\n%s", want, got)
+			}
+		})
+	}
+}
+
+// runShimCompatCase plans and applies the given case's configuration with
+// the given provider, returning the planned action for each resource
+// instance and the final state.
+func runShimCompatCase(t *testing.T, tc shimCompatCase, p *MockProvider) (map[string]plans.Action, *states.State) {
+	t.Helper()
+
+	m := testModuleInline(t, map[string]string{"main.tf": tc.config})
+
+	var state *states.State
+	if tc.state != nil {
+		state = MustShimLegacyState(tc.state)
+	}
+
+	ctx := testContext2(t, &ContextOpts{
+		Config: m,
+		ProviderResolver: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("compat"): testProviderFuncFixed(p),
+			},
+		),
+		State: state,
+	})
+
+	plan, diags := ctx.Plan()
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors during plan: %s", diags.Err())
+	}
+
+	actions := make(map[string]plans.Action)
+	for _, rc := range plan.Changes.Resources {
+		actions[rc.Addr.String()] = rc.Action
+	}
+
+	newState, diags := ctx.Apply()
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors during apply: %s", diags.Err())
+	}
+
+	return actions, newState
+}
+
+// shimCompatLegacyProvider implements the matrix's logical behavior in the
+// old ResourceProvider style, forcing MockProvider to route plan and apply
+// through the InstanceDiff and flatmap shims.
+func shimCompatLegacyProvider() *MockProvider {
+	p := new(MockProvider)
+	p.GetSchemaReturn = &ProviderSchema{
+		ResourceTypes: map[string]*configschema.Block{
+			"compat_object": shimCompatSchema,
+		},
+	}
+	p.DiffFn = func(info *InstanceInfo, s *InstanceState, c *ResourceConfig) (*InstanceDiff, error) {
+		diff := &InstanceDiff{Attributes: make(map[string]*ResourceAttrDiff)}
+
+		flat := flatmap.Flatten(c.Config)
+		// flatmap.Flatten doesn't generate the count entries for maps that
+		// the rest of the flatmap machinery expects, so add those here.
+		for k, v := range c.Config {
+			if m, ok := v.(map[string]interface{}); ok {
+				flat[k+".%"] = strconv.Itoa(len(m))
+			}
+		}
+
+		for k, new := range flat {
+			old := s.Attributes[k]
+			if old == new {
+				continue
+			}
+			diff.Attributes[k] = &ResourceAttrDiff{Old: old, New: new}
+		}
+		if s.ID == "" {
+			diff.Attributes["id"] = &ResourceAttrDiff{NewComputed: true}
+		}
+		return diff, nil
+	}
+	p.ApplyFn = func(info *InstanceInfo, s *InstanceState, d *InstanceDiff) (*InstanceState, error) {
+		if d.Destroy {
+			return nil, nil
+		}
+		result := s.MergeDiff(d)
+		if result.Attributes["id"] == "" || result.Attributes["id"] == hcl2shim.UnknownVariableValue {
+			result.Attributes["id"] = "compat-id"
+		}
+		result.ID = result.Attributes["id"]
+		return result, nil
+	}
+	return p
+}
+
+// shimCompatNativeProvider implements the same logical behavior as
+// shimCompatLegacyProvider directly against the new providers.Interface
+// request types, with no flatmap involvement.
+func shimCompatNativeProvider() *MockProvider {
+	p := new(MockProvider)
+	p.GetSchemaReturn = &ProviderSchema{
+		ResourceTypes: map[string]*configschema.Block{
+			"compat_object": shimCompatSchema,
+		},
+	}
+	p.PlanResourceChangeFn = func(req providers.PlanResourceChangeRequest) (resp providers.PlanResourceChangeResponse) {
+		if req.ProposedNewState.IsNull() {
+			resp.PlannedState = req.ProposedNewState
+			return resp
+		}
+		planned := req.ProposedNewState.AsValueMap()
+		if planned["id"].IsNull() {
+			planned["id"] = cty.UnknownVal(cty.String)
+		}
+		resp.PlannedState = cty.ObjectVal(planned)
+		return resp
+	}
+	p.ApplyResourceChangeFn = func(req providers.ApplyResourceChangeRequest) (resp providers.ApplyResourceChangeResponse) {
+		if req.PlannedState.IsNull() {
+			resp.NewState = req.PlannedState
+			return resp
+		}
+		vals := req.PlannedState.AsValueMap()
+		if !vals["id"].IsKnown() {
+			vals["id"] = cty.StringVal("compat-id")
+		}
+		resp.NewState = cty.ObjectVal(vals)
+		return resp
+	}
+	return p
+}
+
+// shimCompatPriorState builds a single-instance prior state for
+// compat_object.a with the given legacy flatmap attributes.
+func shimCompatPriorState(attrs map[string]string) *State {
+	return &State{
+		Modules: []*ModuleState{
+			&ModuleState{
+				Path: rootModulePath,
+				Resources: map[string]*ResourceState{
+					"compat_object.a": &ResourceState{
+						Type:     "compat_object",
+						Provider: "provider.compat",
+						Primary: &InstanceState{
+							ID:         attrs["id"],
+							Attributes: attrs,
+						},
+					},
+				},
+			},
+		},
+	}
+}
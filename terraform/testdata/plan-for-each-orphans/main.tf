@@ -0,0 +1,7 @@
+resource "aws_instance" "foo" {
+    for_each = {
+        a = "thing"
+        b = "another thing"
+    }
+    num = "3"
+}
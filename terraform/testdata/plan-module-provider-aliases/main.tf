@@ -0,0 +1,11 @@
+provider "aws" {
+    alias = "us_east_1"
+    foo   = "east"
+}
+
+module "child" {
+    source = "./child"
+    providers = {
+        aws.dns = aws.us_east_1
+    }
+}
@@ -0,0 +1,8 @@
+resource "aws_instance" "bar" {
+    num = "2"
+}
+
+moved {
+    from = aws_instance.foo
+    to   = aws_instance.bar
+}
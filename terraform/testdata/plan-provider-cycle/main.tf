@@ -0,0 +1,5 @@
+provider "aws" {
+    foo = aws_instance.foo.id
+}
+
+resource "aws_instance" "foo" {}
@@ -0,0 +1,9 @@
+resource "aws_instance" "foo" {
+    num = "2"
+}
+
+resource "aws_instance" "bar" {
+    provisioner "shell" {
+        command = aws_instance.foo.id
+    }
+}
@@ -0,0 +1,9 @@
+resource "aws_instance" "foo" {
+  connection {
+    host    = "127.0.0.1"
+    timeout = "not a duration"
+  }
+  provisioner "shell" {
+    test_string = "test"
+  }
+}
@@ -0,0 +1,51 @@
+package terraform
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/configs"
+)
+
+// CheckTransformer is a GraphTransformer that adds all of the "check"
+// blocks in the configuration to the graph, so that their assertions can
+// be evaluated once the objects they refer to have been applied.
+type CheckTransformer struct {
+	Config *configs.Config
+
+	// Destroy is set on a full destroy operation, in which case checks are
+	// skipped entirely since the objects they describe are going away.
+	Destroy bool
+}
+
+func (t *CheckTransformer) Transform(g *Graph) error {
+	if t.Destroy {
+		return nil
+	}
+	return t.transform(g, t.Config)
+}
+
+func (t *CheckTransformer) transform(g *Graph, c *configs.Config) error {
+	// If we have no config then there can be no checks.
+	if c == nil {
+		return nil
+	}
+
+	for _, cc := range c.Children {
+		if err := t.transform(g, cc); err != nil {
+			return err
+		}
+	}
+
+	for _, chk := range c.Module.Checks {
+		node := &nodeCheckPlannable{
+			Addr:   addrs.Check{Name: chk.Name},
+			Module: c.Path,
+			Config: chk,
+		}
+		log.Printf("[TRACE] CheckTransformer: adding %s as %T", chk.Name, node)
+		g.Add(node)
+	}
+
+	return nil
+}
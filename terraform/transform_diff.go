@@ -66,7 +66,7 @@ func (t *DiffTransformer) Transform(g *Graph) error {
 		switch rc.Action {
 		case plans.NoOp:
 			continue
-		case plans.Delete:
+		case plans.Delete, plans.Forget:
 			delete = true
 		case plans.DeleteThenCreate, plans.CreateThenDelete:
 			update = true
@@ -0,0 +1,139 @@
+package terraform
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/dag"
+)
+
+// ExcludeTransformer is a GraphTransformer that, when the user specifies a
+// list of resources to exclude, removes those resources from the graph along
+// with anything that depends on them, since those dependents could not be
+// processed correctly with their dependencies missing.
+//
+// This is the inverse of TargetsTransformer: targeting selects a subset of
+// the graph to keep, while exclusion selects a subset to drop.
+type ExcludeTransformer struct {
+	// List of excluded resource names specified by the user
+	Excludes []addrs.Targetable
+
+	// If set, the index portions of resource addresses will be ignored
+	// for comparison. This is used when transforming a graph where
+	// counted resources have not yet been expanded, since otherwise
+	// the unexpanded nodes (which never have indices) would not match.
+	IgnoreIndices bool
+
+	// Set to true when we're in a `terraform destroy` or a
+	// `terraform plan -destroy`
+	Destroy bool
+}
+
+func (t *ExcludeTransformer) Transform(g *Graph) error {
+	if len(t.Excludes) == 0 {
+		return nil
+	}
+
+	excludedNodes, directNodes, err := t.selectExcludedNodes(g, t.Excludes)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range g.Vertices() {
+		removable := false
+		if _, ok := v.(GraphNodeResource); ok {
+			removable = true
+		}
+
+		if vr, ok := v.(RemovableIfNotTargeted); ok {
+			removable = vr.RemoveIfNotTargeted()
+		}
+
+		if !removable || !excludedNodes.Include(v) {
+			continue
+		}
+
+		if directNodes.Include(v) {
+			log.Printf("[WARN] Removing %q, excluded by request.", dag.VertexName(v))
+		} else {
+			log.Printf("[WARN] Removing %q because it depends on an excluded node.", dag.VertexName(v))
+		}
+		g.Remove(v)
+	}
+
+	return nil
+}
+
+// selectExcludedNodes returns the set of nodes to remove from the graph,
+// along with the subset of those that were addressed directly. An excluded
+// node is either addressed directly, addressed indirectly via its container,
+// or a dependent of an excluded node. Destroy mode removes dependencies
+// instead of dependents, matching the inverted edges of destroy graphs.
+func (t *ExcludeTransformer) selectExcludedNodes(g *Graph, addrs []addrs.Targetable) (dag.Set, dag.Set, error) {
+	excludedNodes := make(dag.Set)
+	directNodes := make(dag.Set)
+
+	for _, v := range g.Vertices() {
+		if !vertexMatchesAnyTargetable(v, addrs, t.IgnoreIndices) {
+			continue
+		}
+		directNodes.Add(v)
+		excludedNodes.Add(v)
+
+		var deps dag.Set
+		var err error
+		if t.Destroy {
+			deps, err = g.Ancestors(v)
+		} else {
+			deps, err = g.Descendents(v)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, d := range deps {
+			excludedNodes.Add(d)
+		}
+	}
+
+	return excludedNodes, directNodes, nil
+}
+
+// vertexMatchesAnyTargetable returns true if the given vertex represents a
+// resource or resource instance covered by any of the given target addresses.
+// This is the matching logic shared by TargetsTransformer and
+// ExcludeTransformer.
+func vertexMatchesAnyTargetable(v dag.Vertex, targets []addrs.Targetable, ignoreIndices bool) bool {
+	var vertexAddr addrs.Targetable
+	switch r := v.(type) {
+	case GraphNodeResourceInstance:
+		vertexAddr = r.ResourceInstanceAddr()
+	case GraphNodeResource:
+		vertexAddr = r.ResourceAddr()
+	default:
+		// Only resource and resource instance nodes can be targeted.
+		return false
+	}
+	_, ok := v.(GraphNodeResource)
+	if !ok {
+		return false
+	}
+
+	for _, targetAddr := range targets {
+		if ignoreIndices {
+			// If we're ignoring indices then we'll convert any resource instance
+			// addresses into resource addresses. We don't need to convert
+			// vertexAddr because instance addresses are contained within
+			// their associated resources, and so .TargetContains will take
+			// care of this for us.
+			if instance, isInstance := targetAddr.(addrs.AbsResourceInstance); isInstance {
+				targetAddr = instance.ContainingResource()
+			}
+		}
+		if targetAddr.TargetContains(vertexAddr) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -3,6 +3,8 @@ package terraform
 import (
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/terraform/addrs"
@@ -71,6 +73,12 @@ type GraphNodeProviderConsumer interface {
 	SetProvider(addrs.AbsProviderConfig)
 }
 
+// OrphanedProviderConfigDiagCode is the stable machine-readable code
+// attached to diagnostics about objects in state whose provider
+// configuration is no longer present in the configuration, so that
+// automation can recognize this situation without matching on message text.
+const OrphanedProviderConfigDiagCode = "orphaned-provider-config"
+
 // ProviderTransformer is a GraphTransformer that maps resources to
 // providers within the graph. This will error if there are any resources
 // that don't map to proper resources.
@@ -86,6 +94,12 @@ func (t *ProviderTransformer) Transform(g *Graph) error {
 
 	var diags tfdiags.Diagnostics
 
+	// Collects the resources that need a provider configuration which no
+	// longer exists, keyed by the missing configuration's address, so that
+	// we can report all of them together in one diagnostic per provider
+	// configuration rather than one opaque error per resource.
+	orphaned := map[string][]string{}
+
 	// To start, we'll collect the _requested_ provider addresses for each
 	// node, which we'll then resolve (handling provider inheritence, etc) in
 	// the next step.
@@ -114,6 +128,18 @@ func (t *ProviderTransformer) Transform(g *Graph) error {
 			if exact {
 				log.Printf("[TRACE] ProviderTransformer: %s is provided by %s exactly", dag.VertexName(v), p)
 			} else {
+				// Non-exact addresses were guessed from a local name alone,
+				// so prefer the declared required_providers mapping of the
+				// module the address belongs to when we have configuration
+				// available to consult.
+				if t.Config != nil {
+					if mc := t.Config.DescendentForInstance(p.Module); mc != nil {
+						p.Provider = mc.Module.ProviderForLocalConfig(addrs.LocalProviderConfig{
+							LocalName: p.Provider.Type,
+							Alias:     p.Alias,
+						})
+					}
+				}
 				log.Printf("[TRACE] ProviderTransformer: %s is provided by %s or inherited equivalent", dag.VertexName(v), p)
 			}
 
@@ -181,14 +207,7 @@ func (t *ProviderTransformer) Transform(g *Graph) error {
 			}
 
 			if target == nil {
-				diags = diags.Append(tfdiags.Sourceless(
-					tfdiags.Error,
-					"Provider configuration not present",
-					fmt.Sprintf(
-						"To work with %s its original provider configuration at %s is required, but it has been removed. This occurs when a provider configuration is removed while objects created by that provider still exist in the state. Re-add the provider configuration to destroy %s, after which you can remove the provider configuration again.",
-						dag.VertexName(v), p, dag.VertexName(v),
-					),
-				))
+				orphaned[p.String()] = append(orphaned[p.String()], dag.VertexName(v))
 				break
 			}
 
@@ -207,6 +226,32 @@ func (t *ProviderTransformer) Transform(g *Graph) error {
 		}
 	}
 
+	// Report each missing provider configuration once, naming every resource
+	// that still requires it, so that the user can see the full scope of the
+	// problem and decide how to resolve it.
+	providerKeys := make([]string, 0, len(orphaned))
+	for key := range orphaned {
+		providerKeys = append(providerKeys, key)
+	}
+	sort.Strings(providerKeys)
+	for _, key := range providerKeys {
+		resources := orphaned[key]
+		sort.Strings(resources)
+		var buf strings.Builder
+		for _, addr := range resources {
+			fmt.Fprintf(&buf, "  - %s\n", addr)
+		}
+		diags = diags.Append(tfdiags.SourcelessWithCode(
+			tfdiags.Error,
+			OrphanedProviderConfigDiagCode,
+			"Provider configuration not present",
+			fmt.Sprintf(
+				"The following objects in state require the provider configuration %s, which is no longer present in the configuration:\n%sThis occurs when a provider configuration is removed while objects created by that provider still exist in the state. Re-add the provider configuration to destroy these objects, after which you can remove the provider configuration again. Alternatively, if the remote objects have already been deleted or should no longer be managed by Terraform, use \"terraform state rm\" to remove them from the state.",
+				key, buf.String(),
+			),
+		))
+	}
+
 	return diags.Err()
 }
 
@@ -214,6 +259,12 @@ func (t *ProviderTransformer) Transform(g *Graph) error {
 // graph that will close open provider connections that aren't needed anymore.
 // A provider connection is not needed anymore once all depended resources
 // in the graph are evaluated.
+//
+// Because each close node depends only on its own provider's consumers, the
+// walk releases each provider process as soon as the last of its resources
+// finishes, rather than holding every provider open until the very end of
+// the walk. This matters most during large destroys involving many
+// providers with long-lived connections.
 type CloseProviderTransformer struct{}
 
 func (t *CloseProviderTransformer) Transform(g *Graph) error {
@@ -704,8 +755,10 @@ func (t *ProviderConfigTransformer) addProxyProviders(g *Graph, c *configs.Confi
 			continue
 		}
 
-		// aliased configurations can't be implicitly passed in
-		if fullAddr.Alias != "" {
+		// Aliased configurations can't be implicitly passed in, unless the
+		// child module explicitly declared the alias via the
+		// configuration_aliases argument in its required_providers.
+		if fullAddr.Alias != "" && !c.Module.DeclaresConfigurationAlias(pair.InChild.Addr()) {
 			continue
 		}
 
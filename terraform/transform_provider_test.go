@@ -173,6 +173,60 @@ func TestCloseProviderTransformer_withTargets(t *testing.T) {
 	}
 }
 
+func TestCloseProviderTransformer_multipleProviders(t *testing.T) {
+	// Each provider's close node must depend only on that provider's own
+	// consumers, so that a long-lived provider process can be released as
+	// soon as the last of its resources finishes rather than at the very
+	// end of the walk.
+	mod := testModule(t, "transform-provider-close-multi")
+
+	g := Graph{Path: addrs.RootModuleInstance}
+	transforms := []GraphTransformer{
+		&ConfigTransformer{Config: mod},
+		&AttachResourceConfigTransformer{Config: mod},
+		&MissingProviderTransformer{Providers: []string{"aws", "foo"}},
+		&ProviderTransformer{},
+		&CloseProviderTransformer{},
+	}
+	for _, tr := range transforms {
+		if err := tr.Transform(&g); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+
+	closers := map[string]dag.Vertex{}
+	for _, v := range g.Vertices() {
+		if c, ok := v.(*graphNodeCloseProvider); ok {
+			closers[c.Addr.Provider.Type] = v
+		}
+	}
+	if len(closers) != 2 {
+		t.Fatalf("wrong number of close nodes %d; want 2", len(closers))
+	}
+
+	dependsOn := func(v dag.Vertex, name string) bool {
+		for _, dep := range g.DownEdges(v) {
+			if dag.VertexName(dep) == name {
+				return true
+			}
+		}
+		return false
+	}
+
+	if !dependsOn(closers["aws"], "aws_instance.web") {
+		t.Errorf("aws close node does not depend on aws_instance.web")
+	}
+	if dependsOn(closers["aws"], "foo_instance.db") {
+		t.Errorf("aws close node depends on foo_instance.db, so the aws provider cannot close until foo resources finish")
+	}
+	if !dependsOn(closers["foo"], "foo_instance.db") {
+		t.Errorf("foo close node does not depend on foo_instance.db")
+	}
+	if dependsOn(closers["foo"], "aws_instance.web") {
+		t.Errorf("foo close node depends on aws_instance.web, so the foo provider cannot close until aws resources finish")
+	}
+}
+
 func TestMissingProviderTransformer(t *testing.T) {
 	mod := testModule(t, "transform-provider-missing")
 
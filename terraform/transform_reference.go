@@ -42,7 +42,7 @@ type GraphNodeReferencer interface {
 
 type GraphNodeAttachDependencies interface {
 	GraphNodeResource
-	AttachDependencies([]addrs.AbsResource)
+	AttachDependencies([]addrs.ConfigResource)
 }
 
 // GraphNodeReferenceOutside is an interface that can optionally be implemented.
@@ -135,16 +135,16 @@ func (t AttachDependenciesTransformer) Transform(g *Graph) error {
 
 		// dedupe addrs when there's multiple instances involved, or
 		// multiple paths in the un-reduced graph
-		depMap := map[string]addrs.AbsResource{}
+		depMap := map[string]addrs.ConfigResource{}
 		for _, d := range ans {
-			var addr addrs.AbsResource
+			var addr addrs.ConfigResource
 
 			switch d := d.(type) {
 			case GraphNodeResourceInstance:
 				instAddr := d.ResourceInstanceAddr()
-				addr = instAddr.Resource.Resource.Absolute(instAddr.Module)
+				addr = instAddr.ContainingResource().Config()
 			case GraphNodeResource:
-				addr = d.ResourceAddr()
+				addr = d.ResourceAddr().Config()
 			default:
 				continue
 			}
@@ -154,13 +154,13 @@ func (t AttachDependenciesTransformer) Transform(g *Graph) error {
 				continue
 			}
 
-			if addr.Equal(selfAddr) {
+			if addr.Equal(selfAddr.Config()) {
 				continue
 			}
 			depMap[addr.String()] = addr
 		}
 
-		deps := make([]addrs.AbsResource, 0, len(depMap))
+		deps := make([]addrs.ConfigResource, 0, len(depMap))
 		for _, d := range depMap {
 			deps = append(deps, d)
 		}
@@ -2,9 +2,11 @@ package terraform
 
 import (
 	"log"
+	"sort"
 
 	"github.com/hashicorp/terraform/addrs"
 	"github.com/hashicorp/terraform/dag"
+	"github.com/hashicorp/terraform/plans"
 )
 
 // GraphNodeTargetable is an interface for graph nodes to implement when they
@@ -47,6 +49,11 @@ type TargetsTransformer struct {
 	// Set to true when we're in a `terraform destroy` or a
 	// `terraform plan -destroy`
 	Destroy bool
+
+	// Report, if non-nil, is populated with the resources that targeting
+	// implicitly included or excluded, so that callers can surface the
+	// effect of a partial operation to the user.
+	Report *plans.TargetingReport
 }
 
 func (t *TargetsTransformer) Transform(g *Graph) error {
@@ -56,6 +63,9 @@ func (t *TargetsTransformer) Transform(g *Graph) error {
 			return err
 		}
 
+		included := make(map[string]addrs.AbsResource)
+		excluded := make(map[string]addrs.AbsResource)
+
 		for _, v := range g.Vertices() {
 			removable := false
 			if _, ok := v.(GraphNodeResource); ok {
@@ -68,14 +78,50 @@ func (t *TargetsTransformer) Transform(g *Graph) error {
 
 			if removable && !targetedNodes.Include(v) {
 				log.Printf("[DEBUG] Removing %q, filtered by targeting.", dag.VertexName(v))
+				if rn, ok := v.(GraphNodeResource); ok && t.Report != nil {
+					addr := rn.ResourceAddr()
+					excluded[addr.String()] = addr
+				}
 				g.Remove(v)
+				continue
 			}
+
+			if t.Report != nil && targetedNodes.Include(v) && !t.nodeIsTarget(v, t.Targets) {
+				if rn, ok := v.(GraphNodeResource); ok {
+					addr := rn.ResourceAddr()
+					included[addr.String()] = addr
+				}
+			}
+		}
+
+		if t.Report != nil {
+			t.Report.ImplicitlyIncluded = sortedResourceAddrs(included)
+			t.Report.Excluded = sortedResourceAddrs(excluded)
 		}
 	}
 
 	return nil
 }
 
+// sortedResourceAddrs flattens a set of resource addresses keyed by their
+// string representations into a slice sorted by those strings, for a
+// stable presentation order.
+func sortedResourceAddrs(set map[string]addrs.AbsResource) []addrs.AbsResource {
+	if len(set) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	ret := make([]addrs.AbsResource, len(keys))
+	for i, k := range keys {
+		ret[i] = set[k]
+	}
+	return ret
+}
+
 // Returns a set of targeted nodes. A targeted node is either addressed
 // directly, address indirectly via its container, or it's a dependency of a
 // targeted node. Destroy mode keeps dependents instead of dependencies.
@@ -221,38 +267,7 @@ func filterPartialOutputs(v interface{}, targetedNodes dag.Set, g *Graph) bool {
 }
 
 func (t *TargetsTransformer) nodeIsTarget(v dag.Vertex, targets []addrs.Targetable) bool {
-	var vertexAddr addrs.Targetable
-	switch r := v.(type) {
-	case GraphNodeResourceInstance:
-		vertexAddr = r.ResourceInstanceAddr()
-	case GraphNodeResource:
-		vertexAddr = r.ResourceAddr()
-	default:
-		// Only resource and resource instance nodes can be targeted.
-		return false
-	}
-	_, ok := v.(GraphNodeResource)
-	if !ok {
-		return false
-	}
-
-	for _, targetAddr := range targets {
-		if t.IgnoreIndices {
-			// If we're ignoring indices then we'll convert any resource instance
-			// addresses into resource addresses. We don't need to convert
-			// vertexAddr because instance addresses are contained within
-			// their associated resources, and so .TargetContains will take
-			// care of this for us.
-			if instance, isInstance := targetAddr.(addrs.AbsResourceInstance); isInstance {
-				targetAddr = instance.ContainingResource()
-			}
-		}
-		if targetAddr.TargetContains(vertexAddr) {
-			return true
-		}
-	}
-
-	return false
+	return vertexMatchesAnyTargetable(v, targets, t.IgnoreIndices)
 }
 
 // RemovableIfNotTargeted is a special interface for graph nodes that
@@ -0,0 +1,46 @@
+package terraform
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/states"
+	"github.com/hashicorp/terraform/states/upgrade"
+	"github.com/hashicorp/terraform/tfdiags"
+)
+
+// UpgradeLegacyState translates a legacy state (terraform.State) into the
+// current states.State representation, additionally completing the upgrade
+// of any resource instance attributes that are still stored in the legacy
+// flatmap form.
+//
+// The given schemas must include a schema for every provider referenced by
+// resources in the state, since the flatmap attribute format does not carry
+// enough type information to decode without a schema. Instances whose
+// attributes cannot be decoded are left in their legacy form and reported
+// in the returned diagnostics, so the caller can decide whether to proceed
+// with a partially-upgraded state.
+//
+// Unlike ShimLegacyState, this function is intended for use on real user
+// states, e.g. by the command layer when it encounters a version 3 or older
+// state file, rather than only for constructing test fixtures. The flatmap
+// conversion itself lives in the states/upgrade package, which can also be
+// used directly by callers that already have a states.State in hand.
+func UpgradeLegacyState(legacy *State, schemas *Schemas) (*states.State, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	state, err := ShimLegacyState(legacy)
+	if err != nil {
+		diags = diags.Append(tfdiags.Sourceless(
+			tfdiags.Error,
+			"Failed to upgrade state",
+			fmt.Sprintf("The state could not be converted to the current internal format: %s.", err),
+		))
+		return nil, diags
+	}
+	if state == nil {
+		return nil, diags
+	}
+
+	diags = diags.Append(upgrade.State(state, schemas))
+	return state, diags
+}
@@ -0,0 +1,83 @@
+package terraform
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/addrs"
+)
+
+func TestUpgradeLegacyState(t *testing.T) {
+	legacy := &State{
+		Modules: []*ModuleState{
+			&ModuleState{
+				Path: rootModulePath,
+				Resources: map[string]*ResourceState{
+					"test_object.foo": &ResourceState{
+						Type: "test_object",
+						Primary: &InstanceState{
+							ID: "foo",
+							Attributes: map[string]string{
+								"test_string": "hello",
+							},
+						},
+						Provider: "provider.test",
+					},
+				},
+			},
+		},
+	}
+
+	state, diags := UpgradeLegacyState(legacy, simpleTestSchemas())
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+
+	instAddr := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_object",
+		Name: "foo",
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+
+	is := state.ResourceInstance(instAddr)
+	if is == nil || is.Current == nil {
+		t.Fatalf("no state for %s after upgrade", instAddr)
+	}
+	obj := is.Current
+	if obj.AttrsFlat != nil {
+		t.Errorf("instance still has legacy flatmap attributes after upgrade")
+	}
+	if obj.AttrsJSON == nil {
+		t.Fatalf("instance has no JSON attributes after upgrade")
+	}
+	if got, want := string(obj.AttrsJSON), `"test_string":"hello"`; !strings.Contains(got, want) {
+		t.Errorf("wrong JSON attributes after upgrade\ngot:  %s\nwant substring: %s", got, want)
+	}
+}
+
+func TestUpgradeLegacyState_missingSchema(t *testing.T) {
+	legacy := &State{
+		Modules: []*ModuleState{
+			&ModuleState{
+				Path: rootModulePath,
+				Resources: map[string]*ResourceState{
+					"test_discontinued.foo": &ResourceState{
+						Type: "test_discontinued",
+						Primary: &InstanceState{
+							ID: "foo",
+							Attributes: map[string]string{
+								"anything": "goes",
+							},
+						},
+						Provider: "provider.test",
+					},
+				},
+			},
+		},
+	}
+
+	_, diags := UpgradeLegacyState(legacy, simpleTestSchemas())
+	if !diags.HasErrors() {
+		t.Fatalf("succeeded; want error about missing schema")
+	}
+}
@@ -2,6 +2,7 @@ package terraform
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/hcl/v2"
 	"github.com/zclconf/go-cty/cty"
@@ -227,6 +228,24 @@ func (vv InputValues) Identical(other InputValues) bool {
 	return true
 }
 
+// ConflictingValues returns the names of the variables that are defined in
+// both the receiver and the given other set of values but with unequal
+// values, sorted lexically. Variables defined in only one of the two sets
+// are not considered to conflict.
+//
+// Values are compared using the cty "RawEquals" method, so unknown values
+// of the same type are considered equal to one another.
+func (vv InputValues) ConflictingValues(other InputValues) []string {
+	var ret []string
+	for k, v := range vv {
+		if ov, exists := other[k]; exists && !v.Value.RawEquals(ov.Value) {
+			ret = append(ret, k)
+		}
+	}
+	sort.Strings(ret)
+	return ret
+}
+
 // checkInputVariables ensures that variable values supplied at the UI conform
 // to their corresponding declarations in configuration.
 //
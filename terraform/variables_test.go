@@ -1,6 +1,7 @@
 package terraform
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/davecgh/go-spew/spew"
@@ -205,3 +206,44 @@ func TestSMCUserVariables(t *testing.T) {
 		t.Fatalf("unexpected errors: %s", diags.Err())
 	}
 }
+
+func TestInputValuesConflictingValues(t *testing.T) {
+	a := InputValues{
+		"same": &InputValue{
+			Value:      cty.StringVal("x"),
+			SourceType: ValueFromPlan,
+		},
+		"changed": &InputValue{
+			Value:      cty.StringVal("before"),
+			SourceType: ValueFromPlan,
+		},
+		"only-a": &InputValue{
+			Value:      cty.StringVal("a"),
+			SourceType: ValueFromPlan,
+		},
+	}
+	b := InputValues{
+		"same": &InputValue{
+			Value:      cty.StringVal("x"),
+			SourceType: ValueFromCLIArg,
+		},
+		"changed": &InputValue{
+			Value:      cty.StringVal("after"),
+			SourceType: ValueFromCLIArg,
+		},
+		"only-b": &InputValue{
+			Value:      cty.StringVal("b"),
+			SourceType: ValueFromCLIArg,
+		},
+	}
+
+	got := a.ConflictingValues(b)
+	want := []string{"changed"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("wrong result %#v; want %#v", got, want)
+	}
+
+	if got := a.ConflictingValues(nil); len(got) != 0 {
+		t.Errorf("wrong result %#v against nil; want none", got)
+	}
+}
@@ -0,0 +1,42 @@
+package tfdiags
+
+// Coded is an optional interface implemented by diagnostics that carry a
+// stable machine-readable code, allowing automation to recognize particular
+// classes of problem without matching on the human-oriented message text.
+type Coded interface {
+	// DiagnosticCode returns the machine-readable code for the diagnostic.
+	// Codes are lowercase hyphenated identifiers that remain stable across
+	// releases even if the associated messages are reworded.
+	DiagnosticCode() string
+}
+
+// CodeForDiagnostic returns the machine-readable code for the given
+// diagnostic, or an empty string if it has none.
+func CodeForDiagnostic(diag Diagnostic) string {
+	if coded, ok := diag.(Coded); ok {
+		return coded.DiagnosticCode()
+	}
+	return ""
+}
+
+// SourcelessWithCode is like Sourceless but additionally attaches the given
+// machine-readable code to the returned diagnostic.
+func SourcelessWithCode(severity Severity, code, summary, detail string) Diagnostic {
+	return codedDiagnostic{
+		diagnosticBase: diagnosticBase{
+			severity: severity,
+			summary:  summary,
+			detail:   detail,
+		},
+		code: code,
+	}
+}
+
+type codedDiagnostic struct {
+	diagnosticBase
+	code string
+}
+
+func (d codedDiagnostic) DiagnosticCode() string {
+	return d.code
+}
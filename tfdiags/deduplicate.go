@@ -0,0 +1,88 @@
+package tfdiags
+
+import "fmt"
+
+// Deduplicate returns a new diagnostics with any exact duplicates in the
+// receiver collapsed into a single diagnostic whose detail notes how many
+// times it occurred.
+//
+// Two diagnostics are considered duplicates if they have the same severity,
+// the same summary and detail, and the same source location. Parallel graph
+// walks can produce the same diagnostic once per instance of a problem, and
+// repeating it verbatim adds noise without adding information.
+//
+// The relative ordering of the distinct diagnostics is preserved, so callers
+// that want a deterministic result should call Sort first.
+//
+// This mechanism is here primarily for diagnostics printed out at the CLI.
+// In other contexts it is likely better to just return the diagnostics
+// directly, particularly if they are going to be interpreted by software
+// rather than by a human reader.
+func (diags Diagnostics) Deduplicate() Diagnostics {
+	if len(diags) == 0 {
+		return nil
+	}
+
+	newDiags := make(Diagnostics, 0, len(diags))
+	groups := make(map[string]*duplicateGroup)
+
+	for _, diag := range diags {
+		key := duplicateKey(diag)
+		if g, ok := groups[key]; ok {
+			g.Count++
+			continue
+		}
+		g := &duplicateGroup{Diagnostic: diag, Count: 1}
+		groups[key] = g
+		newDiags = newDiags.Append(g)
+	}
+
+	return newDiags
+}
+
+// duplicateKey produces a string identifying a diagnostic for deduplication
+// purposes. Diagnostics with equal keys are considered duplicates of one
+// another.
+func duplicateKey(diag Diagnostic) string {
+	desc := diag.Description()
+	src := diag.Source()
+	key := fmt.Sprintf("%s\x00%s\x00%s", diag.Severity(), desc.Summary, desc.Detail)
+	if src.Subject != nil {
+		key += "\x00" + src.Subject.StartString()
+	}
+	return key
+}
+
+// A duplicateGroup stands in for one or more identical diagnostics that have
+// been collapsed together by Deduplicate.
+//
+// A duplicateGroup with a count of one is just a passthrough for the wrapped
+// diagnostic. With a higher count it behaves like the wrapped diagnostic but
+// its detail message includes an additional sentence giving the number of
+// other occurrences.
+type duplicateGroup struct {
+	Diagnostic
+	Count int
+}
+
+var _ Diagnostic = (*duplicateGroup)(nil)
+
+func (dg *duplicateGroup) Description() Description {
+	desc := dg.Diagnostic.Description()
+	if dg.Count < 2 {
+		return desc
+	}
+	var msg string
+	switch extraCount := dg.Count - 1; extraCount {
+	case 1:
+		msg = "(and one more identical diagnostic)"
+	default:
+		msg = fmt.Sprintf("(and %d more identical diagnostics)", extraCount)
+	}
+	if desc.Detail != "" {
+		desc.Detail = desc.Detail + "\n\n" + msg
+	} else {
+		desc.Detail = msg
+	}
+	return desc
+}
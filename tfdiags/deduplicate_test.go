@@ -0,0 +1,87 @@
+package tfdiags
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/hcl/v2"
+)
+
+func TestDeduplicate(t *testing.T) {
+	var diags Diagnostics
+
+	// Three identical errors, as might arrive from parallel graph walks
+	// that each hit the same problem.
+	for i := 0; i < 3; i++ {
+		diags = diags.Append(&hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Error 1",
+			Detail:   "This one is repeated",
+			Subject: &hcl.Range{
+				Filename: "foo.tf",
+				Start:    hcl.Pos{Line: 1, Column: 1, Byte: 0},
+				End:      hcl.Pos{Line: 1, Column: 1, Byte: 0},
+			},
+		})
+	}
+
+	// An error with the same summary but a different location is not a
+	// duplicate.
+	diags = diags.Append(&hcl.Diagnostic{
+		Severity: hcl.DiagError,
+		Summary:  "Error 1",
+		Detail:   "This one is repeated",
+		Subject: &hcl.Range{
+			Filename: "bar.tf",
+			Start:    hcl.Pos{Line: 1, Column: 1, Byte: 0},
+			End:      hcl.Pos{Line: 1, Column: 1, Byte: 0},
+		},
+	})
+
+	// Two identical sourceless warnings, and one distinct.
+	for i := 0; i < 2; i++ {
+		diags = diags.Append(Sourceless(
+			Warning,
+			"Warning 1",
+			"This one is sourceless",
+		))
+	}
+	diags = diags.Append(SimpleWarning("Warning 2"))
+
+	got := diags.Deduplicate().ForRPC()
+	want := Diagnostics{
+		&rpcFriendlyDiag{
+			Severity_: Error,
+			Summary_:  "Error 1",
+			Detail_:   "This one is repeated\n\n(and 2 more identical diagnostics)",
+			Subject_: &SourceRange{
+				Filename: "foo.tf",
+				Start:    SourcePos{Line: 1, Column: 1, Byte: 0},
+				End:      SourcePos{Line: 1, Column: 1, Byte: 0},
+			},
+		},
+		&rpcFriendlyDiag{
+			Severity_: Error,
+			Summary_:  "Error 1",
+			Detail_:   "This one is repeated",
+			Subject_: &SourceRange{
+				Filename: "bar.tf",
+				Start:    SourcePos{Line: 1, Column: 1, Byte: 0},
+				End:      SourcePos{Line: 1, Column: 1, Byte: 0},
+			},
+		},
+		&rpcFriendlyDiag{
+			Severity_: Warning,
+			Summary_:  "Warning 1",
+			Detail_:   "This one is sourceless\n\n(and one more identical diagnostic)",
+		},
+		&rpcFriendlyDiag{
+			Severity_: Warning,
+			Summary_:  "Warning 2",
+		},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("wrong result\n%s", diff)
+	}
+}
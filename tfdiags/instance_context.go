@@ -0,0 +1,53 @@
+package tfdiags
+
+// InstanceContext describes the object instance that was being evaluated
+// when a diagnostic was produced, for diagnostics from expression
+// evaluation of "count" or "for_each" objects whose messages would
+// otherwise not identify which instance failed.
+type InstanceContext struct {
+	// ModuleAddr is the address of the module instance that was being
+	// evaluated, or the empty string for the root module.
+	ModuleAddr string
+
+	// InstanceKey is a string representation of the instance key that was
+	// active during evaluation: a decimal integer for counted objects, or
+	// the each key for for_each objects.
+	InstanceKey string
+}
+
+// WithInstanceContext returns a copy of the receiver with the given
+// instance context attached to each error diagnostic that doesn't already
+// have one. Warnings are returned unchanged.
+func (diags Diagnostics) WithInstanceContext(instCtx InstanceContext) Diagnostics {
+	if len(diags) == 0 {
+		return diags
+	}
+
+	ret := make(Diagnostics, len(diags))
+	for i, diag := range diags {
+		if diag.Severity() == Error && InstanceContextForDiagnostic(diag) == nil {
+			ret[i] = instanceContextDiagnostic{diag, instCtx}
+		} else {
+			ret[i] = diag
+		}
+	}
+	return ret
+}
+
+// InstanceContextForDiagnostic returns the instance context attached to
+// the given diagnostic, or nil if it has none.
+func InstanceContextForDiagnostic(diag Diagnostic) *InstanceContext {
+	if d, ok := diag.(instanceContextDiagnostic); ok {
+		instCtx := d.instCtx // copy, so the caller can't modify our own
+		return &instCtx
+	}
+	return nil
+}
+
+// instanceContextDiagnostic wraps another diagnostic to attach an
+// InstanceContext, delegating all of the Diagnostic methods to the wrapped
+// diagnostic.
+type instanceContextDiagnostic struct {
+	Diagnostic
+	instCtx InstanceContext
+}
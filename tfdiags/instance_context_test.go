@@ -0,0 +1,33 @@
+package tfdiags
+
+import (
+	"testing"
+)
+
+func TestWithInstanceContext(t *testing.T) {
+	var diags Diagnostics
+	diags = diags.Append(Sourceless(Error, "bad thing", "it went wrong"))
+	diags = diags.Append(SimpleWarning("meh"))
+
+	instCtx := InstanceContext{
+		ModuleAddr:  `module.child["a"]`,
+		InstanceKey: "2",
+	}
+	diags = diags.WithInstanceContext(instCtx)
+
+	if got := InstanceContextForDiagnostic(diags[0]); got == nil {
+		t.Fatal("error diagnostic has no instance context")
+	} else if *got != instCtx {
+		t.Errorf("wrong instance context %#v; want %#v", *got, instCtx)
+	}
+	if got := InstanceContextForDiagnostic(diags[1]); got != nil {
+		t.Errorf("warning diagnostic has instance context %#v; want none", *got)
+	}
+
+	// Attaching again must not replace the existing context.
+	other := InstanceContext{ModuleAddr: "", InstanceKey: "0"}
+	diags = diags.WithInstanceContext(other)
+	if got := InstanceContextForDiagnostic(diags[0]); got == nil || *got != instCtx {
+		t.Errorf("instance context was replaced; got %#v, want %#v", got, instCtx)
+	}
+}
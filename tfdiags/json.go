@@ -0,0 +1,134 @@
+package tfdiags
+
+// This file defines a JSON-serializable representation of diagnostics,
+// for use by callers that produce machine-readable output. The field names
+// here are part of Terraform's documented machine-readable output formats,
+// so they must remain stable across releases.
+
+// DiagnosticJSON is a JSON-serializable representation of a single
+// Diagnostic, as produced by DiagnosticToJSON.
+type DiagnosticJSON struct {
+	Severity string                 `json:"severity"`
+	Summary  string                 `json:"summary"`
+	Detail   string                 `json:"detail,omitempty"`
+	Code     string                 `json:"code,omitempty"`
+	Range    *SourceRangeJSON       `json:"range,omitempty"`
+	Snippet  *DiagnosticSnippetJSON `json:"snippet,omitempty"`
+}
+
+// SourceRangeJSON is a JSON-serializable representation of a SourceRange.
+type SourceRangeJSON struct {
+	Filename string        `json:"filename"`
+	Start    SourcePosJSON `json:"start"`
+	End      SourcePosJSON `json:"end"`
+}
+
+// SourcePosJSON is a JSON-serializable representation of a SourcePos.
+type SourcePosJSON struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+	Byte   int `json:"byte"`
+}
+
+// DiagnosticSnippetJSON is an extract of the source code surrounding the
+// subject of a diagnostic, allowing consumers to show the offending code
+// without re-reading the source files themselves.
+type DiagnosticSnippetJSON struct {
+	// Code is the full text of the source lines the diagnostic's subject
+	// range touches, without a trailing newline.
+	Code string `json:"code"`
+
+	// StartLine is the line number of the first line included in Code,
+	// matching the line numbering used in Range.
+	StartLine int `json:"start_line"`
+
+	// HighlightStartOffset and HighlightEndOffset are byte offsets into
+	// Code delimiting the diagnostic's subject range.
+	HighlightStartOffset int `json:"highlight_start_offset"`
+	HighlightEndOffset   int `json:"highlight_end_offset"`
+}
+
+// DiagnosticToJSON returns a JSON-serializable representation of the given
+// diagnostic, including a source code snippet if the diagnostic has a
+// subject range and its source file is present in the given sources map.
+// The sources map may be nil, in which case no snippets are produced.
+func DiagnosticToJSON(diag Diagnostic, sources map[string][]byte) *DiagnosticJSON {
+	ret := &DiagnosticJSON{
+		Code: CodeForDiagnostic(diag),
+	}
+
+	switch diag.Severity() {
+	case Error:
+		ret.Severity = "error"
+	case Warning:
+		ret.Severity = "warning"
+	default:
+		ret.Severity = "unknown"
+	}
+
+	desc := diag.Description()
+	ret.Summary = desc.Summary
+	ret.Detail = desc.Detail
+
+	if subject := diag.Source().Subject; subject != nil {
+		ret.Range = &SourceRangeJSON{
+			Filename: subject.Filename,
+			Start: SourcePosJSON{
+				Line:   subject.Start.Line,
+				Column: subject.Start.Column,
+				Byte:   subject.Start.Byte,
+			},
+			End: SourcePosJSON{
+				Line:   subject.End.Line,
+				Column: subject.End.Column,
+				Byte:   subject.End.Byte,
+			},
+		}
+		ret.Snippet = snippetForRange(subject, sources)
+	}
+
+	return ret
+}
+
+// ToJSON returns JSON-serializable representations of all of the
+// diagnostics in the receiver, in the same order.
+func (diags Diagnostics) ToJSON(sources map[string][]byte) []*DiagnosticJSON {
+	// Make sure this always serializes as an array, since that's easier
+	// to consume for dynamically-typed languages.
+	ret := make([]*DiagnosticJSON, 0, len(diags))
+	for _, diag := range diags {
+		ret = append(ret, DiagnosticToJSON(diag, sources))
+	}
+	return ret
+}
+
+// snippetForRange extracts the source lines touched by the given range from
+// the given sources map, returning nil if the relevant source isn't
+// available or the range's byte offsets don't fit within it.
+func snippetForRange(rng *SourceRange, sources map[string][]byte) *DiagnosticSnippetJSON {
+	src, ok := sources[rng.Filename]
+	if !ok {
+		return nil
+	}
+	if rng.Start.Byte < 0 || rng.End.Byte < rng.Start.Byte || rng.End.Byte > len(src) {
+		return nil
+	}
+
+	// Widen the range to cover whole lines, so that the snippet gives some
+	// context around the highlighted portion.
+	start := rng.Start.Byte
+	for start > 0 && src[start-1] != '\n' {
+		start--
+	}
+	end := rng.End.Byte
+	for end < len(src) && src[end] != '\n' {
+		end++
+	}
+
+	return &DiagnosticSnippetJSON{
+		Code:                 string(src[start:end]),
+		StartLine:            rng.Start.Line,
+		HighlightStartOffset: rng.Start.Byte - start,
+		HighlightEndOffset:   rng.End.Byte - start,
+	}
+}
@@ -0,0 +1,82 @@
+package tfdiags
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+)
+
+func TestDiagnosticToJSON(t *testing.T) {
+	src := "resource \"x\" \"y\" {\n  foo = 1\n}\n"
+	sources := map[string][]byte{
+		"test.tf": []byte(src),
+	}
+
+	var diags Diagnostics
+	diags = diags.Append(&hcl.Diagnostic{
+		Severity: hcl.DiagError,
+		Summary:  "Something bad",
+		Detail:   "It was quite bad indeed.",
+		Subject: &hcl.Range{
+			Filename: "test.tf",
+			Start:    hcl.Pos{Line: 2, Column: 3, Byte: 21},
+			End:      hcl.Pos{Line: 2, Column: 6, Byte: 24},
+		},
+	})
+	diags = diags.Append(SourcelessWithCode(
+		Warning,
+		"test-code",
+		"Something questionable",
+		"You might want to look at this.",
+	))
+
+	got := diags.ToJSON(sources)
+	want := []*DiagnosticJSON{
+		{
+			Severity: "error",
+			Summary:  "Something bad",
+			Detail:   "It was quite bad indeed.",
+			Range: &SourceRangeJSON{
+				Filename: "test.tf",
+				Start:    SourcePosJSON{Line: 2, Column: 3, Byte: 21},
+				End:      SourcePosJSON{Line: 2, Column: 6, Byte: 24},
+			},
+			Snippet: &DiagnosticSnippetJSON{
+				Code:                 "  foo = 1",
+				StartLine:            2,
+				HighlightStartOffset: 2,
+				HighlightEndOffset:   5,
+			},
+		},
+		{
+			Severity: "warning",
+			Summary:  "Something questionable",
+			Detail:   "You might want to look at this.",
+			Code:     "test-code",
+		},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("wrong result\ngot:  %#v\nwant: %#v", got, want)
+		for i := range got {
+			t.Logf("got[%d]:  %#v", i, got[i])
+			if got[i].Range != nil {
+				t.Logf("  range: %#v snippet: %#v", got[i].Range, got[i].Snippet)
+			}
+		}
+	}
+}
+
+func TestDiagnosticToJSONNoSource(t *testing.T) {
+	diag := Sourceless(Error, "Boom", "It exploded.")
+	got := DiagnosticToJSON(diag, nil)
+	want := &DiagnosticJSON{
+		Severity: "error",
+		Summary:  "Boom",
+		Detail:   "It exploded.",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("wrong result\ngot:  %#v\nwant: %#v", got, want)
+	}
+}
@@ -0,0 +1,19 @@
+// Package tfexec provides a high-level API for embedding Terraform
+// operations in other Go programs.
+//
+// It wraps configuration loading, state management, and the Terraform
+// language runtime into a small set of entry points — Validate, Plan, and
+// Apply — that accept a configuration directory and an Options value and
+// return typed results along with diagnostics, so that callers need not
+// interact with the graph engine or the other internal packages directly.
+//
+// This package is the supported surface for in-process embedding. The
+// packages it is built from (terraform, configs, states, plans, and so on)
+// are implementation details whose APIs change between releases without
+// notice; this package aims to keep its own API backward-compatible, with
+// any future breaking change called out in the changelog.
+//
+// The caller is responsible for supplying resolved provider plugins via
+// Options.Providers, since plugin discovery and installation is a concern
+// of the CLI working-directory model that does not apply to embedders.
+package tfexec
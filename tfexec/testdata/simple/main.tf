@@ -0,0 +1,11 @@
+variable "greeting" {
+  default = "hello"
+}
+
+resource "test_thing" "a" {
+  message = var.greeting
+}
+
+output "message" {
+  value = test_thing.a.message
+}
@@ -0,0 +1,331 @@
+package tfexec
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/configs"
+	"github.com/hashicorp/terraform/configs/configload"
+	"github.com/hashicorp/terraform/plans"
+	"github.com/hashicorp/terraform/providers"
+	"github.com/hashicorp/terraform/states"
+	"github.com/hashicorp/terraform/states/statemgr"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/terraform/tfdiags"
+)
+
+// Options configures the operations in this package. The zero value is
+// valid for configurations that use no providers or provisioners: state is
+// kept in "terraform.tfstate" inside the configuration directory and all
+// settings take their usual defaults.
+type Options struct {
+	// Providers resolves the provider plugins required by the
+	// configuration. It is required for any configuration that declares
+	// or implies a provider dependency.
+	Providers providers.Resolver
+
+	// Provisioners are factories for the provisioners available to the
+	// configuration, keyed by provisioner type name. Configurations that
+	// use no provisioners may leave this nil.
+	Provisioners map[string]terraform.ProvisionerFactory
+
+	// StatePath is the path of the state file to read and update. If
+	// empty, it defaults to "terraform.tfstate" inside the configuration
+	// directory.
+	StatePath string
+
+	// Variables provides values for the root module's input variables.
+	// Any required variable without an entry here causes an error
+	// diagnostic.
+	Variables map[string]cty.Value
+
+	// SkipRefresh, when set, prevents Plan and Apply from refreshing the
+	// state against the real remote objects before computing changes.
+	SkipRefresh bool
+
+	// Destroy, when set, makes Plan and Apply propose and execute the
+	// destruction of all managed objects, as "terraform destroy" would.
+	Destroy bool
+
+	// Parallelism limits the number of concurrent operations during graph
+	// walks. Zero selects the same default as the CLI.
+	Parallelism int
+
+	// Hooks receive lifecycle callbacks as an operation progresses, for
+	// callers that want to report progress in their own UI.
+	Hooks []terraform.Hook
+}
+
+// PlanResult is the result of a successful call to Plan.
+type PlanResult struct {
+	// Plan describes the proposed changes.
+	Plan *plans.Plan
+
+	// State is the state the plan was computed against, after any
+	// refresh. It is not persisted; the plan itself does not change
+	// the state file.
+	State *states.State
+}
+
+// ApplyResult is the result of a call to Apply.
+type ApplyResult struct {
+	// State is the state after applying, which has also been persisted
+	// to the state file. It may reflect a partial result if the returned
+	// diagnostics contain errors.
+	State *states.State
+}
+
+// Validate loads the configuration in the given directory and checks that
+// it is syntactically valid and internally consistent, without reading any
+// state or contacting any remote system. Provider schemas are consulted,
+// so Options.Providers must be set for configurations that use providers.
+func Validate(ctx context.Context, dir string, opts *Options) tfdiags.Diagnostics {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	var diags tfdiags.Diagnostics
+	cfg, moreDiags := loadConfig(dir)
+	diags = diags.Append(moreDiags)
+	if diags.HasErrors() {
+		return diags
+	}
+
+	tfCtx, ctxDiags := terraform.NewContext(&terraform.ContextOpts{
+		Config:           cfg,
+		Variables:        unknownVariables(cfg),
+		ProviderResolver: opts.Providers,
+		Provisioners:     opts.Provisioners,
+		Parallelism:      opts.Parallelism,
+	})
+	diags = diags.Append(ctxDiags)
+	if ctxDiags.HasErrors() {
+		return diags
+	}
+
+	done := watchCancel(ctx, tfCtx)
+	defer done()
+
+	return diags.Append(tfCtx.Validate())
+}
+
+// Plan loads the configuration in the given directory and computes an
+// execution plan describing the changes needed to make the real objects
+// match it. The state file is read but not modified.
+func Plan(ctx context.Context, dir string, opts *Options) (*PlanResult, tfdiags.Diagnostics) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	r, diags := newRun(ctx, dir, opts, "plan")
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	defer r.close()
+
+	plan, state, moreDiags := r.plan(opts)
+	diags = diags.Append(moreDiags)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	return &PlanResult{Plan: plan, State: state}, diags
+}
+
+// Apply loads the configuration in the given directory, computes an
+// execution plan for it, and then applies that plan, persisting the
+// resulting state to the state file. There is no opportunity to inspect
+// the plan between the two steps; callers that need approval workflows
+// should call Plan first and only call Apply once satisfied, accepting
+// that the changes are re-computed.
+//
+// Even when the returned diagnostics contain errors the result may carry
+// a partially-updated state, which has been persisted.
+func Apply(ctx context.Context, dir string, opts *Options) (*ApplyResult, tfdiags.Diagnostics) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	r, diags := newRun(ctx, dir, opts, "apply")
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	defer r.close()
+
+	_, _, moreDiags := r.plan(opts)
+	diags = diags.Append(moreDiags)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	state, applyDiags := r.tfCtx.Apply()
+	diags = diags.Append(applyDiags)
+	if state == nil {
+		// Apply failed before producing any state at all; the prior
+		// state is untouched so there is nothing to persist.
+		return nil, diags
+	}
+
+	if err := r.sm.WriteState(state); err != nil {
+		diags = diags.Append(fmt.Errorf("failed to write state: %s", err))
+		return &ApplyResult{State: state}, diags
+	}
+	if err := r.sm.PersistState(); err != nil {
+		diags = diags.Append(fmt.Errorf("failed to persist state: %s", err))
+	}
+
+	return &ApplyResult{State: state}, diags
+}
+
+// run carries the working objects shared by the Plan and Apply entry
+// points: a configured terraform.Context, the state manager holding the
+// lock on the state file, and the cancellation watcher.
+type run struct {
+	tfCtx      *terraform.Context
+	sm         statemgr.Full
+	lockID     string
+	cancelDone func()
+}
+
+func newRun(ctx context.Context, dir string, opts *Options, operation string) (*run, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	cfg, moreDiags := loadConfig(dir)
+	diags = diags.Append(moreDiags)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	statePath := opts.StatePath
+	if statePath == "" {
+		statePath = filepath.Join(dir, "terraform.tfstate")
+	}
+	sm := statemgr.NewFilesystem(statePath)
+
+	lockInfo := statemgr.NewLockInfo()
+	lockInfo.Operation = operation
+	lockID, err := statemgr.LockWithContext(ctx, sm, lockInfo)
+	if err != nil {
+		diags = diags.Append(fmt.Errorf("failed to lock state: %s", err))
+		return nil, diags
+	}
+
+	if err := sm.RefreshState(); err != nil {
+		sm.Unlock(lockID)
+		diags = diags.Append(fmt.Errorf("failed to read state: %s", err))
+		return nil, diags
+	}
+
+	variables := make(terraform.InputValues, len(opts.Variables))
+	for name, value := range opts.Variables {
+		variables[name] = &terraform.InputValue{
+			Value:      value,
+			SourceType: terraform.ValueFromCaller,
+		}
+	}
+
+	tfCtx, ctxDiags := terraform.NewContext(&terraform.ContextOpts{
+		Config:           cfg,
+		State:            sm.State(),
+		Variables:        variables,
+		Destroy:          opts.Destroy,
+		ProviderResolver: opts.Providers,
+		Provisioners:     opts.Provisioners,
+		Parallelism:      opts.Parallelism,
+		Hooks:            opts.Hooks,
+	})
+	diags = diags.Append(ctxDiags)
+	if ctxDiags.HasErrors() {
+		sm.Unlock(lockID)
+		return nil, diags
+	}
+
+	return &run{
+		tfCtx:      tfCtx,
+		sm:         sm,
+		lockID:     lockID,
+		cancelDone: watchCancel(ctx, tfCtx),
+	}, diags
+}
+
+// plan optionally refreshes and then computes the plan, leaving the
+// receiver's context ready for a subsequent Apply. It returns the plan
+// and the state it was computed against.
+func (r *run) plan(opts *Options) (*plans.Plan, *states.State, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	state := r.tfCtx.State()
+	if !opts.SkipRefresh {
+		refreshed, refreshDiags := r.tfCtx.Refresh()
+		diags = diags.Append(refreshDiags)
+		if diags.HasErrors() {
+			return nil, nil, diags
+		}
+		state = refreshed
+	}
+
+	plan, planDiags := r.tfCtx.Plan()
+	diags = diags.Append(planDiags)
+	if diags.HasErrors() {
+		return nil, nil, diags
+	}
+
+	return plan, state, diags
+}
+
+func (r *run) close() {
+	r.cancelDone()
+	r.sm.Unlock(r.lockID)
+}
+
+func loadConfig(dir string) (*configs.Config, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	loader, err := configload.NewLoader(&configload.Config{
+		ModulesDir: filepath.Join(dir, ".terraform", "modules"),
+	})
+	if err != nil {
+		diags = diags.Append(fmt.Errorf("failed to initialize configuration loader: %s", err))
+		return nil, diags
+	}
+
+	cfg, hclDiags := loader.LoadConfig(dir)
+	diags = diags.Append(hclDiags)
+	return cfg, diags
+}
+
+// unknownVariables produces an unknown value for each of the root module's
+// input variables so that validation can type-check the configuration
+// without assuming any particular values.
+func unknownVariables(cfg *configs.Config) terraform.InputValues {
+	ret := make(terraform.InputValues)
+	for name, variable := range cfg.Module.Variables {
+		ty := variable.Type
+		if ty == cty.NilType {
+			ty = cty.DynamicPseudoType
+		}
+		ret[name] = &terraform.InputValue{
+			Value:      cty.UnknownVal(ty),
+			SourceType: terraform.ValueFromCaller,
+		}
+	}
+	return ret
+}
+
+// watchCancel arranges for the terraform.Context to be gracefully stopped
+// if the given context.Context is cancelled, and returns a function that
+// must be called once the operation completes to release the watcher.
+func watchCancel(ctx context.Context, tfCtx *terraform.Context) func() {
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			tfCtx.Stop()
+		case <-done:
+		}
+	}()
+	return func() { close(done) }
+}
@@ -0,0 +1,141 @@
+package tfexec
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/hashicorp/terraform/plans"
+	"github.com/hashicorp/terraform/providers"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testOptions(t *testing.T) *Options {
+	p := &terraform.MockProvider{}
+	p.GetSchemaReturn = &terraform.ProviderSchema{
+		ResourceTypes: map[string]*configschema.Block{
+			"test_thing": {
+				Attributes: map[string]*configschema.Attribute{
+					"id":      {Type: cty.String, Computed: true},
+					"message": {Type: cty.String, Optional: true},
+				},
+			},
+		},
+	}
+	p.PlanResourceChangeFn = func(req providers.PlanResourceChangeRequest) providers.PlanResourceChangeResponse {
+		planned := req.ProposedNewState
+		if planned.GetAttr("id").IsNull() {
+			vals := planned.AsValueMap()
+			vals["id"] = cty.UnknownVal(cty.String)
+			planned = cty.ObjectVal(vals)
+		}
+		return providers.PlanResourceChangeResponse{
+			PlannedState: planned,
+		}
+	}
+	p.ApplyResourceChangeFn = func(req providers.ApplyResourceChangeRequest) providers.ApplyResourceChangeResponse {
+		newVal := req.PlannedState
+		if newVal.GetAttr("id").IsNull() || !newVal.GetAttr("id").IsKnown() {
+			vals := newVal.AsValueMap()
+			vals["id"] = cty.StringVal("placeholder")
+			newVal = cty.ObjectVal(vals)
+		}
+		return providers.ApplyResourceChangeResponse{
+			NewState: newVal,
+		}
+	}
+
+	return &Options{
+		Providers: providers.ResolverFixed(
+			map[addrs.Provider]providers.Factory{
+				addrs.NewLegacyProvider("test"): providers.FactoryFixed(p),
+			},
+		),
+		SkipRefresh: true,
+	}
+}
+
+// testConfigDir copies the named fixture into a temporary directory so that
+// the state file written by Apply doesn't pollute testdata.
+func testConfigDir(t *testing.T, fixture string) string {
+	t.Helper()
+	dir := t.TempDir()
+	src := filepath.Join("testdata", fixture, "main.tf")
+	data, err := ioutil.ReadFile(src)
+	if err != nil {
+		t.Fatalf("failed to read fixture: %s", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "main.tf"), data, 0644); err != nil {
+		t.Fatalf("failed to copy fixture: %s", err)
+	}
+	return dir
+}
+
+func TestValidate(t *testing.T) {
+	dir := testConfigDir(t, "simple")
+	diags := Validate(context.Background(), dir, testOptions(t))
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+}
+
+func TestPlanApply(t *testing.T) {
+	dir := testConfigDir(t, "simple")
+	opts := testOptions(t)
+	opts.Variables = map[string]cty.Value{
+		"greeting": cty.StringVal("howdy"),
+	}
+
+	planResult, diags := Plan(context.Background(), dir, opts)
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors from Plan: %s", diags.Err())
+	}
+	var rChanges []*plans.ResourceInstanceChangeSrc
+	for _, rc := range planResult.Plan.Changes.Resources {
+		rChanges = append(rChanges, rc)
+	}
+	if len(rChanges) != 1 {
+		t.Fatalf("wrong number of planned resource changes %d; want 1", len(rChanges))
+	}
+	if got, want := rChanges[0].Action, plans.Create; got != want {
+		t.Errorf("wrong planned action %s; want %s", got, want)
+	}
+
+	// Plan must not create a state file.
+	statePath := filepath.Join(dir, "terraform.tfstate")
+	if _, err := os.Stat(statePath); !os.IsNotExist(err) {
+		t.Fatalf("state file exists after Plan; should not")
+	}
+
+	applyResult, diags := Apply(context.Background(), dir, opts)
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors from Apply: %s", diags.Err())
+	}
+
+	addr := addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_thing",
+		Name: "a",
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+	if applyResult.State.ResourceInstance(addr) == nil {
+		t.Fatalf("no instance for %s in final state", addr)
+	}
+	if _, err := os.Stat(statePath); err != nil {
+		t.Fatalf("state file was not persisted: %s", err)
+	}
+
+	// A further plan against the persisted state should propose no changes.
+	planResult, diags = Plan(context.Background(), dir, opts)
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors from second Plan: %s", diags.Err())
+	}
+	if !planResult.Plan.Changes.Empty() {
+		t.Errorf("second plan is not empty:\n%#v", planResult.Plan.Changes)
+	}
+}
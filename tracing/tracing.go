@@ -0,0 +1,238 @@
+// Package tracing provides a lightweight tracing facility modeled on the
+// OpenTelemetry span data model, used to instrument long-running operations
+// such as graph walks, provider RPCs, and state persistence.
+//
+// Finished spans are exported as JSON objects, one per line, with field
+// names following the OpenTelemetry protocol conventions (trace_id,
+// span_id, parent_span_id, *_time_unix_nano), so the output can be
+// translated into OTLP and ingested by OpenTelemetry-compatible tooling.
+// Keeping the encoder local avoids a dependency on the full OpenTelemetry
+// SDK for what is, for Terraform's purposes, a simple write-only exporter.
+//
+// Tracing is disabled unless the TF_TRACE_FILE environment variable names
+// a file to append spans to. All span operations are safe to call on nil
+// receivers so that instrumentation points need no enablement checks.
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// TraceFileEnvVar is the environment variable that enables tracing by
+// naming the file that finished spans are appended to.
+const TraceFileEnvVar = "TF_TRACE_FILE"
+
+// Tracer creates spans and exports them to a single destination. All
+// methods are safe for concurrent use.
+type Tracer struct {
+	mu      sync.Mutex
+	w       io.Writer
+	closer  io.Closer
+	traceID string
+}
+
+// NewTracer returns a tracer that exports finished spans to the given
+// writer, all under a single newly-minted trace ID.
+func NewTracer(w io.Writer) *Tracer {
+	return &Tracer{
+		w:       w,
+		traceID: newTraceableID(16),
+	}
+}
+
+// Open creates a tracer exporting to the file named by TraceFileEnvVar,
+// or nil if the variable is unset.
+func Open() (*Tracer, error) {
+	path := os.Getenv(TraceFileEnvVar)
+	if path == "" {
+		return nil, nil
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	t := NewTracer(f)
+	t.closer = f
+	return t, nil
+}
+
+// Close flushes and closes the tracer's destination, if it owns one.
+func (t *Tracer) Close() error {
+	if t == nil || t.closer == nil {
+		return nil
+	}
+	return t.closer.Close()
+}
+
+var (
+	globalOnce   sync.Once
+	globalTracer *Tracer
+)
+
+// Global returns the process-wide tracer configured from the environment,
+// or nil if tracing is not enabled. Errors opening the destination are
+// treated as tracing being disabled, since tracing is advisory.
+func Global() *Tracer {
+	globalOnce.Do(func() {
+		t, err := Open()
+		if err != nil {
+			return
+		}
+		globalTracer = t
+	})
+	return globalTracer
+}
+
+type contextKey int
+
+const spanContextKey contextKey = 0
+
+// SpanFromContext returns the span recorded in the given context, or nil.
+func SpanFromContext(ctx context.Context) *Span {
+	if ctx == nil {
+		return nil
+	}
+	span, _ := ctx.Value(spanContextKey).(*Span)
+	return span
+}
+
+// StartSpan begins a new span as a child of whichever span is recorded in
+// the given context, using that span's tracer. If the context carries no
+// span then the global tracer is used, and if tracing is disabled entirely
+// then the original context and a nil span are returned; a nil span accepts
+// all Span method calls as no-ops.
+func StartSpan(ctx context.Context, name string) (context.Context, *Span) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	parent := SpanFromContext(ctx)
+	tracer := Global()
+	if parent != nil {
+		tracer = parent.tracer
+	}
+	if tracer == nil {
+		return ctx, nil
+	}
+
+	span := &Span{
+		tracer: tracer,
+		name:   name,
+		spanID: newTraceableID(8),
+		start:  time.Now(),
+	}
+	if parent != nil {
+		span.parentID = parent.spanID
+	}
+
+	return context.WithValue(ctx, spanContextKey, span), span
+}
+
+// Span is a single named, timed operation within a trace. Spans are
+// created with StartSpan and exported when End is called.
+type Span struct {
+	tracer   *Tracer
+	name     string
+	spanID   string
+	parentID string
+	start    time.Time
+
+	mu      sync.Mutex
+	attrs   map[string]interface{}
+	failed  bool
+	errText string
+}
+
+// SetAttribute records a key/value attribute on the span.
+func (s *Span) SetAttribute(key string, value interface{}) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.attrs == nil {
+		s.attrs = make(map[string]interface{})
+	}
+	s.attrs[key] = value
+}
+
+// RecordError marks the span's status as an error, recording the error
+// message.
+func (s *Span) RecordError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failed = true
+	s.errText = err.Error()
+}
+
+// spanJSON is the exported representation of a finished span, with field
+// names following the OpenTelemetry protocol conventions.
+type spanJSON struct {
+	Name              string                 `json:"name"`
+	TraceID           string                 `json:"trace_id"`
+	SpanID            string                 `json:"span_id"`
+	ParentSpanID      string                 `json:"parent_span_id,omitempty"`
+	StartTimeUnixNano int64                  `json:"start_time_unix_nano"`
+	EndTimeUnixNano   int64                  `json:"end_time_unix_nano"`
+	Status            string                 `json:"status"`
+	StatusMessage     string                 `json:"status_message,omitempty"`
+	Attributes        map[string]interface{} `json:"attributes,omitempty"`
+}
+
+// End finishes the span and exports it. A span must be ended exactly once,
+// after which it must not be used further.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+	end := time.Now()
+
+	s.mu.Lock()
+	out := spanJSON{
+		Name:              s.name,
+		TraceID:           s.tracer.traceID,
+		SpanID:            s.spanID,
+		ParentSpanID:      s.parentID,
+		StartTimeUnixNano: s.start.UnixNano(),
+		EndTimeUnixNano:   end.UnixNano(),
+		Status:            "ok",
+		Attributes:        s.attrs,
+	}
+	if s.failed {
+		out.Status = "error"
+		out.StatusMessage = s.errText
+	}
+	s.mu.Unlock()
+
+	src, err := json.Marshal(&out)
+	if err != nil {
+		// Attributes of an unmarshalable type; drop the span rather than
+		// failing the operation it describes.
+		return
+	}
+	src = append(src, '\n')
+
+	s.tracer.mu.Lock()
+	s.tracer.w.Write(src)
+	s.tracer.mu.Unlock()
+}
+
+func newTraceableID(byteLen int) string {
+	buf := make([]byte, byteLen)
+	if _, err := rand.Read(buf); err != nil {
+		// Degrade to a zero ID; tracing is advisory and must not fail the
+		// operation being traced.
+		return hex.EncodeToString(buf)
+	}
+	return hex.EncodeToString(buf)
+}
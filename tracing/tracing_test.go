@@ -0,0 +1,107 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func testStartSpan(t *testing.T, tracer *Tracer, ctx context.Context, name string) (context.Context, *Span) {
+	t.Helper()
+
+	// StartSpan normally discovers the tracer through the context or the
+	// process environment; tests inject one by seeding the context with a
+	// root span instead, so they don't depend on global state.
+	span := &Span{
+		tracer: tracer,
+		name:   name,
+		spanID: newTraceableID(8),
+	}
+	if parent := SpanFromContext(ctx); parent != nil {
+		span.parentID = parent.spanID
+	}
+	return context.WithValue(ctx, spanContextKey, span), span
+}
+
+func TestSpanExport(t *testing.T) {
+	var buf bytes.Buffer
+	tracer := NewTracer(&buf)
+
+	ctx, parent := testStartSpan(t, tracer, context.Background(), "walk")
+	parent.SetAttribute("graph.vertices", 3)
+
+	childCtx, child := StartSpan(ctx, "eval")
+	if child == nil {
+		t.Fatalf("child span is nil despite the context carrying a tracer")
+	}
+	if got := SpanFromContext(childCtx); got != child {
+		t.Errorf("child context does not carry the child span")
+	}
+	child.RecordError(errors.New("boom"))
+	child.End()
+	parent.End()
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("wrong number of exported spans %d; want 2", len(lines))
+	}
+
+	var childOut, parentOut spanJSON
+	if err := json.Unmarshal([]byte(lines[0]), &childOut); err != nil {
+		t.Fatalf("invalid JSON for child span: %s", err)
+	}
+	if err := json.Unmarshal([]byte(lines[1]), &parentOut); err != nil {
+		t.Fatalf("invalid JSON for parent span: %s", err)
+	}
+
+	if childOut.Name != "eval" {
+		t.Errorf("wrong child span name %q; want %q", childOut.Name, "eval")
+	}
+	if childOut.ParentSpanID != parentOut.SpanID {
+		t.Errorf("child parent_span_id %q does not match parent span_id %q", childOut.ParentSpanID, parentOut.SpanID)
+	}
+	if childOut.TraceID != parentOut.TraceID {
+		t.Errorf("child and parent have different trace IDs")
+	}
+	if childOut.Status != "error" || childOut.StatusMessage != "boom" {
+		t.Errorf("wrong child status %q/%q; want error/boom", childOut.Status, childOut.StatusMessage)
+	}
+	if parentOut.Status != "ok" {
+		t.Errorf("wrong parent status %q; want ok", parentOut.Status)
+	}
+	if got := parentOut.Attributes["graph.vertices"]; got != float64(3) {
+		t.Errorf("wrong graph.vertices attribute %#v; want 3", got)
+	}
+}
+
+func TestSpanNil(t *testing.T) {
+	// All Span methods must be no-ops on a nil receiver so that
+	// instrumentation points need no enablement checks.
+	var span *Span
+	span.SetAttribute("k", "v")
+	span.RecordError(errors.New("boom"))
+	span.End()
+
+	var tracer *Tracer
+	if err := tracer.Close(); err != nil {
+		t.Errorf("unexpected error closing nil tracer: %s", err)
+	}
+}
+
+func TestStartSpanDisabled(t *testing.T) {
+	// With no tracer in the context and none configured in the
+	// environment, StartSpan must return the original context unchanged.
+	ctx := context.Background()
+	gotCtx, span := StartSpan(ctx, "anything")
+	if Global() == nil {
+		if span != nil {
+			t.Errorf("got a span despite tracing being disabled")
+		}
+		if gotCtx != ctx {
+			t.Errorf("context was replaced despite tracing being disabled")
+		}
+	}
+}